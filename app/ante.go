@@ -5,22 +5,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	pairingante "github.com/lavanet/lava/x/pairing/ante"
 )
 
-func NewAnteHandler(accountKeeper ante.AccountKeeper, bankKeeper authtypes.BankKeeper, signModeHandler signing.SignModeHandler, feegrantKeeper ante.FeegrantKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer) sdk.AnteHandler {
+func NewAnteHandler(accountKeeper ante.AccountKeeper, bankKeeper authtypes.BankKeeper, signModeHandler signing.SignModeHandler, feegrantKeeper ante.FeegrantKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer, pairingKeeper pairingante.PairingKeeper, epochstorageKeeper pairingante.EpochstorageKeeper, conflictKeeper pairingante.ConflictKeeper, msgServiceRouter pairingante.MsgServiceRouter) sdk.AnteHandler {
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(accountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(accountKeeper),
-		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper),
 		ante.NewSetPubKeyDecorator(accountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(accountKeeper),
 		ante.NewSigGasConsumeDecorator(accountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(accountKeeper, signModeHandler),
+		// fees are checked after the signatures, so only the real sender can use its fee-free quota
+		pairingante.NewFreeTxDecorator(pairingKeeper, epochstorageKeeper, conflictKeeper, msgServiceRouter,
+			ante.NewMempoolFeeDecorator(),
+			ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper),
+		),
 		ante.NewIncrementSequenceDecorator(accountKeeper),
 	}
 	return sdk.ChainAnteDecorators(anteDecorators...)
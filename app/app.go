@@ -95,6 +95,7 @@ import (
 	epochstoragemodulekeeper "github.com/lavanet/lava/x/epochstorage/keeper"
 	epochstoragemoduletypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingmodule "github.com/lavanet/lava/x/pairing"
+	pairingmoduleclient "github.com/lavanet/lava/x/pairing/client"
 	pairingmodulekeeper "github.com/lavanet/lava/x/pairing/keeper"
 	pairingmoduletypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/lavanet/lava/x/spec"
@@ -116,7 +117,7 @@ const (
 )
 
 // Upgrades add here future upgrades (upgrades.Upgrade)
-var Upgrades = []upgrades.Upgrade{upgrades.Upgrade_0_4_0, upgrades.Upgrade_0_4_3, upgrades.Upgrade_0_4_4, upgrades.Upgrade_0_4_5, v0_5_0.Upgrade}
+var Upgrades = []upgrades.Upgrade{upgrades.Upgrade_0_4_0, upgrades.Upgrade_0_4_3, upgrades.Upgrade_0_4_4, upgrades.Upgrade_0_4_5, v0_5_0.Upgrade, upgrades.Upgrade_0_5_1}
 
 // this line is used by starport scaffolding # stargate/wasm/app/enabledProposals
 
@@ -132,6 +133,10 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
 		specmoduleclient.SpecAddProposalHandler,
+		specmoduleclient.SpecDisableProposalHandler,
+		specmoduleclient.SpecApisModifyProposalHandler,
+		specmoduleclient.SpecRemoveProposalHandler,
+		pairingmoduleclient.PlansAddProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -347,12 +352,39 @@ func New(
 	)
 	specModule := spec.NewAppModule(appCodec, app.SpecKeeper, app.AccountKeeper, app.BankKeeper)
 
+	// Initialize PairingKeeper prior to govRouter (order is critical)
+	app.EpochstorageKeeper = *epochstoragemodulekeeper.NewKeeper(
+		appCodec,
+		keys[epochstoragemoduletypes.StoreKey],
+		keys[epochstoragemoduletypes.MemStoreKey],
+		app.GetSubspace(epochstoragemoduletypes.ModuleName),
+
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.SpecKeeper,
+	)
+	epochstorageModule := epochstoragemodule.NewAppModule(appCodec, app.EpochstorageKeeper, app.AccountKeeper, app.BankKeeper)
+
+	app.PairingKeeper = *pairingmodulekeeper.NewKeeper(
+		appCodec,
+		keys[pairingmoduletypes.StoreKey],
+		keys[pairingmoduletypes.MemStoreKey],
+		app.GetSubspace(pairingmoduletypes.ModuleName),
+
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.SpecKeeper,
+		&app.EpochstorageKeeper,
+	)
+	pairingModule := pairingmodule.NewAppModule(appCodec, app.PairingKeeper, app.AccountKeeper, app.BankKeeper, app.SpecKeeper, &app.EpochstorageKeeper)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		//
 		// user defined
-		AddRoute(specmoduletypes.ProposalsRouterKey, spec.NewSpecProposalsHandler(app.SpecKeeper)).
+		AddRoute(specmoduletypes.ProposalsRouterKey, spec.NewSpecProposalsHandler(app.SpecKeeper, app.EpochstorageKeeper)).
+		AddRoute(pairingmoduletypes.ProposalsRouterKey, pairingmodule.NewPairingProposalsHandler(app.PairingKeeper)).
 		// copied the code from param and changed the handler to enable functionality
 		AddRoute(paramproposal.RouterKey, spec.NewParamChangeProposalHandler(app.ParamsKeeper)).
 
@@ -383,31 +415,6 @@ func New(
 		&stakingKeeper, govRouter,
 	)
 
-	app.EpochstorageKeeper = *epochstoragemodulekeeper.NewKeeper(
-		appCodec,
-		keys[epochstoragemoduletypes.StoreKey],
-		keys[epochstoragemoduletypes.MemStoreKey],
-		app.GetSubspace(epochstoragemoduletypes.ModuleName),
-
-		app.BankKeeper,
-		app.AccountKeeper,
-		app.SpecKeeper,
-	)
-	epochstorageModule := epochstoragemodule.NewAppModule(appCodec, app.EpochstorageKeeper, app.AccountKeeper, app.BankKeeper)
-
-	app.PairingKeeper = *pairingmodulekeeper.NewKeeper(
-		appCodec,
-		keys[pairingmoduletypes.StoreKey],
-		keys[pairingmoduletypes.MemStoreKey],
-		app.GetSubspace(pairingmoduletypes.ModuleName),
-
-		app.BankKeeper,
-		app.AccountKeeper,
-		app.SpecKeeper,
-		&app.EpochstorageKeeper,
-	)
-	pairingModule := pairingmodule.NewAppModule(appCodec, app.PairingKeeper, app.AccountKeeper, app.BankKeeper)
-
 	app.ConflictKeeper = *conflictmodulekeeper.NewKeeper(
 		appCodec,
 		keys[conflictmoduletypes.StoreKey],
@@ -420,7 +427,7 @@ func New(
 		app.EpochstorageKeeper,
 		app.SpecKeeper,
 	)
-	conflictModule := conflictmodule.NewAppModule(appCodec, app.ConflictKeeper, app.AccountKeeper, app.BankKeeper)
+	conflictModule := conflictmodule.NewAppModule(appCodec, app.ConflictKeeper, app.AccountKeeper, app.BankKeeper, app.SpecKeeper, app.EpochstorageKeeper)
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
@@ -594,7 +601,11 @@ func New(
 			app.BankKeeper,
 			encodingConfig.TxConfig.SignModeHandler(),
 			app.FeeGrantKeeper,
-			ante.DefaultSigVerificationGasConsumer),
+			ante.DefaultSigVerificationGasConsumer,
+			app.PairingKeeper,
+			app.EpochstorageKeeper,
+			app.ConflictKeeper,
+			app.MsgServiceRouter()),
 	)
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -1,6 +1,8 @@
 package app_test
 
 import (
+	"encoding/json"
+	"math/rand"
 	"os"
 	"testing"
 	"time"
@@ -11,9 +13,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
 	"github.com/lavanet/lava/app"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -54,6 +58,42 @@ var defaultConsensusParams = &abci.ConsensusParams{
 	},
 }
 
+// simulationAccountFunds is the ulava balance of every simulated account, the simulated accounts are only funded
+// with the bond denom and lava stakes and pays in ulava
+var simulationAccountFunds = sdk.NewInt(1_000_000_000)
+
+// appStateFn is simapp.AppStateFn with the simulated accounts funded in ulava
+func appStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simulationtypes.AppStateFn {
+	stateFn := simapp.AppStateFn(cdc, simManager)
+	return func(r *rand.Rand, accs []simulationtypes.Account, config simulationtypes.Config) (json.RawMessage, []simulationtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTimestamp := stateFn(r, accs, config)
+
+		rawState := map[string]json.RawMessage{}
+		if err := json.Unmarshal(appState, &rawState); err != nil {
+			panic(err)
+		}
+		bankState := banktypes.GetGenesisStateFromAppState(cdc, rawState)
+		simAddresses := map[string]bool{}
+		for _, acc := range simAccs {
+			simAddresses[acc.Address.String()] = true
+		}
+		funds := sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, simulationAccountFunds))
+		for i, balance := range bankState.Balances {
+			if simAddresses[balance.Address] {
+				bankState.Balances[i].Coins = balance.Coins.Add(funds...)
+				bankState.Supply = bankState.Supply.Add(funds...)
+			}
+		}
+		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+		appState, err := json.Marshal(rawState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, simAccs, chainID, genesisTimestamp
+	}
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `starport chain simulate -v --numBlocks 200 --blockSize 50`
@@ -93,7 +133,7 @@ func BenchmarkSimulation(b *testing.B) {
 		b,
 		os.Stdout,
 		simApp.GetBaseApp(),
-		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		appStateFn(simApp.AppCodec(), simApp.SimulationManager()),
 		simulationtypes.RandomAccounts,
 		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
 		simApp.ModuleAccountAddrs(),
@@ -15,17 +15,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
 	"github.com/lavanet/lava/app"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/loadtest"
+	"github.com/lavanet/lava/protocol/recorder"
+	"github.com/lavanet/lava/protocol/replay"
 	"github.com/lavanet/lava/protocol/rpcconsumer"
 	"github.com/lavanet/lava/protocol/rpcprovider"
+	"github.com/lavanet/lava/protocol/rpcprovider/faults"
 	"github.com/lavanet/lava/relayer"
 	"github.com/lavanet/lava/relayer/chainproxy"
 	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
+	pairingcli "github.com/lavanet/lava/x/pairing/client/cli"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -197,11 +207,14 @@ func main() {
 		all configs should be located in the local running directory /config or ` + app.DefaultNodeHome + `
 		if no arguments are passed, assumes default config file: ` + DefaultRPCConsumerFileName + `
 		if one argument is passed, its assumed the config file name
+		endpoints that share a listen address are served by one listener, which routes by the path prefix /<chain-id>/<api-interface>/ (or /<chain-id>/ for a chain with a single api interface),
+		or by the host <chain-id>.<domain> or <chain-id>-<api-interface>.<domain>, and lists the chains it serves at ` + chainlib.ChainsDiscoveryPath + `, grpc endpoints need their own listen address
 		`,
 		Example: `required flags: --geolocation 1 --from alice
 		rpcconsumer <flags>
 		rpcconsumer rpcconsumer_conf <flags>
-		rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>`,
+		rpcconsumer 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3334 COS3 rest <flags>
+		rpcconsumer 127.0.0.1:3333 ETH1 jsonrpc 127.0.0.1:3333 COS3 tendermintrpc 127.0.0.1:3333 COS3 rest <flags>`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			// Optionally run one of the validators provided by cobra
 			if err := cobra.RangeArgs(0, 1)(cmd, args); err == nil {
@@ -290,23 +303,46 @@ func main() {
 			requiredResponses := 1 // TODO: handle secure flag, for a majority between providers
 			utils.LavaFormatInfo("lavad Binary Version: "+version.Version, nil)
 			rand.Seed(time.Now().UnixNano())
-			vrf_sk, _, err := utils.GetOrCreateVRFKey(clientCtx)
+			signer, err := sigs.GetSigner(ctx, clientCtx, cmd.Flags(), true)
 			if err != nil {
-				utils.LavaFormatFatal("failed getting or creating a VRF key", err, nil)
+				utils.LavaFormatFatal("failed getting the relay signer", err, nil)
+			}
+			txFactory = sigs.TxFactoryWithSigner(txFactory, signer)
+			rpcConsumer.ProviderTLS, err = lavatls.ClientConfigFromFlags(cmd.Flags())
+			if err != nil {
+				return err
 			}
 			var cache *performance.Cache = nil
 			cacheAddr, err := cmd.Flags().GetString(performance.CacheFlagName)
 			if err != nil {
 				utils.LavaFormatError("Failed To Get Cache Address flag", err, &map[string]string{"flags": fmt.Sprintf("%v", cmd.Flags())})
 			} else if cacheAddr != "" {
-				cache, err = performance.InitCache(ctx, cacheAddr)
+				cache, err = performance.InitCache(ctx, cacheAddr, rpcConsumer.ProviderTLS)
 				if err != nil {
 					utils.LavaFormatError("Failed To Connect to cache at address", err, &map[string]string{"address": cacheAddr})
 				} else {
 					utils.LavaFormatInfo("cache service connected", &map[string]string{"address": cacheAddr})
 				}
 			}
-			rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, vrf_sk, cache)
+			var relayRecorder *recorder.Recorder = nil
+			recordDir, err := cmd.Flags().GetString(recorder.RecordDirFlag)
+			if err != nil {
+				utils.LavaFormatError("Failed To Get Record Dir flag", err, &map[string]string{"flags": fmt.Sprintf("%v", cmd.Flags())})
+			} else if recordDir != "" {
+				recordConfig := recorder.Config{Dir: recordDir}
+				recordConfig.SampleRate, _ = cmd.Flags().GetFloat64(recorder.RecordSampleRateFlag)
+				recordConfig.MaxFileSize, _ = cmd.Flags().GetInt64(recorder.RecordMaxFileSizeFlag)
+				recordConfig.MaxFiles, _ = cmd.Flags().GetInt(recorder.RecordMaxFilesFlag)
+				recordConfig.RedactKeys, _ = cmd.Flags().GetStringSlice(recorder.RecordRedactKeysFlag)
+				recordConfig.OmitReplies, _ = cmd.Flags().GetBool(recorder.RecordOmitRepliesFlag)
+				relayRecorder, err = recorder.NewRecorder(recordConfig)
+				if err != nil {
+					utils.LavaFormatFatal("failed creating the relay recorder", err, &map[string]string{"dir": recordDir})
+				}
+				defer relayRecorder.Close()
+				utils.LavaFormatInfo("recording relays", &map[string]string{"dir": recordDir})
+			}
+			rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, signer, cache, relayRecorder)
 			return nil
 		},
 	}
@@ -409,12 +445,24 @@ func main() {
 			rpcProvider := rpcprovider.RPCProvider{}
 			utils.LavaFormatInfo("lavad Binary Version: "+version.Version, nil)
 			rand.Seed(time.Now().UnixNano())
+			var certFingerprint string
+			rpcProvider.TLSConfig, certFingerprint, err = lavatls.ServerConfigFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if rpcProvider.TLSConfig != nil {
+				utils.LavaFormatInfo("serving relays over tls", &map[string]string{"tlsCertHash": certFingerprint})
+			}
+			cacheTLS, err := lavatls.ClientConfigFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
 			var cache *performance.Cache = nil
 			cacheAddr, err := cmd.Flags().GetString(performance.CacheFlagName)
 			if err != nil {
 				utils.LavaFormatError("Failed To Get Cache Address flag", err, &map[string]string{"flags": fmt.Sprintf("%v", cmd.Flags())})
 			} else if cacheAddr != "" {
-				cache, err = performance.InitCache(ctx, cacheAddr)
+				cache, err = performance.InitCache(ctx, cacheAddr, cacheTLS)
 				if err != nil {
 					utils.LavaFormatError("Failed To Connect to cache at address", err, &map[string]string{"address": cacheAddr})
 				} else {
@@ -425,11 +473,278 @@ func main() {
 			if err != nil {
 				utils.LavaFormatFatal("error fetching chainproxy.ParallelConnectionsFlag", err, nil)
 			}
-			rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, cache, numberOfNodeParallelConnections)
+			signer, err := sigs.GetSigner(ctx, clientCtx, cmd.Flags(), false)
+			if err != nil {
+				utils.LavaFormatFatal("failed getting the relay signer", err, nil)
+			}
+			txFactory = sigs.TxFactoryWithSigner(txFactory, signer)
+			faultsFile, err := cmd.Flags().GetString(faults.FaultsFlag)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read faults flag", err, nil)
+			}
+			if faultsFile != "" {
+				rpcProvider.Faults, err = faults.ReadConfig(faultsFile)
+				if err != nil {
+					return err
+				}
+			}
+			rpcProvider.Start(ctx, txFactory, clientCtx, rpcProviderEndpoints, signer, cache, numberOfNodeParallelConnections)
 			return nil
 		},
 	}
 
+	cmdLoadTest := &cobra.Command{
+		Use:   "loadtest [chain-id] [api-interface] [consumer-url]",
+		Short: "loadtest sends a mix of spec apis to an rpcconsumer and reports the latency, errors and compute units",
+		Long: `loadtest sends a weighted mix of the spec apis of the api interface to the rpcconsumer listener at consumer-url, at a target rate or concurrency,
+		and reports latency histograms, an error breakdown, the compute units throughput and how the relays spread across the providers.
+		the mix is a yaml file of apis, weights and sample params (--mix), with no mix every api of the interface is sent without params with the same weight.
+		a file of recorded relays (--requests-file) is replayed instead of a mix`,
+		Example: `lavad loadtest ETH1 jsonrpc http://127.0.0.1:3333 --rps 100 --duration 1m --node tcp://localhost:26657
+lavad loadtest ETH1 jsonrpc http://127.0.0.1:3333 --concurrency 50 --mix eth_mix.yml`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			chainID, apiInterface, consumerURL := args[0], args[1], args[2]
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err, nil)
+			}
+			utils.LoggingLevel(logLevel)
+			ctx := context.Background()
+			specResponse, err := spectypes.NewQueryClient(clientCtx).Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: chainID})
+			if err != nil {
+				return utils.LavaFormatError("failed querying spec for chain", err, &map[string]string{"chainID": chainID})
+			}
+			spec := &specResponse.Spec
+			computeUnits := map[string]uint64{}
+			for _, serviceApi := range spec.Apis {
+				computeUnits[serviceApi.Name] = serviceApi.ComputeUnits
+			}
+
+			var picker loadtest.RequestPicker
+			requestsFile, _ := cmd.Flags().GetString(loadtest.RequestsFileFlag)
+			mixFile, _ := cmd.Flags().GetString(loadtest.MixFlag)
+			if requestsFile != "" {
+				picker, err = loadtest.ReadRecordedRequests(requestsFile, apiInterface)
+			} else {
+				var mix *loadtest.Mix
+				if mixFile != "" {
+					mix, err = loadtest.ReadMix(mixFile)
+					if err != nil {
+						return err
+					}
+				}
+				picker, err = loadtest.NewMixPicker(spec, apiInterface, mix)
+			}
+			if err != nil {
+				return err
+			}
+
+			config := loadtest.Config{ConsumerURL: consumerURL}
+			config.DappID, _ = cmd.Flags().GetString(loadtest.DappIDFlag)
+			config.RPS, _ = cmd.Flags().GetFloat64(loadtest.RPSFlag)
+			config.Concurrency, _ = cmd.Flags().GetInt(loadtest.ConcurrencyFlag)
+			config.Duration, _ = cmd.Flags().GetDuration(loadtest.DurationFlag)
+			config.Timeout, _ = cmd.Flags().GetDuration(loadtest.TimeoutFlag)
+			loadTest, err := loadtest.NewLoadTest(config, picker, computeUnits)
+			if err != nil {
+				return err
+			}
+			utils.LavaFormatInfo("Loadtest started", &map[string]string{"chainID": chainID, "apiInterface": apiInterface, "consumer": consumerURL, "config": fmt.Sprintf("%+v", config)})
+			start := time.Now()
+			stats := loadTest.Run(ctx)
+			stats.Report(cmd.OutOrStdout(), time.Since(start))
+			return nil
+		},
+	}
+
+	cmdReplay := &cobra.Command{
+		Use:   "replay [recording-file] [chain-id] [api-interface]",
+		Short: "replay resends relays recorded by an rpcconsumer and diffs the replies against the recorded ones",
+		Long: `replay resends the relays of the chain and api interface from a recording file of an rpcconsumer (--` + recorder.RecordDirFlag + `), in the recorded order,
+		either through the rpcconsumer listening at --` + replay.ConsumerURLFlag + ` or straight to the node at --` + replay.NodeURLFlag + ` the way a provider sends them,
+		and reports the relays whose replies differ from the recorded ones. json replies are compared field by field, skipping --` + replay.IgnoreKeysFlag,
+		Example: `lavad replay recordings/relays-20230101T000000.000000000.jsonl ETH1 jsonrpc --consumer-url http://127.0.0.1:3333
+lavad replay recordings/relays-20230101T000000.000000000.jsonl LAV1 rest --node-url http://127.0.0.1:1317 --node tcp://localhost:26657`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recordingFile, chainID, apiInterface := args[0], args[1], args[2]
+			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+			if err != nil {
+				utils.LavaFormatFatal("failed to read log level flag", err, nil)
+			}
+			utils.LoggingLevel(logLevel)
+			consumerURL, _ := cmd.Flags().GetString(replay.ConsumerURLFlag)
+			nodeURL, _ := cmd.Flags().GetString(replay.NodeURLFlag)
+			if (consumerURL == "") == (nodeURL == "") {
+				return fmt.Errorf("exactly one of --%s and --%s has to be set", replay.ConsumerURLFlag, replay.NodeURLFlag)
+			}
+			records, err := recorder.ReadRecords(recordingFile)
+			if err != nil {
+				return err
+			}
+			records, err = replay.FilterRecords(records, chainID, apiInterface)
+			if err != nil {
+				return err
+			}
+			ctx := context.Background()
+			timeout, _ := cmd.Flags().GetDuration(replay.TimeoutFlag)
+			var sender replay.Sender
+			if consumerURL != "" {
+				dappID, _ := cmd.Flags().GetString(replay.DappIDFlag)
+				sender = replay.NewConsumerSender(consumerURL, dappID)
+			} else {
+				clientCtx, err := client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
+				specResponse, err := spectypes.NewQueryClient(clientCtx).Spec(ctx, &spectypes.QueryGetSpecRequest{ChainID: chainID})
+				if err != nil {
+					return utils.LavaFormatError("failed querying spec for chain", err, &map[string]string{"chainID": chainID})
+				}
+				chainParser, err := chainlib.NewChainParser(apiInterface)
+				if err != nil {
+					return err
+				}
+				chainParser.SetSpec(specResponse.Spec)
+				chainProxy, err := chainlib.GetChainProxy(ctx, 1, &lavasession.RPCProviderEndpoint{ChainID: chainID, ApiInterface: apiInterface, NodeUrl: nodeURL})
+				if err != nil {
+					return utils.LavaFormatError("failed creating chain proxy", err, &map[string]string{"nodeUrl": nodeURL})
+				}
+				sender = replay.NewNodeSender(chainParser, chainProxy)
+			}
+			ignoreKeys, _ := cmd.Flags().GetStringSlice(replay.IgnoreKeysFlag)
+			if timeout > 0 {
+				sender = replay.WithTimeout(sender, timeout)
+			}
+			summary := replay.NewReplayer(sender, ignoreKeys).Run(ctx, records, cmd.OutOrStdout())
+			summary.Report(cmd.OutOrStdout())
+			return nil
+		},
+	}
+
+	cmdTLS := &cobra.Command{
+		Use:   "tls",
+		Short: "tls certificates for serving relays",
+		Long: `providers serve relays over tls, consumers validate the provider certificate against the root CAs,
+		or pin it to the hash the provider publishes as the fourth element of its endpoints: IP:PORT,useType,geolocation,tlsCertHash`,
+		RunE: client.ValidateCmd,
+	}
+	cmdTLSGenerate := &cobra.Command{
+		Use:     "generate [cert-file] [key-file] [host...]",
+		Short:   "generate a self signed certificate for the provider hosts and print the hash to publish in the provider endpoints",
+		Example: `tls generate provider.crt provider.key provider.example.com 203.0.113.7`,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validFor, err := cmd.Flags().GetDuration(lavatls.ValidForFlag)
+			if err != nil {
+				return err
+			}
+			certPEM, keyPEM, err := lavatls.GenerateSelfSignedCertificate(args[2:], validFor)
+			if err != nil {
+				return err
+			}
+			err = writeNewFile(args[1], keyPEM, 0o600)
+			if err != nil {
+				return err
+			}
+			err = writeNewFile(args[0], certPEM, 0o644)
+			if err != nil {
+				return err
+			}
+			fingerprint, err := lavatls.FingerprintFromPEM(certPEM)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), fingerprint)
+			return nil
+		},
+	}
+	cmdTLSFingerprint := &cobra.Command{
+		Use:   "fingerprint [cert-file]",
+		Short: "print the hash of a certificate to publish in the provider endpoints",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			certPEM, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			fingerprint, err := lavatls.FingerprintFromPEM(certPEM)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), fingerprint)
+			return nil
+		},
+	}
+
+	cmdSigner := &cobra.Command{
+		Use:   "signer [listen-address]",
+		Short: "signer serves the relay signing key and the vrf key of the account to remote signers",
+		Long: `signer serves the relay signing key and the vrf key of the account to remote signers,
+		so the relaying processes (server, portal_server, rpcprovider, rpcconsumer) run with --` + sigs.RemoteSignerFlag + ` and never hold the keys.
+		the signer signs relays, relay replies, finalization data and vrf values it hashes itself, never a raw hash,
+		and the relay payment and conflict transactions of the account with a fee of at most --` + sigs.MaxTxFeeFlag + `.
+		the keyring of the relaying processes only needs the public key of the account (lavad keys add <name> --pubkey <pubkey>).
+		the listen address is a unix socket, unix:///path/to/socket, only accessible to the user running the signer,
+		or tcp://host:port with mutual tls: the signer serves --` + lavatls.TLSCertFlag + ` and --` + lavatls.TLSKeyFlag + ` and only accepts client certificates of --` + sigs.SignerClientCAFlag + `,
+		the relaying processes connect with --` + sigs.RemoteSignerCertFlag + ` and --` + sigs.RemoteSignerKeyFlag + ` and validate the signer against --` + sigs.RemoteSignerCAFlag + ``,
+		Example: `signer unix:///var/run/lava-signer.sock --from alice --chain-id lava
+		server 127.0.0.1 2221 http://127.0.0.1:8545 ETH1 jsonrpc --from alice --geolocation 1 --remote-signer unix:///var/run/lava-signer.sock
+		signer tcp://0.0.0.0:2300 --from alice --chain-id lava --tls-cert signer.crt --tls-key signer.key --tls-client-ca ca.crt
+		server 127.0.0.1 2221 http://127.0.0.1:8545 ETH1 jsonrpc --from alice --geolocation 1 --remote-signer tcp://signer.example.com:2300 --remote-signer-cert relayer.crt --remote-signer-key relayer.key --remote-signer-ca ca.crt`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.LavaFormatInfo("Signer started", &map[string]string{"args": strings.Join(args, ",")})
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			keyName, err := sigs.GetKeyName(clientCtx)
+			if err != nil {
+				return err
+			}
+			privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+			if err != nil {
+				return err
+			}
+			// the vrf key is created when staking as a client, providers don't have one
+			vrfSk, _, err := utils.LoadVRFKey(clientCtx)
+			if err != nil {
+				utils.LavaFormatInfo("no vrf key for the account, vrf requests will fail", &map[string]string{"keyName": keyName})
+				vrfSk = nil
+			}
+			signer := sigs.NewLocalSigner(privKey, vrfSk)
+			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			maxTxFee, err := cmd.Flags().GetString(sigs.MaxTxFeeFlag)
+			if err != nil {
+				return err
+			}
+			maxFee, err := sdk.ParseCoinsNormalized(maxTxFee)
+			if err != nil {
+				return err
+			}
+			txRules := sigs.SignerTxRules{Codec: clientCtx.Codec, ChainID: networkChainId, MaxFee: maxFee}
+			tlsConfig, err := sigs.SignerServerTLSConfigFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			listener, err := sigs.ListenSigner(args[0], tlsConfig)
+			if err != nil {
+				return err
+			}
+			utils.LavaFormatInfo("Signer listening", &map[string]string{"address": args[0], "account": clientCtx.GetFromAddress().String()})
+			return sigs.ServeSigner(context.Background(), listener, signer, txRules)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmdServer)
 	cmdServer.MarkFlagRequired(flags.FlagFrom)
 	flags.AddTxFlagsToCmd(cmdPortalServer)
@@ -452,11 +767,52 @@ func main() {
 	cmdPortalServer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
 	cmdServer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
 	cmdServer.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
+	sigs.AddRemoteSignerFlags(cmdServer.Flags())
+	sigs.AddRemoteSignerFlags(cmdPortalServer.Flags())
+	sigs.AddRemoteSignerFlags(cmdTestClient.Flags())
+	lavatls.AddServerFlags(cmdServer.Flags())
+	lavatls.AddClientFlags(cmdPortalServer.Flags())
+	lavatls.AddClientFlags(cmdTestClient.Flags())
 
 	rootCmd.AddCommand(cmdServer)
 	rootCmd.AddCommand(cmdPortalServer)
 	rootCmd.AddCommand(cmdTestClient)
 
+	// Signer command flags
+	flags.AddTxFlagsToCmd(cmdSigner)
+	cmdSigner.MarkFlagRequired(flags.FlagFrom)
+	cmdSigner.Flags().String(sigs.MaxTxFeeFlag, sigs.DefaultMaxTxFee, "highest fee of a transaction the signer signs for the relaying process")
+	sigs.AddSignerFlags(cmdSigner.Flags())
+	rootCmd.AddCommand(cmdSigner)
+
+	rootCmd.AddCommand(pairingcli.CmdVRFKeys())
+
+	// TLS command flags
+	cmdTLSGenerate.Flags().Duration(lavatls.ValidForFlag, 365*24*time.Hour, "how long the certificate is valid for")
+	cmdTLS.AddCommand(cmdTLSGenerate)
+	cmdTLS.AddCommand(cmdTLSFingerprint)
+	rootCmd.AddCommand(cmdTLS)
+
+	// Loadtest command flags
+	flags.AddQueryFlagsToCmd(cmdLoadTest)
+	cmdLoadTest.Flags().Float64(loadtest.RPSFlag, 0, "target requests per second, 0 sends as fast as the concurrency allows, the rate can't pass what the concurrency can hold")
+	cmdLoadTest.Flags().Int(loadtest.ConcurrencyFlag, 10, "number of requests in flight")
+	cmdLoadTest.Flags().Duration(loadtest.DurationFlag, time.Minute, "how long to send requests for")
+	cmdLoadTest.Flags().Duration(loadtest.TimeoutFlag, 30*time.Second, "timeout of a single request")
+	cmdLoadTest.Flags().String(loadtest.MixFlag, "", "yaml file of the apis to send, their weights and sample params")
+	cmdLoadTest.Flags().String(loadtest.RequestsFileFlag, "", "file of recorded relays to replay instead of a mix")
+	cmdLoadTest.Flags().String(loadtest.DappIDFlag, "loadtest", "dapp id the requests are sent with")
+	rootCmd.AddCommand(cmdLoadTest)
+
+	// Replay command flags
+	flags.AddQueryFlagsToCmd(cmdReplay)
+	cmdReplay.Flags().String(replay.ConsumerURLFlag, "", "address of the rpcconsumer listener to replay the relays through, e.g. http://127.0.0.1:3333")
+	cmdReplay.Flags().String(replay.NodeURLFlag, "", "address of a node to replay the relays to directly, the spec is queried from --node")
+	cmdReplay.Flags().String(replay.DappIDFlag, "", "dapp id to replay the relays with through the rpcconsumer, the recorded dapp ids are kept when empty")
+	cmdReplay.Flags().StringSlice(replay.IgnoreKeysFlag, []string{"id"}, "json keys that are skipped when comparing the replies")
+	cmdReplay.Flags().Duration(replay.TimeoutFlag, 30*time.Second, "timeout of a single relay")
+	rootCmd.AddCommand(cmdReplay)
+
 	// RPCConsumer command flags
 	flags.AddTxFlagsToCmd(cmdRPCConsumer)
 	cmdRPCConsumer.MarkFlagRequired(flags.FlagFrom)
@@ -466,6 +822,14 @@ func main() {
 	cmdRPCConsumer.Flags().Bool("secure", false, "secure sends reliability on every message")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	sigs.AddRemoteSignerFlags(cmdRPCConsumer.Flags())
+	cmdRPCConsumer.Flags().String(recorder.RecordDirFlag, "", "directory to record the relays of the chain listeners to, recording is disabled when empty")
+	cmdRPCConsumer.Flags().Float64(recorder.RecordSampleRateFlag, 1, "share of the relays that are recorded, between 0 and 1")
+	cmdRPCConsumer.Flags().Int64(recorder.RecordMaxFileSizeFlag, recorder.DefaultMaxFileSize, "size in bytes a recording file is rotated at")
+	cmdRPCConsumer.Flags().Int(recorder.RecordMaxFilesFlag, 10, "number of recording files kept, 0 keeps them all")
+	cmdRPCConsumer.Flags().StringSlice(recorder.RecordRedactKeysFlag, []string{}, "json keys and url query params whose values are redacted from the recorded requests and replies")
+	cmdRPCConsumer.Flags().Bool(recorder.RecordOmitRepliesFlag, false, "don't record the replies")
+	lavatls.AddClientFlags(cmdRPCConsumer.Flags())
 	// rootCmd.AddCommand(cmdRPCConsumer) // TODO: DISABLE COMMAND SO IT'S NOT EXPOSED ON MAIN YET
 
 	// RPCProvider command flags
@@ -477,9 +841,25 @@ func main() {
 	cmdRPCProvider.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCProvider.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
+	sigs.AddRemoteSignerFlags(cmdRPCProvider.Flags())
+	cmdRPCProvider.Flags().String(faults.FaultsFlag, "", "yaml file of faults to inject into the relays, makes the provider misbehave for testing conflict detection")
+	lavatls.AddServerFlags(cmdRPCProvider.Flags())
 	// rootCmd.AddCommand(cmdRPCProvider) // TODO: DISABLE COMMAND SO IT'S NOT EXPOSED ON MAIN YET
 
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
 	}
 }
+
+// writeNewFile writes data to a file that doesn't exist yet
+func writeNewFile(name string, data []byte, perm os.FileMode) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -2,23 +2,137 @@ package chainlib
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/parser"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
+// replyFormatter is implemented by chain proxies whose node replies aren't json, so the spec result parsing can run on them
+type replyFormatter interface {
+	FormatReply(ctx context.Context, chainMessage ChainMessage, replyData []byte) (json.RawMessage, error)
+}
+
 type ChainFetcher struct {
-	chainProxy ChainProxy
+	chainProxy  ChainProxy
+	chainParser ChainParser
+	endpoint    *lavasession.RPCProviderEndpoint
 }
 
 func (cf *ChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error) {
-	return 0, fmt.Errorf("not implemented")
+	serviceApi, ok := cf.chainParser.GetSpecApiByTag(spectypes.GET_BLOCKNUM)
+	if !ok {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError(spectypes.GET_BLOCKNUM+" tag function not found", nil, &map[string]string{"chainID": cf.endpoint.ChainID, "APIInterface": cf.endpoint.ApiInterface})
+	}
+	reply, err := cf.sendTaggedApi(ctx, &serviceApi)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, err
+	}
+	blockNum, err := parser.ParseBlockFromReply(reply, serviceApi.Parsing.ResultParsing)
+	if err != nil {
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Failed To Parse FetchLatestBlockNum", err, &map[string]string{"nodeUrl": cf.endpoint.NodeUrl, "reply": string(reply.GetResult())})
+	}
+	return blockNum, nil
 }
 
 func (cf *ChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
-	return "", fmt.Errorf("not implemented")
+	serviceApi, ok := cf.chainParser.GetSpecApiByTag(spectypes.GET_BLOCK_BY_NUM)
+	if !ok {
+		return "", utils.LavaFormatError(spectypes.GET_BLOCK_BY_NUM+" tag function not found", nil, &map[string]string{"chainID": cf.endpoint.ChainID, "APIInterface": cf.endpoint.ApiInterface})
+	}
+	reply, err := cf.sendTaggedApi(ctx, &serviceApi, blockNum)
+	if err != nil {
+		return "", err
+	}
+	res, err := parser.ParseMessageResponse(reply, serviceApi.Parsing.ResultParsing)
+	if err != nil {
+		return "", utils.LavaFormatError("Failed To Parse FetchBlockHashByNum", err, &map[string]string{"nodeUrl": cf.endpoint.NodeUrl, "reply": string(reply.GetResult()), "block": strconv.FormatInt(blockNum, 10)})
+	}
+	if len(res) <= spectypes.DEFAULT_PARSED_RESULT_INDEX {
+		return "", utils.LavaFormatError("FetchBlockHashByNum parsed an empty result", nil, &map[string]string{"nodeUrl": cf.endpoint.NodeUrl, "block": strconv.FormatInt(blockNum, 10)})
+	}
+	hash, ok := res[spectypes.DEFAULT_PARSED_RESULT_INDEX].(string)
+	if !ok {
+		return "", utils.LavaFormatError("FetchBlockHashByNum hash is not a string", nil, &map[string]string{"nodeUrl": cf.endpoint.NodeUrl, "block": strconv.FormatInt(blockNum, 10), "hash": fmt.Sprintf("%v", res[spectypes.DEFAULT_PARSED_RESULT_INDEX])})
+	}
+	return hash, nil
 }
 
-func NewChainFetcher(ctx context.Context, chainProxy ChainProxy /*here needs some more params, maybe chainParser*/) *ChainFetcher {
-	// save here the information needed to fetch the latest block and it's hash
-	cf := &ChainFetcher{chainProxy: chainProxy}
+// sendTaggedApi crafts the message of a tagged api from its function template, sends it to the node and returns the result of the reply
+func (cf *ChainFetcher) sendTaggedApi(ctx context.Context, serviceApi *spectypes.ServiceApi, templateArgs ...interface{}) (*fetchedReply, error) {
+	template := serviceApi.Parsing.FunctionTemplate
+	if len(templateArgs) > 0 {
+		template = fmt.Sprintf(template, templateArgs...)
+	}
+	connectionType := ""
+	for _, apiInterface := range serviceApi.ApiInterfaces {
+		if apiInterface.Interface == cf.endpoint.ApiInterface {
+			connectionType = apiInterface.Type
+			break
+		}
+	}
+	// rest messages are addressed by their path, the other interfaces carry the message in the data
+	url, data := serviceApi.Name, []byte(template)
+	if cf.endpoint.ApiInterface == spectypes.APIInterfaceRest {
+		url, data = template, nil
+	}
+	chainMessage, err := cf.chainParser.ParseMsg(url, data, connectionType)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed parsing tagged api message", err, &map[string]string{"api": serviceApi.Name, "template": template})
+	}
+	relayReply, _, _, err := cf.chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed sending tagged api message", err, &map[string]string{"api": serviceApi.Name, "nodeUrl": cf.endpoint.NodeUrl})
+	}
+
+	switch cf.endpoint.ApiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC:
+		// json rpc node errors are returned inside the reply
+		var rpcReply struct {
+			Result json.RawMessage `json:"result,omitempty"`
+			Error  json.RawMessage `json:"error,omitempty"`
+		}
+		err = json.Unmarshal(relayReply.Data, &rpcReply)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed unmarshaling tagged api reply", err, &map[string]string{"api": serviceApi.Name, "reply": string(relayReply.Data)})
+		}
+		if len(rpcReply.Error) > 0 && string(rpcReply.Error) != "null" {
+			return nil, utils.LavaFormatError("node returned an error on tagged api", nil, &map[string]string{"api": serviceApi.Name, "error": string(rpcReply.Error)})
+		}
+		return &fetchedReply{result: rpcReply.Result}, nil
+	}
+	if formatter, ok := cf.chainProxy.(replyFormatter); ok {
+		result, err := formatter.FormatReply(ctx, chainMessage, relayReply.Data)
+		if err != nil {
+			return nil, err
+		}
+		return &fetchedReply{result: result}, nil
+	}
+	return &fetchedReply{result: relayReply.Data}, nil
+}
+
+func NewChainFetcher(ctx context.Context, chainProxy ChainProxy, chainParser ChainParser, endpoint *lavasession.RPCProviderEndpoint) *ChainFetcher {
+	cf := &ChainFetcher{chainProxy: chainProxy, chainParser: chainParser, endpoint: endpoint}
 	return cf
 }
+
+// fetchedReply holds the json result of a node reply, it implements parser.RPCInput so the spec result parsing can run on it
+type fetchedReply struct {
+	result json.RawMessage
+}
+
+func (fr *fetchedReply) GetParams() interface{} {
+	return nil
+}
+
+func (fr *fetchedReply) GetResult() json.RawMessage {
+	return fr.result
+}
+
+func (fr *fetchedReply) ParseBlock(block string) (int64, error) {
+	return parser.ParseDefaultBlockParameter(block)
+}
@@ -0,0 +1,218 @@
+package chainlib
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/favicon"
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/utils"
+)
+
+// ChainsDiscoveryPath lists the chains and api interfaces a ChainRouter serves
+const ChainsDiscoveryPath = "/chains"
+
+// RoutedChainListener is a chain listener that can serve its routes on a listener shared with other chains
+type RoutedChainListener interface {
+	ChainListener
+	RegisterRoutes(ctx context.Context, router fiber.Router)
+}
+
+// ChainRoute describes where a ChainRouter serves a chain api interface, it is the discovery endpoint entry
+type ChainRoute struct {
+	ChainID      string   `json:"chainId"`
+	ApiInterface string   `json:"apiInterface"`
+	Paths        []string `json:"paths"`
+	Hosts        []string `json:"hosts"`
+}
+
+type routedListener struct {
+	endpoint *lavasession.RPCEndpoint
+	listener RoutedChainListener
+}
+
+// ChainRouter serves the chain listeners of several endpoints on one address.
+// requests are routed by the path prefix /<chain-id>/<api-interface>, or /<chain-id> when the chain is served with a single api interface,
+// or by the first label of the Host header: <chain-id>.<domain> and <chain-id>-<api-interface>.<domain>, the chain ids are lower cased
+type ChainRouter struct {
+	networkAddress string
+	listeners      []routedListener
+}
+
+func NewChainRouter(networkAddress string) *ChainRouter {
+	return &ChainRouter{networkAddress: networkAddress}
+}
+
+// AddChainListener routes the requests of the endpoint to the listener, must be called before Serve
+func (cr *ChainRouter) AddChainListener(endpoint *lavasession.RPCEndpoint, chainListener ChainListener) error {
+	routedChainListener, ok := chainListener.(RoutedChainListener)
+	if !ok {
+		return utils.LavaFormatError("api interface can't share a listener with other chains, it needs its own network address", nil, &map[string]string{"chainID": endpoint.ChainID, "apiInterface": endpoint.ApiInterface, "networkAddress": endpoint.NetworkAddress})
+	}
+	if chainPrefix(endpoint.ChainID) == strings.TrimPrefix(ChainsDiscoveryPath, "/") {
+		return utils.LavaFormatError("chain id is reserved for the chains discovery path", nil, &map[string]string{"chainID": endpoint.ChainID})
+	}
+	for _, existing := range cr.listeners {
+		if existing.endpoint.Key() == endpoint.Key() {
+			return utils.LavaFormatError("chain api interface is already served on the network address", nil, &map[string]string{"chainID": endpoint.ChainID, "apiInterface": endpoint.ApiInterface, "networkAddress": cr.networkAddress})
+		}
+	}
+	listeners := append(cr.listeners[:len(cr.listeners):len(cr.listeners)], routedListener{endpoint: endpoint, listener: routedChainListener})
+	// chain ids are lower cased and joined with the api interface, so different chains can end up on the same path or host
+	if err := verifyRoutes(routesOf(listeners)); err != nil {
+		return utils.LavaFormatError("chain api interface routes collide with another chain on the network address", err, &map[string]string{"chainID": endpoint.ChainID, "apiInterface": endpoint.ApiInterface, "networkAddress": cr.networkAddress})
+	}
+	cr.listeners = listeners
+	return nil
+}
+
+func verifyRoutes(routes []ChainRoute) error {
+	paths := map[string]string{}
+	hosts := map[string]string{}
+	for _, route := range routes {
+		name := route.ChainID + " " + route.ApiInterface
+		for _, path := range route.Paths {
+			if existing, ok := paths[path]; ok {
+				return fmt.Errorf("path %s of %s is already routed to %s", path, name, existing)
+			}
+			paths[path] = name
+		}
+		for _, host := range route.Hosts {
+			if existing, ok := hosts[host]; ok {
+				return fmt.Errorf("host %s of %s is already routed to %s", host, name, existing)
+			}
+			hosts[host] = name
+		}
+	}
+	return nil
+}
+
+// Routes returns the paths and hosts every chain api interface is served on
+func (cr *ChainRouter) Routes() []ChainRoute {
+	return routesOf(cr.listeners)
+}
+
+func routesOf(listeners []routedListener) []ChainRoute {
+	interfacesPerChain := map[string]int{}
+	for _, routed := range listeners {
+		interfacesPerChain[chainPrefix(routed.endpoint.ChainID)]++
+	}
+	routes := make([]ChainRoute, 0, len(listeners))
+	for _, routed := range listeners {
+		chain := chainPrefix(routed.endpoint.ChainID)
+		route := ChainRoute{
+			ChainID:      routed.endpoint.ChainID,
+			ApiInterface: routed.endpoint.ApiInterface,
+			Paths:        []string{"/" + chain + "/" + routed.endpoint.ApiInterface},
+			Hosts:        []string{chain + "-" + routed.endpoint.ApiInterface},
+		}
+		if interfacesPerChain[chain] == 1 {
+			route.Paths = append(route.Paths, "/"+chain)
+			route.Hosts = append(route.Hosts, chain)
+		}
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Paths[0] < routes[j].Paths[0]
+	})
+	return routes
+}
+
+// Serve http server for all the chain listeners of the router
+func (cr *ChainRouter) Serve(ctx context.Context) {
+	if cr == nil {
+		return
+	}
+	app := cr.newApp(ctx)
+	for _, route := range cr.Routes() {
+		utils.LavaFormatInfo("RPCConsumer routing chain", &map[string]string{"chainID": route.ChainID, "apiInterface": route.ApiInterface, "paths": strings.Join(route.Paths, ","), "networkAddress": cr.networkAddress})
+	}
+	err := app.Listen(cr.networkAddress)
+	if err != nil {
+		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
+	}
+}
+
+func (cr *ChainRouter) newApp(ctx context.Context) *fiber.App {
+	app := fiber.New(fiber.Config{})
+
+	app.Use(favicon.New())
+
+	routes := cr.Routes()
+	hostPrefixes := map[string]string{}
+	for _, route := range routes {
+		for _, host := range route.Hosts {
+			hostPrefixes[host] = route.Paths[0]
+		}
+	}
+	app.Use(func(c *fiber.Ctx) error {
+		prefix, ok := hostPrefixes[hostLabel(c.Hostname())]
+		if !ok || c.Path() == prefix || strings.HasPrefix(c.Path(), prefix+"/") {
+			return c.Next()
+		}
+		// route by the host as if the path had the chain prefix
+		c.Path(prefix + c.Path())
+		return c.RestartRouting()
+	})
+
+	app.Get(ChainsDiscoveryPath, func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"chains": routes})
+	})
+
+	listeners := map[string]RoutedChainListener{}
+	for _, routed := range cr.listeners {
+		listeners[routed.endpoint.Key()] = routed.listener
+	}
+	// the /<chain-id>/<api-interface> groups are registered first, so a dapp id isn't mistaken for an api interface
+	for _, route := range routes {
+		routed := listeners[route.ChainID+route.ApiInterface]
+		routed.RegisterRoutes(ctx, app.Group(route.Paths[0]))
+	}
+	for _, route := range routes {
+		if len(route.Paths) > 1 {
+			routed := listeners[route.ChainID+route.ApiInterface]
+			routed.RegisterRoutes(ctx, app.Group(route.Paths[1]))
+		}
+	}
+	return app
+}
+
+func chainPrefix(chainID string) string {
+	return strings.ToLower(chainID)
+}
+
+// hostLabel returns the first label of the host without the port, e.g. eth1 for eth1.lava.example.com:3333
+func hostLabel(host string) string {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	if net.ParseIP(host) != nil {
+		return ""
+	}
+	label, _, _ := strings.Cut(host, ".")
+	return strings.ToLower(label)
+}
+
+// GroupEndpointsByAddress returns the endpoints that share a network address, each group is served by a single listener
+func GroupEndpointsByAddress(rpcEndpoints []*lavasession.RPCEndpoint) (groups [][]*lavasession.RPCEndpoint, err error) {
+	indexes := map[string]int{}
+	for _, rpcEndpoint := range rpcEndpoints {
+		index, ok := indexes[rpcEndpoint.NetworkAddress]
+		if !ok {
+			index = len(groups)
+			indexes[rpcEndpoint.NetworkAddress] = index
+			groups = append(groups, nil)
+		}
+		for _, existing := range groups[index] {
+			if existing.Key() == rpcEndpoint.Key() {
+				return nil, fmt.Errorf("endpoint %s is configured twice on %s", lavasession.PrintRPCEndpoint(rpcEndpoint), rpcEndpoint.NetworkAddress)
+			}
+		}
+		groups[index] = append(groups[index], rpcEndpoint)
+	}
+	return groups, nil
+}
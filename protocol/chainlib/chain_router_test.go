@@ -0,0 +1,136 @@
+package chainlib
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/metrics"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// echoRelaySender replies with the chain and api interface of the listener and the relay it got
+type echoRelaySender struct {
+	endpoint *lavasession.RPCEndpoint
+}
+
+func (ers echoRelaySender) SendRelay(ctx context.Context, url string, req string, connectionType string, dappID string, analytics *metrics.RelayMetrics) (*pairingtypes.RelayReply, *pairingtypes.Relayer_RelaySubscribeClient, error) {
+	return &pairingtypes.RelayReply{Data: []byte(ers.endpoint.ChainID + " " + ers.endpoint.ApiInterface + " " + url + " " + req)}, nil, nil
+}
+
+func newTestChainRouter(t *testing.T) *ChainRouter {
+	endpoints := []*lavasession.RPCEndpoint{
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC},
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "COS3", ApiInterface: spectypes.APIInterfaceTendermintRPC},
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "COS3", ApiInterface: spectypes.APIInterfaceRest},
+	}
+	chainRouter := NewChainRouter("127.0.0.1:3333")
+	for _, endpoint := range endpoints {
+		chainListener, err := NewChainListener(context.Background(), endpoint, echoRelaySender{endpoint: endpoint}, &common.RPCConsumerLogs{})
+		require.NoError(t, err)
+		require.NoError(t, chainRouter.AddChainListener(endpoint, chainListener))
+	}
+	return chainRouter
+}
+
+func TestChainRouterRoutes(t *testing.T) {
+	app := newTestChainRouter(t).newApp(context.Background())
+	send := func(method string, host string, path string, body string) string {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if host != "" {
+			req.Host = host
+		}
+		res, err := app.Test(req, -1)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		reply, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode, string(reply))
+		return string(reply)
+	}
+
+	// a chain with a single api interface is routed with or without it
+	require.Equal(t, "ETH1 jsonrpc  {}", send(http.MethodPost, "", "/eth1/1", "{}"))
+	require.Equal(t, "ETH1 jsonrpc  {}", send(http.MethodPost, "", "/eth1/jsonrpc/1", "{}"))
+	require.Equal(t, "COS3 rest /cosmos/base/blocks/latest ?", send(http.MethodGet, "", "/cos3/rest/1/cosmos/base/blocks/latest", ""))
+	require.Equal(t, "COS3 tendermintrpc  {}", send(http.MethodPost, "", "/cos3/tendermintrpc/1", "{}"))
+
+	// routed by the host
+	require.Equal(t, "ETH1 jsonrpc  {}", send(http.MethodPost, "eth1.lava.example.com", "/1", "{}"))
+	require.Equal(t, "COS3 rest /cosmos/base/blocks/latest ?", send(http.MethodGet, "cos3-rest.lava.example.com:3333", "/1/cosmos/base/blocks/latest", ""))
+	require.Equal(t, "ETH1 jsonrpc  {}", send(http.MethodPost, "eth1.lava.example.com", "/eth1/1", "{}"))
+
+	// a chain with several api interfaces has no default
+	req := httptest.NewRequest(http.MethodPost, "/cos3/1", strings.NewReader("{}"))
+	res, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	discovery := struct {
+		Chains []ChainRoute `json:"chains"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(send(http.MethodGet, "", ChainsDiscoveryPath, "")), &discovery))
+	require.Equal(t, []ChainRoute{
+		{ChainID: "COS3", ApiInterface: spectypes.APIInterfaceRest, Paths: []string{"/cos3/rest"}, Hosts: []string{"cos3-rest"}},
+		{ChainID: "COS3", ApiInterface: spectypes.APIInterfaceTendermintRPC, Paths: []string{"/cos3/tendermintrpc"}, Hosts: []string{"cos3-tendermintrpc"}},
+		{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC, Paths: []string{"/eth1/jsonrpc", "/eth1"}, Hosts: []string{"eth1-jsonrpc", "eth1"}},
+	}, discovery.Chains)
+}
+
+func TestChainRouterWebsocket(t *testing.T) {
+	app := newTestChainRouter(t).newApp(context.Background())
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go app.Listener(listener)
+	defer app.Shutdown()
+
+	for _, path := range []string{"/eth1/ws/1", "/cos3/tendermintrpc/1/websocket"} {
+		conn, _, err := websocket.DefaultDialer.Dial("ws://"+listener.Addr().String()+path, nil)
+		require.NoError(t, err, path)
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("{}")))
+		_, reply, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(string(reply), "  {}"), string(reply))
+		conn.Close()
+	}
+}
+
+func TestChainRouterRejects(t *testing.T) {
+	chainRouter := newTestChainRouter(t)
+	endpoint := &lavasession.RPCEndpoint{NetworkAddress: "127.0.0.1:3333", ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC}
+	chainListener, err := NewChainListener(context.Background(), endpoint, echoRelaySender{endpoint: endpoint}, &common.RPCConsumerLogs{})
+	require.NoError(t, err)
+	require.Error(t, chainRouter.AddChainListener(endpoint, chainListener))
+
+	// grpc is routed by the service name and can't share the listener
+	endpoint = &lavasession.RPCEndpoint{NetworkAddress: "127.0.0.1:3333", ChainID: "COS3", ApiInterface: spectypes.APIInterfaceGrpc}
+	chainListener, err = NewChainListener(context.Background(), endpoint, echoRelaySender{endpoint: endpoint}, &common.RPCConsumerLogs{})
+	require.NoError(t, err)
+	require.Error(t, chainRouter.AddChainListener(endpoint, chainListener))
+
+	// chain ids that differ only in case, or whose host label joins into another chain's, would shadow each other
+	for _, endpoint := range []*lavasession.RPCEndpoint{
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "eth1", ApiInterface: spectypes.APIInterfaceJsonRPC},
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "cos3", ApiInterface: spectypes.APIInterfaceRest},
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "COS3-REST", ApiInterface: spectypes.APIInterfaceJsonRPC},
+		{NetworkAddress: "127.0.0.1:3333", ChainID: "ETH1-JSONRPC", ApiInterface: spectypes.APIInterfaceRest},
+	} {
+		chainListener, err = NewChainListener(context.Background(), endpoint, echoRelaySender{endpoint: endpoint}, &common.RPCConsumerLogs{})
+		require.NoError(t, err)
+		require.Error(t, chainRouter.AddChainListener(endpoint, chainListener), endpoint.ChainID)
+	}
+	require.Len(t, chainRouter.Routes(), 3)
+
+	_, err = GroupEndpointsByAddress([]*lavasession.RPCEndpoint{endpoint, endpoint})
+	require.Error(t, err)
+}
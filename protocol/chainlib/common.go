@@ -44,6 +44,9 @@ func (pm parsedMessage) GetRPCMessage() parser.RPCInput {
 	return rpcInput
 }
 
+// ProviderAddressHeader is set on the http replies of the chain listeners to the provider that served the relay
+const ProviderAddressHeader = "Lava-Provider-Address"
+
 func extractDappIDFromFiberContext(c *fiber.Ctx) (dappID string) {
 	if len(c.Route().Params) > 1 {
 		dappID = c.Route().Params[1]
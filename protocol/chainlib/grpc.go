@@ -3,9 +3,9 @@ package chainlib
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"strings"
@@ -128,7 +128,22 @@ func (apip *GrpcChainParser) SetSpec(spec spectypes.Spec) {
 	apip.taggedApis = taggedApis
 }
 
-// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+// GetSpecApiByTag returns the service api tagged with the function tag in the spec
+func (apip *GrpcChainParser) GetSpecApiByTag(tag string) (spectypes.ServiceApi, bool) {
+	// Guard that the GrpcChainParser instance exists
+	if apip == nil {
+		return spectypes.ServiceApi{}, false
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	val, ok := apip.taggedApis[tag]
+	return val, ok
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.dataReliabilityEnabled and spec.dataReliabilityThreshold)
 func (apip *GrpcChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
 	// Guard that the GrpcChainParser instance exists
 	if apip == nil {
@@ -140,7 +155,7 @@ func (apip *GrpcChainParser) DataReliabilityParams() (enabled bool, dataReliabil
 	defer apip.rwLock.RUnlock()
 
 	// Return enabled and data reliability threshold from spec
-	return apip.spec.Enabled, apip.spec.GetReliabilityThreshold()
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
 }
 
 // ChainBlockStats returns block stats from spec
@@ -156,7 +171,7 @@ func (apip *GrpcChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64,
 	defer apip.rwLock.RUnlock()
 
 	// Convert average block time from int64 -> time.Duration
-	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Second
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
 
 	// Return allowedBlockLagForQosSync, averageBlockTime, blockDistanceForFinalizedData from spec
 	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
@@ -254,31 +269,16 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	connectCtx, cancel := context.WithTimeout(ctx, DefaultTimeout)
 	defer cancel()
 
-	cl := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn)) // TODO: improve functionality, this is reading descriptors every send
-	descriptorSource := chainproxy.DescriptorSourceFromServer(cl)
-	svc, methodName := chainproxy.ParseSymbol(nodeMessage.Path)
-	var descriptor desc.Descriptor
-	if descriptor, err = descriptorSource.FindSymbol(svc); err != nil {
-		return nil, "", nil, utils.LavaFormatError("descriptorSource.FindSymbol", err, nil)
-	}
-
-	serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)
-	if !ok {
-		return nil, "", nil, utils.LavaFormatError("serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)", err, &map[string]string{"descriptor": fmt.Sprintf("%v", descriptor)})
-	}
-	methodDescriptor := serviceDescriptor.FindMethodByName(methodName)
-	if methodDescriptor == nil {
-		return nil, "", nil, utils.LavaFormatError("serviceDescriptor.FindMethodByName returned nil", err, &map[string]string{"methodName": methodName})
+	descriptorSource, methodDescriptor, err := findMethodDescriptor(ctx, conn, nodeMessage.Path)
+	if err != nil {
+		return nil, "", nil, err
 	}
 	msgFactory := dynamic.NewMessageFactoryWithDefaults()
 
-	var reader io.Reader
+	// the parser doesn't accept a nil reader even when there is no message to parse
+	reader := bytes.NewReader(nodeMessage.Msg)
 	msg := msgFactory.NewMessage(methodDescriptor.GetInputType())
-	formatMessage := false
-	if len(nodeMessage.Msg) > 0 {
-		reader = bytes.NewReader(nodeMessage.Msg)
-		formatMessage = true
-	}
+	formatMessage := len(nodeMessage.Msg) > 0
 
 	// nodeMessage.MethodDesc = methodDescriptor // TODO: this is useful for parsing the response
 	// rp, formatter, err := grpcurl.RequestParserAndFormatter(grpcurl.FormatJSON, descriptorSource, reader, grpcurl.FormatOptions{
@@ -315,3 +315,48 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	}
 	return reply, "", nil, nil
 }
+
+// FormatReply returns the json form of a node reply, grpc replies are protobuf encoded so the reply message descriptor is read from the node
+func (cp *GrpcChainProxy) FormatReply(ctx context.Context, chainMessage ChainMessage, replyData []byte) (json.RawMessage, error) {
+	conn, err := cp.conn.GetRpc(ctx, true)
+	if err != nil {
+		return nil, utils.LavaFormatError("grpc get connection failed ", err, nil)
+	}
+	defer cp.conn.ReturnRpc(conn)
+
+	rpcInputMessage := chainMessage.GetRPCMessage()
+	nodeMessage, ok := rpcInputMessage.(chainproxy.GrpcMessage)
+	if !ok {
+		return nil, utils.LavaFormatError("invalid message type in grpc failed to cast RPCInput from chainMessage", nil, &map[string]string{"rpcMessage": fmt.Sprintf("%+v", rpcInputMessage)})
+	}
+	_, methodDescriptor, err := findMethodDescriptor(ctx, conn, nodeMessage.Path)
+	if err != nil {
+		return nil, err
+	}
+	response := dynamic.NewMessageFactoryWithDefaults().NewDynamicMessage(methodDescriptor.GetOutputType())
+	err = response.Unmarshal(replyData)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed unmarshaling grpc reply", err, &map[string]string{"Method": nodeMessage.Path})
+	}
+	return response.MarshalJSON()
+}
+
+func findMethodDescriptor(ctx context.Context, conn *grpc.ClientConn, path string) (grpcurl.DescriptorSource, *desc.MethodDescriptor, error) {
+	cl := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn)) // TODO: improve functionality, this is reading descriptors every send
+	descriptorSource := chainproxy.DescriptorSourceFromServer(cl)
+	svc, methodName := chainproxy.ParseSymbol(path)
+	descriptor, err := descriptorSource.FindSymbol(svc)
+	if err != nil {
+		return nil, nil, utils.LavaFormatError("descriptorSource.FindSymbol", err, nil)
+	}
+
+	serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)
+	if !ok {
+		return nil, nil, utils.LavaFormatError("serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)", nil, &map[string]string{"descriptor": fmt.Sprintf("%v", descriptor)})
+	}
+	methodDescriptor := serviceDescriptor.FindMethodByName(methodName)
+	if methodDescriptor == nil {
+		return nil, nil, utils.LavaFormatError("serviceDescriptor.FindMethodByName returned nil", nil, &map[string]string{"methodName": methodName})
+	}
+	return descriptorSource, methodDescriptor, nil
+}
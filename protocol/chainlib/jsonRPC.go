@@ -77,7 +77,7 @@ func (apip *JsonRPCChainParser) ParseMsg(url string, data []byte, connectionType
 		serviceApi:     serviceApi,
 		apiInterface:   apiInterface,
 		requestedBlock: requestedBlock,
-		msg:            msg,
+		msg:            *msg, // the chain proxy casts the message by value
 	}
 	return nodeMsg, nil
 }
@@ -129,7 +129,22 @@ func (apip *JsonRPCChainParser) getSupportedApi(name string) (*spectypes.Service
 	return &api, nil
 }
 
-// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+// GetSpecApiByTag returns the service api tagged with the function tag in the spec
+func (apip *JsonRPCChainParser) GetSpecApiByTag(tag string) (spectypes.ServiceApi, bool) {
+	// Guard that the JsonRPCChainParser instance exists
+	if apip == nil {
+		return spectypes.ServiceApi{}, false
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	val, ok := apip.taggedApis[tag]
+	return val, ok
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.dataReliabilityEnabled and spec.dataReliabilityThreshold)
 func (apip *JsonRPCChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
 	// Guard that the JsonRPCChainParser instance exists
 	if apip == nil {
@@ -141,7 +156,7 @@ func (apip *JsonRPCChainParser) DataReliabilityParams() (enabled bool, dataRelia
 	defer apip.rwLock.RUnlock()
 
 	// Return enabled and data reliability threshold from spec
-	return apip.spec.Enabled, apip.spec.GetReliabilityThreshold()
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
 }
 
 // ChainBlockStats returns block stats from spec
@@ -157,7 +172,7 @@ func (apip *JsonRPCChainParser) ChainBlockStats() (allowedBlockLagForQosSync int
 	defer apip.rwLock.RUnlock()
 
 	// Convert average block time from int64 -> time.Duration
-	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Second
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
 
 	// Return allowedBlockLagForQosSync, averageBlockTime, blockDistanceForFinalizedData from spec
 	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
@@ -193,7 +208,18 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 
 	app.Use(favicon.New())
 
-	app.Use("/ws/:dappId", func(c *fiber.Ctx) error {
+	apil.RegisterRoutes(ctx, app)
+
+	// Go
+	err := app.Listen(apil.endpoint.NetworkAddress)
+	if err != nil {
+		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
+	}
+}
+
+// RegisterRoutes adds the routes of the listener to the router, which is the app of the listener or its group in a ChainRouter
+func (apil *JsonRPCChainListener) RegisterRoutes(ctx context.Context, router fiber.Router) {
+	router.Use("/ws/:dappId", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("jsonRpc-WebSocket")
 		// IsWebSocketUpgrade returns true if the client
 		// requested upgrade to the WebSocket protocol.
@@ -227,6 +253,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 			reply, replyServer, err := apil.relaySender.SendRelay(ctx, "", string(msg), http.MethodGet, dappID, metricsData)
 			go apil.logger.AddMetric(metricsData, err != nil)
+			apil.logger.RecordRelay("", string(msg), http.MethodGet, metricsData, reply, err)
 			if err != nil {
 				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 				continue
@@ -271,10 +298,10 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 		}
 	})
 	websocketCallbackWithDappID := constructFiberCallbackWithDappIDExtraction(webSocketCallback)
-	app.Get("/ws/:dappId", websocketCallbackWithDappID)
-	app.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
+	router.Get("/ws/:dappId", websocketCallbackWithDappID)
+	router.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
 
-	app.Post("/:dappId/*", func(c *fiber.Ctx) error {
+	router.Post("/:dappId/*", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("jsonRpc-http post")
 		msgSeed := apil.logger.GetMessageSeed()
 		dappID := extractDappIDFromFiberContext(c)
@@ -283,6 +310,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 
 		reply, _, err := apil.relaySender.SendRelay(ctx, "", string(c.Body()), http.MethodGet, dappID, metricsData)
 		go apil.logger.AddMetric(metricsData, err != nil)
+		apil.logger.RecordRelay("", string(c.Body()), http.MethodGet, metricsData, reply, err)
 		if err != nil {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
@@ -310,15 +338,10 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context) {
 			nil,
 		)
 
+		c.Set(ProviderAddressHeader, metricsData.ProviderAddress)
 		// Return json response
 		return c.SendString(string(reply.Data))
 	})
-
-	// Go
-	err := app.Listen(apil.endpoint.NetworkAddress)
-	if err != nil {
-		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
-	}
 }
 
 type JrpcChainProxy struct {
@@ -123,7 +123,22 @@ func (apip *RestChainParser) SetSpec(spec spectypes.Spec) {
 	apip.taggedApis = taggedApis
 }
 
-// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+// GetSpecApiByTag returns the service api tagged with the function tag in the spec
+func (apip *RestChainParser) GetSpecApiByTag(tag string) (spectypes.ServiceApi, bool) {
+	// Guard that the RestChainParser instance exists
+	if apip == nil {
+		return spectypes.ServiceApi{}, false
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	val, ok := apip.taggedApis[tag]
+	return val, ok
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.dataReliabilityEnabled and spec.dataReliabilityThreshold)
 func (apip *RestChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
 	// Guard that the RestChainParser instance exists
 	if apip == nil {
@@ -135,7 +150,7 @@ func (apip *RestChainParser) DataReliabilityParams() (enabled bool, dataReliabil
 	defer apip.rwLock.RUnlock()
 
 	// Return enabled and data reliability threshold from spec
-	return apip.spec.Enabled, apip.spec.GetReliabilityThreshold()
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
 }
 
 // ChainBlockStats returns block stats from spec
@@ -151,7 +166,7 @@ func (apip *RestChainParser) ChainBlockStats() (allowedBlockLagForQosSync int64,
 	defer apip.rwLock.RUnlock()
 
 	// Convert average block time from int64 -> time.Duration
-	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Second
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
 
 	// Return values
 	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
@@ -187,10 +202,21 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 
 	app.Use(favicon.New())
 
+	apil.RegisterRoutes(ctx, app)
+
+	// Go
+	err := app.Listen(apil.endpoint.NetworkAddress)
+	if err != nil {
+		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
+	}
+}
+
+// RegisterRoutes adds the routes of the listener to the router, which is the app of the listener or its group in a ChainRouter
+func (apil *RestChainListener) RegisterRoutes(ctx context.Context, router fiber.Router) {
 	chainID := apil.endpoint.ChainID
 	apiInterface := apil.endpoint.ApiInterface
 	// Catch Post
-	app.Post("/:dappId/*", func(c *fiber.Ctx) error {
+	router.Post("/:dappId/*", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("rest-http")
 
 		msgSeed := apil.logger.GetMessageSeed()
@@ -205,6 +231,7 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 		requestBody := string(c.Body())
 		reply, _, err := apil.relaySender.SendRelay(ctx, path, requestBody, http.MethodPost, dappID, metricsData)
 		go apil.logger.AddMetric(metricsData, err != nil)
+		apil.logger.RecordRelay(path, requestBody, http.MethodPost, metricsData, reply, err)
 		if err != nil {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
@@ -224,12 +251,13 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 		// Log request and response
 		apil.logger.LogRequestAndResponse("http in/out", false, http.MethodPost, path, requestBody, string(reply.Data), msgSeed, nil)
 
+		c.Set(ProviderAddressHeader, metricsData.ProviderAddress)
 		// Return json response
 		return c.SendString(string(reply.Data))
 	})
 
 	// Catch the others
-	app.Use("/:dappId/*", func(c *fiber.Ctx) error {
+	router.Use("/:dappId/*", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("rest-http")
 		msgSeed := apil.logger.GetMessageSeed()
 
@@ -245,6 +273,7 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 
 		reply, _, err := apil.relaySender.SendRelay(ctx, path, query, http.MethodGet, dappID, analytics)
 		go apil.logger.AddMetric(analytics, err != nil)
+		apil.logger.RecordRelay(path, query, http.MethodGet, analytics, reply, err)
 		if err != nil {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
@@ -264,15 +293,10 @@ func (apil *RestChainListener) Serve(ctx context.Context) {
 		// Log request and response
 		apil.logger.LogRequestAndResponse("http in/out", false, http.MethodGet, path, "", string(reply.Data), msgSeed, nil)
 
+		c.Set(ProviderAddressHeader, analytics.ProviderAddress)
 		// Return json response
 		return c.SendString(string(reply.Data))
 	})
-
-	// Go
-	err := app.Listen(apil.endpoint.NetworkAddress)
-	if err != nil {
-		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
-	}
 }
 
 type RestChainProxy struct {
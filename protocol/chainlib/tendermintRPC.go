@@ -47,6 +47,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 	// connectionType is currently only used in rest api
 	// Unmarshal request
 	var msg chainproxy.JsonrpcMessage
+	path := "" // a uri request is sent to the node by its path
 	if string(data) != "" {
 		// Fetch pointer to message and error
 		msgPtr, err := chainproxy.ParseJsonRPCMsg(data)
@@ -58,6 +59,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 		msg = *msgPtr
 	} else {
 		// assuming URI
+		path = url
 		var parsedMethod string
 		idx := strings.Index(url, "?")
 		if idx == -1 {
@@ -124,7 +126,7 @@ func (apip *TendermintChainParser) ParseMsg(url string, data []byte, connectionT
 		serviceApi:     serviceApi,
 		apiInterface:   apiInterface,
 		requestedBlock: requestedBlock,
-		msg:            msg,
+		msg:            chainproxy.TendermintrpcMessage{JsonrpcMessage: msg, Path: path},
 	}
 	return nodeMsg, nil
 }
@@ -176,7 +178,22 @@ func (apip *TendermintChainParser) SetSpec(spec spectypes.Spec) {
 	apip.taggedApis = taggedApis
 }
 
-// DataReliabilityParams returns data reliability params from spec (spec.enabled and spec.dataReliabilityThreshold)
+// GetSpecApiByTag returns the service api tagged with the function tag in the spec
+func (apip *TendermintChainParser) GetSpecApiByTag(tag string) (spectypes.ServiceApi, bool) {
+	// Guard that the TendermintChainParser instance exists
+	if apip == nil {
+		return spectypes.ServiceApi{}, false
+	}
+
+	// Acquire read lock
+	apip.rwLock.RLock()
+	defer apip.rwLock.RUnlock()
+
+	val, ok := apip.taggedApis[tag]
+	return val, ok
+}
+
+// DataReliabilityParams returns data reliability params from spec (spec.dataReliabilityEnabled and spec.dataReliabilityThreshold)
 func (apip *TendermintChainParser) DataReliabilityParams() (enabled bool, dataReliabilityThreshold uint32) {
 	// Guard that the TendermintChainParser instance exists
 	if apip == nil {
@@ -188,7 +205,7 @@ func (apip *TendermintChainParser) DataReliabilityParams() (enabled bool, dataRe
 	defer apip.rwLock.RUnlock()
 
 	// Return enabled and data reliability threshold from spec
-	return apip.spec.Enabled, apip.spec.GetReliabilityThreshold()
+	return apip.spec.DataReliabilityEnabled, apip.spec.GetReliabilityThreshold()
 }
 
 // ChainBlockStats returns block stats from spec
@@ -204,7 +221,7 @@ func (apip *TendermintChainParser) ChainBlockStats() (allowedBlockLagForQosSync
 	defer apip.rwLock.RUnlock()
 
 	// Convert average block time from int64 -> time.Duration
-	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Second
+	averageBlockTime = time.Duration(apip.spec.AverageBlockTime) * time.Millisecond
 
 	// Return allowedBlockLagForQosSync, averageBlockTime, blockDistanceForFinalizedData from spec
 	return apip.spec.AllowedBlockLagForQosSync, averageBlockTime, apip.spec.BlockDistanceForFinalizedData, apip.spec.BlocksInFinalizationProof
@@ -237,12 +254,24 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
-	chainID := apil.endpoint.ChainID
-	apiInterface := apil.endpoint.ApiInterface
 
 	app.Use(favicon.New())
 
-	app.Use("/ws/:dappId", func(c *fiber.Ctx) error {
+	apil.RegisterRoutes(ctx, app)
+
+	// Go
+	err := app.Listen(apil.endpoint.NetworkAddress)
+	if err != nil {
+		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
+	}
+}
+
+// RegisterRoutes adds the routes of the listener to the router, which is the app of the listener or its group in a ChainRouter
+func (apil *TendermintRpcChainListener) RegisterRoutes(ctx context.Context, router fiber.Router) {
+	chainID := apil.endpoint.ChainID
+	apiInterface := apil.endpoint.ApiInterface
+
+	router.Use("/ws/:dappId", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("tendermint-WebSocket")
 		// IsWebSocketUpgrade returns true if the client
 		// requested upgrade to the WebSocket protocol.
@@ -272,6 +301,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 			reply, replyServer, err := apil.relaySender.SendRelay(ctx, "", string(msg), http.MethodGet, dappID, metricsData)
 			go apil.logger.AddMetric(metricsData, err != nil)
+			apil.logger.RecordRelay("", string(msg), http.MethodGet, metricsData, reply, err)
 			if err != nil {
 				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 				continue
@@ -315,10 +345,10 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		}
 	})
 	websocketCallbackWithDappID := constructFiberCallbackWithDappIDExtraction(webSocketCallback)
-	app.Get("/ws/:dappId", websocketCallbackWithDappID)
-	app.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
+	router.Get("/ws/:dappId", websocketCallbackWithDappID)
+	router.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
 
-	app.Post("/:dappId/*", func(c *fiber.Ctx) error {
+	router.Post("/:dappId/*", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("tendermint-WebSocket")
 		msgSeed := apil.logger.GetMessageSeed()
 		dappID := extractDappIDFromFiberContext(c)
@@ -326,6 +356,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		reply, _, err := apil.relaySender.SendRelay(ctx, "", string(c.Body()), http.MethodGet, dappID, metricsData)
 		go apil.logger.AddMetric(metricsData, err != nil)
+		apil.logger.RecordRelay("", string(c.Body()), http.MethodGet, metricsData, reply, err)
 		if err != nil {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
@@ -345,11 +376,12 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		// Log request and response
 		apil.logger.LogRequestAndResponse("tendermint http in/out", false, "POST", c.Request().URI().String(), string(c.Body()), string(reply.Data), msgSeed, nil)
 
+		c.Set(ProviderAddressHeader, metricsData.ProviderAddress)
 		// Return json response
 		return c.SendString(string(reply.Data))
 	})
 
-	app.Get("/:dappId/*", func(c *fiber.Ctx) error {
+	router.Get("/:dappId/*", func(c *fiber.Ctx) error {
 		apil.logger.LogStartTransaction("tendermint-WebSocket")
 
 		query := "?" + string(c.Request().URI().QueryString())
@@ -364,6 +396,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		reply, _, err := apil.relaySender.SendRelay(ctx, path+query, "", http.MethodGet, dappID, metricsData)
 		go apil.logger.AddMetric(metricsData, err != nil)
+		apil.logger.RecordRelay(path+query, "", http.MethodGet, metricsData, reply, err)
 		if err != nil {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
@@ -387,15 +420,10 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context) {
 		// Log request and response
 		apil.logger.LogRequestAndResponse("tendermint http in/out", false, "GET", c.Request().URI().String(), "", string(reply.Data), msgSeed, nil)
 
+		c.Set(ProviderAddressHeader, metricsData.ProviderAddress)
 		// Return json response
 		return c.SendString(string(reply.Data))
 	})
-	//
-	// Go
-	err := app.Listen(apil.endpoint.NetworkAddress)
-	if err != nil {
-		utils.LavaFormatError("app.Listen(listenAddr)", err, nil)
-	}
 }
 
 type tendermintRpcChainProxy struct {
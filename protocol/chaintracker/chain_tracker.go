@@ -119,6 +119,7 @@ func (cs *ChainTracker) fetchAllPreviousBlocks(ctx context.Context, latestBlock
 		blockNumToFetch := latestBlock - idx // reading the blocks from the newest to oldest
 		newHashForBlock, err := cs.fetchBlockHashByNum(ctx, blockNumToFetch)
 		if err != nil {
+			cs.blockQueueMu.RUnlock()
 			return utils.LavaFormatError("could not get block data in Chain Tracker", err, &map[string]string{"block": strconv.FormatInt(blockNumToFetch, 10)})
 		}
 		var foundOverlap bool
@@ -270,6 +271,9 @@ func (cs *ChainTracker) start(ctx context.Context, pollingBlockTime time.Duratio
 			case <-cs.quit:
 				ticker.Stop()
 				return
+			case <-ctx.Done():
+				ticker.Stop()
+				return
 			}
 		}
 	}()
@@ -5,12 +5,15 @@ import (
 	"math/rand"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/websocket/v2"
 	"github.com/joho/godotenv"
+	"github.com/lavanet/lava/protocol/recorder"
 	"github.com/lavanet/lava/relayer/metrics"
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
@@ -22,6 +25,7 @@ type RPCConsumerLogs struct {
 	newRelicApplication *newrelic.Application
 	MetricService       *metrics.MetricService
 	StoreMetricData     bool
+	Recorder            *recorder.Recorder // optional, records the relays of the chain listeners
 }
 
 func NewRPCConsumerLogs() (*RPCConsumerLogs, error) {
@@ -101,3 +105,29 @@ func (pl *RPCConsumerLogs) AddMetric(data *metrics.RelayMetrics, isNotSuccessful
 		pl.MetricService.SendData(*data)
 	}
 }
+
+// RecordRelay records a relay sent by a chain listener, url, req and connectionType are the arguments of SendRelay
+func (pl *RPCConsumerLogs) RecordRelay(url string, req string, connectionType string, data *metrics.RelayMetrics, reply *pairingtypes.RelayReply, err error) {
+	if pl.Recorder == nil {
+		return
+	}
+	record := &recorder.Record{
+		Time:           data.Timestamp,
+		ChainID:        data.ChainID,
+		ApiInterface:   data.APIType,
+		Api:            data.ApiName,
+		ConnectionType: connectionType,
+		Url:            url,
+		Data:           req,
+		DappID:         data.ProjectHash,
+		Provider:       data.ProviderAddress,
+		LatencyMs:      time.Since(data.Timestamp).Milliseconds(),
+	}
+	if reply != nil {
+		record.Reply = string(reply.Data)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	go pl.Recorder.Record(record)
+}
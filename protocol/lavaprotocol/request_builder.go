@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/sigs"
@@ -50,7 +49,7 @@ func NewRelayRequestCommonData(chainID string, connectionType string, apiUrl str
 	}
 }
 
-func ConstructRelayRequest(ctx context.Context, privKey *btcec.PrivateKey, chainID string, relayRequestCommonData RelayRequestCommonData, providerPublicAddress string, consumerSession *lavasession.SingleConsumerSession, epoch int64, reportedProviders []byte) (*pairingtypes.RelayRequest, error) {
+func ConstructRelayRequest(ctx context.Context, signer sigs.Signer, chainID string, relayRequestCommonData RelayRequestCommonData, providerPublicAddress string, consumerSession *lavasession.SingleConsumerSession, epoch int64, reportedProviders []byte) (*pairingtypes.RelayRequest, error) {
 	relayRequest := &pairingtypes.RelayRequest{
 		Provider:              providerPublicAddress,
 		ConnectionType:        relayRequestCommonData.ConnectionType,
@@ -66,7 +65,13 @@ func ConstructRelayRequest(ctx context.Context, privKey *btcec.PrivateKey, chain
 		DataReliability:       nil,
 		UnresponsiveProviders: reportedProviders,
 	}
-	sig, err := sigs.SignRelay(privKey, *relayRequest)
+	// the compact proof lets the provider claim the payment without the relay payload
+	proofSig, err := sigs.SignRelayProof(signer, *relayRequest.RelayProof())
+	if err != nil {
+		return nil, err
+	}
+	relayRequest.ProofSig = proofSig
+	sig, err := sigs.SignRelay(signer, *relayRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +131,7 @@ func NewVRFData(differentiator bool, vrf_res []byte, vrf_proof []byte, request *
 	return dataReliability
 }
 
-func ConstructDataReliabilityRelayRequest(ctx context.Context, vrfData *pairingtypes.VRFData, privKey *btcec.PrivateKey, chainID string, relayRequestCommonData *RelayRequestCommonData, providerPublicAddress string, epoch int64, reportedProviders []byte) (*pairingtypes.RelayRequest, error) {
+func ConstructDataReliabilityRelayRequest(ctx context.Context, vrfData *pairingtypes.VRFData, signer sigs.Signer, chainID string, relayRequestCommonData *RelayRequestCommonData, providerPublicAddress string, epoch int64, reportedProviders []byte) (*pairingtypes.RelayRequest, error) {
 	if relayRequestCommonData.RequestBlock < 0 {
 		return nil, utils.LavaFormatError("tried to construct data reliability relay with invalid request block, need to specify exactly what block is required", nil,
 			&map[string]string{"requested_common_data": fmt.Sprintf("%+v", relayRequestCommonData), "epoch": strconv.FormatInt(epoch, 10), "chainID": chainID})
@@ -146,13 +151,13 @@ func ConstructDataReliabilityRelayRequest(ctx context.Context, vrfData *pairingt
 		DataReliability:       vrfData,
 		UnresponsiveProviders: reportedProviders,
 	}
-	sig, err := sigs.SignRelay(privKey, *relayRequest)
+	sig, err := sigs.SignRelay(signer, *relayRequest)
 	if err != nil {
 		return nil, err
 	}
 	relayRequest.Sig = sig
 
-	sig, err = sigs.SignVRFData(privKey, relayRequest.DataReliability)
+	sig, err = sigs.SignVRFData(signer, relayRequest.DataReliability)
 	if err != nil {
 		return nil, err
 	}
@@ -201,10 +206,10 @@ func compareRelaysFindConflict(result1 *RelayResult, result2 *RelayResult) (conf
 		return false, nil
 	}
 	// they have different data! report!
-	utils.LavaFormatWarning("Simulation: DataReliability detected mismatching results, Reporting...", nil, &map[string]string{"Data0": string(result1.Reply.Data), "Data1": string(result2.Reply.Data)})
+	utils.LavaFormatWarning("DataReliability detected mismatching results, Reporting...", nil, &map[string]string{"Data0": string(result1.Reply.Data), "Data1": string(result2.Reply.Data)})
 	responseConflict = &conflicttypes.ResponseConflict{
 		ConflictRelayData0: &conflicttypes.ConflictRelayData{Reply: result1.Reply, Request: result1.Request},
 		ConflictRelayData1: &conflicttypes.ConflictRelayData{Reply: result2.Reply, Request: result2.Request},
 	}
-	return
+	return true, responseConflict
 }
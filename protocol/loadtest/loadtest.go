@@ -0,0 +1,194 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	RPSFlag          = "rps"
+	ConcurrencyFlag  = "concurrency"
+	DurationFlag     = "duration"
+	MixFlag          = "mix"
+	RequestsFileFlag = "requests-file"
+	DappIDFlag       = "dapp-id"
+	TimeoutFlag      = "timeout"
+
+	maxErrorLength = 120
+)
+
+type Config struct {
+	ConsumerURL string // the address of the rpcconsumer listener of the api interface, e.g. http://127.0.0.1:3333
+	DappID      string
+	RPS         float64 // the requests sent per second across all the workers, 0 sends as fast as the workers can
+	Concurrency int     // the number of requests in flight
+	Duration    time.Duration
+	Timeout     time.Duration // the timeout of a single request
+}
+
+// LoadTest sends the picked requests to an rpcconsumer and aggregates the results
+type LoadTest struct {
+	config       Config
+	picker       RequestPicker
+	computeUnits map[string]uint64 // the compute units of each spec api
+	client       *http.Client
+}
+
+func NewLoadTest(config Config, picker RequestPicker, computeUnits map[string]uint64) (*LoadTest, error) {
+	if config.Concurrency <= 0 {
+		return nil, utils.LavaFormatError("loadtest concurrency has to be positive", nil, &map[string]string{"concurrency": strconv.Itoa(config.Concurrency)})
+	}
+	if config.RPS < 0 {
+		return nil, utils.LavaFormatError("loadtest rps can't be negative", nil, &map[string]string{"rps": strconv.FormatFloat(config.RPS, 'f', -1, 64)})
+	}
+	_, err := url.ParseRequestURI(config.ConsumerURL)
+	if err != nil {
+		return nil, utils.LavaFormatError("invalid consumer url", err, &map[string]string{"url": config.ConsumerURL})
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = config.Concurrency
+	return &LoadTest{
+		config:       config,
+		picker:       picker,
+		computeUnits: computeUnits,
+		client:       &http.Client{Timeout: config.Timeout, Transport: transport},
+	}, nil
+}
+
+// Run sends requests until the duration passes or the context is done and returns their stats
+func (lt *LoadTest) Run(ctx context.Context) *Stats {
+	ctx, cancel := context.WithTimeout(ctx, lt.config.Duration)
+	defer cancel()
+	stats := NewStats()
+	// with a target rate the workers wait for a tick before every request, otherwise they send back to back
+	var ticks <-chan time.Time
+	if lt.config.RPS > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / lt.config.RPS))
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+	wg := sync.WaitGroup{}
+	for worker := 0; worker < lt.config.Concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if ticks != nil {
+					select {
+					case <-ticks:
+					case <-ctx.Done():
+						return
+					}
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				result := lt.send(ctx, lt.picker.Pick())
+				if ctx.Err() != nil {
+					// requests cut by the end of the test aren't counted
+					return
+				}
+				stats.Add(result)
+			}
+		}()
+	}
+	wg.Wait()
+	return stats
+}
+
+func (lt *LoadTest) send(ctx context.Context, request *Request) *Result {
+	result := &Result{Api: request.Api}
+	requestURL := strings.TrimSuffix(lt.config.ConsumerURL, "/") + "/" + lt.config.DappID + "/" + strings.TrimPrefix(request.Url, "/")
+	httpRequest, err := http.NewRequestWithContext(ctx, request.HttpMethod(), requestURL, strings.NewReader(request.Data))
+	if err != nil {
+		result.Error = "invalid request: " + err.Error()
+		return result
+	}
+	if request.Data != "" {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	start := time.Now()
+	res, err := lt.client.Do(httpRequest)
+	if err != nil {
+		result.Latency = time.Since(start)
+		result.Error = transportErrorCategory(err)
+		return result
+	}
+	defer res.Body.Close()
+	reply, err := io.ReadAll(res.Body)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Error = transportErrorCategory(err)
+		return result
+	}
+	result.Error = replyErrorCategory(res.StatusCode, reply)
+	result.ProviderAddress = res.Header.Get(chainlib.ProviderAddressHeader)
+	result.ComputeUnits = lt.computeUnits[request.Api]
+	return result
+}
+
+func transportErrorCategory(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if urlErr.Timeout() {
+			return "request timeout"
+		}
+		err = urlErr.Err
+	}
+	return truncate("request failed: " + err.Error())
+}
+
+// replyErrorCategory returns the error of the reply without the parts that are unique to the request, or an empty string for a successful reply
+func replyErrorCategory(statusCode int, reply []byte) string {
+	errorReply := struct {
+		Error json.RawMessage `json:"error"`
+	}{}
+	// a reply that isn't a json object, e.g. a json array of a batch, has no error field
+	_ = json.Unmarshal(bytes.TrimSpace(reply), &errorReply)
+	if statusCode != http.StatusOK {
+		message := ""
+		if json.Unmarshal(errorReply.Error, &message) != nil {
+			message = string(reply)
+		}
+		// the consumer masks its errors with a guid: "Error GUID: <guid>, Error: <error> -- <details>"
+		if idx := strings.Index(message, "Error: "); idx >= 0 {
+			message = message[idx+len("Error: "):]
+		}
+		if idx := strings.Index(message, " -- "); idx >= 0 {
+			message = message[:idx]
+		}
+		return truncate(fmt.Sprintf("status %d: %s", statusCode, message))
+	}
+	if len(errorReply.Error) == 0 || string(errorReply.Error) == "null" {
+		return ""
+	}
+	// the node replied with a json rpc error
+	nodeError := struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{}
+	if json.Unmarshal(errorReply.Error, &nodeError) != nil {
+		return truncate("node error: " + string(errorReply.Error))
+	}
+	return truncate(fmt.Sprintf("node error %d: %s", nodeError.Code, nodeError.Message))
+}
+
+func truncate(message string) string {
+	if len(message) > maxErrorLength {
+		return message[:maxErrorLength] + "..."
+	}
+	return message
+}
@@ -0,0 +1,182 @@
+package loadtest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpec() *spectypes.Spec {
+	jsonRpcApi := func(name string, computeUnits uint64) spectypes.ServiceApi {
+		return spectypes.ServiceApi{
+			Name:          name,
+			Enabled:       true,
+			ComputeUnits:  computeUnits,
+			ApiInterfaces: []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceJsonRPC, Type: http.MethodGet, Category: &spectypes.SpecCategory{Deterministic: true}}},
+		}
+	}
+	return &spectypes.Spec{
+		Index: "TEST1",
+		Apis: []spectypes.ServiceApi{
+			jsonRpcApi("eth_blockNumber", 10),
+			jsonRpcApi("eth_getBalance", 20),
+			{
+				Name:          "/blocks/latest",
+				Enabled:       true,
+				ComputeUnits:  10,
+				ApiInterfaces: []spectypes.ApiInterface{{Interface: spectypes.APIInterfaceRest, Type: http.MethodGet, Category: &spectypes.SpecCategory{Deterministic: true}}},
+			},
+		},
+	}
+}
+
+func TestMixPicker(t *testing.T) {
+	spec := testSpec()
+	// with no mix every api of the interface is sent
+	picker, err := NewMixPicker(spec, spectypes.APIInterfaceJsonRPC, nil)
+	require.NoError(t, err)
+	picked := map[string]int{}
+	for idx := 0; idx < 1000; idx++ {
+		picked[picker.Pick().Api]++
+	}
+	require.Len(t, picked, 2)
+
+	mix := &Mix{Apis: []MixEntry{
+		{Api: "eth_blockNumber", Weight: 3},
+		{Api: "eth_getBalance", Weight: 1, Params: []string{`["0x0", "latest"]`}},
+	}}
+	picker, err = NewMixPicker(spec, spectypes.APIInterfaceJsonRPC, mix)
+	require.NoError(t, err)
+	picked = map[string]int{}
+	for idx := 0; idx < 4000; idx++ {
+		request := picker.Pick()
+		picked[request.Api]++
+		if request.Api == "eth_getBalance" {
+			require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0","latest"]}`, request.Data)
+		}
+	}
+	require.InDelta(t, 3000, picked["eth_blockNumber"], 200)
+
+	_, err = NewMixPicker(spec, spectypes.APIInterfaceJsonRPC, &Mix{Apis: []MixEntry{{Api: "/blocks/latest", Weight: 1}}})
+	require.Error(t, err)
+	_, err = NewMixPicker(spec, spectypes.APIInterfaceJsonRPC, &Mix{Apis: []MixEntry{{Api: "eth_getBalance", Weight: 1, Params: []string{"not json"}}}})
+	require.Error(t, err)
+
+	picker, err = NewMixPicker(spec, spectypes.APIInterfaceRest, nil)
+	require.NoError(t, err)
+	request := picker.Pick()
+	require.Equal(t, "/blocks/latest", request.Url)
+	require.Equal(t, http.MethodGet, request.HttpMethod())
+}
+
+func TestReadRecordedRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.jsonl")
+	records := `{"api":"eth_blockNumber","api_interface":"jsonrpc","data":"{\"method\":\"eth_blockNumber\"}","provider":"lava@1"}
+
+{"api":"/blocks/latest","api_interface":"rest","connection_type":"GET","url":"/blocks/latest","data":"?height=1"}
+{"api":"eth_getBalance","api_interface":"jsonrpc","data":"{\"method\":\"eth_getBalance\"}"}
+`
+	require.NoError(t, os.WriteFile(path, []byte(records), 0o600))
+	picker, err := ReadRecordedRequests(path, spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	// the requests are replayed in order
+	require.Equal(t, "eth_blockNumber", picker.Pick().Api)
+	require.Equal(t, "eth_getBalance", picker.Pick().Api)
+	require.Equal(t, "eth_blockNumber", picker.Pick().Api)
+
+	// the query string the rest listener relays as data is sent in the url
+	picker, err = ReadRecordedRequests(path, spectypes.APIInterfaceRest)
+	require.NoError(t, err)
+	request := picker.Pick()
+	require.Equal(t, "/blocks/latest?height=1", request.Url)
+	require.Equal(t, http.MethodGet, request.HttpMethod())
+
+	_, err = ReadRecordedRequests(path, spectypes.APIInterfaceGrpc)
+	require.Error(t, err)
+}
+
+func TestReplyErrorCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		reply      string
+		expected   string
+	}{
+		{name: "success", statusCode: http.StatusOK, reply: `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, expected: ""},
+		{name: "batch", statusCode: http.StatusOK, reply: `[{"jsonrpc":"2.0","id":1,"result":"0x1"}]`, expected: ""},
+		{name: "node error", statusCode: http.StatusOK, reply: `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`, expected: "node error -32601: method not found"},
+		{name: "masked consumer error", statusCode: http.StatusInternalServerError, reply: `{"error":"Error GUID: GUID_123, Error: Failed all retries -- map[errors:...]"}`, expected: "status 500: Failed all retries"},
+		{name: "not json", statusCode: http.StatusBadGateway, reply: "bad gateway", expected: "status 502: bad gateway"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, replyErrorCategory(tt.statusCode, []byte(tt.reply)))
+		})
+	}
+}
+
+func TestLoadTest(t *testing.T) {
+	var served uint64
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "/dapp/", request.URL.Path)
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		message := struct {
+			Method string `json:"method"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, &message))
+		if message.Method == "eth_getBalance" {
+			writer.WriteHeader(http.StatusInternalServerError)
+			writer.Write([]byte(`{"error":"Error GUID: GUID_1, Error: Failed all retries -- details"}`))
+			return
+		}
+		if atomic.AddUint64(&served, 1)%2 == 0 {
+			writer.Header().Set(chainlib.ProviderAddressHeader, "lava@provider0")
+		} else {
+			writer.Header().Set(chainlib.ProviderAddressHeader, "lava@provider1")
+		}
+		writer.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer server.Close()
+
+	spec := testSpec()
+	picker, err := NewMixPicker(spec, spectypes.APIInterfaceJsonRPC, nil)
+	require.NoError(t, err)
+	computeUnits := map[string]uint64{"eth_blockNumber": 10, "eth_getBalance": 20}
+	loadTest, err := NewLoadTest(Config{ConsumerURL: server.URL, DappID: "dapp", Concurrency: 4, Duration: 300 * time.Millisecond, Timeout: time.Second}, picker, computeUnits)
+	require.NoError(t, err)
+	stats := loadTest.Run(context.Background())
+
+	require.Greater(t, stats.Requests, uint64(10))
+	blockNumber, getBalance := stats.Apis["eth_blockNumber"], stats.Apis["eth_getBalance"]
+	require.NotNil(t, blockNumber)
+	require.NotNil(t, getBalance)
+	require.Equal(t, blockNumber.Requests*10, stats.ComputeUnits)
+	require.Equal(t, getBalance.Requests, stats.Failed)
+	require.Equal(t, getBalance.Requests, stats.Errors["status 500: Failed all retries"])
+	require.Len(t, stats.Providers, 2)
+	require.Equal(t, blockNumber.Requests, stats.Providers["lava@provider0"]+stats.Providers["lava@provider1"])
+	var histogramTotal uint64
+	for _, count := range stats.Histogram {
+		histogramTotal += count
+	}
+	require.Equal(t, stats.Requests, histogramTotal)
+
+	// a target rate caps the requests no matter the concurrency
+	loadTest, err = NewLoadTest(Config{ConsumerURL: server.URL, DappID: "dapp", RPS: 20, Concurrency: 4, Duration: 500 * time.Millisecond, Timeout: time.Second}, picker, computeUnits)
+	require.NoError(t, err)
+	stats = loadTest.Run(context.Background())
+	require.LessOrEqual(t, stats.Requests, uint64(11))
+	require.Greater(t, stats.Requests, uint64(0))
+}
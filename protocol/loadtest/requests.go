@@ -0,0 +1,204 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/lavanet/lava/protocol/recorder"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/viper"
+)
+
+// Request is a request sent to the rpcconsumer
+type Request struct {
+	Api            string // the spec api name, used for the compute units and the per api stats
+	ApiInterface   string
+	ConnectionType string
+	Url            string // the path and query after the dapp id
+	Data           string // the request body
+}
+
+// HttpMethod returns the method the request is sent with, json rpc bodies are posted
+func (request *Request) HttpMethod() string {
+	if request.Data != "" || request.ConnectionType == http.MethodPost {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+// MixEntry is a spec api in the mix and the relative share of the requests it gets
+type MixEntry struct {
+	Api    string   `mapstructure:"api"`
+	Weight uint     `mapstructure:"weight"`
+	Params []string `mapstructure:"params"` // json rpc params arrays, rest paths or post bodies, a random one is sent on every request
+}
+
+type Mix struct {
+	Apis []MixEntry `mapstructure:"apis"`
+}
+
+// ReadMix loads a mix from a yaml file
+func ReadMix(path string) (*Mix, error) {
+	viperMix := viper.New()
+	viperMix.SetConfigFile(path)
+	viperMix.SetConfigType("yml")
+	err := viperMix.ReadInConfig()
+	if err != nil {
+		return nil, utils.LavaFormatError("could not read loadtest mix file", err, &map[string]string{"path": path})
+	}
+	mix := &Mix{}
+	err = viperMix.Unmarshal(mix)
+	if err != nil {
+		return nil, utils.LavaFormatError("could not unmarshal loadtest mix", err, &map[string]string{"path": path})
+	}
+	return mix, nil
+}
+
+type weightedRequests struct {
+	requests []*Request
+	weight   uint
+}
+
+// RequestPicker returns the next request to send
+type RequestPicker interface {
+	Pick() *Request
+}
+
+// MixPicker picks the api by its weight and then one of its sample requests
+type MixPicker struct {
+	apis        []weightedRequests
+	totalWeight uint
+}
+
+func (mp *MixPicker) Pick() *Request {
+	pick := uint(rand.Int63n(int64(mp.totalWeight)))
+	for _, api := range mp.apis {
+		if pick < api.weight {
+			return api.requests[rand.Intn(len(api.requests))]
+		}
+		pick -= api.weight
+	}
+	return nil
+}
+
+// NewMixPicker builds the requests of the mix from the spec apis of the api interface, with no mix every api
+// of the interface gets the same weight and is sent without params
+func NewMixPicker(spec *spectypes.Spec, apiInterface string, mix *Mix) (*MixPicker, error) {
+	apis := map[string]*spectypes.ServiceApi{}
+	names := []string{} // keeps the spec order
+	for idx := range spec.Apis {
+		serviceApi := &spec.Apis[idx]
+		if !serviceApi.Enabled {
+			continue
+		}
+		for _, apiInterfaceStruct := range serviceApi.ApiInterfaces {
+			if apiInterfaceStruct.Interface == apiInterface && !apiInterfaceStruct.Category.GetSubscription() {
+				apis[serviceApi.Name] = serviceApi
+				names = append(names, serviceApi.Name)
+				break
+			}
+		}
+	}
+	if mix == nil {
+		mix = &Mix{}
+		for _, name := range names {
+			mix.Apis = append(mix.Apis, MixEntry{Api: name, Weight: 1})
+		}
+	}
+	mp := &MixPicker{}
+	for _, entry := range mix.Apis {
+		serviceApi, ok := apis[entry.Api]
+		if !ok {
+			return nil, utils.LavaFormatError("loadtest mix api is not an api of the interface in the spec", nil, &map[string]string{"api": entry.Api, "apiInterface": apiInterface, "chainID": spec.Index})
+		}
+		if entry.Weight == 0 {
+			continue
+		}
+		params := entry.Params
+		if len(params) == 0 {
+			params = []string{""}
+		}
+		requests := []*Request{}
+		for _, param := range params {
+			request, err := specApiRequest(serviceApi, apiInterface, param)
+			if err != nil {
+				return nil, err
+			}
+			requests = append(requests, request)
+		}
+		mp.apis = append(mp.apis, weightedRequests{requests: requests, weight: entry.Weight})
+		mp.totalWeight += entry.Weight
+	}
+	if mp.totalWeight == 0 {
+		return nil, utils.LavaFormatError("no apis to send in the loadtest mix", nil, &map[string]string{"apiInterface": apiInterface, "chainID": spec.Index})
+	}
+	return mp, nil
+}
+
+// specApiRequest builds the request of a spec api with the sample param, the param is the params array of a json rpc api,
+// and for a rest api the path to send, or the body of a POST api
+func specApiRequest(serviceApi *spectypes.ServiceApi, apiInterface string, param string) (*Request, error) {
+	request := &Request{Api: serviceApi.Name, ApiInterface: apiInterface}
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC:
+		if param == "" {
+			param = "[]"
+		}
+		params := json.RawMessage(param)
+		data, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": serviceApi.Name, "params": params})
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid json rpc params in the loadtest mix", err, &map[string]string{"api": serviceApi.Name, "params": param})
+		}
+		request.Data = string(data)
+	case spectypes.APIInterfaceRest:
+		request.ConnectionType = serviceApi.ApiInterfaces[0].Type
+		for _, apiInterfaceStruct := range serviceApi.ApiInterfaces {
+			if apiInterfaceStruct.Interface == apiInterface {
+				request.ConnectionType = apiInterfaceStruct.Type
+			}
+		}
+		request.Url = serviceApi.Name
+		if request.ConnectionType == http.MethodPost {
+			request.Data = param
+		} else if param != "" {
+			request.Url = param
+		}
+	default:
+		return nil, utils.LavaFormatError("loadtest doesn't support the api interface", nil, &map[string]string{"apiInterface": apiInterface})
+	}
+	return request, nil
+}
+
+// RecordedPicker sends the recorded requests in order, starting over once they were all sent
+type RecordedPicker struct {
+	requests []*Request
+	next     uint64
+}
+
+func (rp *RecordedPicker) Pick() *Request {
+	idx := atomic.AddUint64(&rp.next, 1) - 1
+	return rp.requests[idx%uint64(len(rp.requests))]
+}
+
+// ReadRecordedRequests reads the requests of the api interface from a recording of an rpcconsumer
+func ReadRecordedRequests(path string, apiInterface string) (*RecordedPicker, error) {
+	records, err := recorder.ReadRecords(path)
+	if err != nil {
+		return nil, err
+	}
+	rp := &RecordedPicker{}
+	for _, record := range records {
+		if record.ApiInterface != apiInterface {
+			continue
+		}
+		method, url, data := record.ListenerRequest()
+		rp.requests = append(rp.requests, &Request{Api: record.Api, ApiInterface: record.ApiInterface, ConnectionType: method, Url: url, Data: data})
+	}
+	if len(rp.requests) == 0 {
+		return nil, utils.LavaFormatError("no recorded requests of the api interface", nil, &map[string]string{"path": path, "apiInterface": apiInterface})
+	}
+	return rp, nil
+}
@@ -0,0 +1,162 @@
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the latency histogram, slower requests fall in the last bucket
+var LatencyBuckets = []time.Duration{
+	5 * time.Millisecond, 10 * time.Millisecond, 25 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond,
+	250 * time.Millisecond, 500 * time.Millisecond, time.Second, 2500 * time.Millisecond, 5 * time.Second, 10 * time.Second,
+}
+
+type ApiStats struct {
+	Requests     uint64
+	Failed       uint64
+	ComputeUnits uint64
+}
+
+// Result is the outcome of a single request
+type Result struct {
+	Api             string
+	Latency         time.Duration
+	ComputeUnits    uint64
+	ProviderAddress string
+	Error           string // the error category, empty for a successful request
+}
+
+// Stats aggregates the results of a loadtest
+type Stats struct {
+	lock         sync.Mutex
+	Requests     uint64
+	Failed       uint64
+	ComputeUnits uint64
+	Latencies    []time.Duration
+	Histogram    []uint64 // the number of requests in each of the LatencyBuckets and one more for the slower requests
+	Errors       map[string]uint64
+	Providers    map[string]uint64 // successful relays per provider
+	Apis         map[string]*ApiStats
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		Histogram: make([]uint64, len(LatencyBuckets)+1),
+		Errors:    map[string]uint64{},
+		Providers: map[string]uint64{},
+		Apis:      map[string]*ApiStats{},
+	}
+}
+
+func (s *Stats) Add(result *Result) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.Requests++
+	apiStats, ok := s.Apis[result.Api]
+	if !ok {
+		apiStats = &ApiStats{}
+		s.Apis[result.Api] = apiStats
+	}
+	apiStats.Requests++
+	s.Latencies = append(s.Latencies, result.Latency)
+	s.Histogram[sort.Search(len(LatencyBuckets), func(idx int) bool { return result.Latency <= LatencyBuckets[idx] })]++
+	if result.Error != "" {
+		s.Failed++
+		apiStats.Failed++
+		s.Errors[result.Error]++
+		return
+	}
+	s.ComputeUnits += result.ComputeUnits
+	apiStats.ComputeUnits += result.ComputeUnits
+	if result.ProviderAddress != "" {
+		s.Providers[result.ProviderAddress]++
+	}
+}
+
+// Percentile returns the latency the given percent of the requests were faster than or equal to
+func (s *Stats) Percentile(percent float64) time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.Latencies) == 0 {
+		return 0
+	}
+	sort.Slice(s.Latencies, func(i, j int) bool { return s.Latencies[i] < s.Latencies[j] })
+	idx := int(float64(len(s.Latencies))*percent/100+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	} else if idx >= len(s.Latencies) {
+		idx = len(s.Latencies) - 1
+	}
+	return s.Latencies[idx]
+}
+
+// Report writes a summary of the stats of a loadtest that ran for the duration
+func (s *Stats) Report(writer io.Writer, duration time.Duration) {
+	p50, p90, p99, max := s.Percentile(50), s.Percentile(90), s.Percentile(99), s.Percentile(100)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	seconds := duration.Seconds()
+	if seconds <= 0 {
+		seconds = 1
+	}
+	fmt.Fprintf(writer, "duration %s, requests %d (%.1f/s), succeeded %d, failed %d\n", duration.Round(time.Millisecond), s.Requests, float64(s.Requests)/seconds, s.Requests-s.Failed, s.Failed)
+	fmt.Fprintf(writer, "compute units %d (%.1f/s)\n", s.ComputeUnits, float64(s.ComputeUnits)/seconds)
+	fmt.Fprintf(writer, "latency p50 %s, p90 %s, p99 %s, max %s\n", p50, p90, p99, max)
+
+	fmt.Fprintln(writer, "latency histogram:")
+	for idx, count := range s.Histogram {
+		bucket := "> " + LatencyBuckets[len(LatencyBuckets)-1].String()
+		if idx < len(LatencyBuckets) {
+			bucket = "<= " + LatencyBuckets[idx].String()
+		}
+		fmt.Fprintf(writer, "  %-10s %d\n", bucket, count)
+	}
+
+	if len(s.Errors) > 0 {
+		fmt.Fprintln(writer, "errors:")
+		for _, key := range sortedByCount(s.Errors) {
+			fmt.Fprintf(writer, "  %d  %s\n", s.Errors[key], key)
+		}
+	}
+
+	if len(s.Providers) > 0 {
+		fmt.Fprintln(writer, "providers:")
+		succeeded := s.Requests - s.Failed
+		for _, key := range sortedByCount(s.Providers) {
+			fmt.Fprintf(writer, "  %s  %d (%.1f%%)\n", key, s.Providers[key], float64(s.Providers[key])*100/float64(succeeded))
+		}
+	}
+
+	fmt.Fprintln(writer, "apis:")
+	apis := make([]string, 0, len(s.Apis))
+	for api := range s.Apis {
+		apis = append(apis, api)
+	}
+	sort.Strings(apis)
+	for _, api := range apis {
+		apiStats := s.Apis[api]
+		name := api
+		if name == "" {
+			name = "unknown"
+		}
+		fmt.Fprintf(writer, "  %s  requests %d, failed %d, compute units %d\n", name, apiStats.Requests, apiStats.Failed, apiStats.ComputeUnits)
+	}
+}
+
+// sortedByCount returns the keys from the highest count to the lowest
+func sortedByCount(counts map[string]uint64) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
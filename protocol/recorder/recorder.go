@@ -0,0 +1,311 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	RecordDirFlag         = "record-dir"
+	RecordSampleRateFlag  = "record-sample-rate"
+	RecordMaxFileSizeFlag = "record-max-file-size"
+	RecordMaxFilesFlag    = "record-max-files"
+	RecordRedactKeysFlag  = "record-redact-keys"
+	RecordOmitRepliesFlag = "record-omit-replies"
+
+	RedactedValue        = "REDACTED"
+	DefaultMaxFileSize   = 100 * 1024 * 1024
+	maxRecordSize        = 64 * 1024 * 1024
+	recordingFilePrefix  = "relays-"
+	recordingFileSuffix  = ".jsonl"
+	recordingFileTimeFmt = "20060102T150405.000000000"
+)
+
+// Record is a relay received by a chain listener, the url, data and connection type are the ones passed to SendRelay
+type Record struct {
+	Time           time.Time `json:"time"`
+	ChainID        string    `json:"chain_id"`
+	ApiInterface   string    `json:"api_interface"`
+	Api            string    `json:"api,omitempty"` // the spec api name, empty if the request didn't parse
+	ConnectionType string    `json:"connection_type"`
+	Url            string    `json:"url"`
+	Data           string    `json:"data"`
+	DappID         string    `json:"dapp_id"`
+	Provider       string    `json:"provider,omitempty"`
+	Reply          string    `json:"reply,omitempty"`
+	LatencyMs      int64     `json:"latency_ms"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// ListenerRequest returns the http request a chain listener turns into the relay of the record,
+// the path is relative to the dapp id
+func (record *Record) ListenerRequest() (method string, path string, body string) {
+	switch record.ApiInterface {
+	case spectypes.APIInterfaceRest:
+		if record.ConnectionType == http.MethodPost {
+			return http.MethodPost, record.Url, record.Data
+		}
+		// the rest listener passes the query string as the data of a GET relay
+		if record.Data != "?" {
+			return http.MethodGet, record.Url + record.Data, ""
+		}
+		return http.MethodGet, record.Url, ""
+	default:
+		// json rpc bodies are posted, tendermint uri requests are sent in the url
+		if record.Data != "" {
+			return http.MethodPost, record.Url, record.Data
+		}
+		return http.MethodGet, record.Url, ""
+	}
+}
+
+type Config struct {
+	Dir         string
+	SampleRate  float64  // the share of the relays that are recorded, between 0 and 1
+	MaxFileSize int64    // the size in bytes a recording file is rotated at
+	MaxFiles    int      // the number of recording files kept, the oldest are deleted, 0 keeps them all
+	RedactKeys  []string // json keys and url query params whose values are replaced in the recorded requests and replies
+	OmitReplies bool
+}
+
+// Recorder writes the relays of the chain listeners to rotated files of newline delimited json records
+type Recorder struct {
+	config     Config
+	redactKeys map[string]struct{}
+	lock       sync.Mutex
+	file       *os.File
+	writer     *bufio.Writer
+	fileSize   int64
+}
+
+func NewRecorder(config Config) (*Recorder, error) {
+	if config.SampleRate <= 0 || config.SampleRate > 1 {
+		return nil, utils.LavaFormatError("record sample rate has to be between 0 and 1", nil, &map[string]string{"sampleRate": strconv.FormatFloat(config.SampleRate, 'f', -1, 64)})
+	}
+	if config.MaxFileSize <= 0 {
+		config.MaxFileSize = DefaultMaxFileSize
+	}
+	err := os.MkdirAll(config.Dir, 0o755)
+	if err != nil {
+		return nil, utils.LavaFormatError("could not create the recording directory", err, &map[string]string{"dir": config.Dir})
+	}
+	recorder := &Recorder{config: config, redactKeys: map[string]struct{}{}}
+	for _, key := range config.RedactKeys {
+		recorder.redactKeys[key] = struct{}{}
+	}
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return recorder, recorder.rotate()
+}
+
+// Record writes the record if it's sampled, it's safe to call concurrently
+func (r *Recorder) Record(record *Record) {
+	if r.config.SampleRate < 1 && rand.Float64() >= r.config.SampleRate {
+		return
+	}
+	r.redact(record)
+	if r.config.OmitReplies {
+		record.Reply = ""
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		utils.LavaFormatError("failed marshaling relay record", err, &map[string]string{"chainID": record.ChainID, "apiInterface": record.ApiInterface})
+		return
+	}
+	line = append(line, '\n')
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.writer == nil {
+		// closed
+		return
+	}
+	if r.fileSize > 0 && r.fileSize+int64(len(line)) > r.config.MaxFileSize {
+		err = r.rotate()
+		if err != nil {
+			return
+		}
+	}
+	written, err := r.writer.Write(line)
+	r.fileSize += int64(written)
+	if err != nil {
+		utils.LavaFormatError("failed writing relay record", err, &map[string]string{"file": r.file.Name()})
+		return
+	}
+	// records are flushed right away so a crash doesn't lose the relays that led to it
+	err = r.writer.Flush()
+	if err != nil {
+		utils.LavaFormatError("failed flushing relay record", err, &map[string]string{"file": r.file.Name()})
+	}
+}
+
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.writer == nil {
+		return nil
+	}
+	err := r.closeFile()
+	r.writer = nil
+	return err
+}
+
+func (r *Recorder) closeFile() error {
+	err := r.writer.Flush()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// rotate closes the current file, opens a new one and deletes the oldest files above MaxFiles, has to be called with the lock held
+func (r *Recorder) rotate() error {
+	if r.writer != nil {
+		err := r.closeFile()
+		if err != nil {
+			utils.LavaFormatError("failed closing recording file", err, &map[string]string{"file": r.file.Name()})
+		}
+	}
+	path := filepath.Join(r.config.Dir, recordingFilePrefix+time.Now().UTC().Format(recordingFileTimeFmt)+recordingFileSuffix)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		r.writer = nil
+		return utils.LavaFormatError("could not open recording file", err, &map[string]string{"file": path})
+	}
+	r.file = file
+	r.writer = bufio.NewWriter(file)
+	r.fileSize = 0
+	if r.config.MaxFiles > 0 {
+		files, err := RecordingFiles(r.config.Dir)
+		if err != nil {
+			return nil
+		}
+		for len(files) > r.config.MaxFiles {
+			err = os.Remove(files[0])
+			if err != nil {
+				utils.LavaFormatWarning("failed removing old recording file", err, &map[string]string{"file": files[0]})
+			}
+			files = files[1:]
+		}
+	}
+	return nil
+}
+
+// RecordingFiles returns the recording files in the directory from the oldest to the newest
+func RecordingFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, recordingFilePrefix+"*"+recordingFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	// the file names hold their creation time so the names sort by age
+	sort.Strings(files)
+	return files, nil
+}
+
+func (r *Recorder) redact(record *Record) {
+	if len(r.redactKeys) == 0 {
+		return
+	}
+	record.Url = r.redactQuery(record.Url)
+	record.Data = r.redactQuery(r.redactJson(record.Data))
+	record.Reply = r.redactJson(record.Reply)
+}
+
+// redactJson replaces the values of the redacted keys anywhere in a json body, bodies that aren't json are kept as is
+func (r *Recorder) redactJson(body string) string {
+	var parsed interface{}
+	if json.Unmarshal([]byte(body), &parsed) != nil {
+		return body
+	}
+	if !r.redactValue(parsed) {
+		// only a redacted body is marshaled again so the recorded bodies keep their field order
+		return body
+	}
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return body
+	}
+	return string(redacted)
+}
+
+func (r *Recorder) redactValue(value interface{}) (redacted bool) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range typed {
+			if _, ok := r.redactKeys[key]; ok {
+				typed[key] = RedactedValue
+				redacted = true
+				continue
+			}
+			redacted = r.redactValue(inner) || redacted
+		}
+	case []interface{}:
+		for _, inner := range typed {
+			redacted = r.redactValue(inner) || redacted
+		}
+	}
+	return redacted
+}
+
+// redactQuery replaces the values of the redacted query params of a url or of a query string
+func (r *Recorder) redactQuery(rawUrl string) string {
+	idx := strings.Index(rawUrl, "?")
+	if idx < 0 {
+		return rawUrl
+	}
+	query, err := url.ParseQuery(rawUrl[idx+1:])
+	if err != nil {
+		return rawUrl
+	}
+	redacted := false
+	for key := range query {
+		if _, ok := r.redactKeys[key]; ok {
+			query[key] = []string{RedactedValue}
+			redacted = true
+		}
+	}
+	if !redacted {
+		return rawUrl
+	}
+	return rawUrl[:idx+1] + query.Encode()
+}
+
+// ReadRecords reads the records of a recording file
+func ReadRecords(path string) ([]*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, utils.LavaFormatError("could not open recording file", err, &map[string]string{"path": path})
+	}
+	defer file.Close()
+	records := []*Record{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &Record{}
+		err = json.Unmarshal(scanner.Bytes(), record)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid relay record", err, &map[string]string{"path": path, "line": strconv.Itoa(line)})
+		}
+		records = append(records, record)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, utils.LavaFormatError("failed reading recording file", err, &map[string]string{"path": path})
+	}
+	return records, nil
+}
@@ -0,0 +1,174 @@
+package recorder
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecord(idx int) *Record {
+	return &Record{
+		Time:           time.Now(),
+		ChainID:        "ETH1",
+		ApiInterface:   spectypes.APIInterfaceJsonRPC,
+		Api:            "eth_getBalance",
+		ConnectionType: http.MethodGet,
+		Data:           `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0","latest"]}`,
+		DappID:         "dapp",
+		Provider:       "lava@provider",
+		Reply:          `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		LatencyMs:      int64(idx),
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecorder(Config{Dir: dir, SampleRate: 1})
+	require.NoError(t, err)
+	for idx := 0; idx < 5; idx++ {
+		recorder.Record(testRecord(idx))
+	}
+	require.NoError(t, recorder.Close())
+	// records after closing are dropped
+	recorder.Record(testRecord(5))
+
+	files, err := RecordingFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	records, err := ReadRecords(files[0])
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	for idx, record := range records {
+		expected := testRecord(idx)
+		require.Equal(t, expected.LatencyMs, record.LatencyMs)
+		require.Equal(t, expected.Data, record.Data)
+		require.Equal(t, expected.Reply, record.Reply)
+		require.Equal(t, expected.Provider, record.Provider)
+	}
+
+	_, err = NewRecorder(Config{Dir: dir, SampleRate: 0})
+	require.Error(t, err)
+}
+
+func TestRecorderRotation(t *testing.T) {
+	dir := t.TempDir()
+	// every file holds a single record
+	recorder, err := NewRecorder(Config{Dir: dir, SampleRate: 1, MaxFileSize: 10, MaxFiles: 3})
+	require.NoError(t, err)
+	for idx := 0; idx < 6; idx++ {
+		recorder.Record(testRecord(idx))
+	}
+	require.NoError(t, recorder.Close())
+
+	files, err := RecordingFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	// the newest files are kept
+	for idx, file := range files {
+		records, err := ReadRecords(file)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, int64(idx+3), records[0].LatencyMs)
+	}
+}
+
+func TestRecorderSampling(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecorder(Config{Dir: dir, SampleRate: 0.1})
+	require.NoError(t, err)
+	for idx := 0; idx < 2000; idx++ {
+		recorder.Record(testRecord(idx))
+	}
+	require.NoError(t, recorder.Close())
+
+	files, err := RecordingFiles(dir)
+	require.NoError(t, err)
+	records, err := ReadRecords(files[0])
+	require.NoError(t, err)
+	require.InDelta(t, 200, len(records), 80)
+}
+
+func TestRecorderRedaction(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecorder(Config{Dir: dir, SampleRate: 1, RedactKeys: []string{"params", "key"}, OmitReplies: true})
+	require.NoError(t, err)
+	recorder.Record(testRecord(0))
+	recorder.Record(&Record{
+		ApiInterface:   spectypes.APIInterfaceRest,
+		ConnectionType: http.MethodGet,
+		Url:            "/balances",
+		Data:           "?address=lava@1&key=secret",
+		Reply:          `{"balances":[]}`,
+	})
+	recorder.Record(&Record{
+		ApiInterface:   spectypes.APIInterfaceRest,
+		ConnectionType: http.MethodPost,
+		Url:            "/txs",
+		Data:           `{"tx":{"body":"abc","key":"secret"}}`,
+	})
+	require.NoError(t, recorder.Close())
+
+	files, err := RecordingFiles(dir)
+	require.NoError(t, err)
+	records, err := ReadRecords(files[0])
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":"REDACTED"}`, records[0].Data)
+	require.Empty(t, records[0].Reply)
+	require.Equal(t, "?address=lava%401&key=REDACTED", records[1].Data)
+	require.Empty(t, records[1].Reply)
+	require.JSONEq(t, `{"tx":{"body":"abc","key":"REDACTED"}}`, records[2].Data)
+}
+
+func TestListenerRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		record         Record
+		expectedMethod string
+		expectedPath   string
+		expectedBody   string
+	}{
+		{
+			name:           "json rpc",
+			record:         Record{ApiInterface: spectypes.APIInterfaceJsonRPC, ConnectionType: http.MethodGet, Data: `{"method":"eth_blockNumber"}`},
+			expectedMethod: http.MethodPost,
+			expectedBody:   `{"method":"eth_blockNumber"}`,
+		},
+		{
+			name:           "tendermint uri",
+			record:         Record{ApiInterface: spectypes.APIInterfaceTendermintRPC, ConnectionType: http.MethodGet, Url: "block?height=1"},
+			expectedMethod: http.MethodGet,
+			expectedPath:   "block?height=1",
+		},
+		{
+			name:           "rest get",
+			record:         Record{ApiInterface: spectypes.APIInterfaceRest, ConnectionType: http.MethodGet, Url: "/blocks/latest", Data: "?"},
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/blocks/latest",
+		},
+		{
+			name:           "rest get with query",
+			record:         Record{ApiInterface: spectypes.APIInterfaceRest, ConnectionType: http.MethodGet, Url: "/balances", Data: "?address=lava@1"},
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/balances?address=lava@1",
+		},
+		{
+			name:           "rest post",
+			record:         Record{ApiInterface: spectypes.APIInterfaceRest, ConnectionType: http.MethodPost, Url: "/txs", Data: `{"tx":"abc"}`},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/txs",
+			expectedBody:   `{"tx":"abc"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, path, body := tt.record.ListenerRequest()
+			require.Equal(t, tt.expectedMethod, method)
+			require.Equal(t, tt.expectedPath, path)
+			require.Equal(t, tt.expectedBody, body)
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/recorder"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	ConsumerURLFlag = "consumer-url"
+	NodeURLFlag     = "node-url"
+	DappIDFlag      = "dapp-id"
+	IgnoreKeysFlag  = "ignore-keys"
+	TimeoutFlag     = "timeout"
+
+	maxDiffsPerRecord = 10
+	maxValueLength    = 80
+)
+
+// Sender resends a recorded relay and returns the reply
+type Sender interface {
+	Send(ctx context.Context, record *recorder.Record) (reply string, err error)
+}
+
+// ConsumerSender resends the recorded relays through the chain listener of an rpcconsumer
+type ConsumerSender struct {
+	consumerURL string
+	dappID      string
+	client      *http.Client
+}
+
+// NewConsumerSender returns a sender to the rpcconsumer listening at the url, an empty dapp id keeps the recorded ones
+func NewConsumerSender(consumerURL string, dappID string) *ConsumerSender {
+	return &ConsumerSender{consumerURL: strings.TrimSuffix(consumerURL, "/"), dappID: dappID, client: &http.Client{}}
+}
+
+func (cs *ConsumerSender) Send(ctx context.Context, record *recorder.Record) (string, error) {
+	dappID := cs.dappID
+	if dappID == "" {
+		dappID = record.DappID
+	}
+	method, path, body := record.ListenerRequest()
+	request, err := http.NewRequestWithContext(ctx, method, cs.consumerURL+"/"+dappID+"/"+strings.TrimPrefix(path, "/"), strings.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	res, err := cs.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	reply, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %d: %s", res.StatusCode, reply)
+	}
+	return string(reply), nil
+}
+
+// NodeSender resends the recorded relays straight to a node, the way a provider sends them
+type NodeSender struct {
+	chainParser chainlib.ChainParser
+	chainProxy  chainlib.ChainProxy
+}
+
+func NewNodeSender(chainParser chainlib.ChainParser, chainProxy chainlib.ChainProxy) *NodeSender {
+	return &NodeSender{chainParser: chainParser, chainProxy: chainProxy}
+}
+
+func (ns *NodeSender) Send(ctx context.Context, record *recorder.Record) (string, error) {
+	chainMessage, err := ns.chainParser.ParseMsg(record.Url, []byte(record.Data), record.ConnectionType)
+	if err != nil {
+		return "", err
+	}
+	reply, _, _, err := ns.chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	if err != nil {
+		return "", err
+	}
+	return string(reply.Data), nil
+}
+
+type timeoutSender struct {
+	sender  Sender
+	timeout time.Duration
+}
+
+// WithTimeout bounds every relay the sender sends by the timeout
+func WithTimeout(sender Sender, timeout time.Duration) Sender {
+	return &timeoutSender{sender: sender, timeout: timeout}
+}
+
+func (ts *timeoutSender) Send(ctx context.Context, record *recorder.Record) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, ts.timeout)
+	defer cancel()
+	return ts.sender.Send(ctx, record)
+}
+
+// Result is the outcome of replaying a single record
+type Result struct {
+	Index   int // the position of the record in the recording
+	Record  *recorder.Record
+	Reply   string
+	Error   string
+	Latency time.Duration
+	Diffs   []string // the differences from the recorded reply, empty if the replies match
+}
+
+type Summary struct {
+	Replayed   uint64
+	Matched    uint64
+	Differed   uint64
+	Failed     uint64 // the replay failed where the recorded relay succeeded
+	Unverified uint64 // the recorded reply was omitted so there's nothing to compare to
+}
+
+func (s *Summary) Report(writer io.Writer) {
+	fmt.Fprintf(writer, "replayed %d, matched %d, differed %d, failed %d, unverified %d\n", s.Replayed, s.Matched, s.Differed, s.Failed, s.Unverified)
+}
+
+// Replayer resends recorded relays one by one, in the recorded order, and compares the replies to the recorded ones
+type Replayer struct {
+	sender     Sender
+	ignoreKeys map[string]struct{}
+}
+
+// NewReplayer returns a replayer that skips the ignored json keys when comparing replies, e.g. the json rpc id
+func NewReplayer(sender Sender, ignoreKeys []string) *Replayer {
+	replayer := &Replayer{sender: sender, ignoreKeys: map[string]struct{}{}}
+	for _, key := range ignoreKeys {
+		replayer.ignoreKeys[key] = struct{}{}
+	}
+	return replayer
+}
+
+// Run replays the records and writes the results that don't match the recording
+func (rp *Replayer) Run(ctx context.Context, records []*recorder.Record, writer io.Writer) *Summary {
+	summary := &Summary{}
+	for idx, record := range records {
+		if ctx.Err() != nil {
+			break
+		}
+		result := rp.Replay(ctx, idx, record)
+		summary.Replayed++
+		switch {
+		case result.Error != "" && record.Error == "":
+			summary.Failed++
+		case len(result.Diffs) > 0:
+			summary.Differed++
+		case result.Error == "" && record.Error == "" && record.Reply == "":
+			summary.Unverified++
+			continue
+		default:
+			summary.Matched++
+			continue
+		}
+		fmt.Fprintf(writer, "record %d %s %s %s (provider %s):\n", result.Index, record.ApiInterface, record.Api, record.Time.Format(time.RFC3339), record.Provider)
+		if result.Error != "" {
+			fmt.Fprintf(writer, "  replay error: %s\n", truncate(result.Error))
+		}
+		for _, diff := range result.Diffs {
+			fmt.Fprintf(writer, "  %s\n", diff)
+		}
+	}
+	return summary
+}
+
+func (rp *Replayer) Replay(ctx context.Context, idx int, record *recorder.Record) *Result {
+	result := &Result{Index: idx, Record: record}
+	start := time.Now()
+	reply, err := rp.sender.Send(ctx, record)
+	result.Latency = time.Since(start)
+	if err != nil {
+		// a relay that failed in the recording as well counts as a match
+		result.Error = err.Error()
+		return result
+	}
+	result.Reply = reply
+	if record.Error != "" {
+		result.Diffs = []string{"recorded error: " + truncate(record.Error) + ", replay succeeded"}
+		return result
+	}
+	if record.Reply == "" {
+		return result
+	}
+	result.Diffs = rp.diffReplies(record.Reply, reply)
+	return result
+}
+
+// diffReplies compares json replies field by field and other replies as strings
+func (rp *Replayer) diffReplies(recorded string, replayed string) []string {
+	var recordedJson, replayedJson interface{}
+	if json.Unmarshal([]byte(recorded), &recordedJson) != nil || json.Unmarshal([]byte(replayed), &replayedJson) != nil {
+		if recorded == replayed {
+			return nil
+		}
+		return []string{fmt.Sprintf("reply: %s != %s", truncate(recorded), truncate(replayed))}
+	}
+	diffs := rp.diffJson("reply", recordedJson, replayedJson, nil)
+	if len(diffs) > maxDiffsPerRecord {
+		diffs = append(diffs[:maxDiffsPerRecord], fmt.Sprintf("... %d more", len(diffs)-maxDiffsPerRecord))
+	}
+	return diffs
+}
+
+func (rp *Replayer) diffJson(path string, recorded interface{}, replayed interface{}, diffs []string) []string {
+	switch recordedTyped := recorded.(type) {
+	case map[string]interface{}:
+		replayedTyped, ok := replayed.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(recordedTyped))
+		for key := range recordedTyped {
+			keys = append(keys, key)
+		}
+		for key := range replayedTyped {
+			if _, ok := recordedTyped[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, ok := rp.ignoreKeys[key]; ok {
+				continue
+			}
+			recordedValue, inRecorded := recordedTyped[key]
+			replayedValue, inReplayed := replayedTyped[key]
+			switch {
+			case !inReplayed:
+				diffs = append(diffs, path+"."+key+": missing in the replay")
+			case !inRecorded:
+				diffs = append(diffs, path+"."+key+": missing in the recording")
+			default:
+				diffs = rp.diffJson(path+"."+key, recordedValue, replayedValue, diffs)
+			}
+		}
+		return diffs
+	case []interface{}:
+		replayedTyped, ok := replayed.([]interface{})
+		if !ok {
+			break
+		}
+		if len(recordedTyped) != len(replayedTyped) {
+			return append(diffs, fmt.Sprintf("%s: length %d != %d", path, len(recordedTyped), len(replayedTyped)))
+		}
+		for idx := range recordedTyped {
+			diffs = rp.diffJson(fmt.Sprintf("%s[%d]", path, idx), recordedTyped[idx], replayedTyped[idx], diffs)
+		}
+		return diffs
+	}
+	if !reflect.DeepEqual(recorded, replayed) {
+		diffs = append(diffs, fmt.Sprintf("%s: %s != %s", path, jsonValue(recorded), jsonValue(replayed)))
+	}
+	return diffs
+}
+
+func jsonValue(value interface{}) string {
+	marshaled, err := json.Marshal(value)
+	if err != nil {
+		return truncate(fmt.Sprintf("%v", value))
+	}
+	return truncate(string(marshaled))
+}
+
+func truncate(value string) string {
+	if len(value) > maxValueLength {
+		return value[:maxValueLength] + "..."
+	}
+	return value
+}
+
+// FilterRecords returns the records of the chain and api interface
+func FilterRecords(records []*recorder.Record, chainID string, apiInterface string) ([]*recorder.Record, error) {
+	filtered := []*recorder.Record{}
+	for _, record := range records {
+		if record.ChainID == chainID && record.ApiInterface == apiInterface {
+			filtered = append(filtered, record)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, utils.LavaFormatError("no records of the chain and api interface in the recording", nil, &map[string]string{"chainID": chainID, "apiInterface": apiInterface})
+	}
+	return filtered, nil
+}
@@ -0,0 +1,99 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/recorder"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonRpcRecord(method string, reply string, recordedError string) *recorder.Record {
+	return &recorder.Record{
+		ChainID:        "ETH1",
+		ApiInterface:   spectypes.APIInterfaceJsonRPC,
+		Api:            method,
+		ConnectionType: http.MethodGet,
+		Data:           `{"jsonrpc":"2.0","id":7,"method":"` + method + `","params":[]}`,
+		DappID:         "recorded-dapp",
+		Reply:          reply,
+		Error:          recordedError,
+	}
+}
+
+func TestReplayThroughConsumer(t *testing.T) {
+	// the consumer replies to every method with a fixed reply, and fails eth_call
+	replies := map[string]string{
+		"eth_blockNumber": `{"jsonrpc":"2.0","id":1,"result":"0x10"}`,
+		"eth_chainId":     `{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		"eth_getBlock":    `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10","hash":"0xabc","transactions":["0x1"]}}`,
+	}
+	dappIDs := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		dappIDs[request.URL.Path]++
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		message := struct {
+			Method string `json:"method"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, &message))
+		reply, ok := replies[message.Method]
+		if !ok {
+			writer.WriteHeader(http.StatusInternalServerError)
+			writer.Write([]byte(`{"error":"Failed all retries"}`))
+			return
+		}
+		writer.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	records := []*recorder.Record{
+		// matches, the id is ignored
+		jsonRpcRecord("eth_chainId", `{"jsonrpc":"2.0","id":7,"result":"0x1"}`, ""),
+		// differs in a nested field and in the length of an array
+		jsonRpcRecord("eth_getBlock", `{"jsonrpc":"2.0","id":7,"result":{"number":"0x10","hash":"0xdef","transactions":[]}}`, ""),
+		// failed in the recording and succeeds in the replay
+		jsonRpcRecord("eth_blockNumber", "", "Failed all retries"),
+		// succeeded in the recording and fails in the replay
+		jsonRpcRecord("eth_call", `{"jsonrpc":"2.0","id":7,"result":"0x"}`, ""),
+		// failed in both
+		jsonRpcRecord("eth_call", "", "Failed all retries"),
+		// the reply wasn't recorded
+		jsonRpcRecord("eth_chainId", "", ""),
+	}
+	output := &bytes.Buffer{}
+	replayer := NewReplayer(NewConsumerSender(server.URL+"/", ""), []string{"id"})
+	summary := replayer.Run(context.Background(), records, output)
+	require.Equal(t, Summary{Replayed: 6, Matched: 2, Differed: 2, Failed: 1, Unverified: 1}, *summary)
+	require.Equal(t, map[string]int{"/recorded-dapp/": 6}, dappIDs)
+	require.Contains(t, output.String(), `reply.result.hash: "0xdef" != "0xabc"`)
+	require.Contains(t, output.String(), "reply.result.transactions: length 0 != 1")
+	require.Contains(t, output.String(), "recorded error: Failed all retries, replay succeeded")
+	require.Contains(t, output.String(), "replay error: status 500")
+
+	// a dapp id replaces the recorded ones and without ignored keys the ids differ
+	dappIDs = map[string]int{}
+	replayer = NewReplayer(NewConsumerSender(server.URL, "replay"), nil)
+	summary = replayer.Run(context.Background(), records[:1], io.Discard)
+	require.Equal(t, uint64(1), summary.Differed)
+	require.Equal(t, map[string]int{"/replay/": 1}, dappIDs)
+}
+
+func TestFilterRecords(t *testing.T) {
+	records := []*recorder.Record{
+		{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC},
+		{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceRest},
+		{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC},
+	}
+	filtered, err := FilterRecords(records, "ETH1", spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+	_, err = FilterRecords(records, "ETH1", spectypes.APIInterfaceRest)
+	require.Error(t, err)
+}
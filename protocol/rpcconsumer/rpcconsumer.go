@@ -7,14 +7,15 @@ import (
 	"os/signal"
 	"strconv"
 
-	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/recorder"
 	"github.com/lavanet/lava/protocol/statetracker"
 	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
@@ -32,19 +33,21 @@ var (
 )
 
 type ConsumerStateTrackerInf interface {
-	RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager)
-	RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser)
-	RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus)
-	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict)
+	RegisterConsumerSessionManagerForPairingUpdates(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) error
+	RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error
+	RegisterForSpecUpdates(ctx context.Context, specUpdatable statetracker.SpecUpdatable, chainID string) error
+	RegisterFinalizationConsensusForUpdates(context.Context, *lavaprotocol.FinalizationConsensus) error
+	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error
 }
 
 type RPCConsumer struct {
 	consumerStateTracker ConsumerStateTrackerInf
 	rpcConsumerServers   map[string]*RPCConsumerServer
+	ProviderTLS          *lavatls.ClientConfig // how the connections to the providers are secured, nil validates them against the system CAs
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
-func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, vrf_sk vrf.PrivateKey, cache *performance.Cache) (err error) {
+func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcEndpoints []*lavasession.RPCEndpoint, requiredResponses int, signer sigs.Signer, cache *performance.Cache, relayRecorder *recorder.Recorder) (err error) {
 	// spawn up ConsumerStateTracker
 	consumerStateTracker := statetracker.ConsumerStateTracker{}
 	rpcc.consumerStateTracker, err = consumerStateTracker.New(ctx, txFactory, clientCtx)
@@ -53,45 +56,70 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	}
 	rpcc.rpcConsumerServers = make(map[string]*RPCConsumerServer, len(rpcEndpoints))
 
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		utils.LavaFormatFatal("failed getting key name from clientCtx", err, nil)
-	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, &map[string]string{"keyName": keyName})
-	}
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
-
-	var addr sdk.AccAddress
-	err = addr.Unmarshal(clientKey.GetPubKey().Address())
-	if err != nil {
-		utils.LavaFormatFatal("failed unmarshaling public address", err, &map[string]string{"keyName": keyName, "pubkey": clientKey.GetPubKey().Address().String()})
-	}
+	addr := sdk.AccAddress(signer.PubKey().Address())
 	utils.LavaFormatInfo("RPCConsumer pubkey: "+addr.String(), nil)
 	utils.LavaFormatInfo("RPCConsumer setting up endpoints", &map[string]string{"length": strconv.Itoa(len(rpcEndpoints))})
-	for _, rpcEndpoint := range rpcEndpoints {
-		consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint)
-		key := rpcEndpoint.Key()
-		rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
-		chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
-		if err != nil {
-			return err
+	// endpoints that share a network address are served by one listener that routes the requests to their chain
+	endpointGroups, err := chainlib.GroupEndpointsByAddress(rpcEndpoints)
+	if err != nil {
+		return err
+	}
+	for _, endpointGroup := range endpointGroups {
+		var chainRouter *chainlib.ChainRouter
+		if len(endpointGroup) > 1 {
+			chainRouter = chainlib.NewChainRouter(endpointGroup[0].NetworkAddress)
+		}
+		for _, rpcEndpoint := range endpointGroup {
+			err = rpcc.startEndpoint(ctx, rpcEndpoint, requiredResponses, signer, cache, relayRecorder, chainRouter)
+			if err != nil {
+				return err
+			}
+		}
+		if chainRouter != nil {
+			go chainRouter.Serve(ctx)
 		}
-		consumerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser)
-		finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
-		consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
-		rpcc.rpcConsumerServers[key] = &RPCConsumerServer{}
-		utils.LavaFormatInfo("RPCConsumer Listening", &map[string]string{"endpoints": lavasession.PrintRPCEndpoint(rpcEndpoint)})
-		rpcc.rpcConsumerServers[key].ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, privKey, vrf_sk, cache)
 	}
 
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
-	<-signalChan
+	defer signal.Stop(signalChan)
+	select {
+	case <-signalChan:
+	case <-ctx.Done():
+	}
 	return nil
 }
 
+// startEndpoint sets up the session manager and chain parser of the endpoint and serves it, on its own listener or on the chain router
+func (rpcc *RPCConsumer) startEndpoint(ctx context.Context, rpcEndpoint *lavasession.RPCEndpoint, requiredResponses int, signer sigs.Signer, cache *performance.Cache, relayRecorder *recorder.Recorder, chainRouter *chainlib.ChainRouter) error {
+	consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, rpcc.ProviderTLS)
+	key := rpcEndpoint.Key()
+	err := rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+	if err != nil {
+		return err
+	}
+	chainParser, err := chainlib.NewChainParser(rpcEndpoint.ApiInterface)
+	if err != nil {
+		return err
+	}
+	err = rpcc.consumerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser, rpcEndpoint.ChainID)
+	if err != nil {
+		return err
+	}
+	finalizationConsensus := &lavaprotocol.FinalizationConsensus{}
+	err = rpcc.consumerStateTracker.RegisterFinalizationConsensusForUpdates(ctx, finalizationConsensus)
+	if err != nil {
+		return err
+	}
+	rpcc.rpcConsumerServers[key] = &RPCConsumerServer{}
+	utils.LavaFormatInfo("RPCConsumer Listening", &map[string]string{"endpoints": lavasession.PrintRPCEndpoint(rpcEndpoint)})
+	err = rpcc.rpcConsumerServers[key].ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, requiredResponses, signer, cache, relayRecorder, chainRouter)
+	if err != nil {
+		return err
+	}
+	return rpcc.consumerStateTracker.RegisterForSpecUpdates(ctx, rpcc.rpcConsumerServers[key], rpcEndpoint.ChainID)
+}
+
 func ParseEndpointArgs(endpoint_strings []string, yaml_config_properties []string, endpointsConfigName string) (viper_endpoints *viper.Viper, err error) {
 	numFieldsInConfig := len(yaml_config_properties)
 	viper_endpoints = viper.New()
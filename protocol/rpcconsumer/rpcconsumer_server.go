@@ -5,16 +5,18 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
-	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/recorder"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/metrics"
 	"github.com/lavanet/lava/relayer/performance"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -32,15 +34,17 @@ type RPCConsumerServer struct {
 	listenEndpoint         *lavasession.RPCEndpoint
 	rpcConsumerLogs        *common.RPCConsumerLogs
 	cache                  *performance.Cache
-	privKey                *btcec.PrivateKey
+	signer                 sigs.Signer
 	consumerTxSender       ConsumerTxSender
 	requiredResponses      int
 	finalizationConsensus  *lavaprotocol.FinalizationConsensus
-	VrfSk                  vrf.PrivateKey
+	reportedLock           sync.Mutex
+	reportedFinalization   map[string]int64 // the epoch each provider was last reported in for a finalization conflict
+	specDisabled           uint32           // set atomically when the spec of the chain is disabled or removed
 }
 
 type ConsumerTxSender interface {
-	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict)
+	TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error
 }
 
 func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndpoint *lavasession.RPCEndpoint,
@@ -49,9 +53,10 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	finalizationConsensus *lavaprotocol.FinalizationConsensus,
 	consumerSessionManager *lavasession.ConsumerSessionManager,
 	requiredResponses int,
-	privKey *btcec.PrivateKey,
-	vrfSk vrf.PrivateKey,
+	signer sigs.Signer,
 	cache *performance.Cache, // optional
+	relayRecorder *recorder.Recorder, // optional
+	chainRouter *chainlib.ChainRouter, // optional, serves the endpoint on a listener shared with other chains
 ) (err error) {
 	rpccs.consumerSessionManager = consumerSessionManager
 	rpccs.listenEndpoint = listenEndpoint
@@ -62,18 +67,32 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	if err != nil {
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err, nil)
 	}
+	pLogs.Recorder = relayRecorder
 	rpccs.rpcConsumerLogs = pLogs
-	rpccs.privKey = privKey
+	rpccs.signer = signer
 	rpccs.chainParser = chainParser
 	rpccs.finalizationConsensus = finalizationConsensus
+	rpccs.reportedFinalization = map[string]int64{}
 	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, pLogs)
 	if err != nil {
 		return err
 	}
+	if chainRouter != nil {
+		return chainRouter.AddChainListener(listenEndpoint, chainListener)
+	}
 	go chainListener.Serve(ctx)
 	return nil
 }
 
+// SetSpec is called when the spec of the endpoint chain changes, a disabled or removed spec stops the endpoint from serving relays
+func (rpccs *RPCConsumerServer) SetSpec(spec spectypes.Spec) {
+	specDisabled := uint32(0)
+	if !spec.Enabled {
+		specDisabled = 1
+	}
+	atomic.StoreUint32(&rpccs.specDisabled, specDisabled)
+}
+
 func (rpccs *RPCConsumerServer) SendRelay(
 	ctx context.Context,
 	url string,
@@ -90,10 +109,17 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// compares the response with other consumer wallets if defined so
 	// asynchronously sends data reliability if necessary
 
+	if atomic.LoadUint32(&rpccs.specDisabled) == 1 {
+		return nil, nil, utils.LavaFormatError("spec of the chain is disabled, the endpoint doesn't serve relays", nil, &map[string]string{"chainID": rpccs.listenEndpoint.ChainID, "apiInterface": rpccs.listenEndpoint.ApiInterface})
+	}
+	relayStart := time.Now()
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType)
 	if err != nil {
 		return nil, nil, err
 	}
+	if analytics != nil {
+		analytics.ApiName = chainMessage.GetServiceApi().Name
+	}
 	// Unmarshal request
 	unwantedProviders := map[string]struct{}{}
 
@@ -119,17 +145,18 @@ func (rpccs *RPCConsumerServer) SendRelay(
 			continue
 		}
 		relayResults = append(relayResults, relayResult)
+		// future requests and data reliability need to ask for the same block height to get consensus on the reply
+		relayRequestCommonData.RequestBlock = relayResult.Request.RequestBlock
 		if len(relayResults) >= rpccs.requiredResponses {
 			break
 		}
-		// future requests need to ask for the same block height to get consensus on the reply
-		relayRequestCommonData.RequestBlock = relayResult.Request.RequestBlock
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
 		for _, relayResult := range relayResults {
-			go rpccs.sendDataReliabilityRelayIfApplicable(ctx, relayResult, chainMessage, dataReliabilityThreshold, &relayRequestCommonData) // runs asynchronously
+			// runs asynchronously, the request context is done once the reply is returned
+			go rpccs.sendDataReliabilityRelayIfApplicable(context.Background(), relayResult, chainMessage, dataReliabilityThreshold, &relayRequestCommonData)
 		}
 	}
 
@@ -144,6 +171,12 @@ func (rpccs *RPCConsumerServer) SendRelay(
 		// TODO: go over rpccs.requiredResponses and get majority
 		returnedResult = iteratedResult
 	}
+	if analytics != nil {
+		analytics.Latency = time.Since(relayStart).Milliseconds()
+		analytics.ComputeUnits = chainMessage.GetServiceApi().ComputeUnits
+		analytics.ProviderAddress = returnedResult.ProviderAddress
+		analytics.Success = true
+	}
 	return returnedResult.Reply, returnedResult.ReplyServer, nil
 }
 
@@ -173,9 +206,8 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	if err != nil {
 		return relayResult, err
 	}
-	privKey := rpccs.privKey
 	chainID := rpccs.listenEndpoint.ChainID
-	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, privKey, chainID, relayRequestCommonData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
+	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, rpccs.signer, chainID, relayRequestCommonData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
 	if err != nil {
 		return relayResult, err
 	}
@@ -214,6 +246,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	}
 	// get here only if performed a regular relay successfully
 	expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
+	reply = relayResult.Reply
 	err = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, epoch, reply.LatestBlock, chainMessage.GetServiceApi().ComputeUnits, relayLatency, expectedBH, numOfProviders, rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()) // session done successfully
 
 	// set cache in a non blocking call
@@ -255,14 +288,16 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
 		if err != nil {
 			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
-				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil)
+				go rpccs.reportFinalizationConflict(ctx, providerPublicAddress, relayRequest.BlockHeight, finalizationConflict)
 			}
 			return relayResult, 0, err
 		}
 
 		finalizationConflict, err = rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, reply.LatestBlock, finalizedBlocks, relayRequest, reply)
 		if err != nil {
-			go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil)
+			if finalizationConflict != nil {
+				go rpccs.reportFinalizationConflict(ctx, providerPublicAddress, relayRequest.BlockHeight, finalizationConflict)
+			}
 			return relayResult, 0, err
 		}
 	}
@@ -303,7 +338,10 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	sessionEpoch := uint64(relayResult.Request.BlockHeight)
 	providerPubAddress := relayResult.ProviderAddress
 	// handle data reliability
-	vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(relayResult.Request, relayResult.Reply, rpccs.VrfSk, sessionEpoch)
+	vrfRes0, vrfRes1, err := sigs.CalculateVrfOnRelay(rpccs.signer, relayResult.Request, relayResult.Reply, sessionEpoch)
+	if err != nil {
+		return utils.LavaFormatError("failed calculating the data reliability vrf", err, nil)
+	}
 	// get two indexesMap for data reliability.
 	providersCount := uint32(rpccs.consumerSessionManager.GetAtomicPairingAddressesLength())
 	indexesMap := lavaprotocol.DataReliabilityThresholdToSession([][]byte{vrfRes0, vrfRes1}, []bool{false, true}, dataReliabilityThreshold, providersCount)
@@ -335,7 +373,10 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	}
 
 	sendReliabilityRelay := func(singleConsumerSession *lavasession.SingleConsumerSession, providerAddress string, differentiator bool, epoch int64) (reliabilityResult *lavaprotocol.RelayResult, err error) {
-		vrf_res, vrf_proof := utils.ProveVrfOnRelay(relayResult.Request, relayResult.Reply, rpccs.VrfSk, differentiator, sessionEpoch)
+		vrf_res, vrf_proof, err := sigs.ProveVrfOnRelay(rpccs.signer, relayResult.Request, relayResult.Reply, differentiator, sessionEpoch)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed proving the data reliability vrf", err, nil)
+		}
 		// calculated from query body anyway, but we will use this on payment
 		// calculated in cb_send_reliability
 		vrfData := lavaprotocol.NewVRFData(differentiator, vrf_res, vrf_proof, relayResult.Request, relayResult.Reply)
@@ -344,12 +385,12 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 			reportedProviders = nil
 			utils.LavaFormatError("failed reading reported providers for epoch", err, &map[string]string{"epoch": strconv.FormatInt(epoch, 10)})
 		}
-		reliabilityRequest, err := lavaprotocol.ConstructDataReliabilityRelayRequest(ctx, vrfData, rpccs.privKey, rpccs.listenEndpoint.ChainID, relayRequestCommonData, providerAddress, epoch, reportedProviders)
+		reliabilityRequest, err := lavaprotocol.ConstructDataReliabilityRelayRequest(ctx, vrfData, rpccs.signer, rpccs.listenEndpoint.ChainID, relayRequestCommonData, providerAddress, epoch, reportedProviders)
 		if err != nil {
 			return nil, utils.LavaFormatError("failed creating data reliability relay", err, &map[string]string{"relayRequestCommonData": fmt.Sprintf("%+v", relayRequestCommonData)})
 		}
-		relayResult = &lavaprotocol.RelayResult{Request: reliabilityRequest, ProviderAddress: providerAddress, Finalized: false}
-		relayResult, dataReliabilityLatency, err := rpccs.relayInner(ctx, singleConsumerSession, relayResult)
+		reliabilityResult = &lavaprotocol.RelayResult{Request: reliabilityRequest, ProviderAddress: providerAddress, Finalized: false}
+		reliabilityResult, dataReliabilityLatency, err := rpccs.relayInner(ctx, singleConsumerSession, reliabilityResult)
 		if err != nil {
 			errRet := rpccs.consumerSessionManager.OnDataReliabilitySessionFailure(singleConsumerSession, err)
 			if errRet != nil {
@@ -359,8 +400,8 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 		}
 
 		expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
-		err = rpccs.consumerSessionManager.OnDataReliabilitySessionDone(singleConsumerSession, relayResult.Reply.LatestBlock, singleConsumerSession.LatestRelayCu, dataReliabilityLatency, expectedBH, numOfProviders, uint64(providersCount))
-		return relayResult, err
+		err = rpccs.consumerSessionManager.OnDataReliabilitySessionDone(singleConsumerSession, reliabilityResult.Reply.LatestBlock, singleConsumerSession.LatestRelayCu, dataReliabilityLatency, expectedBH, numOfProviders, uint64(providersCount))
+		return reliabilityResult, err
 	}
 
 	checkReliability := func() {
@@ -392,7 +433,7 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 			report, conflicts := lavaprotocol.VerifyReliabilityResults(relayResult, dataReliabilityVerifications, numberOfReliabilitySessions)
 			if report {
 				for _, conflict := range conflicts {
-					rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil)
+					rpccs.reportConflict(ctx, nil, conflict, nil)
 				}
 			}
 			// detectionMessage = conflicttypes.NewMsgDetection(consumerAddress, nil, &responseConflict, nil)
@@ -401,3 +442,25 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	checkReliability()
 	return nil
 }
+
+// reportFinalizationConflict reports a provider once per epoch, its replies keep conflicting until the consensus resets on the next epoch
+// and reporting each of them would flood the consumer's txs
+func (rpccs *RPCConsumerServer) reportFinalizationConflict(ctx context.Context, providerAddress string, epoch int64, finalizationConflict *conflicttypes.FinalizationConflict) {
+	rpccs.reportedLock.Lock()
+	if reportedEpoch, ok := rpccs.reportedFinalization[providerAddress]; ok && reportedEpoch >= epoch {
+		rpccs.reportedLock.Unlock()
+		utils.LavaFormatDebug("finalization conflict already reported this epoch", &map[string]string{"provider": providerAddress, "epoch": strconv.FormatInt(epoch, 10)})
+		return
+	}
+	rpccs.reportedFinalization[providerAddress] = epoch
+	rpccs.reportedLock.Unlock()
+	rpccs.reportConflict(ctx, finalizationConflict, nil, nil)
+}
+
+// reportConflict sends a detection tx, failing to send it doesn't affect the relay so it's only logged
+func (rpccs *RPCConsumerServer) reportConflict(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) {
+	err := rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, responseConflict, sameProviderConflict)
+	if err != nil {
+		utils.LavaFormatError("failed sending conflict detection", err, &map[string]string{"chainID": rpccs.listenEndpoint.ChainID})
+	}
+}
@@ -0,0 +1,169 @@
+package faults
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/spf13/viper"
+)
+
+const (
+	FaultsFlag = "faults"
+)
+
+var DroppedConnectionError = fmt.Errorf("fault injection dropped the node connection")
+
+// Config is the misbehaviour injected into a provider, it exists to test that consumers and the conflict module catch faulty providers.
+// the zero value is an honest provider
+type Config struct {
+	// AlteredReplies maps an api name to the result returned instead of the node result,
+	// json rpc results are replaced inside the reply and the other interfaces return it as the whole reply
+	AlteredReplies map[string]string `mapstructure:"altered-replies"`
+	// LatestBlockOffset is added to the latest block the provider reports, its finalized blocks are shifted with it
+	LatestBlockOffset int64 `mapstructure:"latest-block-offset"`
+	// ContradictFinalizedHashes signs forged hashes for the finalized blocks on every other relay
+	ContradictFinalizedHashes bool `mapstructure:"contradict-finalized-hashes"`
+	// Latency is added to every node request
+	Latency time.Duration `mapstructure:"latency"`
+	// DropRate is the share of node requests that fail as if the connection dropped, 1 drops all of them
+	DropRate float64 `mapstructure:"drop-rate"`
+}
+
+// ReadConfig loads the faults from a yaml file
+func ReadConfig(path string) (*Config, error) {
+	viperFaults := viper.New()
+	viperFaults.SetConfigFile(path)
+	viperFaults.SetConfigType("yml")
+	err := viperFaults.ReadInConfig()
+	if err != nil {
+		return nil, utils.LavaFormatError("could not read faults config file", err, &map[string]string{"path": path})
+	}
+	config := &Config{}
+	err = viperFaults.Unmarshal(config)
+	if err != nil {
+		return nil, utils.LavaFormatError("could not unmarshal faults config", err, &map[string]string{"path": path})
+	}
+	return config, config.Validate()
+}
+
+func (config *Config) Validate() error {
+	if config.DropRate < 0 || config.DropRate > 1 {
+		return utils.LavaFormatError("invalid faults drop rate, has to be between 0 and 1", nil, &map[string]string{"drop-rate": strconv.FormatFloat(config.DropRate, 'f', -1, 64)})
+	}
+	if config.Latency < 0 {
+		return utils.LavaFormatError("invalid faults latency, can't be negative", nil, &map[string]string{"latency": config.Latency.String()})
+	}
+	return nil
+}
+
+// ChainProxy injects the latency, dropped connections and altered replies of the config into the node requests of the wrapped chain proxy
+type ChainProxy struct {
+	chainlib.ChainProxy
+	config *Config
+}
+
+func NewChainProxy(chainProxy chainlib.ChainProxy, config *Config) *ChainProxy {
+	return &ChainProxy{ChainProxy: chainProxy, config: config}
+}
+
+func (cp *ChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessage) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	if cp.config.Latency > 0 {
+		select {
+		case <-time.After(cp.config.Latency):
+		case <-ctx.Done():
+			return nil, "", nil, ctx.Err()
+		}
+	}
+	if cp.config.DropRate > 0 && rand.Float64() < cp.config.DropRate {
+		return nil, "", nil, utils.LavaFormatWarning("node request failed", DroppedConnectionError, &map[string]string{"api": chainMessage.GetServiceApi().Name})
+	}
+	relayReply, subscriptionID, relayReplyServer, err = cp.ChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	if err != nil || relayReply == nil {
+		return relayReply, subscriptionID, relayReplyServer, err
+	}
+	if alteredResult, ok := cp.config.AlteredReplies[chainMessage.GetServiceApi().Name]; ok {
+		relayReply.Data = AlterReply(relayReply.Data, alteredResult)
+	}
+	return relayReply, subscriptionID, relayReplyServer, nil
+}
+
+// AlterReply replaces the result of a json rpc reply, a reply that isn't a json rpc reply is replaced as a whole
+func AlterReply(data []byte, alteredResult string) []byte {
+	reply := map[string]json.RawMessage{}
+	err := json.Unmarshal(data, &reply)
+	if _, isJsonRpc := reply["result"]; err != nil || !isJsonRpc {
+		return []byte(alteredResult)
+	}
+	result := json.RawMessage(alteredResult)
+	if !json.Valid(result) {
+		// a plain value is returned as a json string
+		result, _ = json.Marshal(alteredResult)
+	}
+	reply["result"] = result
+	altered, err := json.Marshal(reply)
+	if err != nil {
+		return []byte(alteredResult)
+	}
+	return altered
+}
+
+type LatestBlockDataGetter interface {
+	GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error)
+	GetLatestBlockNum() int64
+}
+
+// ReliabilityManager injects the lies about the latest block and the finalized hashes into the block data the provider signs on
+type ReliabilityManager struct {
+	LatestBlockDataGetter
+	config *Config
+	calls  uint64
+}
+
+func NewReliabilityManager(reliabilityManager LatestBlockDataGetter, config *Config) *ReliabilityManager {
+	return &ReliabilityManager{LatestBlockDataGetter: reliabilityManager, config: config}
+}
+
+func (rm *ReliabilityManager) GetLatestBlockNum() int64 {
+	return rm.LatestBlockDataGetter.GetLatestBlockNum() + rm.config.LatestBlockOffset
+}
+
+func (rm *ReliabilityManager) GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error) {
+	// the chain the provider reports is the real one shifted by the offset, so the real blocks are requested
+	offset := rm.config.LatestBlockOffset
+	latestBlock, hashes, err := rm.LatestBlockDataGetter.GetLatestBlockData(shiftBlock(fromBlock, -offset), shiftBlock(toBlock, -offset), shiftBlock(specificBlock, -offset))
+	if err != nil {
+		return latestBlock, nil, err
+	}
+	forge := rm.config.ContradictFinalizedHashes && atomic.AddUint64(&rm.calls, 1)%2 == 0
+	requestedHashes = make([]*chaintracker.BlockStore, 0, len(hashes))
+	for _, blockStore := range hashes {
+		hash := blockStore.Hash
+		if forge {
+			hash = hex.EncodeToString(sigs.HashMsg([]byte(hash)))
+		}
+		// the block stores are copied, the chain tracker keeps the originals
+		requestedHashes = append(requestedHashes, &chaintracker.BlockStore{Block: shiftBlock(blockStore.Block, offset), Hash: hash})
+	}
+	return latestBlock + offset, requestedHashes, nil
+}
+
+// shiftBlock shifts a block number, the special values and the blocks relative to the latest block are kept as they are
+func shiftBlock(block int64, offset int64) int64 {
+	if block == spectypes.NOT_APPLICABLE || block <= spectypes.LATEST_BLOCK {
+		return block
+	}
+	return block + offset
+}
@@ -0,0 +1,139 @@
+package faults
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/relayer/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlterReply(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		data          string
+		alteredResult string
+		expected      string
+	}{
+		{name: "json rpc plain result", data: `{"id":1,"jsonrpc":"2.0","result":"0x10"}`, alteredResult: "0x1", expected: `{"id":1,"jsonrpc":"2.0","result":"0x1"}`},
+		{name: "json rpc json result", data: `{"id":1,"jsonrpc":"2.0","result":"0x10"}`, alteredResult: `{"balance":1}`, expected: `{"id":1,"jsonrpc":"2.0","result":{"balance":1}}`},
+		{name: "json without result", data: `{"balance":"10"}`, alteredResult: `{"balance":"1"}`, expected: `{"balance":"1"}`},
+		{name: "not json", data: "10", alteredResult: "1", expected: "1"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, string(AlterReply([]byte(tt.data), tt.alteredResult)))
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, (&Config{}).Validate())
+	require.NoError(t, (&Config{DropRate: 1, Latency: time.Second}).Validate())
+	require.Error(t, (&Config{DropRate: 1.5}).Validate())
+	require.Error(t, (&Config{DropRate: -0.5}).Validate())
+	require.Error(t, (&Config{Latency: -time.Second}).Validate())
+}
+
+type mockChainTracker struct {
+	latestBlock int64
+}
+
+func (mct *mockChainTracker) GetLatestBlockNum() int64 {
+	return mct.latestBlock
+}
+
+func (mct *mockChainTracker) GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error) {
+	for block := fromBlock; block <= toBlock && block <= mct.latestBlock; block++ {
+		requestedHashes = append(requestedHashes, &chaintracker.BlockStore{Block: block, Hash: blockHash(block)})
+	}
+	return mct.latestBlock, requestedHashes, nil
+}
+
+func blockHash(block int64) string {
+	return hex.EncodeToString([]byte{byte(block)})
+}
+
+func TestReliabilityManagerLatestBlockOffset(t *testing.T) {
+	rm := NewReliabilityManager(&mockChainTracker{latestBlock: 100}, &Config{LatestBlockOffset: 2})
+	require.Equal(t, int64(102), rm.GetLatestBlockNum())
+	latestBlock, hashes, err := rm.GetLatestBlockData(100, 102, spectypes.NOT_APPLICABLE)
+	require.NoError(t, err)
+	require.Equal(t, int64(102), latestBlock)
+	// the blocks the provider reports hold the hashes of the real blocks the offset before them
+	require.Len(t, hashes, 3)
+	for _, blockStore := range hashes {
+		require.Equal(t, blockHash(blockStore.Block-2), blockStore.Hash)
+	}
+}
+
+func TestReliabilityManagerContradictFinalizedHashes(t *testing.T) {
+	rm := NewReliabilityManager(&mockChainTracker{latestBlock: 100}, &Config{ContradictFinalizedHashes: true})
+	forged := 0
+	for call := 0; call < 4; call++ {
+		_, hashes, err := rm.GetLatestBlockData(99, 100, spectypes.NOT_APPLICABLE)
+		require.NoError(t, err)
+		require.Len(t, hashes, 2)
+		for _, blockStore := range hashes {
+			if blockStore.Hash != blockHash(blockStore.Block) {
+				require.Equal(t, hex.EncodeToString(sigs.HashMsg([]byte(blockHash(blockStore.Block)))), blockStore.Hash)
+				forged++
+			}
+		}
+	}
+	// every other call is forged
+	require.Equal(t, 4, forged)
+}
+
+type mockChainProxy struct {
+	chainlib.ChainProxy
+	calls int
+}
+
+func (mcp *mockChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage chainlib.ChainMessage) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	mcp.calls++
+	return &pairingtypes.RelayReply{Data: []byte(`{"id":1,"jsonrpc":"2.0","result":"0x10"}`)}, "", nil, nil
+}
+
+type mockChainMessage struct {
+	chainlib.ChainMessage
+	api string
+}
+
+func (mcm *mockChainMessage) GetServiceApi() *spectypes.ServiceApi {
+	return &spectypes.ServiceApi{Name: mcm.api}
+}
+
+func TestChainProxy(t *testing.T) {
+	ctx := context.Background()
+	nodeProxy := &mockChainProxy{}
+	chainProxy := NewChainProxy(nodeProxy, &Config{AlteredReplies: map[string]string{"eth_getBalance": "0x1"}})
+	reply, _, _, err := chainProxy.SendNodeMsg(ctx, nil, &mockChainMessage{api: "eth_getBalance"})
+	require.NoError(t, err)
+	require.Equal(t, `{"id":1,"jsonrpc":"2.0","result":"0x1"}`, string(reply.Data))
+	reply, _, _, err = chainProxy.SendNodeMsg(ctx, nil, &mockChainMessage{api: "eth_blockNumber"})
+	require.NoError(t, err)
+	require.Equal(t, `{"id":1,"jsonrpc":"2.0","result":"0x10"}`, string(reply.Data))
+
+	droppingProxy := NewChainProxy(nodeProxy, &Config{DropRate: 1})
+	_, _, _, err = droppingProxy.SendNodeMsg(ctx, nil, &mockChainMessage{api: "eth_getBalance"})
+	require.ErrorIs(t, err, DroppedConnectionError)
+	require.Equal(t, 2, nodeProxy.calls)
+
+	slowProxy := NewChainProxy(nodeProxy, &Config{Latency: time.Minute})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, _, _, err = slowProxy.SendNodeMsg(timeoutCtx, nil, &mockChainMessage{api: "eth_getBalance"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 2, nodeProxy.calls)
+}
@@ -1,9 +1,58 @@
 package reliabilitymanager
 
-import "github.com/lavanet/lava/protocol/chaintracker"
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	VoteStartParams  = iota // a conflict vote was opened, the voters commit to the response of their node
+	VoteRevealParams        // the vote moved to reveal, the voters reveal the response they committed to
+	VoteCloseParams         // the vote was resolved
+)
+
+// VoteParams are the details of a conflict vote event, the request fields are set only when the vote starts
+type VoteParams struct {
+	ParamsType     int
+	VoteID         string
+	VoteDeadline   uint64
+	ChainID        string
+	ApiURL         string
+	RequestData    []byte
+	RequestBlock   uint64
+	Voters         []string
+	ConnectionType string
+}
+
+type VoteData struct {
+	RelayDataHash []byte
+	Nonce         int64
+	CommitHash    []byte
+}
+
+type TxSender interface {
+	TxConflictVoteCommit(ctx context.Context, voteID string, commitHash []byte) error
+	TxConflictVoteReveal(ctx context.Context, voteID string, nonce int64, relayDataHash []byte) error
+}
 
 type ReliabilityManager struct {
-	chainTracker *chaintracker.ChainTracker
+	chainTracker  *chaintracker.ChainTracker
+	chainParser   chainlib.ChainParser
+	chainProxy    chainlib.ChainProxy
+	txSender      TxSender
+	publicAddress string
+	votesLock     sync.Mutex
+	votes         map[string]*VoteData
 }
 
 func (rm *ReliabilityManager) GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error) {
@@ -14,8 +63,74 @@ func (rm *ReliabilityManager) GetLatestBlockNum() int64 {
 	return rm.chainTracker.GetLatestBlockNum()
 }
 
-func NewReliabilityManager(chainTracker *chaintracker.ChainTracker) *ReliabilityManager {
-	rm := &ReliabilityManager{}
+// VoteHandler takes part in the conflict votes this provider is a voter in, it commits to the response of its own node when a vote starts and reveals it later
+func (rm *ReliabilityManager) VoteHandler(voteParams *VoteParams, nodeHeight uint64) {
+	if voteParams.ParamsType != VoteCloseParams && voteParams.VoteDeadline < nodeHeight {
+		utils.LavaFormatError("Vote Event received but it's too late to vote", nil,
+			&map[string]string{"deadline": strconv.FormatUint(voteParams.VoteDeadline, 10), "nodeHeight": strconv.FormatUint(nodeHeight, 10), "voteID": voteParams.VoteID})
+		return
+	}
+	rm.votesLock.Lock()
+	defer rm.votesLock.Unlock()
+	vote, ok := rm.votes[voteParams.VoteID]
+	switch voteParams.ParamsType {
+	case VoteCloseParams:
+		if ok {
+			utils.LavaFormatInfo("Received Vote termination event for vote, cleared entry", &map[string]string{"voteID": voteParams.VoteID})
+			delete(rm.votes, voteParams.VoteID)
+		}
+	case VoteRevealParams:
+		if !ok {
+			// not a vote of this provider
+			return
+		}
+		utils.LavaFormatInfo("Received Vote Reveal for vote, sending Reveal for result", &map[string]string{"voteID": voteParams.VoteID, "voteData": fmt.Sprintf("%+v", vote)})
+		err := rm.txSender.TxConflictVoteReveal(context.Background(), voteParams.VoteID, vote.Nonce, vote.RelayDataHash)
+		if err != nil {
+			utils.LavaFormatError("failed to send vote reveal", err, &map[string]string{"voteID": voteParams.VoteID})
+		}
+	case VoteStartParams:
+		if ok {
+			utils.LavaFormatError("new vote Request for vote had existing entry", nil,
+				&map[string]string{"voteParams": fmt.Sprintf("%+v", voteParams), "voteID": voteParams.VoteID, "voteData": fmt.Sprintf("%+v", vote)})
+			return
+		}
+		if !slices.Contains(voteParams.Voters, rm.publicAddress) {
+			// this is a new vote but not for us
+			return
+		}
+		// we need to send a commit, first we need to use the chainProxy and get the response
+		// TODO: implement code that verified the requested block is finalized and if its not waits and tries again
+		chainMessage, err := rm.chainParser.ParseMsg(voteParams.ApiURL, voteParams.RequestData, voteParams.ConnectionType)
+		if err != nil {
+			// the vote is on an api of another api interface of the chain
+			utils.LavaFormatDebug("vote Request did not pass the api check on chain parser", &map[string]string{"voteID": voteParams.VoteID, "chainID": voteParams.ChainID, "error": err.Error()})
+			return
+		}
+		reply, _, _, err := rm.chainProxy.SendNodeMsg(context.Background(), nil, chainMessage)
+		if err != nil {
+			utils.LavaFormatError("vote relay send has failed", err,
+				&map[string]string{"ApiURL": voteParams.ApiURL, "RequestData": string(voteParams.RequestData), "voteID": voteParams.VoteID})
+			return
+		}
+		nonce := rand.Int63()
+		relayDataHash := sigs.HashMsg(reply.Data)
+		vote = &VoteData{RelayDataHash: relayDataHash, Nonce: nonce, CommitHash: conflicttypes.CommitVoteData(nonce, relayDataHash)}
+		rm.votes[voteParams.VoteID] = vote
+		utils.LavaFormatInfo("Received Vote start, sending commitment for result", &map[string]string{"voteID": voteParams.VoteID, "voteData": fmt.Sprintf("%+v", vote)})
+		err = rm.txSender.TxConflictVoteCommit(context.Background(), voteParams.VoteID, vote.CommitHash)
+		if err != nil {
+			utils.LavaFormatError("failed to send vote commitment", err, &map[string]string{"voteID": voteParams.VoteID})
+		}
+	}
+}
+
+func NewReliabilityManager(chainTracker *chaintracker.ChainTracker, txSender TxSender, publicAddress string, chainProxy chainlib.ChainProxy, chainParser chainlib.ChainParser) *ReliabilityManager {
+	rm := &ReliabilityManager{votes: map[string]*VoteData{}}
 	rm.chainTracker = chainTracker
+	rm.txSender = txSender
+	rm.publicAddress = publicAddress
+	rm.chainProxy = chainProxy
+	rm.chainParser = chainParser
 	return rm
 }
@@ -2,28 +2,138 @@ package rewardserver
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
 type RewardServer struct {
 	rewardsTxSender RewardsTxSender
+	lock            sync.RWMutex
+	rewards         map[uint64]*EpochRewards // key is the epoch of the proofs
+	seenEpochs      []uint64
 }
 
 type RewardsTxSender interface {
-	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest)
+	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest) error
 }
 
-func (rws *RewardServer) SendNewProof(ctx context.Context, singleProviderSession *lavasession.SingleProviderSession, epoch uint64, consumerAddr string) {
-	// TODO: implement
-	// get the proof for this consumer for this epoch for this session, update the latest proof
-	// write to a channel the epoch
+type EpochRewards struct {
+	epoch           uint64
+	consumerRewards map[string]*ConsumerRewards // key is consumer
+}
+
+type ConsumerRewards struct {
+	epoch           uint64
+	consumer        string
+	proofs          map[uint64]*pairingtypes.RelayRequest // key is session id
+	dataReliability *pairingtypes.VRFData
+}
+
+// PrepareRewardsForClaim returns the relay requests that claim the consumer usage, data reliability is attached to one of the relays
+func (csrw *ConsumerRewards) PrepareRewardsForClaim() (retProofs []*pairingtypes.RelayRequest) {
+	sessionIds := make([]uint64, 0, len(csrw.proofs))
+	for sessionId := range csrw.proofs {
+		sessionIds = append(sessionIds, sessionId)
+	}
+	sort.Slice(sessionIds, func(i, j int) bool { return sessionIds[i] < sessionIds[j] })
+	for _, sessionId := range sessionIds {
+		retProofs = append(retProofs, csrw.proofs[sessionId])
+	}
+	if csrw.dataReliability != nil {
+		if len(retProofs) == 0 {
+			utils.LavaFormatWarning("data reliability without relays can't be claimed", nil, &map[string]string{"consumer": csrw.consumer, "epoch": strconv.FormatUint(csrw.epoch, 10)})
+		} else {
+			dataReliabilityRelay := retProofs[0].ShallowCopy()
+			dataReliabilityRelay.DataReliability = csrw.dataReliability
+			retProofs[0] = dataReliabilityRelay
+		}
+	}
+	return retProofs
+}
+
+// SendNewProof saves the latest proof of a session, proofs are claimed once the epoch is stale
+func (rws *RewardServer) SendNewProof(ctx context.Context, proof *pairingtypes.RelayRequest, epoch uint64, consumerAddr string) (existingCU uint64, updatedWithProof bool) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	consumerRewards := rws.getOrCreateConsumerRewards(epoch, consumerAddr)
+	if existingProof, ok := consumerRewards.proofs[proof.SessionId]; ok && existingProof.CuSum >= proof.CuSum {
+		return existingProof.CuSum, false
+	}
+	consumerRewards.proofs[proof.SessionId] = proof
+	return 0, true
+}
+
+// SendNewDataReliabilityProof saves the data reliability the consumer sent, it is claimed alongside the consumer relays of the epoch
+func (rws *RewardServer) SendNewDataReliabilityProof(ctx context.Context, dataReliability *pairingtypes.VRFData, epoch uint64, consumerAddr string) (updatedWithProof bool) {
+	rws.lock.Lock()
+	defer rws.lock.Unlock()
+	consumerRewards := rws.getOrCreateConsumerRewards(epoch, consumerAddr)
+	if consumerRewards.dataReliability != nil {
+		return false
+	}
+	consumerRewards.dataReliability = dataReliability
+	return true
+}
+
+func (rws *RewardServer) getOrCreateConsumerRewards(epoch uint64, consumerAddr string) *ConsumerRewards {
+	epochRewards, ok := rws.rewards[epoch]
+	if !ok {
+		epochRewards = &EpochRewards{epoch: epoch, consumerRewards: map[string]*ConsumerRewards{}}
+		rws.rewards[epoch] = epochRewards
+	}
+	consumerRewards, ok := epochRewards.consumerRewards[consumerAddr]
+	if !ok {
+		consumerRewards = &ConsumerRewards{epoch: epoch, consumer: consumerAddr, proofs: map[uint64]*pairingtypes.RelayRequest{}}
+		epochRewards.consumerRewards[consumerAddr] = consumerRewards
+	}
+	return consumerRewards
+}
+
+// UpdateEpoch claims the rewards of the epochs that became stale, relays done StaleEpochDistance epochs back are ready to be rewarded
+func (rws *RewardServer) UpdateEpoch(epoch uint64) {
+	ctx := context.Background()
+	rws.lock.Lock()
+	if len(rws.seenEpochs) > 0 && rws.seenEpochs[len(rws.seenEpochs)-1] >= epoch {
+		rws.lock.Unlock()
+		return
+	}
+	rws.seenEpochs = append(rws.seenEpochs, epoch)
+	if len(rws.seenEpochs) <= lavasession.StaleEpochDistance {
+		rws.lock.Unlock()
+		return
+	}
+	rws.seenEpochs = rws.seenEpochs[len(rws.seenEpochs)-lavasession.StaleEpochDistance-1:]
+	staleEpoch := rws.seenEpochs[0]
+	relays := []*pairingtypes.RelayRequest{}
+	for rewardsEpoch, epochRewards := range rws.rewards {
+		if rewardsEpoch > staleEpoch {
+			continue
+		}
+		for _, consumerRewards := range epochRewards.consumerRewards {
+			relays = append(relays, consumerRewards.PrepareRewardsForClaim()...)
+		}
+		delete(rws.rewards, rewardsEpoch)
+	}
+	rws.lock.Unlock()
+
+	if len(relays) == 0 {
+		// no rewards to ask for
+		return
+	}
+	err := rws.rewardsTxSender.TxRelayPayment(ctx, relays)
+	if err != nil {
+		utils.LavaFormatError("failed sending relay payment", err, &map[string]string{"staleEpoch": strconv.FormatUint(staleEpoch, 10), "relays": strconv.Itoa(len(relays))})
+	}
 }
 
 func NewRewardServer(rewardsTxSender RewardsTxSender) *RewardServer {
 	//
-	rws := &RewardServer{}
+	rws := &RewardServer{rewards: map[uint64]*EpochRewards{}}
 	rws.rewardsTxSender = rewardsTxSender
 	return rws
 }
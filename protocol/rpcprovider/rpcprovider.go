@@ -2,6 +2,7 @@ package rpcprovider
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/rpcprovider/faults"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/protocol/statetracker"
@@ -34,19 +36,27 @@ var (
 )
 
 type ProviderStateTrackerInf interface {
-	RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser)
-	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, reliabilityManager *reliabilitymanager.ReliabilityManager)
-	RegisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable)
-	QueryVerifyPairing(ctx context.Context, consumer string, blockHeight uint64)
-	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest)
+	RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error
+	RegisterForSpecUpdates(ctx context.Context, specUpdatable statetracker.SpecUpdatable, chainID string) error
+	RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable statetracker.VoteUpdatable, chainID string)
+	RegisterForEpochUpdates(ctx context.Context, epochUpdatable statetracker.EpochUpdatable) error
+	RegisterForUnstakeCancelUpdates(ctx context.Context, unstakeCancelUpdatable statetracker.UnstakeCancelUpdatable, chainID string)
+	VerifyPairing(ctx context.Context, consumerAddress string, providerAddress string, epoch uint64, chainID string) (valid bool, index int64, err error)
+	GetVrfPkAndMaxCuForUser(ctx context.Context, consumerAddress string, chainID string, epoch uint64) (vrfPk *utils.VrfPubKey, maxCu uint64, err error)
+	GetProvidersCountForConsumer(ctx context.Context) (uint32, error)
+	TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest) error
+	TxConflictVoteCommit(ctx context.Context, voteID string, commitHash []byte) error
+	TxConflictVoteReveal(ctx context.Context, voteID string, nonce int64, relayDataHash []byte) error
 }
 
 type RPCProvider struct {
 	providerStateTracker ProviderStateTrackerInf
 	rpcProviderServers   map[string]*RPCProviderServer
+	Faults               *faults.Config // misbehaviour injected into the relays, only set when testing conflict detection
+	TLSConfig            *tls.Config    // certificate the relays are served with, nil serves plaintext
 }
 
-func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, cache *performance.Cache, parallelConnections uint) (err error) {
+func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, clientCtx client.Context, rpcProviderEndpoints []*lavasession.RPCProviderEndpoint, signer sigs.Signer, cache *performance.Cache, parallelConnections uint) (err error) {
 	// single state tracker
 	providerStateTracker := statetracker.ProviderStateTracker{}
 	rpcp.providerStateTracker, err = providerStateTracker.New(ctx, txFactory, clientCtx)
@@ -55,34 +65,30 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 	}
 	rpcp.rpcProviderServers = make(map[string]*RPCProviderServer, len(rpcProviderEndpoints))
 	// single reward server
-	rewardServer := rewardserver.NewRewardServer(&providerStateTracker)
-
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		utils.LavaFormatFatal("failed getting key name from clientCtx", err, nil)
-	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	rewardServer := rewardserver.NewRewardServer(rpcp.providerStateTracker)
+	err = rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, rewardServer)
 	if err != nil {
-		utils.LavaFormatFatal("failed getting private key from key name", err, &map[string]string{"keyName": keyName})
+		return err
 	}
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
 
-	var addr sdk.AccAddress
-	err = addr.Unmarshal(clientKey.GetPubKey().Address())
-	if err != nil {
-		utils.LavaFormatFatal("failed unmarshaling public address", err, &map[string]string{"keyName": keyName, "pubkey": clientKey.GetPubKey().Address().String()})
-	}
+	addr := sdk.AccAddress(signer.PubKey().Address())
 	utils.LavaFormatInfo("RPCProvider pubkey: "+addr.String(), nil)
 	utils.LavaFormatInfo("RPCProvider setting up endpoints", &map[string]string{"length": strconv.Itoa(len(rpcProviderEndpoints))})
 	for _, rpcProviderEndpoint := range rpcProviderEndpoints {
-		providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint, &providerStateTracker)
+		providerSessionManager := lavasession.NewProviderSessionManager(rpcProviderEndpoint)
 		key := rpcProviderEndpoint.Key()
-		rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
+		err = rpcp.providerStateTracker.RegisterForEpochUpdates(ctx, providerSessionManager)
+		if err != nil {
+			return err
+		}
 		chainParser, err := chainlib.NewChainParser(rpcProviderEndpoint.ApiInterface)
 		if err != nil {
 			return err
 		}
-		providerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser)
+		err = rpcp.providerStateTracker.RegisterChainParserForSpecUpdates(ctx, chainParser, rpcProviderEndpoint.ChainID)
+		if err != nil {
+			return err
+		}
 
 		chainProxy, err := chainlib.GetChainProxy(ctx, parallelConnections, rpcProviderEndpoint)
 		if err != nil {
@@ -92,24 +98,45 @@ func (rpcp *RPCProvider) Start(ctx context.Context, txFactory tx.Factory, client
 		_, avergaeBlockTime, blocksToFinalization, blocksInFinalizationData := chainParser.ChainBlockStats()
 		blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
 		chainTrackerConfig := chaintracker.ChainTrackerConfig{
-			ServerAddress:     rpcProviderEndpoint.NodeUrl,
+			ServerAddress:     "", // the chain tracker of the provider is not served
 			BlocksToSave:      blocksToSaveChainTracker,
 			AverageBlockTime:  avergaeBlockTime, // divide here to make the querying more often so we don't miss block changes by that much
 			ServerBlockMemory: ChainTrackerDefaultMemory + blocksToSaveChainTracker,
 		}
-		chainFetcher := chainlib.NewChainFetcher(ctx, chainProxy)
+		chainFetcher := chainlib.NewChainFetcher(ctx, chainProxy, chainParser, rpcProviderEndpoint)
 		chainTracker := chaintracker.New(ctx, chainFetcher, chainTrackerConfig)
-		reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker)
-		providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager)
+		if rpcp.Faults != nil {
+			// the chain tracker follows the real chain, the faults apply to what the provider serves and votes on
+			utils.LavaFormatWarning("RPCProvider injecting faults", nil, &map[string]string{"faults": fmt.Sprintf("%+v", *rpcp.Faults), "chainID": rpcProviderEndpoint.ChainID, "apiInterface": rpcProviderEndpoint.ApiInterface})
+			chainProxy = faults.NewChainProxy(chainProxy, rpcp.Faults)
+		}
+		reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker, rpcp.providerStateTracker, addr.String(), chainProxy, chainParser)
+		rpcp.providerStateTracker.RegisterReliabilityManagerForVoteUpdates(ctx, reliabilityManager, rpcProviderEndpoint.ChainID)
+		var relayReliabilityManager ReliabilityManagerInf = reliabilityManager
+		if rpcp.Faults != nil {
+			relayReliabilityManager = faults.NewReliabilityManager(reliabilityManager, rpcp.Faults)
+		}
 
 		rpcp.rpcProviderServers[key] = &RPCProviderServer{}
 		utils.LavaFormatInfo("RPCProvider Listening", &map[string]string{"endpoints": lavasession.PrintRPCProviderEndpoint(rpcProviderEndpoint)})
-		rpcp.rpcProviderServers[key].ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rewardServer, providerSessionManager, reliabilityManager, privKey, cache, chainProxy)
+		err = rpcp.rpcProviderServers[key].ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rewardServer, providerSessionManager, relayReliabilityManager, signer, cache, chainProxy, rpcp.providerStateTracker, rpcp.TLSConfig)
+		if err != nil {
+			return err
+		}
+		err = rpcp.providerStateTracker.RegisterForSpecUpdates(ctx, rpcp.rpcProviderServers[key], rpcProviderEndpoint.ChainID)
+		if err != nil {
+			return err
+		}
+		rpcp.providerStateTracker.RegisterForUnstakeCancelUpdates(ctx, rpcp.rpcProviderServers[key], rpcProviderEndpoint.ChainID)
 	}
 
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
-	<-signalChan
+	defer signal.Stop(signalChan)
+	select {
+	case <-signalChan:
+	case <-ctx.Done():
+	}
 	return nil
 }
 
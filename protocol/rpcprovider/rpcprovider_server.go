@@ -1,16 +1,53 @@
 package rpcprovider
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
 
-	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/performance"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
-type RPCProviderServer struct{}
+const (
+	latestBlockDataRetries = 3
+)
+
+type RPCProviderServer struct {
+	pairingtypes.UnimplementedRelayerServer
+	cache                  *performance.Cache
+	chainProxy             chainlib.ChainProxy
+	signer                 sigs.Signer
+	reliabilityManager     ReliabilityManagerInf
+	providerSessionManager *lavasession.ProviderSessionManager
+	rpcProviderEndpoint    *lavasession.RPCProviderEndpoint
+	chainParser            chainlib.ChainParser
+	rewardServer           RewardServerInf
+	stateTracker           StateTrackerInf
+	providerAddress        sdk.AccAddress
+	grpcServer             *grpc.Server
+	specDisabled           uint32 // set atomically when the spec of the chain is disabled or removed
+}
 
 type ReliabilityManagerInf interface {
 	GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, err error)
@@ -18,7 +55,14 @@ type ReliabilityManagerInf interface {
 }
 
 type RewardServerInf interface {
-	SendNewProof(ctx context.Context, singleProviderSession *lavasession.SingleProviderSession, epoch uint64, consumerAddr string)
+	SendNewProof(ctx context.Context, proof *pairingtypes.RelayRequest, epoch uint64, consumerAddr string) (existingCU uint64, updatedWithProof bool)
+	SendNewDataReliabilityProof(ctx context.Context, dataReliability *pairingtypes.VRFData, epoch uint64, consumerAddr string) (updatedWithProof bool)
+}
+
+type StateTrackerInf interface {
+	VerifyPairing(ctx context.Context, consumerAddress string, providerAddress string, epoch uint64, chainID string) (valid bool, index int64, err error)
+	GetVrfPkAndMaxCuForUser(ctx context.Context, consumerAddress string, chainID string, epoch uint64) (vrfPk *utils.VrfPubKey, maxCu uint64, err error)
+	GetProvidersCountForConsumer(ctx context.Context) (uint32, error)
 }
 
 func (rpcps *RPCProviderServer) ServeRPCRequests(
@@ -27,19 +71,490 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rewardServer RewardServerInf,
 	providerSessionManager *lavasession.ProviderSessionManager,
 	reliabilityManager ReliabilityManagerInf,
-	privKey *btcec.PrivateKey,
+	signer sigs.Signer,
 	cache *performance.Cache, chainProxy chainlib.ChainProxy,
-) {
-	// spin up a grpc listener
-	// verify the relay metadata is valid (epoch, signature)
-	// verify the consumer is authorised
-	// create/bring a session
-	// verify the relay data is valid (cu, chainParser, requested block)
-	// check cache hit
-	// send the relay to the node using chainProxy
-	// set cache entry (async)
-	// attach data reliability finalization data
-	// sign the response
-	// send the proof to reward server
-	// finalize the session
+	stateTracker StateTrackerInf,
+	tlsConfig *tls.Config,
+) error {
+	rpcps.cache = cache
+	rpcps.chainProxy = chainProxy
+	rpcps.signer = signer
+	rpcps.reliabilityManager = reliabilityManager
+	rpcps.providerSessionManager = providerSessionManager
+	rpcps.rpcProviderEndpoint = rpcProviderEndpoint
+	rpcps.chainParser = chainParser
+	rpcps.rewardServer = rewardServer
+	rpcps.stateTracker = stateTracker
+	rpcps.providerAddress = sdk.AccAddress(signer.PubKey().Address())
+
+	lis, err := net.Listen("tcp", rpcProviderEndpoint.NetworkAddress)
+	if err != nil {
+		return utils.LavaFormatError("provider failure setting up listener", err, &map[string]string{"listenAddr": rpcProviderEndpoint.NetworkAddress})
+	}
+	if tlsConfig == nil {
+		utils.LavaFormatWarning("provider listening in plaintext, relays can be read and modified on the way", nil, &map[string]string{"listenAddr": rpcProviderEndpoint.NetworkAddress})
+		rpcps.grpcServer = grpc.NewServer()
+	} else {
+		rpcps.grpcServer = grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	pairingtypes.RegisterRelayerServer(rpcps.grpcServer, rpcps)
+	go func() {
+		<-ctx.Done()
+		rpcps.grpcServer.Stop()
+	}()
+	go func() {
+		if err := rpcps.grpcServer.Serve(lis); err != nil {
+			utils.LavaFormatError("provider failed to serve", err, &map[string]string{"Address": lis.Addr().String()})
+		}
+	}()
+	return nil
+}
+
+// SetSpec is called when the spec of the endpoint chain changes, a disabled or removed spec stops the endpoint from serving relays
+func (rpcps *RPCProviderServer) SetSpec(spec spectypes.Spec) {
+	specDisabled := uint32(0)
+	if !spec.Enabled {
+		specDisabled = 1
+	}
+	atomic.StoreUint32(&rpcps.specDisabled, specDisabled)
+}
+
+// UnstakeCanceled is called when the unstake of the provider on the endpoint chain was canceled
+func (rpcps *RPCProviderServer) UnstakeCanceled(entry epochstoragetypes.StakeEntry) {
+	utils.LavaFormatInfo("Provider unstake was canceled, pairing resumes from the next epoch", &map[string]string{"chainID": entry.Chain, "apiInterface": rpcps.rpcProviderEndpoint.ApiInterface, "stake": entry.Stake.String()})
+}
+
+// function used to handle relay requests from a consumer, it is called by a provider_listener by calling RegisterReceiver
+func (rpcps *RPCProviderServer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	utils.LavaFormatDebug("Provider got relay request", &map[string]string{
+		"request.SessionId":   strconv.FormatUint(request.SessionId, 10),
+		"request.relayNumber": strconv.FormatUint(request.RelayNum, 10),
+		"request.cu":          strconv.FormatUint(request.CuSum, 10),
+	})
+	relaySession, consumerAddress, chainMessage, err := rpcps.initRelay(ctx, request)
+	if err != nil {
+		return nil, rpcps.handleRelayErrorStatus(err)
+	}
+	// the proof is the request the consumer signed, TryRelay updates the requested block on the request
+	proof := request.ShallowCopy()
+	reply, err := rpcps.TryRelay(ctx, request, consumerAddress, chainMessage)
+	if err != nil && request.DataReliability == nil { // we ignore data reliability because its not checking/adding cu/relaynum.
+		// failed to send relay. we need to adjust session state. cuSum and relayNumber.
+		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, chainMessage.GetServiceApi().ComputeUnits, request.RelayNum)
+		if relayFailureError != nil {
+			err = sdkerrors.Wrapf(relayFailureError, "On relay failure: "+err.Error())
+		}
+		utils.LavaFormatError("TryRelay Failed", err, &map[string]string{
+			"request.SessionId": strconv.FormatUint(request.SessionId, 10),
+			"request.userAddr":  consumerAddress.String(),
+		})
+		return nil, rpcps.handleRelayErrorStatus(err)
+	} else if err != nil {
+		return nil, err
+	}
+	epoch := uint64(request.BlockHeight)
+	if request.DataReliability == nil {
+		err = rpcps.providerSessionManager.OnSessionDone(relaySession, proof)
+		if err != nil {
+			return nil, rpcps.handleRelayErrorStatus(err)
+		}
+		rpcps.rewardServer.SendNewProof(ctx, proof, epoch, consumerAddress.String())
+	} else {
+		rpcps.rewardServer.SendNewDataReliabilityProof(ctx, request.DataReliability, epoch, consumerAddress.String())
+	}
+	utils.LavaFormatDebug("Provider Finished Relay Successfully", &map[string]string{
+		"request.SessionId":   strconv.FormatUint(request.SessionId, 10),
+		"request.relayNumber": strconv.FormatUint(request.RelayNum, 10),
+	})
+	return reply, nil
+}
+
+func (rpcps *RPCProviderServer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	utils.LavaFormatInfo("Provider got relay request subscribe", &map[string]string{
+		"request.SessionId": strconv.FormatUint(request.SessionId, 10),
+	})
+	ctx := srv.Context()
+	if request.DataReliability != nil {
+		return utils.LavaFormatError("data reliability is not supported on subscriptions", nil, nil)
+	}
+	relaySession, consumerAddress, chainMessage, err := rpcps.initRelay(ctx, request)
+	if err != nil {
+		return rpcps.handleRelayErrorStatus(err)
+	}
+	proof := request.ShallowCopy()
+	err = rpcps.TryRelaySubscribe(ctx, request, srv, chainMessage, relaySession, proof, consumerAddress)
+	if err != nil {
+		// failed to send relay. we need to adjust session state. cuSum and relayNumber.
+		relayFailureError := rpcps.providerSessionManager.OnSessionFailure(relaySession, chainMessage.GetServiceApi().ComputeUnits, request.RelayNum)
+		if relayFailureError != nil {
+			err = sdkerrors.Wrapf(relayFailureError, "Relay Error: "+err.Error())
+		}
+	}
+	return rpcps.handleRelayErrorStatus(err)
+}
+
+func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer, chainMessage chainlib.ChainMessage, relaySession *lavasession.SingleProviderSession, proof *pairingtypes.RelayRequest, consumerAddress sdk.AccAddress) error {
+	subscribeRepliesChan := make(chan interface{})
+	reply, _, clientSub, err := rpcps.chainProxy.SendNodeMsg(ctx, subscribeRepliesChan, chainMessage)
+	if err != nil {
+		return utils.LavaFormatError("Subscription failed", err, nil)
+	}
+	if clientSub == nil {
+		return utils.LavaFormatError("Subscription failed, node did not open a subscription", nil, &map[string]string{"api": chainMessage.GetServiceApi().Name})
+	}
+	defer clientSub.Unsubscribe()
+	// the subscription is open, the consumer pays for it from here on
+	err = rpcps.providerSessionManager.OnSessionDone(relaySession, proof)
+	if err != nil {
+		return err
+	}
+	rpcps.rewardServer.SendNewProof(ctx, proof, uint64(request.BlockHeight), consumerAddress.String())
+
+	err = srv.Send(reply) // this reply contains the RPC ID
+	if err != nil {
+		utils.LavaFormatError("Error getting RPC ID", err, nil)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-clientSub.Err():
+			utils.LavaFormatError("client sub", err, nil)
+			return nil
+		case subscribeReply := <-subscribeRepliesChan:
+			data, err := json.Marshal(subscribeReply)
+			if err != nil {
+				utils.LavaFormatError("client sub unmarshal", err, nil)
+				return nil
+			}
+			err = srv.Send(&pairingtypes.RelayReply{Data: data})
+			if err != nil {
+				// usually triggered when client closes connection
+				if strings.Contains(err.Error(), "Canceled desc = context canceled") {
+					utils.LavaFormatWarning("Client closed connection", err, nil)
+				} else {
+					utils.LavaFormatError("srv.Send", err, nil)
+				}
+				return nil
+			}
+		}
+	}
+}
+
+// verifies the relay metadata and the consumer pairing, and prepares the session of the relay
+func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingtypes.RelayRequest) (relaySession *lavasession.SingleProviderSession, consumerAddress sdk.AccAddress, chainMessage chainlib.ChainMessage, err error) {
+	if atomic.LoadUint32(&rpcps.specDisabled) == 1 {
+		return nil, nil, nil, utils.LavaFormatError("spec of the chain is disabled, the endpoint doesn't serve relays", nil, &map[string]string{"chainID": rpcps.rpcProviderEndpoint.ChainID, "apiInterface": rpcps.rpcProviderEndpoint.ApiInterface})
+	}
+	relaySession, consumerAddress, err = rpcps.verifyRelaySession(ctx, request)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// parse the message to extract the cu and chainMessage for sending it
+	chainMessage, err = rpcps.chainParser.ParseMsg(request.ApiUrl, request.Data, request.ConnectionType)
+	if err != nil {
+		return nil, nil, nil, utils.LavaFormatError("failed parsing request message", err, &map[string]string{"apiInterface": rpcps.rpcProviderEndpoint.ApiInterface, "request URL": request.ApiUrl, "request data": string(request.Data), "userAddr": consumerAddress.String()})
+	}
+	if request.DataReliability == nil {
+		err = relaySession.PrepareSessionForUsage(chainMessage.GetServiceApi().ComputeUnits, request.CuSum, request.RelayNum)
+		if err != nil {
+			if lavasession.RelayNumReplayError.Is(err) || lavasession.RelayNumTooOldError.Is(err) {
+				// the consumer might have lost the reply of a relay the provider served, it continues from the session state
+				relayNum, cuSum := relaySession.SyncState()
+				return nil, nil, nil, lavasession.RelayNumMismatchStatus(err, relayNum, cuSum)
+			}
+			return nil, nil, nil, err
+		}
+	}
+	return relaySession, consumerAddress, chainMessage, nil
+}
+
+func (rpcps *RPCProviderServer) verifyRelaySession(ctx context.Context, request *pairingtypes.RelayRequest) (singleProviderSession *lavasession.SingleProviderSession, consumerAddress sdk.AccAddress, err error) {
+	epoch := uint64(request.BlockHeight)
+	err = rpcps.providerSessionManager.VerifyEpoch(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rpcps.providerAddress.String() != request.Provider {
+		return nil, nil, utils.LavaFormatError("User is trying to communicate with the wrong provider address.", nil, &map[string]string{
+			"ProviderWhoGotTheRequest": rpcps.providerAddress.String(),
+			"ProviderInTheRequest":     request.Provider,
+		})
+	}
+	if request.ChainID != rpcps.rpcProviderEndpoint.ChainID {
+		return nil, nil, utils.LavaFormatError("spec not supported by server", nil, &map[string]string{"request.chainID": request.ChainID, "chainID": rpcps.rpcProviderEndpoint.ChainID})
+	}
+	extractedConsumerAddress, err := rpcps.extractConsumerAddress(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	consumerAddressString := extractedConsumerAddress.String()
+
+	if request.DataReliability != nil {
+		err = rpcps.verifyDataReliabilityRelay(ctx, request, extractedConsumerAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, extractedConsumerAddress, nil
+	}
+	singleProviderSession, err = rpcps.providerSessionManager.GetSession(consumerAddressString, epoch, request.SessionId, request.RelayNum)
+	if err != nil {
+		if !lavasession.ConsumerNotActive.Is(err) {
+			return nil, nil, utils.LavaFormatError("failed to get a provider session", err, &map[string]string{"sessionID": strconv.FormatUint(request.SessionId, 10), "consumer": consumerAddressString, "epoch": strconv.FormatUint(epoch, 10)})
+		}
+		// the consumer is new in this epoch, verify its pairing and register it
+		_, err = rpcps.registerConsumer(ctx, consumerAddressString, epoch)
+		if err != nil {
+			return nil, nil, err
+		}
+		singleProviderSession, err = rpcps.providerSessionManager.GetSession(consumerAddressString, epoch, request.SessionId, request.RelayNum)
+		if err != nil {
+			return nil, nil, utils.LavaFormatError("failed to get a provider session", err, &map[string]string{"sessionID": strconv.FormatUint(request.SessionId, 10), "consumer": consumerAddressString, "epoch": strconv.FormatUint(epoch, 10)})
+		}
+	}
+	if singleProviderSession.GetPairingEpoch() != epoch {
+		return nil, nil, utils.LavaFormatError("request blockheight mismatch to session epoch", nil, &map[string]string{"pairingEpoch": strconv.FormatUint(singleProviderSession.GetPairingEpoch(), 10), "userAddr": consumerAddressString, "relay blockheight": strconv.FormatInt(request.BlockHeight, 10)})
+	}
+	return singleProviderSession, extractedConsumerAddress, nil
+}
+
+// registerConsumer verifies the consumer is paired with this provider in the epoch and registers it in the session manager, it returns the provider index in the consumer pairing
+func (rpcps *RPCProviderServer) registerConsumer(ctx context.Context, consumerAddress string, epoch uint64) (pairingIndex int64, err error) {
+	valid, pairingIndex, err := rpcps.stateTracker.VerifyPairing(ctx, consumerAddress, rpcps.providerAddress.String(), epoch, rpcps.rpcProviderEndpoint.ChainID)
+	if err != nil {
+		return 0, utils.LavaFormatError("user not authorized or error occurred", err, &map[string]string{"userAddr": consumerAddress, "block": strconv.FormatUint(epoch, 10)})
+	}
+	if !valid {
+		return 0, utils.LavaFormatError("invalid pairing with consumer", nil, &map[string]string{"userAddr": consumerAddress, "block": strconv.FormatUint(epoch, 10)})
+	}
+	vrfPk, maxCu, err := rpcps.stateTracker.GetVrfPkAndMaxCuForUser(ctx, consumerAddress, rpcps.rpcProviderEndpoint.ChainID, epoch)
+	if err != nil {
+		return 0, utils.LavaFormatError("failed to get vrfpk and maxCURes for user", err, &map[string]string{"userAddr": consumerAddress, "block": strconv.FormatUint(epoch, 10)})
+	}
+	_, err = rpcps.providerSessionManager.RegisterProviderSessionWithConsumer(consumerAddress, epoch, maxCu, *vrfPk)
+	if err != nil {
+		return 0, err
+	}
+	return pairingIndex, nil
+}
+
+func (rpcps *RPCProviderServer) verifyDataReliabilityRelay(ctx context.Context, request *pairingtypes.RelayRequest, consumerAddress sdk.AccAddress) error {
+	epoch := uint64(request.BlockHeight)
+	if request.RelayNum > lavasession.DataReliabilitySessionId {
+		return utils.LavaFormatError("request's relay num is larger than the data reliability session ID", nil, &map[string]string{"relayNum": strconv.FormatUint(request.RelayNum, 10), "DataReliabilitySessionId": strconv.Itoa(lavasession.DataReliabilitySessionId)})
+	}
+	if request.CuSum != lavasession.DataReliabilityCuSum {
+		return utils.LavaFormatError("request's CU sum is not equal to the data reliability CU sum", nil, &map[string]string{"cuSum": strconv.FormatUint(request.CuSum, 10), "DataReliabilityCuSum": strconv.Itoa(lavasession.DataReliabilityCuSum)})
+	}
+	if rpcps.providerSessionManager.GetDataReliability(consumerAddress.String(), epoch) != nil {
+		return utils.LavaFormatError("dataReliability can only be used once per client per epoch", lavasession.DataReliabilityAlreadySentThisEpochError, &map[string]string{"requested epoch": strconv.FormatUint(epoch, 10), "userAddr": consumerAddress.String()})
+	}
+	// data reliability is not session dependant, the pairing index is needed to verify the vrf
+	pairingIndex, err := rpcps.registerConsumer(ctx, consumerAddress.String(), epoch)
+	if err != nil {
+		return err
+	}
+	vrfPk, _, err := rpcps.stateTracker.GetVrfPkAndMaxCuForUser(ctx, consumerAddress.String(), rpcps.rpcProviderEndpoint.ChainID, epoch)
+	if err != nil {
+		return utils.LavaFormatError("failed to get vrfpk for data reliability!", err, &map[string]string{"userAddr": consumerAddress.String()})
+	}
+	// verify the providerSig is indeed a signature by a valid provider on this query
+	valid, err := rpcps.VerifyReliabilityAddressSigning(ctx, consumerAddress, request)
+	if err != nil {
+		return utils.LavaFormatError("VerifyReliabilityAddressSigning invalid", err, &map[string]string{"requested epoch": strconv.FormatUint(epoch, 10), "userAddr": consumerAddress.String(), "dataReliability": fmt.Sprintf("%v", request.DataReliability)})
+	}
+	if !valid {
+		return utils.LavaFormatError("invalid DataReliability Provider signing", nil, &map[string]string{"requested epoch": strconv.FormatUint(epoch, 10), "userAddr": consumerAddress.String(), "dataReliability": fmt.Sprintf("%v", request.DataReliability)})
+	}
+	// verify data reliability fields correspond to the right vrf
+	valid = utils.VerifyVrfProof(request, *vrfPk, epoch)
+	if !valid {
+		return utils.LavaFormatError("invalid DataReliability fields, VRF wasn't verified with provided proof", nil, &map[string]string{"requested epoch": strconv.FormatUint(epoch, 10), "userAddr": consumerAddress.String(), "dataReliability": fmt.Sprintf("%v", request.DataReliability)})
+	}
+	_, dataReliabilityThreshold := rpcps.chainParser.DataReliabilityParams()
+	providersCount, err := rpcps.stateTracker.GetProvidersCountForConsumer(ctx)
+	if err != nil {
+		return utils.LavaFormatError("failed to get the providers count for data reliability", err, nil)
+	}
+	vrfIndex, vrfErr := utils.GetIndexForVrf(request.DataReliability.VrfValue, providersCount, dataReliabilityThreshold)
+	if vrfErr != nil || vrfIndex != pairingIndex {
+		return utils.LavaFormatError("Provider identified invalid vrfIndex in data reliability request, the given index and self index are different", vrfErr, &map[string]string{
+			"requested epoch": strconv.FormatUint(epoch, 10), "userAddr": consumerAddress.String(),
+			"vrfIndex":   strconv.FormatInt(vrfIndex, 10),
+			"self Index": strconv.FormatInt(pairingIndex, 10),
+		})
+	}
+	utils.LavaFormatInfo("server got valid DataReliability request", nil)
+	return rpcps.providerSessionManager.OnDataReliabilitySession(consumerAddress.String(), epoch, request.DataReliability)
+}
+
+func (rpcps *RPCProviderServer) VerifyReliabilityAddressSigning(ctx context.Context, consumer sdk.AccAddress, request *pairingtypes.RelayRequest) (valid bool, err error) {
+	queryHash := utils.CalculateQueryHash(*request)
+	if !bytes.Equal(queryHash, request.DataReliability.QueryHash) {
+		return false, utils.LavaFormatError("query hash mismatch on data reliability message", nil,
+			&map[string]string{"queryHash": string(queryHash), "request QueryHash": string(request.DataReliability.QueryHash)})
+	}
+
+	// validate consumer signing on VRF data
+	valid, err = sigs.ValidateSignerOnVRFData(consumer, *request.DataReliability)
+	if err != nil {
+		return false, utils.LavaFormatError("failed to Validate Signer On VRF Data", err,
+			&map[string]string{"consumer": consumer.String(), "request.DataReliability": fmt.Sprintf("%v", request.DataReliability)})
+	}
+	if !valid {
+		return false, nil
+	}
+	// validate provider signing on query data
+	pubKey, err := sigs.RecoverProviderPubKeyFromVrfDataAndQuery(request)
+	if err != nil {
+		return false, utils.LavaFormatError("failed to Recover Provider PubKey From Vrf Data And Query", err,
+			&map[string]string{"consumer": consumer.String(), "request": fmt.Sprintf("%v", request)})
+	}
+	providerAccAddress, err := sdk.AccAddressFromHex(pubKey.Address().String()) // consumer signer
+	if err != nil {
+		return false, utils.LavaFormatError("failed converting signer to address", err,
+			&map[string]string{"consumer": consumer.String(), "PubKey": pubKey.Address().String()})
+	}
+	// return if this pairing is authorised
+	valid, _, err = rpcps.stateTracker.VerifyPairing(ctx, consumer.String(), providerAccAddress.String(), uint64(request.BlockHeight), rpcps.rpcProviderEndpoint.ChainID)
+	return valid, err
+}
+
+func (rpcps *RPCProviderServer) extractConsumerAddress(request *pairingtypes.RelayRequest) (address sdk.AccAddress, err error) {
+	pubKey, err := sigs.RecoverPubKeyFromRelay(*request)
+	if err != nil {
+		return nil, utils.LavaFormatError("get relay user", err, nil)
+	}
+	address, err = sdk.AccAddressFromHex(pubKey.Address().String())
+	if err != nil {
+		return nil, utils.LavaFormatError("get relay acc address", err, nil)
+	}
+	return address, nil
+}
+
+func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if lavasession.SessionOutOfSyncError.Is(err) {
+		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
+	} else if lavasession.InvalidEpochError.Is(err) {
+		err = status.Error(codes.Code(lavasession.InvalidEpochError.ABCICode()), err.Error())
+	}
+	return err
+}
+
+func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingtypes.RelayRequest, consumerAddress sdk.AccAddress, chainMessage chainlib.ChainMessage) (*pairingtypes.RelayReply, error) {
+	// Send
+	latestBlock := int64(0)
+	finalizedBlockHashes := map[int64]interface{}{}
+	var requestedBlockHash []byte = nil
+	finalized := false
+	dataReliabilityEnabled, _ := rpcps.chainParser.DataReliabilityParams()
+	if dataReliabilityEnabled {
+		// Add latest block and finalized data
+		var err error
+		latestBlock, finalizedBlockHashes, requestedBlockHash, err = rpcps.getLatestBlockData(request.RequestBlock)
+		if err != nil {
+			return nil, utils.LavaFormatError("Could not guarantee data reliability", err, &map[string]string{"requestedBlock": strconv.FormatInt(request.RequestBlock, 10), "latestBlock": strconv.FormatInt(latestBlock, 10)})
+		}
+		request.RequestBlock = lavaprotocol.ReplaceRequestedBlock(request.RequestBlock, latestBlock)
+		_, _, blockDistanceForFinalizedData, _ := rpcps.chainParser.ChainBlockStats()
+		finalized = spectypes.IsFinalizedBlock(request.RequestBlock, latestBlock, blockDistanceForFinalizedData)
+	}
+	apiInterface := rpcps.rpcProviderEndpoint.ApiInterface
+	chainID := rpcps.rpcProviderEndpoint.ChainID
+	// TODO: handle cache on fork for dataReliability = false
+	var reply *pairingtypes.RelayReply = nil
+	var err error = nil
+	if requestedBlockHash != nil || finalized {
+		reply, err = rpcps.cache.GetEntry(ctx, request, apiInterface, requestedBlockHash, chainID, finalized)
+	}
+	if err != nil || reply == nil {
+		if err != nil && performance.NotConnectedError.Is(err) {
+			utils.LavaFormatWarning("cache not connected", err, nil)
+		}
+		// cache miss or invalid
+		reply, _, _, err = rpcps.chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+		if err != nil {
+			return nil, utils.LavaFormatError("Sending chainMsg failed", err, nil)
+		}
+		if requestedBlockHash != nil || finalized {
+			err := rpcps.cache.SetEntry(ctx, request, apiInterface, requestedBlockHash, chainID, consumerAddress.String(), reply, finalized)
+			if err != nil && !performance.NotInitialisedError.Is(err) {
+				utils.LavaFormatWarning("error updating cache with new entry", err, nil)
+			}
+		}
+	}
+
+	jsonStr, err := json.Marshal(finalizedBlockHashes)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed unmarshaling finalizedBlockHashes", err,
+			&map[string]string{"finalizedBlockHashes": fmt.Sprintf("%v", finalizedBlockHashes)})
+	}
+	reply.FinalizedBlocksHashes = jsonStr
+	reply.LatestBlock = latestBlock
+
+	// request is a copy of the original request, but won't modify it
+	signRequest := *request
+	// update relay request requestedBlock to the provided one in case it was arbitrary
+	lavaprotocol.UpdateRequestedBlock(&signRequest, reply)
+	reply.Sig, err = sigs.SignRelayResponse(rpcps.signer, reply, &signRequest)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed signing relay response", err,
+			&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply)})
+	}
+	if dataReliabilityEnabled {
+		// update sig blocks signature
+		reply.SigBlocks, err = sigs.SignResponseFinalizationData(rpcps.signer, reply, &signRequest, consumerAddress)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed signing finalization data", err,
+				&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply), "userAddr": consumerAddress.String()})
+		}
+	}
+	// return reply to user
+	return reply, nil
+}
+
+// getLatestBlockData returns the latest block with the hashes of the finalized blocks the provider signs on, and the hash of the requested block if the provider has it
+func (rpcps *RPCProviderServer) getLatestBlockData(requestedBlock int64) (latestBlock int64, finalizedBlockHashes map[int64]interface{}, requestedBlockHash []byte, err error) {
+	_, _, blockDistanceForFinalizedData, blocksInFinalizationData := rpcps.chainParser.ChainBlockStats()
+	var hashes []*chaintracker.BlockStore
+	for retry := 0; retry < latestBlockDataRetries; retry++ {
+		// the finalized blocks range has to be consistent with the returned latest block, if a block arrived in between we try again
+		expectedLatestBlock := rpcps.reliabilityManager.GetLatestBlockNum()
+		toBlock := expectedLatestBlock - int64(blockDistanceForFinalizedData) + 1
+		fromBlock := toBlock - int64(blocksInFinalizationData)
+		latestBlock, hashes, err = rpcps.reliabilityManager.GetLatestBlockData(fromBlock, toBlock, spectypes.NOT_APPLICABLE)
+		if err == nil && latestBlock == expectedLatestBlock {
+			break
+		}
+	}
+	if err != nil {
+		return latestBlock, nil, nil, err
+	}
+	finalizedBlockHashes = map[int64]interface{}{}
+	for _, blockStore := range hashes {
+		finalizedBlockHashes[blockStore.Block] = blockStore.Hash
+	}
+	requestedBlock = lavaprotocol.ReplaceRequestedBlock(requestedBlock, latestBlock)
+	if requestedBlock >= 0 {
+		if hash, ok := finalizedBlockHashes[requestedBlock]; ok {
+			requestedBlockHash = []byte(hash.(string))
+		} else {
+			_, requestedHashes, err := rpcps.reliabilityManager.GetLatestBlockData(spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, requestedBlock)
+			if err == nil && len(requestedHashes) == 1 {
+				requestedBlockHash = []byte(requestedHashes[0].Hash)
+			}
+		}
+	}
+	if requestedBlockHash == nil {
+		// avoid using cache, but can still service
+		utils.LavaFormatDebug("no hash data for requested block", &map[string]string{"requestedBlock": strconv.FormatInt(requestedBlock, 10), "latestBlock": strconv.FormatInt(latestBlock, 10)})
+	}
+	return latestBlock, finalizedBlockHashes, requestedBlockHash, nil
 }
@@ -0,0 +1,60 @@
+package statetracker
+
+import (
+	"context"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	CallbackKeyForEpochUpdate = "epoch-update"
+)
+
+// EpochUpdater calls the registered EpochUpdatables when a new epoch starts
+type EpochUpdater struct {
+	epochUpdatables    []EpochUpdatable
+	currentEpoch       uint64
+	nextBlockForUpdate uint64
+	stateQuery         *StateQuery
+}
+
+func NewEpochUpdater(stateQuery *StateQuery) *EpochUpdater {
+	return &EpochUpdater{epochUpdatables: []EpochUpdatable{}, stateQuery: stateQuery}
+}
+
+func (eu *EpochUpdater) RegisterEpochUpdatable(ctx context.Context, epochUpdatable EpochUpdatable) error {
+	epoch, nextBlockForUpdate, err := eu.stateQuery.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	epochUpdatable.UpdateEpoch(epoch)
+	if len(eu.epochUpdatables) == 0 {
+		eu.currentEpoch = epoch
+		eu.nextBlockForUpdate = nextBlockForUpdate
+	}
+	eu.epochUpdatables = append(eu.epochUpdatables, epochUpdatable)
+	return nil
+}
+
+func (eu *EpochUpdater) UpdaterKey() string {
+	return CallbackKeyForEpochUpdate
+}
+
+func (eu *EpochUpdater) Update(latestBlock int64) {
+	if int64(eu.nextBlockForUpdate) > latestBlock {
+		return
+	}
+	epoch, nextBlockForUpdate, err := eu.stateQuery.GetEpoch(context.Background())
+	if err != nil {
+		utils.LavaFormatError("could not get epoch, trying again next block", err, nil)
+		return
+	}
+	eu.nextBlockForUpdate = nextBlockForUpdate
+	if epoch <= eu.currentEpoch {
+		return
+	}
+	eu.currentEpoch = epoch
+	for _, epochUpdatable := range eu.epochUpdatables {
+		epochUpdatable.UpdateEpoch(epoch)
+	}
+}
@@ -1,8 +1,11 @@
 package statetracker
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/utils"
 )
 
 const (
@@ -19,9 +22,17 @@ func NewFinalizationConsensusUpdater(consumerAddress sdk.AccAddress, stateQuery
 	return &FinalizationConsensusUpdater{registeredFinalizationConsensuses: []*lavaprotocol.FinalizationConsensus{}, stateQuery: stateQuery}
 }
 
-func (fcu *FinalizationConsensusUpdater) RegisterFinalizationConsensus(finalizationConsensus *lavaprotocol.FinalizationConsensus) {
-	// TODO: also update here for the first time
+func (fcu *FinalizationConsensusUpdater) RegisterFinalizationConsensus(ctx context.Context, finalizationConsensus *lavaprotocol.FinalizationConsensus) error {
+	epoch, nextBlockForUpdate, err := fcu.stateQuery.GetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	finalizationConsensus.NewEpoch(epoch)
+	if len(fcu.registeredFinalizationConsensuses) == 0 || nextBlockForUpdate < fcu.nextBlockForUpdate {
+		fcu.nextBlockForUpdate = nextBlockForUpdate
+	}
 	fcu.registeredFinalizationConsensuses = append(fcu.registeredFinalizationConsensuses, finalizationConsensus)
+	return nil
 }
 
 func (fcu *FinalizationConsensusUpdater) UpdaterKey() string {
@@ -32,7 +43,11 @@ func (fcu *FinalizationConsensusUpdater) Update(latestBlock int64) {
 	if int64(fcu.nextBlockForUpdate) > latestBlock {
 		return
 	}
-	_, epoch, nextBlockForUpdate := fcu.stateQuery.GetPairing(latestBlock)
+	epoch, nextBlockForUpdate, err := fcu.stateQuery.GetEpoch(context.Background())
+	if err != nil {
+		utils.LavaFormatError("could not get epoch for finalization consensus, trying again next block", err, nil)
+		return
+	}
 	fcu.nextBlockForUpdate = nextBlockForUpdate
 	for _, finalizationConsensus := range fcu.registeredFinalizationConsensuses {
 		finalizationConsensus.NewEpoch(epoch)
@@ -0,0 +1,35 @@
+package statetracker
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
+)
+
+// LavaChainFetcher fetches the lava chain blocks for the lava chain tracker, it queries the node the clientCtx is connected to
+type LavaChainFetcher struct {
+	clientCtx client.Context
+}
+
+func (lcf *LavaChainFetcher) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	resultStatus, err := lcf.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, utils.LavaFormatError("failed querying lava node status", err, nil)
+	}
+	return resultStatus.SyncInfo.LatestBlockHeight, nil
+}
+
+func (lcf *LavaChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
+	resultBlock, err := lcf.clientCtx.Client.Block(ctx, &blockNum)
+	if err != nil {
+		return "", utils.LavaFormatError("failed querying lava block", err, &map[string]string{"block": strconv.FormatInt(blockNum, 10)})
+	}
+	return resultBlock.BlockID.Hash.String(), nil
+}
+
+func NewLavaChainFetcher(ctx context.Context, clientCtx client.Context) *LavaChainFetcher {
+	lcf := &LavaChainFetcher{clientCtx: clientCtx}
+	return lcf
+}
@@ -1,8 +1,13 @@
 package statetracker
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 )
 
@@ -11,18 +16,30 @@ const (
 )
 
 type PairingUpdater struct {
-	consumerSessionManagers []*lavasession.ConsumerSessionManager
-	nextBlockForUpdate      uint64
-	stateQuery              *StateQuery
+	consumerSessionManagersMap map[string][]*lavasession.ConsumerSessionManager // key is chainID so we don't run getPairing more than once per chain
+	nextBlockForUpdate         uint64
+	stateQuery                 *StateQuery
 }
 
 func NewPairingUpdater(consumerAddress sdk.AccAddress, stateQuery *StateQuery) *PairingUpdater {
-	return &PairingUpdater{consumerSessionManagers: []*lavasession.ConsumerSessionManager{}, stateQuery: stateQuery}
+	return &PairingUpdater{consumerSessionManagersMap: map[string][]*lavasession.ConsumerSessionManager{}, stateQuery: stateQuery}
 }
 
-func (pu *PairingUpdater) RegisterPairing(consumerSessionManager *lavasession.ConsumerSessionManager) {
-	// TODO: also update here for the first time
-	pu.consumerSessionManagers = append(pu.consumerSessionManagers, consumerSessionManager)
+func (pu *PairingUpdater) RegisterPairing(ctx context.Context, consumerSessionManager *lavasession.ConsumerSessionManager) error {
+	chainID := consumerSessionManager.RPCEndpoint().ChainID
+	pairingList, epoch, nextBlockForUpdate, err := pu.stateQuery.GetPairing(ctx, chainID, -1)
+	if err != nil {
+		return err
+	}
+	err = pu.updateConsumerSessionManager(ctx, pairingList, consumerSessionManager, epoch)
+	if err != nil {
+		return err
+	}
+	if len(pu.consumerSessionManagersMap) == 0 || nextBlockForUpdate < pu.nextBlockForUpdate {
+		pu.nextBlockForUpdate = nextBlockForUpdate
+	}
+	pu.consumerSessionManagersMap[chainID] = append(pu.consumerSessionManagersMap[chainID], consumerSessionManager)
+	return nil
 }
 
 func (pu *PairingUpdater) UpdaterKey() string {
@@ -30,19 +47,88 @@ func (pu *PairingUpdater) UpdaterKey() string {
 }
 
 func (pu *PairingUpdater) Update(latestBlock int64) {
+	ctx := context.Background()
 	if int64(pu.nextBlockForUpdate) > latestBlock {
 		return
 	}
-	pairingList, epoch, nextBlockForUpdate := pu.stateQuery.GetPairing(latestBlock)
+	nextBlockForUpdateList := []uint64{}
+	for chainID, consumerSessionManagerList := range pu.consumerSessionManagersMap {
+		pairingList, epoch, nextBlockForUpdate, err := pu.stateQuery.GetPairing(ctx, chainID, latestBlock)
+		if err != nil {
+			utils.LavaFormatError("could not update pairing for chain, trying again next block", err, &map[string]string{"chain": chainID})
+			nextBlockForUpdateList = append(nextBlockForUpdateList, pu.nextBlockForUpdate+1)
+			continue
+		}
+		nextBlockForUpdateList = append(nextBlockForUpdateList, nextBlockForUpdate)
+		for _, consumerSessionManager := range consumerSessionManagerList {
+			err := pu.updateConsumerSessionManager(ctx, pairingList, consumerSessionManager, epoch)
+			if err != nil {
+				utils.LavaFormatError("failed updating consumer session manager", err, &map[string]string{"chainID": chainID, "apiInterface": consumerSessionManager.RPCEndpoint().ApiInterface, "pairingListLen": strconv.Itoa(len(pairingList))})
+				continue
+			}
+		}
+	}
+	nextBlockForUpdateMin := uint64(0)
+	for idx, blockToUpdate := range nextBlockForUpdateList {
+		if idx == 0 || blockToUpdate < nextBlockForUpdateMin {
+			nextBlockForUpdateMin = blockToUpdate
+		}
+	}
+	pu.nextBlockForUpdate = nextBlockForUpdateMin
+}
 
-	for _, consumerSessionManager := range pu.consumerSessionManagers {
-		pairingListForThisCSM := filterPairingListByEndpoint(pairingList, consumerSessionManager.RPCEndpoint())
-		consumerSessionManager.UpdateAllProviders(epoch, pairingListForThisCSM)
+func (pu *PairingUpdater) updateConsumerSessionManager(ctx context.Context, pairingList []epochstoragetypes.StakeEntry, consumerSessionManager *lavasession.ConsumerSessionManager, epoch uint64) (err error) {
+	pairingListForThisCSM, err := pu.filterPairingListByEndpoint(ctx, pairingList, consumerSessionManager.RPCEndpoint(), epoch)
+	if err != nil {
+		return err
 	}
-	pu.nextBlockForUpdate = nextBlockForUpdate
+	return consumerSessionManager.UpdateAllProviders(epoch, pairingListForThisCSM)
 }
 
-func filterPairingListByEndpoint(pairingList []epochstoragetypes.StakeEntry, rpcEndpoint lavasession.RPCEndpoint) (filteredList []*lavasession.ConsumerSessionsWithProvider) {
+func (pu *PairingUpdater) filterPairingListByEndpoint(ctx context.Context, pairingList []epochstoragetypes.StakeEntry, rpcEndpoint lavasession.RPCEndpoint, epoch uint64) (filteredList []*lavasession.ConsumerSessionsWithProvider, err error) {
 	// go over stake entries, and filter endpoints that match geolocation and api interface
-	return
+	maxCu, err := pu.stateQuery.GetMaxCUForUser(ctx, rpcEndpoint.ChainID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	for _, provider := range pairingList {
+		//
+		// Sanity
+		providerEndpoints := provider.GetEndpoints()
+		if len(providerEndpoints) == 0 {
+			utils.LavaFormatError("skipping provider with no endoints", nil, &map[string]string{"Address": provider.Address, "ChainID": provider.Chain})
+			continue
+		}
+
+		relevantEndpoints := []epochstoragetypes.Endpoint{}
+		for _, endpoint := range providerEndpoints {
+			// only take into account endpoints that use the same api interface and the same geolocation
+			if endpoint.UseType == rpcEndpoint.ApiInterface && endpoint.Geolocation == rpcEndpoint.Geolocation {
+				relevantEndpoints = append(relevantEndpoints, endpoint)
+			}
+		}
+		if len(relevantEndpoints) == 0 {
+			utils.LavaFormatError("skipping provider, No relevant endpoints for apiInterface", nil, &map[string]string{"Address": provider.Address, "ChainID": provider.Chain, "apiInterface": rpcEndpoint.ApiInterface, "Endpoints": fmt.Sprintf("%v", providerEndpoints)})
+			continue
+		}
+
+		pairingEndpoints := make([]*lavasession.Endpoint, len(relevantEndpoints))
+		for idx, relevantEndpoint := range relevantEndpoints {
+			endp := &lavasession.Endpoint{NetworkAddress: relevantEndpoint.IPPORT, TLSCertHash: relevantEndpoint.TlsCertHash, Enabled: true, Client: nil, ConnectionRefusals: 0}
+			pairingEndpoints[idx] = endp
+		}
+
+		filteredList = append(filteredList, &lavasession.ConsumerSessionsWithProvider{
+			PublicLavaAddress: provider.Address,
+			Endpoints:         pairingEndpoints,
+			Sessions:          map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits:   maxCu,
+			ReliabilitySent:   false,
+			PairingEpoch:      epoch,
+		})
+	}
+	if len(filteredList) == 0 {
+		return nil, utils.LavaFormatError("Failed getting pairing for consumer, pairing is empty", nil, &map[string]string{"apiInterface": rpcEndpoint.ApiInterface, "ChainID": rpcEndpoint.ChainID, "geolocation": strconv.FormatUint(rpcEndpoint.Geolocation, 10)})
+	}
+	return filteredList, nil
 }
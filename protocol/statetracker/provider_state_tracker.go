@@ -2,45 +2,111 @@ package statetracker
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/lavanet/lava/protocol/chainlib"
-	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
+	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
 // ProviderStateTracker PST is a class for tracking provider data from the lava blockchain, such as epoch changes.
 // it allows also to query specific data form the blockchain and acts as a single place to send transactions
 type ProviderStateTracker struct {
-	// TODO: embed stateTracker
+	providerAddress string
+	stateQuery      *StateQuery
+	txSender        *TxSender
+	*StateTracker
 }
 
 func (pst *ProviderStateTracker) New(ctx context.Context, txFactory tx.Factory, clientCtx client.Context) (ret *ProviderStateTracker, err error) {
 	// set up StateQuery
 	// Spin up chain tracker on the lava node, its address is in the --node flag (or its default), on new block call to newLavaBlock
-	// use StateQuery to get the lava spec and spin up the chain tracker with the right params
 	// set up txSender the same way
+	stateQuery := StateQuery{}
+	pst.stateQuery, err = stateQuery.New(ctx, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	txSender := TxSender{}
+	pst.txSender, err = txSender.New(ctx, txFactory, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	pst.providerAddress = clientCtx.FromAddress.String()
+	pst.StateTracker, err = NewStateTracker(ctx, clientCtx, NewLavaChainFetcher(ctx, clientCtx))
+	if err != nil {
+		return nil, err
+	}
 	return pst, nil
 }
 
-func (pst *ProviderStateTracker) RegisterForEpochUpdates(ctx context.Context, epochUpdatable EpochUpdatable) {
-	// create an epoch updater
-	// add epoch updater to the updater map
+func (pst *ProviderStateTracker) RegisterForEpochUpdates(ctx context.Context, epochUpdatable EpochUpdatable) error {
+	epochUpdater := NewEpochUpdater(pst.stateQuery)
+	epochUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, epochUpdater)
+	epochUpdater, ok := epochUpdaterRaw.(*EpochUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, &map[string]string{"updater": fmt.Sprintf("%+v", epochUpdaterRaw)})
+	}
+	pst.registrationLock.Lock()
+	defer pst.registrationLock.Unlock()
+	return epochUpdater.RegisterEpochUpdatable(ctx, epochUpdatable)
+}
+
+func (pst *ProviderStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser, chainID string) error {
+	return pst.StateTracker.registerForSpecUpdates(ctx, pst.stateQuery, chainParser, chainID)
+}
+
+func (pst *ProviderStateTracker) RegisterForSpecUpdates(ctx context.Context, specUpdatable SpecUpdatable, chainID string) error {
+	return pst.StateTracker.registerForSpecUpdates(ctx, pst.stateQuery, specUpdatable, chainID)
+}
+
+func (pst *ProviderStateTracker) RegisterReliabilityManagerForVoteUpdates(ctx context.Context, voteUpdatable VoteUpdatable, chainID string) {
+	voteUpdater := NewVoteUpdater(pst.stateQuery)
+	voteUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, voteUpdater)
+	voteUpdater, ok := voteUpdaterRaw.(*VoteUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, &map[string]string{"updater": fmt.Sprintf("%+v", voteUpdaterRaw)})
+	}
+	pst.registrationLock.Lock()
+	defer pst.registrationLock.Unlock()
+	voteUpdater.RegisterVoteUpdatable(ctx, voteUpdatable, chainID)
+}
+
+func (pst *ProviderStateTracker) RegisterForUnstakeCancelUpdates(ctx context.Context, unstakeCancelUpdatable UnstakeCancelUpdatable, chainID string) {
+	unstakeCancelUpdater := NewUnstakeCancelUpdater(pst.providerAddress, pst.stateQuery)
+	unstakeCancelUpdaterRaw := pst.StateTracker.RegisterForUpdates(ctx, unstakeCancelUpdater)
+	unstakeCancelUpdater, ok := unstakeCancelUpdaterRaw.(*UnstakeCancelUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, &map[string]string{"updater": fmt.Sprintf("%+v", unstakeCancelUpdaterRaw)})
+	}
+	pst.registrationLock.Lock()
+	defer pst.registrationLock.Unlock()
+	unstakeCancelUpdater.RegisterUnstakeCancelUpdatable(ctx, unstakeCancelUpdatable, chainID)
+}
+
+func (pst *ProviderStateTracker) VerifyPairing(ctx context.Context, consumerAddress string, providerAddress string, epoch uint64, chainID string) (valid bool, index int64, err error) {
+	return pst.stateQuery.VerifyPairing(ctx, consumerAddress, providerAddress, epoch, chainID)
+}
+
+func (pst *ProviderStateTracker) GetVrfPkAndMaxCuForUser(ctx context.Context, consumerAddress string, chainID string, epoch uint64) (vrfPk *utils.VrfPubKey, maxCu uint64, err error) {
+	return pst.stateQuery.GetVrfPkAndMaxCuForUser(ctx, consumerAddress, chainID, epoch)
 }
 
-func (pst *ProviderStateTracker) RegisterChainParserForSpecUpdates(ctx context.Context, chainParser chainlib.ChainParser) {
-	// can be moved to base class
+func (pst *ProviderStateTracker) GetProvidersCountForConsumer(ctx context.Context) (uint32, error) {
+	return pst.stateQuery.GetProvidersCountForConsumer(ctx)
 }
 
-func (pst *ProviderStateTracker) RegisterReliabilityManagerForVoteUpdates(ctx context.Context, reliabilityManager *reliabilitymanager.ReliabilityManager) {
-	// TODO: change to an interface instead of reliabilitymanager.ReliabilityManager
+func (pst *ProviderStateTracker) TxConflictVoteCommit(ctx context.Context, voteID string, commitHash []byte) error {
+	return pst.txSender.TxConflictVoteCommit(ctx, voteID, commitHash)
 }
 
-func (pst *ProviderStateTracker) QueryVerifyPairing(ctx context.Context, consumer string, blockHeight uint64) {
-	// TODO: implement
+func (pst *ProviderStateTracker) TxConflictVoteReveal(ctx context.Context, voteID string, nonce int64, relayDataHash []byte) error {
+	return pst.txSender.TxConflictVoteReveal(ctx, voteID, nonce, relayDataHash)
 }
 
-func (pst *ProviderStateTracker) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest) {
-	// TODO: implement
+func (pst *ProviderStateTracker) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest) error {
+	return pst.txSender.TxRelayPayment(ctx, relayRequests)
 }
@@ -0,0 +1,126 @@
+package statetracker
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	CallbackKeyForSpecUpdate = "spec-update"
+)
+
+var specEventsPrefix = strings.TrimSuffix(proto.MessageName(&spectypes.EventSpecAdd{}), "EventSpecAdd")
+
+type SpecUpdatable interface {
+	SetSpec(spec spectypes.Spec)
+}
+
+type specStateQuery interface {
+	GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error)
+	GetBlockEvents(ctx context.Context, block int64) (events []abci.Event, err error)
+}
+
+// SpecUpdater sets the spec of the chain on its spec updatables whenever a proposal changes it.
+// a disabled spec has no apis, and a removed spec is set as a disabled spec, so the chain parsers and endpoints stop serving the chain
+type SpecUpdater struct {
+	specUpdatables map[string][]SpecUpdatable // chainID -> updatables
+	lastBlock      int64
+	stateQuery     specStateQuery
+}
+
+func NewSpecUpdater(stateQuery specStateQuery) *SpecUpdater {
+	return &SpecUpdater{specUpdatables: map[string][]SpecUpdatable{}, stateQuery: stateQuery}
+}
+
+func (su *SpecUpdater) RegisterSpecUpdatable(ctx context.Context, specUpdatable SpecUpdatable, chainID string) error {
+	spec, err := su.stateQuery.GetSpec(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	specUpdatable.SetSpec(*spec)
+	su.specUpdatables[chainID] = append(su.specUpdatables[chainID], specUpdatable)
+	return nil
+}
+
+func (su *SpecUpdater) UpdaterKey() string {
+	return CallbackKeyForSpecUpdate
+}
+
+func (su *SpecUpdater) Update(latestBlock int64) {
+	ctx := context.Background()
+	// the latest block is committed before its results are stored, so the events are read one block behind
+	resultsBlock := latestBlock - 1
+	if su.lastBlock == 0 {
+		// the specs were read when the updatables registered
+		su.lastBlock = resultsBlock - 1
+	}
+	for block := su.lastBlock + 1; block <= resultsBlock; block++ {
+		events, err := su.stateQuery.GetBlockEvents(ctx, block)
+		if err != nil {
+			utils.LavaFormatError("could not get spec events, trying again next block", err, nil)
+			return
+		}
+		for _, specChange := range parseSpecEvents(events) {
+			specUpdatables, ok := su.specUpdatables[specChange.chainID]
+			if !ok {
+				continue
+			}
+			spec := &spectypes.Spec{Index: specChange.chainID, Enabled: false}
+			if !specChange.removed {
+				spec, err = su.stateQuery.GetSpec(ctx, specChange.chainID)
+				if err != nil {
+					utils.LavaFormatError("could not get updated spec, trying again next block", err, &map[string]string{"chainID": specChange.chainID})
+					return
+				}
+			}
+			if !spec.Enabled {
+				utils.LavaFormatWarning("spec was disabled or removed, the chain is no longer served", nil, &map[string]string{"chainID": specChange.chainID, "removed": strconv.FormatBool(specChange.removed)})
+			} else {
+				utils.LavaFormatInfo("spec was updated", &map[string]string{"chainID": specChange.chainID})
+			}
+			for _, specUpdatable := range specUpdatables {
+				specUpdatable.SetSpec(*spec)
+			}
+		}
+		su.lastBlock = block
+	}
+}
+
+type specChange struct {
+	chainID string
+	removed bool
+}
+
+// parseSpecEvents returns the chains whose spec was added, modified, disabled or removed in events, in the order of the events
+func parseSpecEvents(events []abci.Event) (changes []specChange) {
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, specEventsPrefix) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			utils.LavaFormatError("failed parsing spec event", err, &map[string]string{"event": event.Type})
+			continue
+		}
+		switch specEvent := typedEvent.(type) {
+		case *spectypes.EventSpecAdd:
+			changes = append(changes, specChange{chainID: specEvent.ChainID})
+		case *spectypes.EventSpecModify:
+			changes = append(changes, specChange{chainID: specEvent.ChainID})
+		case *spectypes.EventSpecApisModify:
+			changes = append(changes, specChange{chainID: specEvent.ChainID})
+		case *spectypes.EventSpecDisable:
+			changes = append(changes, specChange{chainID: specEvent.ChainID})
+		case *spectypes.EventSpecRemove:
+			changes = append(changes, specChange{chainID: specEvent.ChainID, removed: true})
+		}
+	}
+	return changes
+}
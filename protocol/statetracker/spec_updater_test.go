@@ -0,0 +1,88 @@
+package statetracker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type mockSpecStateQuery struct {
+	specs  map[string]spectypes.Spec
+	events map[int64][]abci.Event
+}
+
+func (msq *mockSpecStateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error) {
+	spec, ok := msq.specs[chainID]
+	if !ok {
+		return nil, fmt.Errorf("spec %s not found", chainID)
+	}
+	return &spec, nil
+}
+
+func (msq *mockSpecStateQuery) GetBlockEvents(ctx context.Context, block int64) (events []abci.Event, err error) {
+	return msq.events[block], nil
+}
+
+func (msq *mockSpecStateQuery) emit(t *testing.T, block int64, typedEvent proto.Message) {
+	event, err := sdk.TypedEventToEvent(typedEvent)
+	require.NoError(t, err)
+	msq.events[block] = append(msq.events[block], abci.Event(event))
+}
+
+type mockSpecUpdatable struct {
+	spec spectypes.Spec
+}
+
+func (msu *mockSpecUpdatable) SetSpec(spec spectypes.Spec) {
+	msu.spec = spec
+}
+
+func TestSpecUpdater(t *testing.T) {
+	ctx := context.Background()
+	stateQuery := &mockSpecStateQuery{
+		specs: map[string]spectypes.Spec{
+			"ETH1": {Index: "ETH1", Enabled: true, Apis: []spectypes.ServiceApi{{Name: "eth_blockNumber", Enabled: true}}},
+			"COS3": {Index: "COS3", Enabled: true},
+		},
+		events: map[int64][]abci.Event{},
+	}
+	specUpdater := NewSpecUpdater(stateQuery)
+	eth, cos := &mockSpecUpdatable{}, &mockSpecUpdatable{}
+	require.NoError(t, specUpdater.RegisterSpecUpdatable(ctx, eth, "ETH1"))
+	require.NoError(t, specUpdater.RegisterSpecUpdatable(ctx, cos, "COS3"))
+	require.Error(t, specUpdater.RegisterSpecUpdatable(ctx, &mockSpecUpdatable{}, "LAV1"))
+	require.True(t, eth.spec.Enabled)
+	require.Len(t, eth.spec.Apis, 1)
+	specUpdater.Update(10)
+
+	// an api modify proposal sets the modified spec
+	stateQuery.specs["ETH1"] = spectypes.Spec{Index: "ETH1", Enabled: true, Apis: []spectypes.ServiceApi{{Name: "eth_blockNumber", Enabled: false}}}
+	stateQuery.emit(t, 10, &spectypes.EventSpecApisModify{ChainID: "ETH1", Apis: []string{"eth_blockNumber"}})
+	specUpdater.Update(11)
+	require.False(t, eth.spec.Apis[0].Enabled)
+	require.True(t, cos.spec.Enabled)
+
+	// the lava chain tracker can skip blocks, the events of every block in between are read
+	stateQuery.specs["ETH1"] = spectypes.Spec{Index: "ETH1", Enabled: false}
+	stateQuery.emit(t, 11, &spectypes.EventSpecDisable{ChainID: "ETH1"})
+	delete(stateQuery.specs, "COS3")
+	stateQuery.emit(t, 12, &spectypes.EventSpecRemove{ChainID: "COS3"})
+	specUpdater.Update(13)
+	require.False(t, eth.spec.Enabled)
+	require.False(t, cos.spec.Enabled)
+	require.Equal(t, "COS3", cos.spec.Index)
+	require.Empty(t, cos.spec.Apis)
+
+	// a spec added again is served again
+	stateQuery.specs["COS3"] = spectypes.Spec{Index: "COS3", Enabled: true}
+	stateQuery.emit(t, 13, &spectypes.EventSpecAdd{ChainID: "COS3"})
+	specUpdater.Update(14)
+	require.True(t, cos.spec.Enabled)
+	require.False(t, eth.spec.Enabled)
+}
@@ -2,20 +2,132 @@ package statetracker
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
-type StateQuery struct{}
+type StateQuery struct {
+	clientAddress           string
+	tendermintClient        rpcclient.Client
+	pairingQueryClient      pairingtypes.QueryClient
+	specQueryClient         spectypes.QueryClient
+	epochStorageQueryClient epochstoragetypes.QueryClient
+}
 
 func (sq *StateQuery) New(ctx context.Context, clientCtx client.Context) (ret *StateQuery, err error) {
 	// set up the rpcClient necessary to make queries
+	sq.clientAddress = clientCtx.FromAddress.String()
+	sq.tendermintClient = clientCtx.Client
+	sq.pairingQueryClient = pairingtypes.NewQueryClient(clientCtx)
+	sq.specQueryClient = spectypes.NewQueryClient(clientCtx)
+	sq.epochStorageQueryClient = epochstoragetypes.NewQueryClient(clientCtx)
 	return sq, nil
 }
 
-func (sq *StateQuery) GetPairing(latestBlock int64) (pairingList []epochstoragetypes.StakeEntry, epoch uint64, nextBlockForUpdate uint64) {
-	// query the node via our clientCtx and run the get pairing query with the client address (in the clientCtx from)
+func (sq *StateQuery) GetSpec(ctx context.Context, chainID string) (*spectypes.Spec, error) {
+	spec, err := sq.specQueryClient.Spec(ctx, &spectypes.QueryGetSpecRequest{
+		ChainID: chainID,
+	})
+	if err != nil {
+		return nil, utils.LavaFormatError("Failed Querying spec for chain", err, &map[string]string{"ChainID": chainID})
+	}
+	return &spec.Spec, nil
+}
+
+// GetPairing returns the providers paired with the client on the chain in the current epoch
+func (sq *StateQuery) GetPairing(ctx context.Context, chainID string, latestBlock int64) (pairingList []epochstoragetypes.StakeEntry, epoch uint64, nextBlockForUpdate uint64, errRet error) {
 	// latestBlock arg can be used for caching the result
-	return
+	pairingResp, err := sq.pairingQueryClient.GetPairing(ctx, &pairingtypes.QueryGetPairingRequest{
+		ChainID: chainID,
+		Client:  sq.clientAddress,
+	})
+	if err != nil {
+		return nil, 0, 0, utils.LavaFormatError("Failed in get pairing query", err, &map[string]string{"chainID": chainID, "client": sq.clientAddress, "latestBlock": strconv.FormatInt(latestBlock, 10)})
+	}
+	return pairingResp.Providers, pairingResp.CurrentEpoch, pairingResp.BlockOfNextPairing, nil
+}
+
+func (sq *StateQuery) GetMaxCUForUser(ctx context.Context, chainID string, epoch uint64) (maxCu uint64, err error) {
+	userEntryRes, err := sq.pairingQueryClient.UserEntry(ctx, &pairingtypes.QueryUserEntryRequest{ChainID: chainID, Address: sq.clientAddress, Block: epoch})
+	if err != nil {
+		return 0, utils.LavaFormatError("failed querying StakeEntry for consumer", err, &map[string]string{"chainID": chainID, "address": sq.clientAddress, "block": strconv.FormatUint(epoch, 10)})
+	}
+	return userEntryRes.GetMaxCU(), nil
+}
+
+// VerifyPairing checks the provider is paired with the consumer in the epoch, index is the provider index in the consumer pairing
+func (sq *StateQuery) VerifyPairing(ctx context.Context, consumerAddress string, providerAddress string, epoch uint64, chainID string) (valid bool, index int64, err error) {
+	verifyResponse, err := sq.pairingQueryClient.VerifyPairing(ctx, &pairingtypes.QueryVerifyPairingRequest{
+		ChainID:  chainID,
+		Client:   consumerAddress,
+		Provider: providerAddress,
+		Block:    epoch,
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	if !verifyResponse.Valid {
+		return false, 0, utils.LavaFormatError("invalid self pairing with consumer", nil, &map[string]string{"provider": providerAddress, "consumer address": consumerAddress, "epoch": strconv.FormatUint(epoch, 10)})
+	}
+	return verifyResponse.Valid, verifyResponse.GetIndex(), nil
+}
+
+func (sq *StateQuery) GetVrfPkAndMaxCuForUser(ctx context.Context, consumerAddress string, chainID string, epoch uint64) (vrfPk *utils.VrfPubKey, maxCu uint64, err error) {
+	userEntryRes, err := sq.pairingQueryClient.UserEntry(ctx, &pairingtypes.QueryUserEntryRequest{ChainID: chainID, Address: consumerAddress, Block: epoch})
+	if err != nil {
+		return nil, 0, utils.LavaFormatError("StakeEntry querying for consumer failed", err, &map[string]string{"chainID": chainID, "address": consumerAddress, "block": strconv.FormatUint(epoch, 10)})
+	}
+	vrfPk = &utils.VrfPubKey{}
+	vrfPk, err = vrfPk.DecodeFromBech32(userEntryRes.GetConsumer().Vrfpk)
+	if err != nil {
+		err = utils.LavaFormatError("decoding vrfpk from bech32", err, &map[string]string{"chainID": chainID, "address": consumerAddress, "block": strconv.FormatUint(epoch, 10), "UserEntryRes": fmt.Sprintf("%v", userEntryRes)})
+	}
+	return vrfPk, userEntryRes.GetMaxCU(), err
+}
+
+// GetProvidersCountForConsumer returns the number of providers paired with every consumer
+func (sq *StateQuery) GetProvidersCountForConsumer(ctx context.Context) (uint32, error) {
+	res, err := sq.pairingQueryClient.Params(ctx, &pairingtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return uint32(res.GetParams().ServicersToPairCount), nil
+}
+
+// GetEpoch returns the start block of the current epoch and the start block of the next one
+func (sq *StateQuery) GetEpoch(ctx context.Context) (epoch uint64, nextEpochStart uint64, err error) {
+	epochDetails, err := sq.epochStorageQueryClient.EpochDetails(ctx, &epochstoragetypes.QueryGetEpochDetailsRequest{})
+	if err != nil {
+		return 0, 0, utils.LavaFormatError("failed querying epoch details", err, nil)
+	}
+	params, err := sq.epochStorageQueryClient.Params(ctx, &epochstoragetypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, 0, utils.LavaFormatError("failed querying epochstorage params", err, nil)
+	}
+	epoch = epochDetails.GetEpochDetails().StartBlock
+	return epoch, epoch + params.GetParams().EpochBlocks, nil
+}
+
+// GetBlockEvents returns the events emitted in the block, events of failed transactions are left out
+func (sq *StateQuery) GetBlockEvents(ctx context.Context, block int64) (events []abci.Event, err error) {
+	blockResults, err := sq.tendermintClient.BlockResults(ctx, &block)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed querying lava block results", err, &map[string]string{"block": strconv.FormatInt(block, 10)})
+	}
+	events = append(events, blockResults.BeginBlockEvents...)
+	for _, txResult := range blockResults.TxsResults {
+		if txResult.IsOK() {
+			events = append(events, txResult.Events...)
+		}
+	}
+	events = append(events, blockResults.EndBlockEvents...)
+	return events, nil
 }
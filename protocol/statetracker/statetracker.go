@@ -1,5 +1,111 @@
 package statetracker
 
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	BlocksToSaveLavaChainTracker = 1 // we only need the latest block
+	DefaultLavaAverageBlockTime  = 10 * time.Second
+	blocksForAverageBlockTime    = 10
+)
+
 type EpochUpdatable interface {
 	UpdateEpoch(epoch uint64)
 }
+
+type Updater interface {
+	Update(int64)
+	UpdaterKey() string
+}
+
+// StateTracker is the base of the consumer and provider state trackers, it tracks the lava chain and calls the registered updaters on every new lava block
+type StateTracker struct {
+	chainTracker         *chaintracker.ChainTracker
+	registrationLock     sync.RWMutex
+	newLavaBlockUpdaters map[string]Updater
+}
+
+func NewStateTracker(ctx context.Context, clientCtx client.Context, chainFetcher chaintracker.ChainFetcher) (ret *StateTracker, err error) {
+	cst := &StateTracker{newLavaBlockUpdaters: map[string]Updater{}}
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{
+		NewLatestCallback: cst.newLavaBlock,
+		BlocksToSave:      BlocksToSaveLavaChainTracker,
+		AverageBlockTime:  averageLavaBlockTime(ctx, clientCtx),
+		ServerBlockMemory: BlocksToSaveLavaChainTracker,
+	}
+	cst.chainTracker = chaintracker.New(ctx, chainFetcher, chainTrackerConfig)
+	return cst, nil
+}
+
+func (st *StateTracker) newLavaBlock(latestBlock int64) {
+	// go over the registered updaters and trigger update
+	st.registrationLock.RLock()
+	defer st.registrationLock.RUnlock()
+	for _, updater := range st.newLavaBlockUpdaters {
+		updater.Update(latestBlock)
+	}
+}
+
+// RegisterForUpdates adds the updater to be called on new lava blocks, if an updater with the same key exists it is returned instead
+func (st *StateTracker) RegisterForUpdates(ctx context.Context, updater Updater) Updater {
+	st.registrationLock.Lock()
+	defer st.registrationLock.Unlock()
+	existingUpdater, ok := st.newLavaBlockUpdaters[updater.UpdaterKey()]
+	if ok {
+		return existingUpdater
+	}
+	st.newLavaBlockUpdaters[updater.UpdaterKey()] = updater
+	return updater
+}
+
+// registerForSpecUpdates sets the spec of the chain into the specUpdatable, and again whenever a proposal changes it
+func (st *StateTracker) registerForSpecUpdates(ctx context.Context, stateQuery *StateQuery, specUpdatable SpecUpdatable, chainID string) error {
+	specUpdater := NewSpecUpdater(stateQuery)
+	specUpdaterRaw := st.RegisterForUpdates(ctx, specUpdater)
+	specUpdater, ok := specUpdaterRaw.(*SpecUpdater)
+	if !ok {
+		utils.LavaFormatFatal("invalid updater type returned from RegisterForUpdates", nil, &map[string]string{"updater": fmt.Sprintf("%+v", specUpdaterRaw)})
+	}
+	st.registrationLock.Lock()
+	defer st.registrationLock.Unlock()
+	return specUpdater.RegisterSpecUpdatable(ctx, specUpdatable, chainID)
+}
+
+// averageLavaBlockTime estimates the lava block time from the latest block headers, it is used to poll for new lava blocks
+func averageLavaBlockTime(ctx context.Context, clientCtx client.Context) time.Duration {
+	if clientCtx.Client == nil {
+		return DefaultLavaAverageBlockTime
+	}
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		utils.LavaFormatWarning("failed querying lava status for average block time, using default", err, nil)
+		return DefaultLavaAverageBlockTime
+	}
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	earlierHeight := latestHeight - blocksForAverageBlockTime
+	if earlierHeight < 1 {
+		earlierHeight = 1
+	}
+	if earlierHeight >= latestHeight {
+		return DefaultLavaAverageBlockTime
+	}
+	earlierBlock, err := clientCtx.Client.Block(ctx, &earlierHeight)
+	if err != nil {
+		utils.LavaFormatWarning("failed querying lava block for average block time, using default", err, &map[string]string{"block": strconv.FormatInt(earlierHeight, 10)})
+		return DefaultLavaAverageBlockTime
+	}
+	averageBlockTime := status.SyncInfo.LatestBlockTime.Sub(earlierBlock.Block.Time) / time.Duration(latestHeight-earlierHeight)
+	if averageBlockTime <= 0 {
+		return DefaultLavaAverageBlockTime
+	}
+	return averageBlockTime
+}
@@ -1,21 +1,137 @@
 package statetracker
 
 import (
+	"bytes"
 	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
-type TxSender struct{}
+const (
+	RetryIncorrectSequence = 5
+)
+
+type TxSender struct {
+	txFactory tx.Factory
+	clientCtx client.Context
+	lock      sync.Mutex // txs are sent one at a time so the account sequence stays in order
+}
 
 func (ts *TxSender) New(ctx context.Context, txFactory tx.Factory, clientCtx client.Context) (ret *TxSender, err error) {
 	// set up the rpcClient, and factory necessary to make queries
+	clientCtx.SkipConfirm = true
+	clientCtx.OutputFormat = "json"
+	ts.clientCtx = clientCtx
+	ts.txFactory = txFactory
 	return ts, nil
 }
 
-func (ts *TxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) {
-	// TODO: send a detection tx, simulate, with retry logic for sequence number mismatch
+// SimulateAndBroadCastTx sends the msg, if the account sequence is out of sync with the node it retries with the sequence the node expects
+func (ts *TxSender) SimulateAndBroadCastTx(ctx context.Context, msg sdk.Msg) error {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+	txf := ts.txFactory.WithSequence(0) // queried from the node on every tx
+	var transactionResult string
+	for idx := 0; idx < RetryIncorrectSequence; idx++ {
+		output := bytes.Buffer{}
+		clientCtx := ts.clientCtx.WithOutput(&output)
+		err := sentry.SimulateAndBroadCastTx(clientCtx, txf, msg)
+		if err != nil {
+			transactionResult = err.Error() // incase we got an error the tx result is basically the error
+		} else {
+			var txResponse sdk.TxResponse
+			// the response is printed as proto json, int64 fields are strings
+			err = clientCtx.Codec.UnmarshalJSON(output.Bytes(), &txResponse)
+			if err != nil {
+				return utils.LavaFormatError("failed unmarshaling tx response", err, &map[string]string{"output": output.String()})
+			}
+			if txResponse.Code == 0 {
+				utils.LavaFormatInfo("transaction sent successfully", &map[string]string{"msg": sdk.MsgTypeURL(msg), "txhash": txResponse.TxHash})
+				return nil
+			}
+			transactionResult = txResponse.RawLog
+		}
+		if !strings.Contains(transactionResult, "account sequence") {
+			break
+		}
+		sequence, err := findSequenceNumber(transactionResult)
+		if err != nil {
+			break
+		}
+		utils.LavaFormatInfo("Sequence Number extracted from transaction error, retrying", &map[string]string{"sequence": strconv.FormatUint(sequence, 10)})
+		txf = txf.WithSequence(sequence)
+	}
+	return utils.LavaFormatError("failed sending transaction", nil, &map[string]string{"msg": sdk.MsgTypeURL(msg), "result": transactionResult})
+}
+
+// TxRelayPayment claims the relays, relays the consumer signed a compact proof for are claimed without their payload
+func (ts *TxSender) TxRelayPayment(ctx context.Context, relayRequests []*pairingtypes.RelayRequest) error {
+	fullRelays := []*pairingtypes.RelayRequest{}
+	proofs := []*pairingtypes.RelayProof{}
+	for _, relay := range relayRequests {
+		if proof := compactRelayProof(relay); proof != nil {
+			proofs = append(proofs, proof)
+		} else {
+			fullRelays = append(fullRelays, relay)
+		}
+	}
+	msg := pairingtypes.NewMsgRelayPayment(ts.clientCtx.FromAddress.String(), fullRelays, "")
+	msg.Proofs = proofs
+	utils.LavaFormatInfo("asking for rewards", &map[string]string{"account": ts.clientCtx.FromAddress.String(), "relays": strconv.Itoa(len(fullRelays)), "proofs": strconv.Itoa(len(proofs))})
+	return ts.SimulateAndBroadCastTx(ctx, msg)
+}
+
+func (ts *TxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict) error {
 	// TODO: make sure we are not spamming the same conflicts, previous code only detecs relay by relay, it has no state trackign wether it reported already
+	msg := conflicttypes.NewMsgDetection(ts.clientCtx.FromAddress.String(), finalizationConflict, responseConflict, sameProviderConflict)
+	return ts.SimulateAndBroadCastTx(ctx, msg)
+}
+
+func (ts *TxSender) TxConflictVoteCommit(ctx context.Context, voteID string, commitHash []byte) error {
+	msg := conflicttypes.NewMsgConflictVoteCommit(ts.clientCtx.FromAddress.String(), voteID, commitHash)
+	return ts.SimulateAndBroadCastTx(ctx, msg)
+}
+
+func (ts *TxSender) TxConflictVoteReveal(ctx context.Context, voteID string, nonce int64, relayDataHash []byte) error {
+	msg := conflicttypes.NewMsgConflictVoteReveal(ts.clientCtx.FromAddress.String(), voteID, nonce, relayDataHash)
+	return ts.SimulateAndBroadCastTx(ctx, msg)
+}
+
+// extract requested sequence number from tx error.
+func findSequenceNumber(sequence string) (uint64, error) {
+	re := regexp.MustCompile(`expected (\d+), got (\d+)`)
+	match := re.FindStringSubmatch(sequence)
+	if match == nil || len(match) < 2 {
+		return 0, utils.LavaFormatWarning("Failed to parse sequence number from error", nil, &map[string]string{"sequence": sequence})
+	}
+	return strconv.ParseUint(match[1], 10, 64)
+}
+
+// compactRelayProof returns the compact payment proof of a relay, or nil if the relay has to be claimed in full
+func compactRelayProof(relay *pairingtypes.RelayRequest) *pairingtypes.RelayProof {
+	if relay.DataReliability != nil || len(relay.ProofSig) == 0 {
+		return nil
+	}
+	proof := relay.RelayProof()
+	proofPubKey, err := sigs.RecoverPubKeyFromRelayProof(*proof)
+	if err != nil {
+		return nil
+	}
+	relayPubKey, err := sigs.RecoverPubKeyFromRelay(*relay)
+	if err != nil || !proofPubKey.Equals(relayPubKey) {
+		// a proof that wasn't signed by the consumer would fail the payment
+		return nil
+	}
+	return proof
 }
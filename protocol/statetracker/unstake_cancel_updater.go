@@ -0,0 +1,83 @@
+package statetracker
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	CallbackKeyForUnstakeCancelUpdate = "unstake-cancel-update"
+)
+
+var unstakeCancelProviderEvent = proto.MessageName(&pairingtypes.EventUnstakeCancelProvider{})
+
+type UnstakeCancelUpdatable interface {
+	UnstakeCanceled(entry epochstoragetypes.StakeEntry)
+}
+
+// UnstakeCancelUpdater passes the canceled unstakes of the provider to the updatables of the chain, the entry is paired again from the next epoch
+type UnstakeCancelUpdater struct {
+	unstakeCancelUpdatables map[string][]UnstakeCancelUpdatable // chainID -> updatables
+	providerAddress         string
+	lastBlock               int64
+	stateQuery              *StateQuery
+}
+
+func NewUnstakeCancelUpdater(providerAddress string, stateQuery *StateQuery) *UnstakeCancelUpdater {
+	return &UnstakeCancelUpdater{unstakeCancelUpdatables: map[string][]UnstakeCancelUpdatable{}, providerAddress: providerAddress, stateQuery: stateQuery}
+}
+
+func (ucu *UnstakeCancelUpdater) RegisterUnstakeCancelUpdatable(ctx context.Context, unstakeCancelUpdatable UnstakeCancelUpdatable, chainID string) {
+	ucu.unstakeCancelUpdatables[chainID] = append(ucu.unstakeCancelUpdatables[chainID], unstakeCancelUpdatable)
+}
+
+func (ucu *UnstakeCancelUpdater) UpdaterKey() string {
+	return CallbackKeyForUnstakeCancelUpdate
+}
+
+func (ucu *UnstakeCancelUpdater) Update(latestBlock int64) {
+	// the latest block is committed before its results are stored, so the events are read one block behind
+	resultsBlock := latestBlock - 1
+	if ucu.lastBlock == 0 {
+		ucu.lastBlock = resultsBlock - 1
+	}
+	for block := ucu.lastBlock + 1; block <= resultsBlock; block++ {
+		events, err := ucu.stateQuery.GetBlockEvents(context.Background(), block)
+		if err != nil {
+			utils.LavaFormatError("could not get unstake cancel events, trying again next block", err, nil)
+			return
+		}
+		for _, entry := range parseUnstakeCancelEvents(events, ucu.providerAddress) {
+			for _, unstakeCancelUpdatable := range ucu.unstakeCancelUpdatables[entry.Chain] {
+				unstakeCancelUpdatable.UnstakeCanceled(entry)
+			}
+		}
+		ucu.lastBlock = block
+	}
+}
+
+// parseUnstakeCancelEvents returns the stake entries of the provider whose unstake was canceled in events
+func parseUnstakeCancelEvents(events []abci.Event, providerAddress string) (entries []epochstoragetypes.StakeEntry) {
+	for _, event := range events {
+		if event.Type != unstakeCancelProviderEvent {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			utils.LavaFormatError("failed parsing unstake cancel event", err, &map[string]string{"event": event.Type})
+			continue
+		}
+		unstakeCancel, ok := typedEvent.(*pairingtypes.EventUnstakeCancelProvider)
+		if !ok || unstakeCancel.Entry.Address != providerAddress {
+			continue
+		}
+		entries = append(entries, unstakeCancel.Entry)
+	}
+	return entries
+}
@@ -0,0 +1,30 @@
+package statetracker
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestParseUnstakeCancelEvents(t *testing.T) {
+	events := []abci.Event{}
+	for _, typedEvent := range []proto.Message{
+		&pairingtypes.EventUnstakeCancelProvider{Entry: epochstoragetypes.StakeEntry{Address: "provider", Chain: "ETH1"}},
+		&pairingtypes.EventUnstakeCancelProvider{Entry: epochstoragetypes.StakeEntry{Address: "other", Chain: "ETH1"}},
+		&pairingtypes.EventUnstakeCancelConsumer{Entry: epochstoragetypes.StakeEntry{Address: "provider", Chain: "COS3"}},
+		&pairingtypes.EventUnstakeProvider{Entry: epochstoragetypes.StakeEntry{Address: "provider", Chain: "COS3"}},
+	} {
+		event, err := sdk.TypedEventToEvent(typedEvent)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+
+	entries := parseUnstakeCancelEvents(events, "provider")
+	require.Len(t, entries, 1)
+	require.Equal(t, "ETH1", entries[0].Chain)
+}
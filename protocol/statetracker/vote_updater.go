@@ -0,0 +1,106 @@
+package statetracker
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	CallbackKeyForVoteUpdate = "vote-update"
+)
+
+var conflictEventsPrefix = strings.TrimSuffix(proto.MessageName(&conflicttypes.EventConflictVoteDetection{}), "EventConflictVoteDetection")
+
+type VoteUpdatable interface {
+	VoteHandler(voteParams *reliabilitymanager.VoteParams, nodeHeight uint64)
+}
+
+// VoteUpdater passes the conflict vote events of every new lava block to the vote updatables, new votes only go to the updatables of the vote chain
+type VoteUpdater struct {
+	voteUpdatables map[string][]VoteUpdatable // chainID -> updatables
+	lastBlock      int64
+	stateQuery     *StateQuery
+}
+
+func NewVoteUpdater(stateQuery *StateQuery) *VoteUpdater {
+	return &VoteUpdater{voteUpdatables: map[string][]VoteUpdatable{}, stateQuery: stateQuery}
+}
+
+func (vu *VoteUpdater) RegisterVoteUpdatable(ctx context.Context, voteUpdatable VoteUpdatable, chainID string) {
+	vu.voteUpdatables[chainID] = append(vu.voteUpdatables[chainID], voteUpdatable)
+}
+
+func (vu *VoteUpdater) UpdaterKey() string {
+	return CallbackKeyForVoteUpdate
+}
+
+func (vu *VoteUpdater) Update(latestBlock int64) {
+	// the latest block is committed before its results are stored, so the events are read one block behind
+	resultsBlock := latestBlock - 1
+	if vu.lastBlock == 0 {
+		// votes that started before we were up can't be committed to anymore
+		vu.lastBlock = resultsBlock - 1
+	}
+	// the lava chain tracker can skip blocks, every block in between is read so no vote event is missed
+	for block := vu.lastBlock + 1; block <= resultsBlock; block++ {
+		events, err := vu.stateQuery.GetBlockEvents(context.Background(), block)
+		if err != nil {
+			utils.LavaFormatError("could not get vote events, trying again next block", err, nil)
+			return
+		}
+		for _, voteParams := range parseVoteEvents(events) {
+			for chainID, voteUpdatables := range vu.voteUpdatables {
+				if voteParams.ParamsType == reliabilitymanager.VoteStartParams && voteParams.ChainID != chainID {
+					continue
+				}
+				for _, voteUpdatable := range voteUpdatables {
+					// voting sends transactions, it can't hold the lava block updates
+					go voteUpdatable.VoteHandler(voteParams, uint64(latestBlock))
+				}
+			}
+		}
+		vu.lastBlock = block
+	}
+}
+
+// parseVoteEvents returns the vote params of the conflict vote events in events
+func parseVoteEvents(events []abci.Event) (votes []*reliabilitymanager.VoteParams) {
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, conflictEventsPrefix) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			utils.LavaFormatError("failed parsing conflict event", err, &map[string]string{"event": event.Type})
+			continue
+		}
+		switch conflictEvent := typedEvent.(type) {
+		case *conflicttypes.EventConflictVoteDetection:
+			votes = append(votes, &reliabilitymanager.VoteParams{
+				ParamsType:     reliabilitymanager.VoteStartParams,
+				VoteID:         conflictEvent.VoteID,
+				VoteDeadline:   conflictEvent.VoteDeadline,
+				ChainID:        conflictEvent.ChainID,
+				ApiURL:         conflictEvent.ApiURL,
+				RequestData:    conflictEvent.RequestData,
+				RequestBlock:   conflictEvent.RequestBlock,
+				Voters:         conflictEvent.Voters,
+				ConnectionType: conflictEvent.ConnectionType,
+			})
+		case *conflicttypes.EventConflictVoteRevealStarted:
+			votes = append(votes, &reliabilitymanager.VoteParams{ParamsType: reliabilitymanager.VoteRevealParams, VoteID: conflictEvent.VoteID, VoteDeadline: conflictEvent.VoteDeadline})
+		case *conflicttypes.EventConflictVoteResolved:
+			votes = append(votes, &reliabilitymanager.VoteParams{ParamsType: reliabilitymanager.VoteCloseParams, VoteID: conflictEvent.VoteID})
+		case *conflicttypes.EventConflictVoteUnresolved:
+			votes = append(votes, &reliabilitymanager.VoteParams{ParamsType: reliabilitymanager.VoteCloseParams, VoteID: conflictEvent.VoteID})
+		}
+	}
+	return votes
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/lavanet/lava/relayer/metrics"
 
-	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/websocket/v2"
@@ -43,7 +42,7 @@ type ChainProxy interface {
 	Start(context.Context) error
 	GetSentry() *sentry.Sentry
 	ParseMsg(string, []byte, string) (NodeMessage, error)
-	PortalStart(context.Context, *btcec.PrivateKey, string)
+	PortalStart(context.Context, sigs.Signer, string)
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
 	FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error)
 	GetConsumerSessionManager() *lavasession.ConsumerSessionManager
@@ -105,7 +104,7 @@ func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes
 func SendRelay(
 	ctx context.Context,
 	cp ChainProxy,
-	privKey *btcec.PrivateKey,
+	signer sigs.Signer,
 	url string,
 	req string,
 	connectionType string,
@@ -149,7 +148,14 @@ func SendRelay(
 			UnresponsiveProviders: reportedProviders,
 		}
 
-		sig, err := sigs.SignRelay(privKey, *relayRequest)
+		// the compact proof lets the provider claim the payment without the relay payload
+		proofSig, err := sigs.SignRelayProof(signer, *relayRequest.RelayProof())
+		if err != nil {
+			return nil, nil, nil, 0, false, err
+		}
+		relayRequest.ProofSig = proofSig
+
+		sig, err := sigs.SignRelay(signer, *relayRequest)
 		if err != nil {
 			return nil, nil, nil, 0, false, err
 		}
@@ -234,13 +240,13 @@ func SendRelay(
 			UnresponsiveProviders: reportedProviders,
 		}
 
-		sig, err := sigs.SignRelay(privKey, *relayRequest)
+		sig, err := sigs.SignRelay(signer, *relayRequest)
 		if err != nil {
 			return nil, nil, 0, err
 		}
 		relayRequest.Sig = sig
 
-		sig, err = sigs.SignVRFData(privKey, relayRequest.DataReliability)
+		sig, err = sigs.SignVRFData(signer, relayRequest.DataReliability)
 		if err != nil {
 			return nil, nil, 0, err
 		}
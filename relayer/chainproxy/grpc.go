@@ -12,7 +12,6 @@ import (
 
 	"github.com/lavanet/lava/relayer/metrics"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/fullstorydev/grpcurl"
 	"github.com/golang/protobuf/proto"
 	"github.com/jhump/protoreflect/desc"
@@ -24,6 +23,7 @@ import (
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -270,7 +270,7 @@ func (cp *GrpcChainProxy) GetCache() *performance.Cache {
 	return cp.cache
 }
 
-func (cp *GrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *GrpcChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	utils.LavaFormatInfo("gRPC PortalStart", nil)
 
 	lis, err := net.Listen("tcp", listenAddr)
@@ -283,7 +283,7 @@ func (cp *GrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		utils.LavaFormatInfo("GRPC Got Relay: "+method, nil)
 		var relayReply *pairingtypes.RelayReply
 		metricsData := metrics.NewRelayAnalytics("NoDappID", cp.chainID, apiInterface)
-		if relayReply, _, err = SendRelay(ctx, cp, privKey, method, string(reqBody), "", "NoDappID", metricsData); err != nil {
+		if relayReply, _, err = SendRelay(ctx, cp, signer, method, string(reqBody), "", "NoDappID", metricsData); err != nil {
 			go cp.portalLogs.AddMetric(metricsData, err != nil)
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("http in/out", true, method, string(reqBody), "", errMasking, msgSeed, err)
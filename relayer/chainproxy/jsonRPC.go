@@ -11,7 +11,6 @@ import (
 
 	"github.com/lavanet/lava/relayer/metrics"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/gofiber/websocket/v2"
@@ -20,6 +19,7 @@ import (
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -273,7 +273,7 @@ func (cp *JrpcChainProxy) NewMessage(serviceApi *spectypes.ServiceApi, requested
 	return nodeMsg, nil
 }
 
-func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *JrpcChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	//
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
@@ -312,7 +312,7 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 			defer cancel() // incase there's a problem make sure to cancel the connection
 			dappID := ExtractDappIDFromWebsocketConnection(c)
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
-			reply, replyServer, err := SendRelay(ctx, cp, privKey, "", string(msg), http.MethodGet, dappID, metricsData)
+			reply, replyServer, err := SendRelay(ctx, cp, signer, "", string(msg), http.MethodGet, dappID, metricsData)
 			go cp.portalLogs.AddMetric(metricsData, err != nil)
 			if err != nil {
 				cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
@@ -368,7 +368,7 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		utils.LavaFormatInfo("in <<<", &map[string]string{"seed": msgSeed, "msg": string(c.Body()), "dappID": dappID})
 
-		reply, _, err := SendRelay(ctx, cp, privKey, "", string(c.Body()), http.MethodGet, dappID, metricsData)
+		reply, _, err := SendRelay(ctx, cp, signer, "", string(c.Body()), http.MethodGet, dappID, metricsData)
 		go cp.portalLogs.AddMetric(metricsData, err != nil)
 		if err != nil {
 			// Get unique GUID response
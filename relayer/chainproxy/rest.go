@@ -12,7 +12,6 @@ import (
 
 	"github.com/lavanet/lava/relayer/metrics"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
@@ -20,6 +19,7 @@ import (
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -228,7 +228,7 @@ func (cp *RestChainProxy) ParseMsg(path string, data []byte, connectionType stri
 	return nodeMsg, nil
 }
 
-func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *RestChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	//
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
@@ -251,7 +251,7 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		utils.LavaFormatInfo("in <<<", &map[string]string{"path": path, "dappID": dappID, "msgSeed": msgSeed})
 		requestBody := string(c.Body())
-		reply, _, err := SendRelay(ctx, cp, privKey, path, requestBody, http.MethodPost, dappID, metricsData)
+		reply, _, err := SendRelay(ctx, cp, signer, path, requestBody, http.MethodPost, dappID, metricsData)
 		go cp.portalLogs.AddMetric(metricsData, err != nil)
 		if err != nil {
 			// Get unique GUID response
@@ -292,7 +292,7 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		utils.LavaFormatInfo("in <<<", &map[string]string{"path": path, "dappID": dappID, "msgSeed": msgSeed})
 		analytics := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 
-		reply, _, err := SendRelay(ctx, cp, privKey, path, query, http.MethodGet, dappID, analytics)
+		reply, _, err := SendRelay(ctx, cp, signer, path, query, http.MethodGet, dappID, analytics)
 		go cp.portalLogs.AddMetric(analytics, err != nil)
 		if err != nil {
 			// Get unique GUID response
@@ -14,7 +14,8 @@ const (
 	MaxAllowedBlockListedSessionPerProvider          = 3
 	MaximumNumberOfFailuresAllowedPerConsumerSession = 3
 	RelayNumberIncrement                             = 1
-	DataReliabilitySessionId                         = 0 // data reliability session id is 0. we can change to more sessions later if needed.
+	RelayNumReorderWindow                            = 64 // a provider accepts a skipped relay num that arrives late if it is within this distance from the latest relay num
+	DataReliabilitySessionId                         = 0  // data reliability session id is 0. we can change to more sessions later if needed.
 	DataReliabilityCuSum                             = 0
 	GeolocationFlag                                  = "geolocation"
 )
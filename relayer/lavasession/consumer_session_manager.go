@@ -12,6 +12,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/status"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/utils"
 	"google.golang.org/grpc/codes"
 )
@@ -33,6 +34,8 @@ type ConsumerSessionManager struct {
 	// pairingPurge - contains all pairings that are unwanted this epoch, keeps them in memory in order to avoid release.
 	// (if a consumer session still uses one of them or we want to report it.)
 	pairingPurge map[string]*ConsumerSessionsWithProvider
+
+	providerTLS *lavatls.ClientConfig // how connections to the providers are secured, nil validates them against the system CAs
 }
 
 func (csm *ConsumerSessionManager) RPCEndpoint() RPCEndpoint {
@@ -153,7 +156,7 @@ func (csm *ConsumerSessionManager) GetSession(ctx context.Context, cuNeededForSe
 		}
 
 		// Get a valid Endpoint from the provider chosen
-		connected, endpoint, err := consumerSessionWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, sessionEpoch)
+		connected, endpoint, err := consumerSessionWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, sessionEpoch, csm.providerTLS)
 		if err != nil {
 			// verify err is AllProviderEndpointsDisabled and report.
 			if AllProviderEndpointsDisabledError.Is(err) {
@@ -373,12 +376,33 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 	}
 	cuToDecrease := consumerSession.LatestRelayCu
 	consumerSession.LatestRelayCu = 0 // making sure no one uses it in a wrong way
+	var cuServedByProvider uint64
+	if providerRelayNum, providerCuSum, ok := RelayNumMismatchFromError(errorReceived); ok {
+		cuServedByProvider, ok = consumerSession.resyncRelayNum(providerRelayNum, providerCuSum)
+		if !ok {
+			utils.LavaFormatWarning("provider relay num can't be explained by the failed relays, blocking consumer session", errorReceived, &map[string]string{"id": strconv.FormatInt(consumerSession.SessionId, 10), "relayNum": strconv.FormatUint(consumerSession.RelayNum, 10), "providerRelayNum": strconv.FormatUint(providerRelayNum, 10), "cuSum": strconv.FormatUint(consumerSession.CuSum, 10), "providerCuSum": strconv.FormatUint(providerCuSum, 10)})
+			// the provider will claim its cu sum on chain, so the parent keeps counting it, but never less than what was confirmed here
+			if providerCuSum > consumerSession.CuSum {
+				cuServedByProvider = providerCuSum - consumerSession.CuSum
+				consumerSession.CuSum = providerCuSum
+			}
+			consumerSession.UnconfirmedCu = 0
+			consumerSession.BlockListed = true
+		}
+	} else {
+		consumerSession.UnconfirmedCu += cuToDecrease // the provider might have served the relay even though it failed here
+	}
 
 	parentConsumerSessionsWithProvider := consumerSession.Client // must read this pointer before unlocking
 	// finished with consumerSession here can unlock.
 	consumerSession.lock.Unlock() // we unlock before we change anything in the parent ConsumerSessionsWithProvider
 
-	err := parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease) // change the cu in parent
+	var err error
+	if cuServedByProvider > cuToDecrease {
+		err = parentConsumerSessionsWithProvider.addUsedComputeUnits(cuServedByProvider - cuToDecrease)
+	} else {
+		err = parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease - cuServedByProvider) // change the cu in parent
+	}
 	if err != nil {
 		return err
 	}
@@ -456,6 +480,7 @@ func (csm *ConsumerSessionManager) OnSessionDone(
 	consumerSession.LatestRelayCu = 0                      // reset cu just in case
 	consumerSession.RelayNum += RelayNumberIncrement       // increase relayNum
 	consumerSession.ConsecutiveNumberOfFailures = 0        // reset failures.
+	consumerSession.UnconfirmedCu = 0                      // the provider accepted the relay num, so the failed relays weren't served
 	consumerSession.LatestBlock = latestServicedBlock      // update latest serviced block
 	// calculate QoS
 	consumerSession.CalculateQoS(specComputeUnits, currentLatency, expectedBH-latestServicedBlock, numOfProviders, int64(providersCount))
@@ -505,7 +530,7 @@ func (csm *ConsumerSessionManager) getDataReliabilityProviderIndex(unAllowedAddr
 func (csm *ConsumerSessionManager) getEndpointFromConsumerSessionWithProviderForDR(ctx context.Context, consumerSessionWithProvider *ConsumerSessionsWithProvider, sessionEpoch uint64, providerAddress string) (endpoint *Endpoint, err error) {
 	var connected bool
 	for idx := 0; idx < MaxConsecutiveConnectionAttempts; idx++ { // try to connect to the endpoint 3 times
-		connected, endpoint, err = consumerSessionWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, sessionEpoch)
+		connected, endpoint, err = consumerSessionWithProvider.fetchEndpointConnectionFromConsumerSessionWithProvider(ctx, sessionEpoch, csm.providerTLS)
 		if err != nil {
 			// verify err is AllProviderEndpointsDisabled and report.
 			if AllProviderEndpointsDisabledError.Is(err) {
@@ -582,6 +607,7 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseRelayAndCu(consumerSessi
 	consumerSession.LatestRelayCu = 0                      // reset cu just in case
 	consumerSession.RelayNum += RelayNumberIncrement       // increase relayNum
 	consumerSession.ConsecutiveNumberOfFailures = 0        // reset failures.
+	consumerSession.UnconfirmedCu = 0                      // the provider accepted the relay num, so the failed relays weren't served
 	return nil
 }
 
@@ -631,8 +657,14 @@ func (csm *ConsumerSessionManager) OnDataReliabilitySessionFailure(consumerSessi
 	return nil
 }
 
-func NewConsumerSessionManager(rpcEndpoint *RPCEndpoint) *ConsumerSessionManager {
+func NewConsumerSessionManager(rpcEndpoint *RPCEndpoint, providerTLS *lavatls.ClientConfig) *ConsumerSessionManager {
 	csm := ConsumerSessionManager{}
 	csm.rpcEndpoint = rpcEndpoint
+	csm.providerTLS = providerTLS
 	return &csm
 }
+
+// SetProviderTLS sets how connections to the providers are secured, must be called before the first pairing update
+func (csm *ConsumerSessionManager) SetProviderTLS(providerTLS *lavatls.ClientConfig) {
+	csm.providerTLS = providerTLS
+}
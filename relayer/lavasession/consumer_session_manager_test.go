@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/utils"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -33,7 +34,8 @@ const (
 
 func CreateConsumerSessionManager() *ConsumerSessionManager {
 	rand.Seed(time.Now().UnixNano())
-	return &ConsumerSessionManager{}
+	// the test grpc server serves plaintext
+	return &ConsumerSessionManager{providerTLS: &lavatls.ClientConfig{AllowPlaintext: true}}
 }
 
 func createGRPCServer(t *testing.T) *grpc.Server {
@@ -441,6 +443,105 @@ func TestSessionFailureEpochMisMatch(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// a relay the provider served but the consumer failed on is reported back when the consumer sends its relay num again
+func TestRelayNumResync(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	// a single provider, so every GetSession returns the same session
+	pairingList := createPairingList()[:1]
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil) // get a session
+	require.Nil(t, err)
+	err = csm.OnSessionFailure(cs, fmt.Errorf("reply timeout"))
+	require.Nil(t, err)
+	require.Equal(t, cuForFirstRequest, cs.UnconfirmedCu)
+	require.Equal(t, uint64(0), cs.Client.UsedComputeUnits)
+
+	// the retry of relay num 1 is rejected, the provider already served it
+	retry, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, cs, retry)
+	err = csm.OnSessionFailure(retry, RelayNumMismatchStatus(RelayNumReplayError, 1, cuForFirstRequest))
+	require.Nil(t, err)
+	require.False(t, cs.BlockListed)
+	require.Equal(t, uint64(1), cs.RelayNum)
+	require.Equal(t, cuForFirstRequest, cs.CuSum)
+	require.Equal(t, uint64(0), cs.UnconfirmedCu)
+	require.Equal(t, cuForFirstRequest, cs.Client.UsedComputeUnits) // the served relay counts, the rejected one doesn't
+
+	// the session continues from the provider's relay num
+	next, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, cs, next)
+	err = csm.OnSessionDone(next, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, servicedBlockNumber-1, numberOfProviders, numberOfProviders)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), cs.RelayNum)
+	require.Equal(t, 2*cuForFirstRequest, cs.CuSum)
+	require.Equal(t, 2*cuForFirstRequest, cs.Client.UsedComputeUnits)
+}
+
+func TestRelayNumResyncOutOfSync(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	// a single provider, so every GetSession returns the same session
+	pairingList := createPairingList()[:1]
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil) // get a session
+	require.Nil(t, err)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, servicedBlockNumber-1, numberOfProviders, numberOfProviders)
+	require.Nil(t, err)
+	failed, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, cs, failed)
+	err = csm.OnSessionFailure(failed, fmt.Errorf("reply timeout"))
+	require.Nil(t, err)
+	require.Equal(t, cuForFirstRequest, cs.UnconfirmedCu)
+
+	// a provider claiming more cu than the failed relays is out of sync, the parent keeps counting what it will claim
+	rejected, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, cs, rejected)
+	err = csm.OnSessionFailure(rejected, RelayNumMismatchStatus(RelayNumReplayError, 3, 4*cuForFirstRequest))
+	require.Nil(t, err)
+	require.True(t, cs.BlockListed)
+	require.Equal(t, uint64(1), cs.RelayNum)
+	require.Equal(t, 4*cuForFirstRequest, cs.CuSum)
+	require.Equal(t, uint64(0), cs.UnconfirmedCu)
+	require.Equal(t, 4*cuForFirstRequest, cs.Client.UsedComputeUnits)
+}
+
+func TestRelayNumResyncProviderBehind(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	// a single provider, so every GetSession returns the same session
+	pairingList := createPairingList()[:1]
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.Nil(t, err)
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil) // get a session
+	require.Nil(t, err)
+	err = csm.OnSessionDone(cs, firstEpochHeight, servicedBlockNumber, cuForFirstRequest, time.Millisecond, servicedBlockNumber-1, numberOfProviders, numberOfProviders)
+	require.Nil(t, err)
+
+	// a provider reporting less cu than was confirmed here can't lower the parent below the confirmed cu
+	rejected, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	require.Equal(t, cs, rejected)
+	err = csm.OnSessionFailure(rejected, RelayNumMismatchStatus(RelayNumTooOldError, 1, 0))
+	require.Nil(t, err)
+	require.True(t, cs.BlockListed)
+	require.Equal(t, cuForFirstRequest, cs.CuSum)
+	require.Equal(t, uint64(0), cs.UnconfirmedCu)
+	require.Equal(t, cuForFirstRequest, cs.Client.UsedComputeUnits)
+}
+
 func TestAllProvidersEndpointsDisabled(t *testing.T) {
 	ctx := context.Background()
 	csm := CreateConsumerSessionManager()
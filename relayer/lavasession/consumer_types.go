@@ -11,10 +11,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ignoredProviders struct {
@@ -43,6 +43,22 @@ type SingleConsumerSession struct {
 	Endpoint                    *Endpoint
 	BlockListed                 bool   // if session lost sync we blacklist it.
 	ConsecutiveNumberOfFailures uint64 // number of times this session has failed
+	UnconfirmedCu               uint64 // cu of the relays that failed since the last successful relay, the provider might have served them anyway
+}
+
+// resyncRelayNum continues the session from the relay num and cu sum the provider reported when it rejected a relay num,
+// the provider can only be ahead by relays that failed here but were served there, anything else means the session is out of sync.
+// returns the cu the provider served for those relays
+func (scs *SingleConsumerSession) resyncRelayNum(providerRelayNum uint64, providerCuSum uint64) (cuServed uint64, ok bool) {
+	if providerRelayNum <= scs.RelayNum || providerCuSum < scs.CuSum || providerCuSum-scs.CuSum > scs.UnconfirmedCu {
+		return 0, false
+	}
+	cuServed = providerCuSum - scs.CuSum
+	utils.LavaFormatInfo("resyncing relay num with the provider", &map[string]string{"id": strconv.FormatInt(scs.SessionId, 10), "relayNum": strconv.FormatUint(scs.RelayNum, 10), "providerRelayNum": strconv.FormatUint(providerRelayNum, 10), "cuServed": strconv.FormatUint(cuServed, 10)})
+	scs.RelayNum = providerRelayNum
+	scs.CuSum = providerCuSum
+	scs.UnconfirmedCu = 0
+	return cuServed, true
 }
 
 type DataReliabilitySession struct {
@@ -54,6 +70,7 @@ type DataReliabilitySession struct {
 
 type Endpoint struct {
 	NetworkAddress     string // change at the end to NetworkAddress
+	TLSCertHash        string // hash of the certificate the provider published, the connection is pinned to it when set
 	Enabled            bool
 	Client             *pairingtypes.RelayerClient
 	ConnectionRefusals uint64
@@ -162,11 +179,15 @@ func (cswp *ConsumerSessionsWithProvider) decreaseUsedComputeUnits(cu uint64) er
 	return nil
 }
 
-func (cswp *ConsumerSessionsWithProvider) connectRawClientWithTimeout(ctx context.Context, addr string) (*pairingtypes.RelayerClient, error) {
+func (cswp *ConsumerSessionsWithProvider) connectRawClientWithTimeout(ctx context.Context, endpoint *Endpoint, providerTLS *lavatls.ClientConfig) (*pairingtypes.RelayerClient, error) {
+	transportCredentials, err := providerTLS.TransportCredentials(endpoint.TLSCertHash)
+	if err != nil {
+		return nil, err
+	}
 	connectCtx, cancel := context.WithTimeout(ctx, TimeoutForEstablishingAConnection)
 	defer cancel()
 
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.DialContext(connectCtx, endpoint.NetworkAddress, grpc.WithTransportCredentials(transportCredentials), grpc.WithBlock())
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +252,7 @@ func (cswp *ConsumerSessionsWithProvider) getConsumerSessionInstanceFromEndpoint
 
 // fetching an endpoint from a ConsumerSessionWithProvider and establishing a connection,
 // can fail without an error if trying to connect once to each endpoint but none of them are active.
-func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSessionWithProvider(ctx context.Context, sessionEpoch uint64) (connected bool, endpointPtr *Endpoint, err error) {
+func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSessionWithProvider(ctx context.Context, sessionEpoch uint64, providerTLS *lavatls.ClientConfig) (connected bool, endpointPtr *Endpoint, err error) {
 	getConnectionFromConsumerSessionsWithProvider := func(ctx context.Context) (connected bool, endpointPtr *Endpoint, allDisabled bool) {
 		cswp.Lock.Lock()
 		defer cswp.Lock.Unlock()
@@ -241,7 +262,7 @@ func (cswp *ConsumerSessionsWithProvider) fetchEndpointConnectionFromConsumerSes
 				continue
 			}
 			if endpoint.Client == nil {
-				conn, err := cswp.connectRawClientWithTimeout(ctx, endpoint.NetworkAddress)
+				conn, err := cswp.connectRawClientWithTimeout(ctx, endpoint, providerTLS)
 				if err != nil {
 					endpoint.ConnectionRefusals++
 					utils.LavaFormatError("error connecting to provider", err, &map[string]string{"provider endpoint": endpoint.NetworkAddress, "provider address": cswp.PublicLavaAddress, "endpoint": fmt.Sprintf("%+v", endpoint)})
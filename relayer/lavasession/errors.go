@@ -1,7 +1,12 @@
 package lavasession
 
 import (
+	"strconv"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var ( // Consumer Side Errors
@@ -32,4 +37,59 @@ var ( // Provider Side Errors
 	NewSessionWithRelayNumError = sdkerrors.New("NewSessionWithRelayNum Error", 882, "Requested Session With Relay Number Is Invalid")
 	ConsumerIsBlockListed       = sdkerrors.New("ConsumerIsBlockListed Error", 883, "This Consumer Is Blocked.")
 	ConsumerNotActive           = sdkerrors.New("ConsumerNotActive Error", 884, "This Consumer Is Not Active.")
+	RelayNumReplayError         = sdkerrors.New("RelayNumReplay Error", 885, "Relay Number Was Already Used In This Session")
+	RelayNumTooOldError         = sdkerrors.New("RelayNumTooOld Error", 886, "Relay Number Is Older Than The Session Reorder Window")
+)
+
+const (
+	relayNumMismatchReason = "RELAY_NUM_MISMATCH"
+	relayNumMismatchDomain = "lavasession"
+	relayNumMetadataKey    = "relay_num"
+	cuSumMetadataKey       = "cu_sum"
 )
+
+// RelayNumMismatchStatus returns the grpc status a provider rejects a replayed or stale relay num with,
+// the status details hold the latest relay num and cu sum of the session so the consumer can resync with them
+func RelayNumMismatchStatus(err error, relayNum uint64, cuSum uint64) error {
+	code := codes.Code(RelayNumReplayError.ABCICode())
+	if RelayNumTooOldError.Is(err) {
+		code = codes.Code(RelayNumTooOldError.ABCICode())
+	}
+	st := status.New(code, err.Error())
+	withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: relayNumMismatchReason,
+		Domain: relayNumMismatchDomain,
+		Metadata: map[string]string{
+			relayNumMetadataKey: strconv.FormatUint(relayNum, 10),
+			cuSumMetadataKey:    strconv.FormatUint(cuSum, 10),
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// RelayNumMismatchFromError returns the session relay num and cu sum a provider reported with RelayNumMismatchStatus
+func RelayNumMismatchFromError(err error) (relayNum uint64, cuSum uint64, ok bool) {
+	st, isStatus := status.FromError(err)
+	if !isStatus || (st.Code() != codes.Code(RelayNumReplayError.ABCICode()) && st.Code() != codes.Code(RelayNumTooOldError.ABCICode())) {
+		return 0, 0, false
+	}
+	for _, detail := range st.Details() {
+		info, isInfo := detail.(*errdetails.ErrorInfo)
+		if !isInfo || info.Reason != relayNumMismatchReason || info.Domain != relayNumMismatchDomain {
+			continue
+		}
+		relayNum, err := strconv.ParseUint(info.Metadata[relayNumMetadataKey], 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		cuSum, err := strconv.ParseUint(info.Metadata[cuSumMetadataKey], 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		return relayNum, cuSum, true
+	}
+	return 0, 0, false
+}
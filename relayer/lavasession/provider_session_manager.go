@@ -1,20 +1,21 @@
 package lavasession
 
 import (
-	"fmt"
 	"strconv"
 	"sync"
 	"sync/atomic"
 
 	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
 type ProviderSessionManager struct {
 	sessionsWithAllConsumers map[uint64]map[string]*ProviderSessionsWithConsumer // first key is epochs, second key is a consumer address
 	lock                     sync.RWMutex
-	blockedEpoch             uint64 // requests from this epoch are blocked
+	blockedEpoch             uint64 // requests from this epoch and older epochs are blocked
+	currentEpoch             uint64
 	rpcProviderEndpoint      *RPCProviderEndpoint
-	stateQuery               StateQuery
+	relayRejections          RelayRejections
 }
 
 // reads cs.BlockedEpoch atomically
@@ -31,62 +32,87 @@ func (psm *ProviderSessionManager) IsValidEpoch(epoch uint64) bool {
 	return epoch > psm.atomicReadBlockedEpoch()
 }
 
+// VerifyEpoch returns InvalidEpochError for a relay of an epoch the provider no longer serves, and counts it as a stale epoch relay
+func (psm *ProviderSessionManager) VerifyEpoch(epoch uint64) error {
+	if psm.IsValidEpoch(epoch) {
+		return nil
+	}
+	atomic.AddUint64(&psm.relayRejections.StaleEpochs, 1)
+	return utils.LavaFormatWarning("relay of an epoch that is no longer served", InvalidEpochError, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10), "blockedEpoch": strconv.FormatUint(psm.atomicReadBlockedEpoch(), 10)})
+}
+
+// RelayRejections returns the number of relays rejected as replays or stale since the provider started
+func (psm *ProviderSessionManager) RelayRejections() RelayRejections {
+	return RelayRejections{
+		Replays:        atomic.LoadUint64(&psm.relayRejections.Replays),
+		StaleRelayNums: atomic.LoadUint64(&psm.relayRejections.StaleRelayNums),
+		StaleEpochs:    atomic.LoadUint64(&psm.relayRejections.StaleEpochs),
+	}
+}
+
 // Check if consumer exists and is not blocked, if all is valid return the ProviderSessionsWithConsumer pointer
 func (psm *ProviderSessionManager) IsActiveConsumer(epoch uint64, address string) (active bool, err error) {
 	_, err = psm.getActiveConsumer(epoch, address)
 	if err != nil {
-		return false, nil
+		if ConsumerNotActive.Is(err) {
+			return false, nil
+		}
+		return false, err
 	}
 	return true, nil // no error
 }
 
-func (psm *ProviderSessionManager) GetSession(address string, id uint64, epoch uint64, relayNum uint64, sessionId uint64) (*SingleProviderSession, error) {
-	if psm.IsValidEpoch(epoch) { // fast checking to see if epoch is even relevant
-		utils.LavaFormatError("GetSession", InvalidEpochError, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10)})
-		return nil, InvalidEpochError
-	}
-
-	activeConsumer, err := psm.IsActiveConsumer(epoch, address)
-	if err != nil {
+// GetSession returns the session of an active consumer, creating it if the consumer didn't use it yet.
+// a consumer that is not active returns ConsumerNotActive, it has to be registered with RegisterProviderSessionWithConsumer after its pairing was verified
+func (psm *ProviderSessionManager) GetSession(address string, epoch uint64, sessionId uint64, relayNum uint64) (*SingleProviderSession, error) {
+	if err := psm.VerifyEpoch(epoch); err != nil { // fast checking to see if epoch is even relevant
 		return nil, err
 	}
-	var singleProviderSession *SingleProviderSession
-	if activeConsumer {
-		singleProviderSession, err = psm.getSessionFromAnActiveConsumer(epoch, address, sessionId) // after getting session verify relayNum etc..
-	} else if relayNum == 0 {
-		// if no session found, we need to create and validate few things: pairing,
-		// return here and call a different function.
-		// in this function
-
-		singleProviderSession, err = psm.getNewSession(epoch, address) // after getting session verify relayNum etc..
-	} else {
-		utils.LavaFormatError("GetSession", NewSessionWithRelayNumError, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10)})
-		return nil, NewSessionWithRelayNumError
+	if sessionId == DataReliabilitySessionId {
+		return nil, utils.LavaFormatError("SessionID cannot be 0 for non-data reliability requests", nil, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10), "consumer": address})
 	}
 
+	providerSessionWithConsumer, err := psm.getActiveConsumer(epoch, address)
 	if err != nil {
-		utils.LavaFormatError("GetSession Failure", err, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10)})
 		return nil, err
 	}
-
-	// validate later relayNum etc..
-
-	return singleProviderSession, nil
+	return providerSessionWithConsumer.getOrCreateSession(sessionId, epoch)
 }
 
-func (psm *ProviderSessionManager) createNewSingleProviderSession(providerSessionWithConsumer *ProviderSessionsWithConsumer, sessionId uint64) (singleProviderSession *SingleProviderSession, err error) {
-	providerSessionWithConsumer.Lock.Lock()
-	defer providerSessionWithConsumer.Lock.Unlock()
-
-	// TODO: create a new single provider session
-
-	return nil, fmt.Errorf("not implemented")
+// RegisterProviderSessionWithConsumer adds the consumer for the epoch with the pairing data the provider verified, registering an existing consumer returns its sessions
+func (psm *ProviderSessionManager) RegisterProviderSessionWithConsumer(address string, epoch uint64, maxCuForConsumer uint64, vrfPk utils.VrfPubKey) (*ProviderSessionsWithConsumer, error) {
+	psm.lock.Lock()
+	defer psm.lock.Unlock()
+	if !psm.IsValidEpoch(epoch) { // checking again because we are now locked and epoch cant change now.
+		utils.LavaFormatError("RegisterProviderSessionWithConsumer", InvalidEpochError, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10)})
+		return nil, InvalidEpochError
+	}
+	mapOfProviderSessionsWithConsumer, ok := psm.sessionsWithAllConsumers[epoch]
+	if !ok {
+		mapOfProviderSessionsWithConsumer = map[string]*ProviderSessionsWithConsumer{}
+		psm.sessionsWithAllConsumers[epoch] = mapOfProviderSessionsWithConsumer
+	}
+	providerSessionWithConsumer, ok := mapOfProviderSessionsWithConsumer[address]
+	if !ok {
+		providerSessionWithConsumer = &ProviderSessionsWithConsumer{
+			Sessions:        map[uint64]*SingleProviderSession{},
+			consumer:        address,
+			epochData:       &ProviderSessionsEpochData{MaxComputeUnits: maxCuForConsumer, VrfPk: vrfPk},
+			relayRejections: &psm.relayRejections,
+		}
+		mapOfProviderSessionsWithConsumer[address] = providerSessionWithConsumer
+		utils.LavaFormatInfo("new consumer sessions in epoch", &map[string]string{"consumer": address, "maxCu": strconv.FormatUint(maxCuForConsumer, 10), "epoch": strconv.FormatUint(epoch, 10)})
+	}
+	if providerSessionWithConsumer.atomicReadBlockedEpoch() == blockListedConsumer {
+		return nil, ConsumerIsBlockListed
+	}
+	return providerSessionWithConsumer, nil
 }
 
-func (psm *ProviderSessionManager) getActiveConsumer(epoch uint64, address string) (singleProviderSession *ProviderSessionsWithConsumer, err error) {
+func (psm *ProviderSessionManager) getActiveConsumer(epoch uint64, address string) (providerSessionWithConsumer *ProviderSessionsWithConsumer, err error) {
 	psm.lock.RLock()
 	defer psm.lock.RUnlock()
-	if psm.IsValidEpoch(epoch) { // checking again because we are now locked and epoch cant change now.
+	if !psm.IsValidEpoch(epoch) { // checking again because we are now locked and epoch cant change now.
 		utils.LavaFormatError("getActiveConsumer", InvalidEpochError, &map[string]string{"RequestedEpoch": strconv.FormatUint(epoch, 10)})
 		return nil, InvalidEpochError
 	}
@@ -103,48 +129,87 @@ func (psm *ProviderSessionManager) getActiveConsumer(epoch uint64, address strin
 	return nil, ConsumerNotActive
 }
 
-func (psm *ProviderSessionManager) getSessionFromAnActiveConsumer(epoch uint64, address string, sessionId uint64) (singleProviderSession *SingleProviderSession, err error) {
+// OnDataReliabilitySession saves the data reliability of the consumer for the epoch, a consumer can send data reliability only once per epoch to a provider
+func (psm *ProviderSessionManager) OnDataReliabilitySession(address string, epoch uint64, dataReliability *pairingtypes.VRFData) error {
 	providerSessionWithConsumer, err := psm.getActiveConsumer(epoch, address)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	session, err := providerSessionWithConsumer.GetExistingSession(sessionId)
-	if err == nil {
-		return session, nil
+	providerSessionWithConsumer.Lock.Lock()
+	defer providerSessionWithConsumer.Lock.Unlock()
+	if providerSessionWithConsumer.epochData.DataReliability != nil {
+		return utils.LavaFormatError("dataReliability can only be used once per client per epoch", DataReliabilityAlreadySentThisEpochError, &map[string]string{"requested epoch": strconv.FormatUint(epoch, 10), "consumer": address})
 	}
-	// if we don't have a session we need to create a new one.
-	return psm.createNewSingleProviderSession(providerSessionWithConsumer, sessionId)
-}
-
-func (psm *ProviderSessionManager) getNewSession(epoch uint64, address string) (singleProviderSession *SingleProviderSession, err error) {
-	return
+	providerSessionWithConsumer.epochData.DataReliability = dataReliability
+	return nil
 }
 
-func (psm *ProviderSessionManager) ReportConsumer() (address string, epoch uint64, err error) {
-	return "", 0, nil
+// OnSessionFailure rolls back the usage of a relay that failed so the consumer can retry its relay num, a consumer that lost sync with the provider is block listed
+func (psm *ProviderSessionManager) OnSessionFailure(singleProviderSession *SingleProviderSession, cu uint64, relayNum uint64) error {
+	singleProviderSession.Lock.Lock()
+	retError := singleProviderSession.rollbackRelayNum(relayNum, cu)
+	singleProviderSession.Lock.Unlock()
+
+	userSessions := singleProviderSession.userSessionsParent
+	userSessions.Lock.Lock()
+	defer userSessions.Lock.Unlock()
+	if userSessions.epochData.UsedComputeUnits < cu {
+		// if the provider lost sync with the consumer itself, and not just a session. we blockList the consumer.
+		userSessions.epochData.UsedComputeUnits = 0
+		userSessions.atomicWriteBlockedEpoch(blockListedConsumer)
+		return utils.LavaFormatError("consumer sessions out of sync, blocking consumer", SessionOutOfSyncError, &map[string]string{"consumer": userSessions.consumer})
+	}
+	userSessions.epochData.UsedComputeUnits -= cu
+	return retError
 }
 
-func (psm *ProviderSessionManager) GetDataReliabilitySession(address string, epoch uint64) (err error) {
+// OnSessionDone saves the relay request as the session proof, only the latest relay of a session is needed to get paid
+func (psm *ProviderSessionManager) OnSessionDone(singleProviderSession *SingleProviderSession, relayRequest *pairingtypes.RelayRequest) error {
+	singleProviderSession.Lock.Lock()
+	defer singleProviderSession.Lock.Unlock()
+	if singleProviderSession.Proof != nil && singleProviderSession.Proof.CuSum > relayRequest.CuSum {
+		return utils.LavaFormatError("relay proof has less cu than the existing proof", SessionOutOfSyncError, &map[string]string{"existing": strconv.FormatUint(singleProviderSession.Proof.CuSum, 10), "new": strconv.FormatUint(relayRequest.CuSum, 10)})
+	}
+	singleProviderSession.Proof = relayRequest.ShallowCopy()
 	return nil
 }
 
-func (psm *ProviderSessionManager) OnSessionFailure() (epoch uint64, err error) {
-	return 0, nil
-}
-
-func (psm *ProviderSessionManager) OnSessionDone(proof string) (epoch uint64, err error) {
-	return 0, nil
+// GetDataReliability returns the data reliability the consumer sent in the epoch
+func (psm *ProviderSessionManager) GetDataReliability(address string, epoch uint64) *pairingtypes.VRFData {
+	providerSessionWithConsumer, err := psm.getActiveConsumer(epoch, address)
+	if err != nil {
+		return nil
+	}
+	providerSessionWithConsumer.Lock.RLock()
+	defer providerSessionWithConsumer.Lock.RUnlock()
+	return providerSessionWithConsumer.epochData.DataReliability
 }
 
 func (psm *ProviderSessionManager) RPCProviderEndpoint() *RPCProviderEndpoint {
 	return psm.rpcProviderEndpoint
 }
 
+// UpdateEpoch limits consumer usage to the new epoch and the one before it, sessions of older epochs are deleted
 func (psm *ProviderSessionManager) UpdateEpoch(epoch uint64) {
-	// update the epoch to limit consumer usage
+	psm.lock.Lock()
+	defer psm.lock.Unlock()
+	if epoch <= psm.currentEpoch {
+		return
+	}
+	previousEpoch := psm.currentEpoch
+	psm.currentEpoch = epoch
+	if previousEpoch == 0 {
+		return
+	}
+	psm.atomicWriteBlockedEpoch(previousEpoch - 1)
+	for sessionsEpoch := range psm.sessionsWithAllConsumers {
+		if sessionsEpoch < previousEpoch {
+			delete(psm.sessionsWithAllConsumers, sessionsEpoch)
+		}
+	}
 }
 
 // Returning a new provider session manager
-func NewProviderSessionManager(rpcProviderEndpoint *RPCProviderEndpoint, stateQuery StateQuery) *ProviderSessionManager {
-	return &ProviderSessionManager{rpcProviderEndpoint: rpcProviderEndpoint, stateQuery: stateQuery}
+func NewProviderSessionManager(rpcProviderEndpoint *RPCProviderEndpoint) *ProviderSessionManager {
+	return &ProviderSessionManager{rpcProviderEndpoint: rpcProviderEndpoint, sessionsWithAllConsumers: map[uint64]map[string]*ProviderSessionsWithConsumer{}}
 }
@@ -1 +1,173 @@
 package lavasession
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	consumerAddress     = "consumer"
+	providerSessionId   = uint64(123)
+	providerMaxCu       = uint64(100)
+	providerRelayCu     = uint64(10)
+	providerFirstEpoch  = uint64(20)
+	providerSecondEpoch = uint64(40)
+	providerThirdEpoch  = uint64(60)
+)
+
+func prepareProviderSessionManager(t *testing.T) (*ProviderSessionManager, *SingleProviderSession) {
+	psm := NewProviderSessionManager(&RPCProviderEndpoint{})
+	psm.UpdateEpoch(providerFirstEpoch)
+	_, err := psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 1)
+	require.True(t, ConsumerNotActive.Is(err))
+	_, err = psm.RegisterProviderSessionWithConsumer(consumerAddress, providerFirstEpoch, providerMaxCu, utils.VrfPubKey{})
+	require.Nil(t, err)
+	session, err := psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 1)
+	require.Nil(t, err)
+	require.NotNil(t, session)
+	return psm, session
+}
+
+func TestProviderSessionHappyFlow(t *testing.T) {
+	psm, session := prepareProviderSessionManager(t)
+	err := session.PrepareSessionForUsage(providerRelayCu, providerRelayCu, 1)
+	require.Nil(t, err)
+	err = psm.OnSessionDone(session, &pairingtypes.RelayRequest{CuSum: providerRelayCu, RelayNum: 1, SessionId: providerSessionId})
+	require.Nil(t, err)
+	require.Equal(t, providerRelayCu, session.Proof.CuSum)
+
+	// the same session is returned for the next relay
+	sameSession, err := psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 2)
+	require.Nil(t, err)
+	require.Equal(t, session, sameSession)
+	err = session.PrepareSessionForUsage(providerRelayCu, 2*providerRelayCu, 2)
+	require.Nil(t, err)
+	require.Equal(t, 2*providerRelayCu, session.CuSum)
+	require.Equal(t, 2*providerRelayCu, session.userSessionsParent.epochData.UsedComputeUnits)
+}
+
+func TestProviderSessionOutOfSync(t *testing.T) {
+	_, session := prepareProviderSessionManager(t)
+	err := session.PrepareSessionForUsage(providerRelayCu, providerRelayCu, 1)
+	require.Nil(t, err)
+	// replaying the same relay num
+	err = session.PrepareSessionForUsage(providerRelayCu, 2*providerRelayCu, 1)
+	require.True(t, RelayNumReplayError.Is(err))
+	// cu sum that doesn't match the usage
+	err = session.PrepareSessionForUsage(providerRelayCu, 3*providerRelayCu, 2)
+	require.True(t, SessionOutOfSyncError.Is(err))
+	// exceeding the consumer max cu
+	err = session.PrepareSessionForUsage(providerMaxCu, providerRelayCu+providerMaxCu, 2)
+	require.True(t, MaxComputeUnitsExceededError.Is(err))
+}
+
+func TestProviderSessionFailure(t *testing.T) {
+	psm, session := prepareProviderSessionManager(t)
+	err := session.PrepareSessionForUsage(providerRelayCu, providerRelayCu, 1)
+	require.Nil(t, err)
+	err = psm.OnSessionFailure(session, providerRelayCu, 1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), session.CuSum)
+	require.Equal(t, uint64(0), session.RelayNum)
+	require.Equal(t, uint64(0), session.userSessionsParent.epochData.UsedComputeUnits)
+
+	// rolling back usage that didn't happen blocks the consumer
+	err = psm.OnSessionFailure(session, providerRelayCu, 1)
+	require.True(t, SessionOutOfSyncError.Is(err))
+	_, err = psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 1)
+	require.True(t, ConsumerIsBlockListed.Is(err))
+}
+
+func TestProviderSessionRelayNumReorder(t *testing.T) {
+	psm, session := prepareProviderSessionManager(t)
+	err := session.PrepareSessionForUsage(providerRelayCu, providerRelayCu, 1)
+	require.Nil(t, err)
+	// relay 3 arrives before relay 2, its cu sum pays for both
+	err = session.PrepareSessionForUsage(providerRelayCu, 3*providerRelayCu, 3)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 2*providerRelayCu, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), session.RelayNum)
+	require.Equal(t, 3*providerRelayCu, session.CuSum)
+	require.Equal(t, 3*providerRelayCu, session.userSessionsParent.epochData.UsedComputeUnits)
+
+	// every relay num is accepted once
+	for relayNum := uint64(1); relayNum <= 3; relayNum++ {
+		err = session.PrepareSessionForUsage(providerRelayCu, relayNum*providerRelayCu, relayNum)
+		require.True(t, RelayNumReplayError.Is(err))
+	}
+	// a relay that skips a relay num without paying for it
+	err = session.PrepareSessionForUsage(providerRelayCu, 4*providerRelayCu, 5)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 4*providerRelayCu, 4)
+	require.True(t, SessionOutOfSyncError.Is(err))
+
+	// relay nums that left the window
+	err = session.PrepareSessionForUsage(providerRelayCu, 5*providerRelayCu, 5+RelayNumReorderWindow)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 4*providerRelayCu, 5)
+	require.True(t, RelayNumTooOldError.Is(err))
+	require.Equal(t, RelayRejections{Replays: 3, StaleRelayNums: 1}, psm.RelayRejections())
+}
+
+func TestProviderSessionFailureRetry(t *testing.T) {
+	psm, session := prepareProviderSessionManager(t)
+	err := session.PrepareSessionForUsage(providerRelayCu, providerRelayCu, 1)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 2*providerRelayCu, 2)
+	require.Nil(t, err)
+	// the latest relay failed, the consumer can send its relay num with other cu
+	err = psm.OnSessionFailure(session, providerRelayCu, 2)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(2*providerRelayCu, 3*providerRelayCu, 2)
+	require.Nil(t, err)
+
+	// an earlier relay failed after a later one was accepted, its retry is paid by the later relay
+	err = session.PrepareSessionForUsage(providerRelayCu, 5*providerRelayCu, 4)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 4*providerRelayCu, 3)
+	require.Nil(t, err)
+	err = psm.OnSessionFailure(session, providerRelayCu, 3)
+	require.Nil(t, err)
+	err = session.PrepareSessionForUsage(providerRelayCu, 4*providerRelayCu, 3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), session.RelayNum)
+	require.Equal(t, 5*providerRelayCu, session.CuSum)
+	require.Equal(t, 5*providerRelayCu, session.userSessionsParent.epochData.UsedComputeUnits)
+}
+
+func TestRelayNumMismatchStatus(t *testing.T) {
+	relayNum, cuSum, ok := RelayNumMismatchFromError(RelayNumMismatchStatus(RelayNumTooOldError, 7, 70))
+	require.True(t, ok)
+	require.Equal(t, uint64(7), relayNum)
+	require.Equal(t, uint64(70), cuSum)
+	_, _, ok = RelayNumMismatchFromError(SessionOutOfSyncError)
+	require.False(t, ok)
+}
+
+func TestProviderSessionDataReliabilityOncePerEpoch(t *testing.T) {
+	psm, _ := prepareProviderSessionManager(t)
+	err := psm.OnDataReliabilitySession(consumerAddress, providerFirstEpoch, &pairingtypes.VRFData{})
+	require.Nil(t, err)
+	require.NotNil(t, psm.GetDataReliability(consumerAddress, providerFirstEpoch))
+	err = psm.OnDataReliabilitySession(consumerAddress, providerFirstEpoch, &pairingtypes.VRFData{})
+	require.True(t, DataReliabilityAlreadySentThisEpochError.Is(err))
+}
+
+func TestProviderSessionEpochUpdate(t *testing.T) {
+	psm, _ := prepareProviderSessionManager(t)
+	// the previous epoch is still valid after an epoch change
+	psm.UpdateEpoch(providerSecondEpoch)
+	_, err := psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 1)
+	require.Nil(t, err)
+	// two epochs later the sessions are blocked
+	psm.UpdateEpoch(providerThirdEpoch)
+	_, err = psm.GetSession(consumerAddress, providerFirstEpoch, providerSessionId, 1)
+	require.True(t, InvalidEpochError.Is(err))
+	require.Equal(t, uint64(1), psm.RelayRejections().StaleEpochs)
+	_, err = psm.RegisterProviderSessionWithConsumer(consumerAddress, providerFirstEpoch, providerMaxCu, utils.VrfPubKey{})
+	require.True(t, InvalidEpochError.Is(err))
+}
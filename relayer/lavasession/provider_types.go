@@ -1,8 +1,8 @@
 package lavasession
 
 import (
-	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 
@@ -42,11 +42,19 @@ const (
 
 // holds all of the data for a consumer for a certain epoch
 type ProviderSessionsWithConsumer struct {
-	Sessions      map[uint64]*SingleProviderSession
-	isBlockListed uint32
-	consumer      string
-	epochData     *ProviderSessionsEpochData
-	Lock          sync.RWMutex
+	Sessions        map[uint64]*SingleProviderSession
+	isBlockListed   uint32
+	consumer        string
+	epochData       *ProviderSessionsEpochData
+	relayRejections *RelayRejections
+	Lock            sync.RWMutex
+}
+
+// RelayRejections counts the relays a provider rejected because they were replayed or out of date
+type RelayRejections struct {
+	Replays        uint64 // relay num already accepted in the session
+	StaleRelayNums uint64 // relay num older than the reorder window of the session
+	StaleEpochs    uint64 // relay of an epoch the provider no longer serves
 }
 
 // reads cs.BlockedEpoch atomically
@@ -59,19 +67,26 @@ func (pswc *ProviderSessionsWithConsumer) atomicReadBlockedEpoch() (blockStatus
 	return atomic.LoadUint32(&pswc.isBlockListed)
 }
 
-func (pswc *ProviderSessionsWithConsumer) readBlockListedAtomic() {
-}
-
 type SingleProviderSession struct {
 	userSessionsParent *ProviderSessionsWithConsumer
 	CuSum              uint64
 	UniqueIdentifier   uint64
 	Lock               sync.RWMutex
 	Proof              *pairingtypes.RelayRequest // saves last relay request of a session as proof
-	RelayNum           uint64
+	RelayNum           uint64                     // highest relay num the session accepted
+	relayNumWindow     uint64                     // bit i is set if relay num RelayNum-i was accepted, relays within the window can arrive out of order
+	unservedCu         uint64                     // cu the consumer added to CuSum for relay nums the session didn't accept yet
+	rollback           *relayNumRollback          // the session state before the latest relay num was accepted, restored if that relay fails
 	PairingEpoch       uint64
 }
 
+type relayNumRollback struct {
+	relayNum       uint64
+	cuSum          uint64
+	relayNumWindow uint64
+	addedUnserved  uint64
+}
+
 func (r *SingleProviderSession) GetPairingEpoch() uint64 {
 	return atomic.LoadUint64(&r.PairingEpoch)
 }
@@ -89,6 +104,122 @@ func (pswc *ProviderSessionsWithConsumer) GetExistingSession(sessionId uint64) (
 	return nil, fmt.Errorf("session does not exist")
 }
 
-type StateQuery interface {
-	QueryVerifyPairing(ctx context.Context, consumer string, blockHeight uint64)
+// returns the session with the id, creating a new session if the consumer didn't use it before
+func (pswc *ProviderSessionsWithConsumer) getOrCreateSession(sessionId uint64, epoch uint64) (session *SingleProviderSession, err error) {
+	pswc.Lock.Lock()
+	defer pswc.Lock.Unlock()
+	if session, ok := pswc.Sessions[sessionId]; ok {
+		return session, nil
+	}
+	session = &SingleProviderSession{
+		userSessionsParent: pswc,
+		UniqueIdentifier:   sessionId,
+		PairingEpoch:       epoch,
+	}
+	pswc.Sessions[sessionId] = session
+	return session, nil
+}
+
+// PrepareSessionForUsage verifies the relay request is in sync with the session and adds its compute units to the session and the consumer epoch usage.
+// relay nums must increase, but a relay num that was skipped can still arrive within RelayNumReorderWindow as long as the CuSum of a later relay paid for it.
+// a relay num that was already accepted is a replay and returns RelayNumReplayError, one older than the window returns RelayNumTooOldError
+func (sps *SingleProviderSession) PrepareSessionForUsage(cu uint64, relayRequestTotalCU uint64, relayNum uint64) error {
+	sps.Lock.Lock()
+	defer sps.Lock.Unlock()
+	if relayNum < RelayNumberIncrement {
+		return utils.LavaFormatError("consumer requested relay num 0, relay nums start from 1", SessionOutOfSyncError, &map[string]string{"received": strconv.FormatUint(relayNum, 10)})
+	}
+	isNewRelayNum := relayNum > sps.RelayNum
+	var distance uint64 // how far behind the latest relay num the request is
+	if isNewRelayNum {
+		if sps.CuSum >= relayRequestTotalCU || sps.CuSum+cu > relayRequestTotalCU {
+			return utils.LavaFormatError("bad cu sum", SessionOutOfSyncError, &map[string]string{"cuSum": strconv.FormatUint(sps.CuSum, 10), "cu": strconv.FormatUint(cu, 10), "relayRequestTotalCU": strconv.FormatUint(relayRequestTotalCU, 10)})
+		}
+		if relayNum == sps.RelayNum+RelayNumberIncrement && sps.CuSum+cu != relayRequestTotalCU {
+			// no relay num was skipped, so the consumer has no cu to pay for but this relay's
+			return utils.LavaFormatError("bad cu sum", SessionOutOfSyncError, &map[string]string{"cuSum": strconv.FormatUint(sps.CuSum, 10), "cu": strconv.FormatUint(cu, 10), "relayRequestTotalCU": strconv.FormatUint(relayRequestTotalCU, 10)})
+		}
+	} else {
+		distance = sps.RelayNum - relayNum
+		if distance >= RelayNumReorderWindow {
+			atomic.AddUint64(&sps.userSessionsParent.relayRejections.StaleRelayNums, 1)
+			return utils.LavaFormatWarning("consumer requested a relay num older than the reorder window", RelayNumTooOldError, &map[string]string{"latest": strconv.FormatUint(sps.RelayNum, 10), "received": strconv.FormatUint(relayNum, 10), "consumer": sps.userSessionsParent.consumer})
+		}
+		if sps.relayNumWindow&(1<<distance) != 0 {
+			atomic.AddUint64(&sps.userSessionsParent.relayRejections.Replays, 1)
+			return utils.LavaFormatWarning("consumer requested a relay num that was already used, trying to replay a relay", RelayNumReplayError, &map[string]string{"latest": strconv.FormatUint(sps.RelayNum, 10), "received": strconv.FormatUint(relayNum, 10), "consumer": sps.userSessionsParent.consumer})
+		}
+		// the relay was skipped by a later relay, that relay's CuSum must have paid for it
+		if relayRequestTotalCU > sps.CuSum || cu > sps.unservedCu {
+			return utils.LavaFormatError("bad cu sum for a reordered relay", SessionOutOfSyncError, &map[string]string{"cuSum": strconv.FormatUint(sps.CuSum, 10), "unservedCu": strconv.FormatUint(sps.unservedCu, 10), "cu": strconv.FormatUint(cu, 10), "relayRequestTotalCU": strconv.FormatUint(relayRequestTotalCU, 10)})
+		}
+	}
+
+	userSessions := sps.userSessionsParent
+	userSessions.Lock.Lock()
+	defer userSessions.Lock.Unlock()
+	if userSessions.epochData.UsedComputeUnits+cu > userSessions.epochData.MaxComputeUnits {
+		return utils.LavaFormatError("consumer exceeded max compute units for the epoch", MaxComputeUnitsExceededError, &map[string]string{"used": strconv.FormatUint(userSessions.epochData.UsedComputeUnits, 10), "cu": strconv.FormatUint(cu, 10), "max": strconv.FormatUint(userSessions.epochData.MaxComputeUnits, 10)})
+	}
+	userSessions.epochData.UsedComputeUnits += cu
+	if !isNewRelayNum {
+		sps.relayNumWindow |= 1 << distance
+		sps.unservedCu -= cu
+		return nil
+	}
+	addedUnserved := relayRequestTotalCU - sps.CuSum - cu
+	sps.rollback = &relayNumRollback{relayNum: sps.RelayNum, cuSum: sps.CuSum, relayNumWindow: sps.relayNumWindow, addedUnserved: addedUnserved}
+	if shift := relayNum - sps.RelayNum; shift < RelayNumReorderWindow {
+		sps.relayNumWindow <<= shift
+	} else {
+		sps.relayNumWindow = 0
+	}
+	sps.relayNumWindow |= 1
+	sps.unservedCu += addedUnserved
+	sps.CuSum = relayRequestTotalCU
+	sps.RelayNum = relayNum
+	return nil
+}
+
+// rollbackRelayNum undoes a relay that failed so the consumer can send its relay num again, the session must be locked.
+// the latest relay is rolled back to the state before it, an earlier relay that failed is marked as unused and its cu stays in CuSum for the retry to pay
+func (sps *SingleProviderSession) rollbackRelayNum(relayNum uint64, cu uint64) error {
+	if relayNum == sps.RelayNum && sps.rollback != nil {
+		shift := sps.RelayNum - sps.rollback.relayNum
+		// relays accepted out of order after the failed relay are kept in the window
+		if shift < RelayNumReorderWindow {
+			sps.relayNumWindow = sps.rollback.relayNumWindow | sps.relayNumWindow>>shift
+		} else {
+			sps.relayNumWindow = sps.rollback.relayNumWindow
+		}
+		if sps.unservedCu > sps.rollback.addedUnserved {
+			sps.unservedCu -= sps.rollback.addedUnserved
+		} else {
+			sps.unservedCu = 0
+		}
+		sps.RelayNum = sps.rollback.relayNum
+		sps.CuSum = sps.rollback.cuSum
+		sps.rollback = nil
+		return nil
+	}
+	if relayNum > sps.RelayNum || sps.RelayNum-relayNum >= RelayNumReorderWindow || sps.relayNumWindow&(1<<(sps.RelayNum-relayNum)) == 0 {
+		return utils.LavaFormatError("failed relay num wasn't accepted by the session", SessionOutOfSyncError, &map[string]string{"latest": strconv.FormatUint(sps.RelayNum, 10), "relayNum": strconv.FormatUint(relayNum, 10)})
+	}
+	sps.relayNumWindow &^= 1 << (sps.RelayNum - relayNum)
+	sps.unservedCu += cu
+	return nil
+}
+
+// SyncState returns the latest relay num and cu sum of the session, a consumer that lost track of them continues from there
+func (sps *SingleProviderSession) SyncState() (relayNum uint64, cuSum uint64) {
+	sps.Lock.RLock()
+	defer sps.Lock.RUnlock()
+	return sps.RelayNum, sps.CuSum
+}
+
+// GetVrfPk returns the vrf public key the consumer registered with for the epoch
+func (pswc *ProviderSessionsWithConsumer) GetVrfPk() utils.VrfPubKey {
+	pswc.Lock.RLock()
+	defer pswc.Lock.RUnlock()
+	return pswc.epochData.VrfPk
 }
@@ -0,0 +1,173 @@
+package lavatls
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	TLSCertFlag        = "tls-cert"
+	TLSKeyFlag         = "tls-key"
+	TLSCAFlag          = "tls-ca"
+	AllowPlaintextFlag = "allow-plaintext"
+	ValidForFlag       = "valid-for"
+)
+
+// AddClientFlags adds the flags of processes that connect to providers
+func AddClientFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(TLSCAFlag, "", "pem file of the root CAs that provider certificates are validated against, defaults to the system CAs, providers that publish a certificate hash are pinned to it instead")
+	flagSet.Bool(AllowPlaintextFlag, false, "connect without tls to providers that don't publish a certificate hash, relays can be read and modified on the way, for local testing only")
+}
+
+// AddServerFlags adds the flags of processes that serve relays
+func AddServerFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(TLSCertFlag, "", "pem file of the tls certificate the relays are served with")
+	flagSet.String(TLSKeyFlag, "", "pem file of the tls certificate key")
+	flagSet.String(TLSCAFlag, "", "pem file of the root CAs that the cache certificate is validated against, defaults to the system CAs")
+	flagSet.Bool(AllowPlaintextFlag, false, "serve the relays without tls when no certificate is set, relays can be read and modified on the way, for local testing only")
+}
+
+// CertificateFingerprint returns the hex sha256 of a DER encoded certificate, the hash a provider publishes in its endpoints
+func CertificateFingerprint(certDER []byte) string {
+	hash := sha256.Sum256(certDER)
+	return hex.EncodeToString(hash[:])
+}
+
+// NewServerConfig loads the certificate and key a provider serves relays with, and returns the fingerprint of the certificate
+func NewServerConfig(certFile string, keyFile string) (config *tls.Config, fingerprint string, err error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed loading the tls key pair: %w", err)
+	}
+	config = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return config, CertificateFingerprint(cert.Certificate[0]), nil
+}
+
+// ServerConfigFromFlags returns the tls config of the provider listener,
+// nil means the listener serves plaintext which is only allowed with --allow-plaintext
+func ServerConfigFromFlags(flagSet *pflag.FlagSet) (config *tls.Config, fingerprint string, err error) {
+	certFile, err := flagSet.GetString(TLSCertFlag)
+	if err != nil {
+		return nil, "", err
+	}
+	keyFile, err := flagSet.GetString(TLSKeyFlag)
+	if err != nil {
+		return nil, "", err
+	}
+	allowPlaintext, err := flagSet.GetBool(AllowPlaintextFlag)
+	if err != nil {
+		return nil, "", err
+	}
+	if certFile == "" && keyFile == "" {
+		if !allowPlaintext {
+			return nil, "", fmt.Errorf("relays must be served over tls, set --%s and --%s or --%s for local testing", TLSCertFlag, TLSKeyFlag, AllowPlaintextFlag)
+		}
+		return nil, "", nil
+	}
+	return NewServerConfig(certFile, keyFile)
+}
+
+// ClientConfig decides how a consumer verifies the providers it connects to:
+// providers that publish a certificate hash are pinned to it, the rest are validated against the root CAs
+type ClientConfig struct {
+	RootCAs        *x509.CertPool // nil uses the system pool
+	AllowPlaintext bool           // connect without tls to providers that don't publish a certificate hash, for local testing only
+}
+
+// ClientConfigFromFlags returns the client config set by --tls-ca and --allow-plaintext
+func ClientConfigFromFlags(flagSet *pflag.FlagSet) (*ClientConfig, error) {
+	allowPlaintext, err := flagSet.GetBool(AllowPlaintextFlag)
+	if err != nil {
+		return nil, err
+	}
+	caFile, err := flagSet.GetString(TLSCAFlag)
+	if err != nil {
+		return nil, err
+	}
+	config := &ClientConfig{AllowPlaintext: allowPlaintext}
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+	}
+	return config, nil
+}
+
+// TransportCredentials returns the credentials to dial a provider endpoint with,
+// a nil config validates the provider against the system CAs
+func (cc *ClientConfig) TransportCredentials(certHash string) (credentials.TransportCredentials, error) {
+	if certHash != "" {
+		pinned, err := hex.DecodeString(certHash)
+		if err != nil || len(pinned) != sha256.Size {
+			return nil, fmt.Errorf("invalid tls certificate hash %s, must be a hex encoded sha256", certHash)
+		}
+		return credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+			// the chain and host name aren't validated, the certificate is compared to the hash the provider published instead
+			InsecureSkipVerify: true, //nolint:gosec
+			VerifyConnection: func(state tls.ConnectionState) error {
+				if len(state.PeerCertificates) == 0 {
+					return fmt.Errorf("provider sent no tls certificate")
+				}
+				hash := sha256.Sum256(state.PeerCertificates[0].Raw)
+				if !bytes.Equal(hash[:], pinned) {
+					return fmt.Errorf("provider tls certificate %s doesn't match the pinned hash %s", hex.EncodeToString(hash[:]), certHash)
+				}
+				return nil
+			},
+		}), nil
+	}
+	if cc != nil && cc.AllowPlaintext {
+		return insecure.NewCredentials(), nil
+	}
+	return credentials.NewTLS(cc.caConfig()), nil
+}
+
+// CacheTransportCredentials returns the credentials to dial the cache service with,
+// a cache on the same host is reached in plaintext since the traffic doesn't leave the host
+func (cc *ClientConfig) CacheTransportCredentials(addr string) credentials.TransportCredentials {
+	if (cc != nil && cc.AllowPlaintext) || IsLoopback(addr) {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(cc.caConfig())
+}
+
+func (cc *ClientConfig) caConfig() *tls.Config {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cc != nil {
+		config.RootCAs = cc.RootCAs
+	}
+	return config
+}
+
+// IsLoopback returns true if the host of addr is localhost or a loopback ip
+func IsLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
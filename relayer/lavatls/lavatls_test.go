@@ -0,0 +1,138 @@
+package lavatls
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthgrpc "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startServer serves a health service with the given credentials and returns its address
+func startServer(t *testing.T, creds credentials.TransportCredentials) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(server, healthgrpc.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func check(addr string, creds credentials.TransportCredentials) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func generateCertificate(t *testing.T) (certPEM []byte, certFile string, keyFile string) {
+	certPEM, keyPEM, err := GenerateSelfSignedCertificate([]string{"127.0.0.1"}, time.Hour)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "provider.crt"), filepath.Join(dir, "provider.key")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	return certPEM, certFile, keyFile
+}
+
+func TestPinnedCertificate(t *testing.T) {
+	certPEM, certFile, keyFile := generateCertificate(t)
+	serverConfig, fingerprint, err := NewServerConfig(certFile, keyFile)
+	require.NoError(t, err)
+	pemFingerprint, err := FingerprintFromPEM(certPEM)
+	require.NoError(t, err)
+	require.Equal(t, fingerprint, pemFingerprint)
+	addr := startServer(t, credentials.NewTLS(serverConfig))
+
+	clientConfig := &ClientConfig{}
+	creds, err := clientConfig.TransportCredentials(fingerprint)
+	require.NoError(t, err)
+	require.NoError(t, check(addr, creds))
+
+	// upper case hashes are pinned the same
+	creds, err = clientConfig.TransportCredentials(strings.ToUpper(fingerprint))
+	require.NoError(t, err)
+	require.NoError(t, check(addr, creds))
+
+	// another certificate's hash
+	otherPEM, _, _ := generateCertificate(t)
+	otherFingerprint, err := FingerprintFromPEM(otherPEM)
+	require.NoError(t, err)
+	creds, err = clientConfig.TransportCredentials(otherFingerprint)
+	require.NoError(t, err)
+	require.Error(t, check(addr, creds))
+
+	// a pinned hash is never dialed in plaintext
+	creds, err = (&ClientConfig{AllowPlaintext: true}).TransportCredentials(otherFingerprint)
+	require.NoError(t, err)
+	require.Error(t, check(addr, creds))
+
+	_, err = clientConfig.TransportCredentials("not a hash")
+	require.Error(t, err)
+	_, err = clientConfig.TransportCredentials(fingerprint[:32])
+	require.Error(t, err)
+}
+
+func TestCAValidation(t *testing.T) {
+	certPEM, certFile, keyFile := generateCertificate(t)
+	serverConfig, _, err := NewServerConfig(certFile, keyFile)
+	require.NoError(t, err)
+	addr := startServer(t, credentials.NewTLS(serverConfig))
+
+	// the self signed certificate isn't trusted by the system CAs
+	var nilConfig *ClientConfig
+	creds, err := nilConfig.TransportCredentials("")
+	require.NoError(t, err)
+	require.Error(t, check(addr, creds))
+
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(certPEM))
+	creds, err = (&ClientConfig{RootCAs: rootCAs}).TransportCredentials("")
+	require.NoError(t, err)
+	require.NoError(t, check(addr, creds))
+
+	// a tls provider isn't reachable in plaintext
+	creds, err = (&ClientConfig{AllowPlaintext: true}).TransportCredentials("")
+	require.NoError(t, err)
+	require.Error(t, check(addr, creds))
+}
+
+func TestPlaintext(t *testing.T) {
+	addr := startServer(t, insecure.NewCredentials())
+
+	creds, err := (&ClientConfig{}).TransportCredentials("")
+	require.NoError(t, err)
+	require.Error(t, check(addr, creds))
+
+	creds, err = (&ClientConfig{AllowPlaintext: true}).TransportCredentials("")
+	require.NoError(t, err)
+	require.NoError(t, check(addr, creds))
+
+	// a cache on the same host doesn't need the opt in
+	require.NoError(t, check(addr, (&ClientConfig{}).CacheTransportCredentials(addr)))
+}
+
+func TestIsLoopback(t *testing.T) {
+	require.True(t, IsLoopback("127.0.0.1:2221"))
+	require.True(t, IsLoopback("localhost:2221"))
+	require.True(t, IsLoopback("[::1]:2221"))
+	require.True(t, IsLoopback("127.0.0.1"))
+	require.False(t, IsLoopback("10.0.0.1:2221"))
+	require.False(t, IsLoopback("provider.example.com:443"))
+}
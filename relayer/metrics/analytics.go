@@ -12,6 +12,10 @@ type RelayMetrics struct {
 	Latency      int64
 	Success      bool
 	ComputeUnits uint64
+	// ProviderAddress is the provider that served the relay, set by the rpcconsumer
+	ProviderAddress string
+	// ApiName is the spec api of the relay, set by the rpcconsumer once the request is parsed
+	ApiName string
 }
 
 type RelayAnalyticsDTO struct {
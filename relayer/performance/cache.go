@@ -4,9 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/lavanet/lava/relayer/lavatls"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Cache struct {
@@ -14,10 +14,10 @@ type Cache struct {
 	address string
 }
 
-func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string) (*pairingtypes.RelayerCacheClient, error) {
+func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string, cacheTLS *lavatls.ClientConfig) (*pairingtypes.RelayerCacheClient, error) {
 	connectCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(cacheTLS.CacheTransportCredentials(addr)), grpc.WithBlock())
 	if err != nil {
 		return nil, err
 	}
@@ -27,8 +27,8 @@ func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string
 	return &c, nil
 }
 
-func InitCache(ctx context.Context, addr string) (*Cache, error) {
-	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, addr)
+func InitCache(ctx context.Context, addr string, cacheTLS *lavatls.ClientConfig) (*Cache, error) {
+	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, addr, cacheTLS)
 	if err != nil {
 		return &Cache{client: nil, address: addr}, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
@@ -30,12 +31,13 @@ func PortalServer(
 	//
 	utils.LavaFormatInfo("lavad Binary Version: "+version.Version, nil)
 	rand.Seed(time.Now().UnixNano())
-	sk, _, err := utils.GetOrCreateVRFKey(clientCtx)
+	signer, err := sigs.GetSigner(ctx, clientCtx, flagSet, true)
 	if err != nil {
-		log.Fatalln("error: GetOrCreateVRFKey", err)
+		log.Fatalln("error: GetSigner", err)
 	}
+	txFactory = sigs.TxFactoryWithSigner(txFactory, signer)
 	// Start sentry
-	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, sk, flagSet, 0)
+	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, signer, flagSet, 0)
 	err = sentry.Init(ctx)
 	if err != nil {
 		log.Fatalln("error sentry.Init", err)
@@ -56,6 +58,11 @@ func PortalServer(
 	if err != nil {
 		log.Fatalln("error: GetChainProxy", err)
 	}
+	providerTLS, err := lavatls.ClientConfigFromFlags(flagSet)
+	if err != nil {
+		log.Fatalln("error: ClientConfigFromFlags", err)
+	}
+	chainProxy.GetConsumerSessionManager().SetProviderTLS(providerTLS)
 	// Setting up the sentry callback
 	err = sentry.SetupConsumerSessionManager(ctx, chainProxy.GetConsumerSessionManager())
 	if err != nil {
@@ -64,23 +71,13 @@ func PortalServer(
 	//
 	// Set up a connection to the server.
 	utils.LavaFormatInfo("PortalServer"+apiInterface, nil)
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		log.Fatalln("error: getKeyName", err)
-	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		log.Fatalln("error: getPrivKey", err)
-	}
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
-
-	utils.LavaFormatInfo("Client pubkey: "+fmt.Sprintf("%s", clientKey.GetPubKey().Address()), nil)
+	utils.LavaFormatInfo("Client pubkey: "+fmt.Sprintf("%s", signer.PubKey().Address()), nil)
 
 	cacheAddr, err := flagSet.GetString(performance.CacheFlagName)
 	if err != nil {
 		utils.LavaFormatError("Failed To Get Cache Address flag", err, &map[string]string{"flags": fmt.Sprintf("%v", flagSet)})
 	} else if cacheAddr != "" {
-		cache, err := performance.InitCache(ctx, cacheAddr)
+		cache, err := performance.InitCache(ctx, cacheAddr, providerTLS)
 		if err != nil {
 			utils.LavaFormatError("Failed To Connect to cache at address", err, &map[string]string{"address": cacheAddr})
 		} else {
@@ -89,5 +86,5 @@ func PortalServer(
 		}
 	}
 
-	chainProxy.PortalStart(ctx, privKey, listenAddr)
+	chainProxy.PortalStart(ctx, signer, listenAddr)
 }
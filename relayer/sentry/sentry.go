@@ -16,11 +16,11 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/utils"
@@ -34,8 +34,6 @@ import (
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tenderminttypes "github.com/tendermint/tendermint/types"
 	"golang.org/x/exp/slices"
-	grpc "google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -153,9 +151,11 @@ type Sentry struct {
 	serverSpec spectypes.Spec
 	serverApis map[string]spectypes.ServiceApi
 	taggedApis map[string]spectypes.ServiceApi
+	// set when a spec remove proposal for the served chain passed, the apis are dropped once the spec can't be fetched
+	specRemoved bool
 
-	VrfSkMu utils.LavaMutex
-	VrfSk   vrf.PrivateKey
+	// signs and computes the vrf values of the relays this sentry sends as a consumer
+	Signer sigs.Signer
 
 	// every entry in providerHashesConsensus is conflicted with the other entries
 	providerHashesConsensus          []ProviderHashesConsensus
@@ -281,7 +281,7 @@ func (s *Sentry) getPairing(ctx context.Context) ([]*lavasession.ConsumerSession
 		//
 		pairingEndpoints := make([]*lavasession.Endpoint, len(relevantEndpoints))
 		for idx, relevantEndpoint := range relevantEndpoints {
-			endp := &lavasession.Endpoint{NetworkAddress: relevantEndpoint.IPPORT, Enabled: true, Client: nil, ConnectionRefusals: 0}
+			endp := &lavasession.Endpoint{NetworkAddress: relevantEndpoint.IPPORT, TLSCertHash: relevantEndpoint.TlsCertHash, Enabled: true, Client: nil, ConnectionRefusals: 0}
 			pairingEndpoints[idx] = endp
 		}
 
@@ -363,6 +363,16 @@ func (s *Sentry) getSpec(ctx context.Context) error {
 		ChainID: s.ChainID,
 	})
 	if err != nil {
+		s.specMu.Lock()
+		defer s.specMu.Unlock()
+		if s.specRemoved {
+			// the spec was removed by governance, stop serving its apis
+			s.specHash = nil
+			s.serverSpec = spectypes.Spec{}
+			s.serverApis = map[string]spectypes.ServiceApi{}
+			s.taggedApis = map[string]spectypes.ServiceApi{}
+			return utils.LavaFormatError("Spec for chain was removed", err, &map[string]string{"ChainID": s.ChainID})
+		}
 		return utils.LavaFormatError("Failed Querying spec for chain", err, &map[string]string{"ChainID": s.ChainID})
 	}
 
@@ -389,6 +399,28 @@ func (s *Sentry) getSpec(ctx context.Context) error {
 	return nil
 }
 
+func (s *Sentry) handleSpecChangeEvents(events map[string][]string) {
+	for _, eventType := range []proto.Message{&spectypes.EventSpecDisable{}, &spectypes.EventSpecApisModify{}, &spectypes.EventSpecRemove{}} {
+		specChanges, err := utils.ParseTypedEvents(events, eventType)
+		if err != nil {
+			utils.LavaFormatError("failed to parse spec change events", err, &map[string]string{"event": proto.MessageName(eventType)})
+			continue
+		}
+		for _, event := range specChanges {
+			chainID := event.(interface{ GetChainID() string }).GetChainID()
+			if chainID != s.ChainID {
+				continue
+			}
+			utils.LavaFormatWarning("Spec change passed for the served chain, applying from the next epoch", nil, &map[string]string{"ChainID": chainID, "event": proto.MessageName(event)})
+			if _, removed := event.(*spectypes.EventSpecRemove); removed {
+				s.specMu.Lock()
+				s.specRemoved = true
+				s.specMu.Unlock()
+			}
+		}
+	}
+}
+
 func (s *Sentry) Init(ctx context.Context) error {
 	//
 	// New client
@@ -473,89 +505,64 @@ func (s *Sentry) ListenForTXEvents(ctx context.Context) {
 		switch data := e.Data.(type) {
 		case tenderminttypes.EventDataTx:
 			// got new TX event
-			if providerAddrList, ok := e.Events["lava_relay_payment.provider"]; ok {
-				for idx, providerAddr := range providerAddrList {
-					if s.Acc == providerAddr && s.ChainID == e.Events["lava_relay_payment.chainID"][idx] {
-						utils.LavaFormatInfo("Received relay payment",
-							&map[string]string{
-								"Amount": e.Events["lava_relay_payment.Mint"][idx],
-								"CU":     e.Events["lava_relay_payment.CU"][idx],
-							})
-						CU := e.Events["lava_relay_payment.CU"][idx]
-						paidCU, err := strconv.ParseUint(CU, 10, 64)
-						if err != nil {
-							utils.LavaFormatError("failed to parse payment event CU", err, &map[string]string{"event": e.Events["lava_relay_payment.CU"][idx]})
-							continue
-						}
-						clientAddr, err := sdk.AccAddressFromBech32(e.Events["lava_relay_payment.client"][idx])
-						if err != nil {
-							utils.LavaFormatError("failed to parse payment event client", err, &map[string]string{"event": e.Events["lava_relay_payment.client"][idx]})
-							continue
-						}
-						coin, err := sdk.ParseCoinNormalized(e.Events["lava_relay_payment.Mint"][idx])
-						if err != nil {
-							utils.LavaFormatError("failed to parse payment event mint", err, &map[string]string{"event": e.Events["lava_relay_payment.Mint"][idx]})
-							continue
-						}
-						uniqueID, err := strconv.ParseUint(e.Events["lava_relay_payment.uniqueIdentifier"][idx], 10, 64)
-						if err != nil {
-							utils.LavaFormatError("failed to parse payment event uniqueIdentifier", err, &map[string]string{"event": e.Events["lava_relay_payment.uniqueIdentifier"][idx]})
-							continue
-						}
-						serverID, err := strconv.ParseUint(e.Events["lava_relay_payment.descriptionString"][idx], 10, 64)
-						if err != nil {
-							utils.LavaFormatError("failed to parse payment event serverID", err, &map[string]string{"event": e.Events["lava_relay_payment.descriptionString"][idx]})
-							continue
-						}
-
-						if serverID == s.serverID {
-							s.UpdatePaidCU(paidCU)
-							receivedPayment := PaymentRequest{CU: paidCU, BlockHeightDeadline: data.Height, Amount: coin, Client: clientAddr, UniqueIdentifier: uniqueID}
-							s.AppendToReceivedPayments(receivedPayment)
-							found := s.RemoveExpectedPayment(paidCU, clientAddr, data.Height, uniqueID)
-							if !found {
-								utils.LavaFormatError("payment received, did not find matching expectancy from correct client", nil, &map[string]string{"expected payments": fmt.Sprintf("%v", s.PrintExpectedPayments()), "received payment": fmt.Sprintf("%v", receivedPayment)})
-							} else {
-								utils.LavaFormatInfo("success: payment received as expected", nil)
-							}
-						}
+			relayPayments, err := utils.ParseTypedEvents(e.Events, &pairingtypes.EventRelayPayment{})
+			if err != nil {
+				utils.LavaFormatError("failed to parse relay payment events", err, nil)
+			}
+			for _, event := range relayPayments {
+				payment := event.(*pairingtypes.EventRelayPayment)
+				if s.Acc != payment.Provider || s.ChainID != payment.ChainID {
+					continue
+				}
+				utils.LavaFormatInfo("Received relay payment",
+					&map[string]string{
+						"Amount": payment.Mint.String(),
+						"CU":     strconv.FormatUint(payment.CU, 10),
+					})
+				clientAddr, err := sdk.AccAddressFromBech32(payment.Client)
+				if err != nil {
+					utils.LavaFormatError("failed to parse payment event client", err, &map[string]string{"event": payment.Client})
+					continue
+				}
+				serverID, err := strconv.ParseUint(payment.DescriptionString, 10, 64)
+				if err != nil {
+					utils.LavaFormatError("failed to parse payment event serverID", err, &map[string]string{"event": payment.DescriptionString})
+					continue
+				}
+
+				if serverID == s.serverID {
+					s.UpdatePaidCU(payment.CU)
+					receivedPayment := PaymentRequest{CU: payment.CU, BlockHeightDeadline: data.Height, Amount: payment.Mint, Client: clientAddr, UniqueIdentifier: payment.UniqueIdentifier}
+					s.AppendToReceivedPayments(receivedPayment)
+					found := s.RemoveExpectedPayment(payment.CU, clientAddr, data.Height, payment.UniqueIdentifier)
+					if !found {
+						utils.LavaFormatError("payment received, did not find matching expectancy from correct client", nil, &map[string]string{"expected payments": fmt.Sprintf("%v", s.PrintExpectedPayments()), "received payment": fmt.Sprintf("%v", receivedPayment)})
+					} else {
+						utils.LavaFormatInfo("success: payment received as expected", nil)
 					}
 				}
 			}
 
-			eventToListen := utils.EventPrefix + conflicttypes.ConflictVoteDetectionEventName
+			// a canceled unstake is paired again from the next epoch
+			s.handleUnstakeCancelEvents(e.Events)
+
 			// listen for vote commit event from tx handler on conflict/detection
-			if newVotesList, ok := e.Events[eventToListen+".voteID"]; ok {
-				for idx, voteID := range newVotesList {
-					chainID := e.Events[eventToListen+".chainID"][idx]
-					apiURL := e.Events[eventToListen+".apiURL"][idx]
-					requestData := []byte(e.Events[eventToListen+".requestData"][idx])
-					connectionType := e.Events[eventToListen+".connectionType"][idx]
-					num_str := e.Events[eventToListen+".requestBlock"][idx]
-					requestBlock, err := strconv.ParseUint(num_str, 10, 64)
-					if err != nil {
-						utils.LavaFormatError("vote requested block could not be parsed", err, &map[string]string{"requested block": num_str, "voteID": voteID})
-						continue
-					}
-					num_str = e.Events[eventToListen+".voteDeadline"][idx]
-					voteDeadline, err := strconv.ParseUint(num_str, 10, 64)
-					if err != nil {
-						utils.LavaFormatError("vote deadline could not be parsed", err, &map[string]string{"deadline": num_str, "voteID": voteID})
-						continue
-					}
-					voters_st := e.Events[eventToListen+".voters"][idx]
-					voters := strings.Split(voters_st, ",")
-					voteParams := &VoteParams{
-						ChainID:        chainID,
-						ApiURL:         apiURL,
-						RequestData:    requestData,
-						RequestBlock:   requestBlock,
-						Voters:         voters,
-						CloseVote:      false,
-						ConnectionType: connectionType,
-					}
-					go s.voteInitiationCb(ctx, voteID, voteDeadline, voteParams)
+			voteDetections, err := utils.ParseTypedEvents(e.Events, &conflicttypes.EventConflictVoteDetection{})
+			if err != nil {
+				utils.LavaFormatError("failed to parse conflict vote detection events", err, nil)
+			}
+			for _, event := range voteDetections {
+				detection := event.(*conflicttypes.EventConflictVoteDetection)
+				voteParams := &VoteParams{
+					ChainID:        detection.ChainID,
+					ApiURL:         detection.ApiURL,
+					RequestData:    detection.RequestData,
+					RequestBlock:   detection.RequestBlock,
+					Voters:         detection.Voters,
+					CloseVote:      false,
+					ConnectionType: detection.ConnectionType,
 				}
+				go s.voteInitiationCb(ctx, detection.VoteID, detection.VoteDeadline, voteParams)
 			}
 		default:
 			{
@@ -564,6 +571,20 @@ func (s *Sentry) ListenForTXEvents(ctx context.Context) {
 	}
 }
 
+func (s *Sentry) handleUnstakeCancelEvents(events map[string][]string) {
+	unstakeCancels, err := utils.ParseTypedEvents(events, &pairingtypes.EventUnstakeCancelProvider{})
+	if err != nil {
+		utils.LavaFormatError("failed to parse unstake cancel events", err, nil)
+	}
+	for _, event := range unstakeCancels {
+		entry := event.(*pairingtypes.EventUnstakeCancelProvider).Entry
+		if entry.Address != s.Acc || entry.Chain != s.ChainID {
+			continue
+		}
+		utils.LavaFormatInfo("Provider unstake was canceled, pairing resumes from the next epoch", &map[string]string{"ChainID": s.ChainID, "stake": entry.Stake.String()})
+	}
+}
+
 func (s *Sentry) RemoveExpectedPayment(paidCUToFInd uint64, expectedClient sdk.AccAddress, blockHeight int64, uniqueID uint64) bool {
 	s.PaymentsMu.Lock()
 	defer s.PaymentsMu.Unlock()
@@ -621,7 +642,7 @@ func (s *Sentry) Start(ctx context.Context) {
 			// Update block
 			s.SetBlockHeight(data.Block.Height)
 
-			if _, ok := e.Events["lava_new_epoch.height"]; ok {
+			if _, ok := e.Events[proto.MessageName(&epochstoragetypes.EventNewEpoch{})+".height"]; ok {
 				utils.LavaFormatInfo("New Epoch Event", nil)
 				utils.LavaFormatInfo("New Epoch Info:", &map[string]string{"Height": strconv.FormatInt(data.Block.Height, 10)})
 
@@ -667,51 +688,27 @@ func (s *Sentry) Start(ctx context.Context) {
 				s.clearAuthResponseCache(data.Block.Height) // TODO: Remove this after provider session manager is fully functional
 			}
 
+			// spec proposals take effect from the next epoch, when the spec is fetched again
+			s.handleSpecChangeEvents(e.Events)
+
 			if !s.isUser {
 				// listen for vote reveal event from new block handler on conflict/module.go
-				eventToListen := utils.EventPrefix + conflicttypes.ConflictVoteRevealEventName
-				if votesList, ok := e.Events[eventToListen+".voteID"]; ok {
-					for idx, voteID := range votesList {
-						num_str := e.Events[eventToListen+".voteDeadline"][idx]
-						voteDeadline, err := strconv.ParseUint(num_str, 10, 64)
-						if err != nil {
-							utils.LavaFormatError("parsing vote deadline", err, &map[string]string{"VoteDeadline": num_str})
-							continue
-						}
-						go s.voteInitiationCb(ctx, voteID, voteDeadline, nil)
-					}
+				revealsStarted, err := utils.ParseTypedEvents(e.Events, &conflicttypes.EventConflictVoteRevealStarted{})
+				if err != nil {
+					utils.LavaFormatError("failed to parse vote reveal events", err, nil)
 				}
-
-				eventToListen = utils.EventPrefix + conflicttypes.ConflictVoteResolvedEventName
-				if votesList, ok := e.Events[eventToListen+".voteID"]; ok {
-					for _, voteID := range votesList {
-						voteParams := &VoteParams{CloseVote: true}
-						go s.voteInitiationCb(ctx, voteID, 0, voteParams)
-					}
+				for _, event := range revealsStarted {
+					reveal := event.(*conflicttypes.EventConflictVoteRevealStarted)
+					go s.voteInitiationCb(ctx, reveal.VoteID, reveal.VoteDeadline, nil)
 				}
-			}
 
-			if !s.isUser {
-				// listen for vote reveal event from new block handler on conflict/module.go
-				eventToListen := utils.EventPrefix + conflicttypes.ConflictVoteRevealEventName
-				if votesList, ok := e.Events[eventToListen+".voteID"]; ok {
-					for idx, voteID := range votesList {
-						num_str := e.Events[eventToListen+".voteDeadline"][idx]
-						voteDeadline, err := strconv.ParseUint(num_str, 10, 64)
-						if err != nil {
-							fmt.Printf("ERROR: parsing vote deadline %s, err:%s\n", num_str, err)
-							continue
-						}
-						go s.voteInitiationCb(ctx, voteID, voteDeadline, nil)
-					}
+				votesResolved, err := utils.ParseTypedEvents(e.Events, &conflicttypes.EventConflictVoteResolved{})
+				if err != nil {
+					utils.LavaFormatError("failed to parse vote resolved events", err, nil)
 				}
-
-				eventToListen = utils.EventPrefix + conflicttypes.ConflictVoteResolvedEventName
-				if votesList, ok := e.Events[eventToListen+".voteID"]; ok {
-					for _, voteID := range votesList {
-						voteParams := &VoteParams{CloseVote: true}
-						go s.voteInitiationCb(ctx, voteID, 0, voteParams)
-					}
+				for _, event := range votesResolved {
+					voteParams := &VoteParams{CloseVote: true}
+					go s.voteInitiationCb(ctx, event.(*conflicttypes.EventConflictVoteResolved).VoteID, 0, voteParams)
 				}
 			}
 		default:
@@ -797,19 +794,6 @@ func (s *Sentry) AddExpectedPayment(expectedPay PaymentRequest) {
 	s.expectedPayments = append(s.expectedPayments, expectedPay)
 }
 
-func (s *Sentry) connectRawClient(ctx context.Context, addr string) (*pairingtypes.RelayerClient, error) {
-	connectCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
-	defer cancel()
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
-	if err != nil {
-		return nil, err
-	}
-	/*defer conn.Close()*/
-
-	c := pairingtypes.NewRelayerClient(conn)
-	return &c, nil
-}
-
 func (s *Sentry) CompareRelaysAndReportConflict(reply0 *pairingtypes.RelayReply, request0 *pairingtypes.RelayRequest, reply1 *pairingtypes.RelayReply, request1 *pairingtypes.RelayRequest) (ok bool) {
 	compare_result := bytes.Compare(reply0.Data, reply1.Data)
 	if compare_result == 0 {
@@ -1042,9 +1026,12 @@ func (s *Sentry) SendRelay(
 			var dataReliabilitySessions []*DataReliabilitySession
 
 			// handle data reliability
-			s.VrfSkMu.Lock()
-			vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(request, reply, s.VrfSk, sessionEpoch)
-			s.VrfSkMu.Unlock()
+			vrfRes0, vrfRes1, err := sigs.CalculateVrfOnRelay(s.Signer, request, reply, sessionEpoch)
+			if err != nil {
+				// the relay itself succeeded, only its data reliability is skipped
+				utils.LavaFormatError("failed calculating the data reliability vrf", err, nil)
+				return reply, replyServer, latency, fromCache, nil
+			}
 			// get two indexesMap for data reliability.
 			indexesMap := s.DataReliabilityThresholdToSession([][]byte{vrfRes0, vrfRes1}, []bool{false, true})
 			utils.LavaFormatDebug("DataReliability Randomized Values", &map[string]string{"vrf0": strconv.FormatUint(uint64(binary.LittleEndian.Uint32(vrfRes0)), 10), "vrf1": strconv.FormatUint(uint64(binary.LittleEndian.Uint32(vrfRes1)), 10), "decisionMap": fmt.Sprintf("%+v", indexesMap)})
@@ -1076,9 +1063,10 @@ func (s *Sentry) SendRelay(
 
 			sendReliabilityRelay := func(singleConsumerSession *lavasession.SingleConsumerSession, providerAddress string, differentiator bool) (relay_rep *pairingtypes.RelayReply, relay_req *pairingtypes.RelayRequest, err error) {
 				var dataReliabilityLatency time.Duration
-				s.VrfSkMu.Lock()
-				vrf_res, vrf_proof := utils.ProveVrfOnRelay(request, reply, s.VrfSk, differentiator, sessionEpoch)
-				s.VrfSkMu.Unlock()
+				vrf_res, vrf_proof, err := sigs.ProveVrfOnRelay(s.Signer, request, reply, differentiator, sessionEpoch)
+				if err != nil {
+					return nil, nil, utils.LavaFormatError("failed proving the data reliability vrf", err, nil)
+				}
 				dataReliability := &pairingtypes.VRFData{
 					Differentiator: differentiator,
 					VrfValue:       vrf_res,
@@ -1498,7 +1486,7 @@ func NewSentry(
 	voteInitiationCb func(ctx context.Context, voteID string, voteDeadline uint64, voteParams *VoteParams),
 	newEpochCb func(epochHeight int64),
 	apiInterface string,
-	vrf_sk vrf.PrivateKey,
+	signer sigs.Signer,
 	flagSet *pflag.FlagSet,
 	serverID uint64,
 ) *Sentry {
@@ -1524,7 +1512,7 @@ func NewSentry(
 		Acc:                     acc,
 		newEpochCb:              newEpochCb,
 		ApiInterface:            apiInterface,
-		VrfSk:                   vrf_sk,
+		Signer:                  signer,
 		blockHeight:             currentBlock,
 		specHash:                nil,
 		cmdFlags:                flagSet,
@@ -4,7 +4,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
 const (
@@ -85,18 +87,14 @@ func CheckProfitabilityAndBroadCastTx(clientCtx client.Context, txf tx.Factory,
 	txEvents := simResult.GetResult().Events
 	lavaReward := sdk.NewCoin("ulava", sdk.NewInt(0))
 	for _, txEvent := range txEvents {
-		if txEvent.Type == "lava_relay_payment" {
-			for _, attribute := range txEvent.Attributes {
-				if string(attribute.Key) == "BasePay" {
-					lavaRewardTemp, err := sdk.ParseCoinNormalized(string(attribute.Value))
-					if err != nil {
-						return err
-					}
-					lavaReward = lavaReward.Add(lavaRewardTemp)
-					break
-				}
-			}
+		if txEvent.Type != proto.MessageName(&pairingtypes.EventRelayPayment{}) {
+			continue
 		}
+		event, err := sdk.ParseTypedEvent(txEvent)
+		if err != nil {
+			return err
+		}
+		lavaReward = lavaReward.Add(event.(*pairingtypes.EventRelayPayment).BasePay)
 	}
 
 	txf = txf.WithGas(gasUsed)
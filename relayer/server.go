@@ -25,7 +25,6 @@ import (
 
 	"golang.org/x/exp/slices"
 
-	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +34,7 @@ import (
 	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
 	"github.com/lavanet/lava/relayer/chainsentry"
 	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
@@ -55,7 +55,7 @@ const (
 )
 
 var (
-	g_privKey               *btcSecp256k1.PrivateKey
+	g_signer                sigs.Signer
 	g_sessions              map[string]*UserSessions
 	g_sessions_mutex        utils.LavaMutex
 	g_votes                 map[string]*voteData
@@ -247,9 +247,21 @@ func askForRewards(staleEpochHeight int64) {
 		return
 	}
 
+	// relays the consumer signed a compact proof for are claimed without their payload
+	fullRelays := []*pairingtypes.RelayRequest{}
+	proofs := []*pairingtypes.RelayProof{}
+	for _, relay := range relays {
+		if proof := compactRelayProof(relay); proof != nil {
+			proofs = append(proofs, proof)
+		} else {
+			fullRelays = append(fullRelays, relay)
+		}
+	}
+
 	utils.LavaFormatInfo("asking for rewards", &map[string]string{
 		"account":     g_sentry.Acc,
 		"reliability": fmt.Sprintf("%t", reliability),
+		"proofs":      strconv.Itoa(len(proofs)),
 	})
 
 	myWriter := bytes.Buffer{}
@@ -259,7 +271,8 @@ func askForRewards(staleEpochHeight int64) {
 	sequenceNumberParsed := 0
 	summarizedTransactionResult := ""
 	for ; idx < RETRY_INCORRECT_SEQUENCE && !success; idx++ {
-		msg := pairingtypes.NewMsgRelayPayment(g_sentry.Acc, relays, strconv.FormatUint(g_serverID, 10))
+		msg := pairingtypes.NewMsgRelayPayment(g_sentry.Acc, fullRelays, strconv.FormatUint(g_serverID, 10))
+		msg.Proofs = proofs
 		g_sentry.ClientCtx.Output = &myWriter
 		if hasSequenceError { // a retry
 			// if sequence number error happened it means that we already sent a tx this block.
@@ -348,6 +361,24 @@ func parseTransactionResult(transactionResult string) (string, int) {
 	return summarizedResult, retCode
 }
 
+// compactRelayProof returns the compact payment proof of a relay, or nil if the relay has to be claimed in full
+func compactRelayProof(relay *pairingtypes.RelayRequest) *pairingtypes.RelayProof {
+	if relay.DataReliability != nil || len(relay.ProofSig) == 0 {
+		return nil
+	}
+	proof := relay.RelayProof()
+	proofPubKey, err := sigs.RecoverPubKeyFromRelayProof(*proof)
+	if err != nil {
+		return nil
+	}
+	relayPubKey, err := sigs.RecoverPubKeyFromRelay(*relay)
+	if err != nil || !proofPubKey.Equals(relayPubKey) {
+		// a proof that wasn't signed by the consumer would fail the payment
+		return nil
+	}
+	return proof
+}
+
 func getRelayUser(in *pairingtypes.RelayRequest) (tenderbytes.HexBytes, error) {
 	pubKey, err := sigs.RecoverPubKeyFromRelay(*in)
 	if err != nil {
@@ -910,7 +941,7 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 		// update relay request requestedBlock to the provided one in case it was arbitrary
 		sentry.UpdateRequestedBlock(&request, reply)
 		// Update signature,
-		sig, err := sigs.SignRelayResponse(g_privKey, reply, &request)
+		sig, err := sigs.SignRelayResponse(g_signer, reply, &request)
 		if err != nil {
 			return utils.LavaFormatError("failed signing relay response", err,
 				&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply)})
@@ -919,7 +950,7 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 
 		if g_sentry.GetSpecDataReliabilityEnabled() {
 			// update sig blocks signature
-			sigBlocks, err := sigs.SignResponseFinalizationData(g_privKey, reply, &request, userAddr)
+			sigBlocks, err := sigs.SignResponseFinalizationData(g_signer, reply, &request, userAddr)
 			if err != nil {
 				return utils.LavaFormatError("failed signing finalization data", err,
 					&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply), "userAddr": userAddr.String()})
@@ -1198,10 +1229,18 @@ func Server(
 	g_serverID = uint64(rand.Int63())
 
 	//
+	// Keys
+	signer, err := sigs.GetSigner(ctx, clientCtx, flagSet, false)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to GetSigner", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+	}
+	g_signer = signer
+	txFactory = sigs.TxFactoryWithSigner(txFactory, signer)
+	utils.LavaFormatInfo("Server loaded keys", &map[string]string{"PublicKey": signer.PubKey().Address().String()})
 
 	// Start newSentry
 	newSentry := sentry.NewSentry(clientCtx, txFactory, chainID, false, voteEventHandler, askForRewards, apiInterface, nil, flagSet, g_serverID)
-	err := newSentry.Init(ctx)
+	err = newSentry.Init(ctx)
 	if err != nil {
 		utils.LavaFormatError("sentry init failure to initialize", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
 		return
@@ -1222,20 +1261,6 @@ func Server(
 	// Info
 	utils.LavaFormatInfo("Server starting", &map[string]string{"listenAddr": listenAddr, "ChainID": newSentry.GetChainID(), "node": nodeUrl, "spec": newSentry.GetSpecName(), "api Interface": apiInterface})
 
-	//
-	// Keys
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		utils.LavaFormatFatal("provider failure to getKeyName", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
-	}
-
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		utils.LavaFormatFatal("provider failure to getPrivKey", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
-	}
-	g_privKey = privKey
-	serverKey, _ := clientCtx.Keyring.Key(keyName)
-	utils.LavaFormatInfo("Server loaded keys", &map[string]string{"PublicKey": serverKey.GetPubKey().Address().String()})
 	//
 	// Node
 	// get portal logs
@@ -1285,6 +1310,10 @@ func Server(
 
 	//
 	// GRPC
+	tlsConfig, certFingerprint, err := lavatls.ServerConfigFromFlags(flagSet)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure loading the tls certificate", err, &map[string]string{"ChainID": chainID})
+	}
 	lis, err := net.Listen("tcp", listenAddr)
 	if err != nil {
 		utils.LavaFormatFatal("provider failure setting up listener", err, &map[string]string{"listenAddr": listenAddr, "ChainID": chainID})
@@ -1301,7 +1330,8 @@ func Server(
 	}
 
 	httpServer := http.Server{
-		Handler: h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}),
+		Handler:   h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}),
+		TLSConfig: tlsConfig,
 	}
 
 	go func() {
@@ -1328,7 +1358,11 @@ func Server(
 	if err != nil {
 		utils.LavaFormatError("Failed To Get Cache Address flag", err, &map[string]string{"flags": fmt.Sprintf("%v", flagSet)})
 	} else if cacheAddr != "" {
-		cache, err := performance.InitCache(ctx, cacheAddr)
+		cacheTLS, err := lavatls.ClientConfigFromFlags(flagSet)
+		if err != nil {
+			utils.LavaFormatFatal("provider failure reading the tls flags", err, nil)
+		}
+		cache, err := performance.InitCache(ctx, cacheAddr, cacheTLS)
 		if err != nil {
 			utils.LavaFormatError("Failed To Connect to cache at address", err, &map[string]string{"address": cacheAddr})
 		} else {
@@ -1337,9 +1371,15 @@ func Server(
 		}
 	}
 
-	utils.LavaFormatInfo("Server listening", &map[string]string{"Address": lis.Addr().String()})
+	if tlsConfig == nil {
+		utils.LavaFormatWarning("Server listening in plaintext, relays can be read and modified on the way", nil, &map[string]string{"Address": lis.Addr().String()})
+		err = httpServer.Serve(lis)
+	} else {
+		utils.LavaFormatInfo("Server listening", &map[string]string{"Address": lis.Addr().String(), "tlsCertHash": certFingerprint})
+		err = httpServer.ServeTLS(lis, "", "")
+	}
 	// serve is blocking, until terminated
-	if err := httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+	if !errors.Is(err, http.ErrServerClosed) {
 		utils.LavaFormatFatal("provider failed to serve", err, &map[string]string{"Address": lis.Addr().String(), "ChainID": chainID})
 	}
 	// in case we stop serving, claim rewards
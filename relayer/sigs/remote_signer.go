@@ -0,0 +1,391 @@
+package sigs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	unixSocketPrefix    = "unix://"
+	tcpPrefix           = "tcp://"
+	RemoteSignerTimeout = 3 * time.Second
+	MaxTxFeeFlag        = "max-tx-fee"
+	DefaultMaxTxFee     = "100ulava"
+)
+
+// parseSignerAddress returns the network and the address of a signer address, unix:///path/to/socket or tcp://host:port
+func parseSignerAddress(addr string) (network string, address string, err error) {
+	switch {
+	case strings.HasPrefix(addr, unixSocketPrefix):
+		return "unix", strings.TrimPrefix(addr, unixSocketPrefix), nil
+	case strings.HasPrefix(addr, tcpPrefix):
+		return "tcp", strings.TrimPrefix(addr, tcpPrefix), nil
+	}
+	return "", "", utils.LavaFormatError("the signer address must be unix:///path/to/socket or tcp://host:port", nil, &map[string]string{"address": addr})
+}
+
+// signerTransportSecurity checks the tls config fits the network of the signer:
+// a unix socket is protected by its file permissions, tcp connections need mutual tls
+func signerTransportSecurity(network string, addr string, tlsConfig *tls.Config) error {
+	if network == "unix" && tlsConfig != nil {
+		return utils.LavaFormatError("tls is only used for a signer on tcp, a unix socket is protected by its file permissions", nil, &map[string]string{"address": addr})
+	}
+	if network == "tcp" && tlsConfig == nil {
+		return utils.LavaFormatError("a signer on tcp needs mutual tls", nil, &map[string]string{"address": addr})
+	}
+	return nil
+}
+
+// RemoteSigner signs through a signing daemon (lavad signer), so the keys never enter the relaying process
+type RemoteSigner struct {
+	client pairingtypes.RelaySignerClient
+	pubKey secp256k1.PubKey
+}
+
+// NewRemoteSigner connects to the signing daemon at addr, unix:///path/to/socket or tcp://host:port with the mutual tls config
+func NewRemoteSigner(ctx context.Context, addr string, tlsConfig *tls.Config) (*RemoteSigner, error) {
+	network, address, err := parseSignerAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := signerTransportSecurity(network, addr, tlsConfig); err != nil {
+		return nil, err
+	}
+	// the socket file is only accessible to the user running the signer, so a unix connection needs no credentials of its own
+	target, creds := addr, insecure.NewCredentials()
+	if network == "tcp" {
+		target, creds = address, credentials.NewTLS(tlsConfig)
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, RemoteSignerTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(connectCtx, target, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to the remote signer", err, &map[string]string{"address": addr})
+	}
+	client := pairingtypes.NewRelaySignerClient(conn)
+
+	pubKeysCtx, cancel := context.WithTimeout(ctx, RemoteSignerTimeout)
+	defer cancel()
+	pubKeys, err := client.PubKey(pubKeysCtx, &emptypb.Empty{})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed getting the remote signer public key", err, &map[string]string{"address": addr})
+	}
+	return &RemoteSigner{client: client, pubKey: pubKeys.PubKey}, nil
+}
+
+func (rs *RemoteSigner) PubKey() secp256k1.PubKey {
+	return rs.pubKey
+}
+
+// sign calls the signing method of the signing daemon with a timeout
+func (rs *RemoteSigner) sign(signFunc func(ctx context.Context) (*pairingtypes.SignReply, error)) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteSignerTimeout)
+	defer cancel()
+	reply, err := signFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) SignRelay(request pairingtypes.RelayRequest) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignRelay(ctx, &request)
+	})
+}
+
+func (rs *RemoteSigner) SignRelayProof(proof pairingtypes.RelayProof) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignRelayProof(ctx, &proof)
+	})
+}
+
+func (rs *RemoteSigner) SignVRFData(vrfData pairingtypes.VRFData) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignVRFData(ctx, &vrfData)
+	})
+}
+
+func (rs *RemoteSigner) SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignRelayResponse(ctx, &pairingtypes.SignRelayResponseRequest{Reply: relayResponse, Request: relayReq})
+	})
+}
+
+func (rs *RemoteSigner) SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignResponseFinalizationData(ctx, &pairingtypes.SignFinalizationDataRequest{Reply: relayResponse, Request: relayReq, ClientAddress: clientAddress})
+	})
+}
+
+// SignTx signs the SIGN_MODE_DIRECT sign doc of a transaction of the account
+func (rs *RemoteSigner) SignTx(signDoc []byte) ([]byte, error) {
+	return rs.sign(func(ctx context.Context) (*pairingtypes.SignReply, error) {
+		return rs.client.SignTx(ctx, &pairingtypes.SignTxRequest{SignDoc: signDoc})
+	})
+}
+
+func (rs *RemoteSigner) ComputeVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.VRF(ctx, &pairingtypes.VRFRequest{Request: request, Reply: response, Differentiator: differentiator, Epoch: currentEpoch})
+	if err != nil {
+		return nil, err
+	}
+	return reply.VrfRes, nil
+}
+
+func (rs *RemoteSigner) ProveVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) (vrfRes []byte, proof []byte, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.VRF(ctx, &pairingtypes.VRFRequest{Request: request, Reply: response, Differentiator: differentiator, Epoch: currentEpoch, Prove: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reply.VrfRes, reply.Proof, nil
+}
+
+// signerKeyring signs the transactions of the account through the remote signer, so the keyring of the relaying process only needs the public key of the account
+type signerKeyring struct {
+	keyring.Keyring
+	signer *RemoteSigner
+}
+
+func (sk signerKeyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := sk.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sk.SignByAddress(info.GetAddress(), msg)
+}
+
+func (sk signerKeyring) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	pubKey := &cosmossecp256k1.PubKey{Key: sk.signer.PubKey()}
+	if !bytes.Equal(address.Bytes(), pubKey.Address()) {
+		return nil, nil, utils.LavaFormatError("the remote signer only signs transactions of its account", nil, &map[string]string{"address": address.String()})
+	}
+	sig, err := sk.signer.SignTx(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, pubKey, nil
+}
+
+// TxFactoryWithSigner makes a remote signer sign the transactions of the factory, a local signer leaves the factory signing with the keyring
+func TxFactoryWithSigner(txFactory tx.Factory, signer Signer) tx.Factory {
+	remoteSigner, ok := signer.(*RemoteSigner)
+	if !ok {
+		return txFactory
+	}
+	return txFactory.WithKeybase(signerKeyring{Keyring: txFactory.Keybase(), signer: remoteSigner})
+}
+
+// SignerTxRules are the transactions the signing daemon signs for the relaying process:
+// relay payments and conflict transactions of the account only, on the chain, paying at most MaxFee
+type SignerTxRules struct {
+	Codec   codec.Codec
+	ChainID string
+	MaxFee  sdk.Coins
+}
+
+func (str SignerTxRules) validateSignDoc(signDocBytes []byte, account sdk.AccAddress) error {
+	signDoc := txtypes.SignDoc{}
+	if err := signDoc.Unmarshal(signDocBytes); err != nil {
+		return utils.LavaFormatError("invalid sign doc", err, nil)
+	}
+	// the sign bytes of SIGN_MODE_DIRECT are the encoded sign doc, anything that doesn't encode back the same isn't one
+	if encoded, err := signDoc.Marshal(); err != nil || !bytes.Equal(encoded, signDocBytes) {
+		return utils.LavaFormatError("sign bytes aren't a SIGN_MODE_DIRECT sign doc", err, nil)
+	}
+	if signDoc.ChainId != str.ChainID {
+		return utils.LavaFormatError("sign doc is for another chain", nil, &map[string]string{"chainID": signDoc.ChainId, "signerChainID": str.ChainID})
+	}
+
+	body := txtypes.TxBody{}
+	if err := str.Codec.Unmarshal(signDoc.BodyBytes, &body); err != nil {
+		return utils.LavaFormatError("invalid tx body", err, nil)
+	}
+	if len(body.Messages) == 0 {
+		return utils.LavaFormatError("tx has no messages", nil, nil)
+	}
+	for _, anyMsg := range body.Messages {
+		msg, ok := anyMsg.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return utils.LavaFormatError("tx message can't be decoded", nil, &map[string]string{"typeURL": anyMsg.TypeUrl})
+		}
+		switch msg.(type) {
+		case *pairingtypes.MsgRelayPayment, *conflicttypes.MsgDetection, *conflicttypes.MsgConflictVoteCommit, *conflicttypes.MsgConflictVoteReveal:
+		default:
+			return utils.LavaFormatError("the signer only signs relay payment and conflict transactions", nil, &map[string]string{"typeURL": anyMsg.TypeUrl})
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(account) {
+			return utils.LavaFormatError("tx message isn't signed by the signer account only", nil, &map[string]string{"typeURL": anyMsg.TypeUrl})
+		}
+	}
+
+	authInfo := txtypes.AuthInfo{}
+	if err := str.Codec.Unmarshal(signDoc.AuthInfoBytes, &authInfo); err != nil {
+		return utils.LavaFormatError("invalid tx auth info", err, nil)
+	}
+	if authInfo.Fee == nil || !authInfo.Fee.Amount.IsAllLTE(str.MaxFee) {
+		return utils.LavaFormatError("tx fee is above the signer max fee", nil, &map[string]string{"fee": authInfo.Fee.GetAmount().String(), "maxFee": str.MaxFee.String()})
+	}
+	return nil
+}
+
+// SignerServer serves the keys of a local signer to remote signers, it only signs typed payloads and hashes them itself
+type SignerServer struct {
+	pairingtypes.UnimplementedRelaySignerServer
+	signer  *LocalSigner
+	txRules SignerTxRules
+}
+
+func NewSignerServer(signer *LocalSigner, txRules SignerTxRules) *SignerServer {
+	return &SignerServer{signer: signer, txRules: txRules}
+}
+
+func (ss *SignerServer) PubKey(context.Context, *emptypb.Empty) (*pairingtypes.SignerPubKeys, error) {
+	return &pairingtypes.SignerPubKeys{PubKey: ss.signer.PubKey(), VrfPubKey: ss.signer.VRFPubKey()}, nil
+}
+
+func (ss *SignerServer) SignRelay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.SignReply, error) {
+	sig, err := ss.signer.SignRelay(*request)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) SignRelayProof(ctx context.Context, proof *pairingtypes.RelayProof) (*pairingtypes.SignReply, error) {
+	sig, err := ss.signer.SignRelayProof(*proof)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) SignVRFData(ctx context.Context, vrfData *pairingtypes.VRFData) (*pairingtypes.SignReply, error) {
+	sig, err := ss.signer.SignVRFData(*vrfData)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) SignRelayResponse(ctx context.Context, request *pairingtypes.SignRelayResponseRequest) (*pairingtypes.SignReply, error) {
+	if request.Reply == nil || request.Request == nil {
+		return nil, utils.LavaFormatError("relay response to sign is missing the reply or the request", nil, nil)
+	}
+	sig, err := ss.signer.SignRelayResponse(request.Reply, request.Request)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) SignResponseFinalizationData(ctx context.Context, request *pairingtypes.SignFinalizationDataRequest) (*pairingtypes.SignReply, error) {
+	if request.Reply == nil || request.Request == nil {
+		return nil, utils.LavaFormatError("finalization data to sign is missing the reply or the request", nil, nil)
+	}
+	if err := sdk.VerifyAddressFormat(request.ClientAddress); err != nil {
+		return nil, utils.LavaFormatError("invalid client address of the finalization data", err, nil)
+	}
+	// the finalization data is signed without hashing, so its first 32 bytes are what is signed.
+	// the finalized blocks have to be the json the providers send, so those bytes can't be chosen to be the hash of something else
+	finalizedBlocks := map[int64]string{}
+	if err := json.Unmarshal(request.Reply.FinalizedBlocksHashes, &finalizedBlocks); err != nil {
+		return nil, utils.LavaFormatError("finalized blocks hashes to sign aren't valid", err, nil)
+	}
+	sig, err := ss.signer.SignResponseFinalizationData(request.Reply, request.Request, request.ClientAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) SignTx(ctx context.Context, request *pairingtypes.SignTxRequest) (*pairingtypes.SignReply, error) {
+	if err := ss.txRules.validateSignDoc(request.SignDoc, sdk.AccAddress(ss.signer.PubKey().Address())); err != nil {
+		return nil, err
+	}
+	sig, err := ss.signer.SignTx(request.SignDoc)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.SignReply{Sig: sig}, nil
+}
+
+func (ss *SignerServer) VRF(ctx context.Context, request *pairingtypes.VRFRequest) (*pairingtypes.VRFReply, error) {
+	if request.Request == nil || request.Reply == nil {
+		return nil, utils.LavaFormatError("vrf input is missing the relay request or the reply", nil, nil)
+	}
+	if !request.Prove {
+		vrfRes, err := ss.signer.ComputeVrfOnRelay(request.Request, request.Reply, request.Differentiator, request.Epoch)
+		if err != nil {
+			return nil, err
+		}
+		return &pairingtypes.VRFReply{VrfRes: vrfRes}, nil
+	}
+	vrfRes, proof, err := ss.signer.ProveVrfOnRelay(request.Request, request.Reply, request.Differentiator, request.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &pairingtypes.VRFReply{VrfRes: vrfRes, Proof: proof}, nil
+}
+
+// ListenSigner opens the listener of the signing daemon: a unix socket only accessible to the user running the daemon,
+// or a tcp address that only accepts clients with a certificate of the client CA of the mutual tls config
+func ListenSigner(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+	network, address, err := parseSignerAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := signerTransportSecurity(network, addr, tlsConfig); err != nil {
+		return nil, err
+	}
+	if network == "tcp" {
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+		return tls.NewListener(listener, tlsConfig), nil
+	}
+	// remove a socket left by a previous run
+	if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return listenUnixSocket(address)
+}
+
+// ServeSigner serves the signer on the listener until the context is done
+func ServeSigner(ctx context.Context, listener net.Listener, signer *LocalSigner, txRules SignerTxRules) error {
+	server := grpc.NewServer()
+	pairingtypes.RegisterRelaySignerServer(server, NewSignerServer(signer, txRules))
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	return server.Serve(listener)
+}
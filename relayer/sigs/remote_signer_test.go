@@ -0,0 +1,282 @@
+package sigs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+const signerTestChainID = "lava"
+
+func signerTestCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	pairingtypes.RegisterInterfaces(registry)
+	conflicttypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// startSigner serves the signer on a unix socket of the test and returns its address
+func startSigner(t *testing.T, ctx context.Context, signer *LocalSigner) string {
+	addr := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := ListenSigner(addr, nil)
+	require.Nil(t, err)
+	serveTestSigner(ctx, listener, signer)
+	return addr
+}
+
+func serveTestSigner(ctx context.Context, listener net.Listener, signer *LocalSigner) {
+	txRules := SignerTxRules{Codec: signerTestCodec(), ChainID: signerTestChainID, MaxFee: sdk.NewCoins(sdk.NewInt64Coin("ulava", 100))}
+	go ServeSigner(ctx, listener, signer, txRules)
+}
+
+// testCertificate creates a certificate signed by the parent, a self signed CA when parent is nil, and writes it and its key to pem files
+func testCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (cert *x509.Certificate, key *ecdsa.PrivateKey, certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	cert, err = x509.ParseCertificate(certDER)
+	require.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certFile = filepath.Join(t.TempDir(), name+".crt")
+	keyFile = filepath.Join(t.TempDir(), name+".key")
+	require.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600))
+	require.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return cert, key, certFile, keyFile
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privKey, addr := GenerateFloatingKey()
+	vrfSk, vrfPk, err := utils.GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	localSigner := NewLocalSigner(privKey, vrfSk)
+
+	remoteSigner, err := NewRemoteSigner(ctx, startSigner(t, ctx, localSigner), nil)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(remoteSigner.PubKey().Address()))
+
+	// relays signed remotely recover the account of the signer
+	relayRequest := pairingtypes.RelayRequest{ChainID: "LAV1", SessionId: 1, CuSum: 10, RelayNum: 1, Data: []byte("data")}
+	relayRequest.Sig, err = SignRelay(remoteSigner, relayRequest)
+	require.Nil(t, err)
+	pubKey, err := RecoverPubKeyFromRelay(relayRequest)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(pubKey.Address()))
+
+	relayReply := &pairingtypes.RelayReply{Data: []byte("reply"), LatestBlock: 100, FinalizedBlocksHashes: []byte(`{"99":"hash"}`)}
+	relayReply.Sig, err = SignRelayResponse(remoteSigner, relayReply, &relayRequest)
+	require.Nil(t, err)
+	pubKey, err = RecoverPubKeyFromRelayReply(relayReply, &relayRequest)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(pubKey.Address()))
+
+	relayReply.SigBlocks, err = SignResponseFinalizationData(remoteSigner, relayReply, &relayRequest, addr)
+	require.Nil(t, err)
+	pubKey, err = RecoverPubKeyFromResponseFinalizationData(relayReply, &relayRequest, addr)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(pubKey.Address()))
+
+	// finalization data is signed without hashing, so the signer only signs it with the finalized blocks json of a reply
+	_, err = SignResponseFinalizationData(remoteSigner, &pairingtypes.RelayReply{LatestBlock: 100}, &relayRequest, addr)
+	require.NotNil(t, err)
+
+	// the remote vrf values are the ones of the local key, and verify with its public key
+	vrfRes0, vrfRes1, err := CalculateVrfOnRelay(remoteSigner, &relayRequest, relayReply, 20)
+	require.Nil(t, err)
+	localRes0, localRes1 := utils.CalculateVrfOnRelay(&relayRequest, relayReply, vrfSk, 20)
+	require.Equal(t, localRes0, vrfRes0)
+	require.Equal(t, localRes1, vrfRes1)
+
+	vrfRes, proof, err := ProveVrfOnRelay(remoteSigner, &relayRequest, relayReply, true, 20)
+	require.Nil(t, err)
+	require.Equal(t, vrfRes1, vrfRes)
+	relayRequest.DataReliability = &pairingtypes.VRFData{Differentiator: true, VrfValue: vrfRes, VrfProof: proof, ProviderSig: relayReply.Sig}
+	vrfData := relayRequest.DataReliability
+	vrfData.Sig, err = SignVRFData(remoteSigner, vrfData)
+	require.Nil(t, err)
+	valid, err := ValidateSignerOnVRFData(addr, *vrfData)
+	require.Nil(t, err)
+	require.True(t, valid)
+	vrfPubKey := utils.VrfPubKey{}
+	require.Nil(t, vrfPubKey.Unmarshal(vrfPk))
+	require.True(t, utils.VerifyVrfProof(&relayRequest, vrfPubKey, 20))
+}
+
+func TestRemoteSignerWithoutVRFKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privKey, _ := GenerateFloatingKey()
+	remoteSigner, err := NewRemoteSigner(ctx, startSigner(t, ctx, NewLocalSigner(privKey, nil)), nil)
+	require.Nil(t, err)
+	_, err = remoteSigner.ComputeVrfOnRelay(&pairingtypes.RelayRequest{}, &pairingtypes.RelayReply{}, false, 20)
+	require.NotNil(t, err)
+}
+
+func TestSignerAddress(t *testing.T) {
+	// the address has to say which transport it uses
+	_, err := ListenSigner("127.0.0.1:0", nil)
+	require.NotNil(t, err)
+	_, err = NewRemoteSigner(context.Background(), "127.0.0.1:2345", nil)
+	require.NotNil(t, err)
+
+	// tcp needs mutual tls, a unix socket is protected by its file permissions instead
+	_, err = ListenSigner("tcp://127.0.0.1:0", nil)
+	require.NotNil(t, err)
+	_, err = NewRemoteSigner(context.Background(), "tcp://127.0.0.1:2345", nil)
+	require.NotNil(t, err)
+	_, err = ListenSigner("unix://"+filepath.Join(t.TempDir(), "signer.sock"), &tls.Config{})
+	require.NotNil(t, err)
+}
+
+func TestSignerSocketPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := ListenSigner("unix://"+path, nil)
+	require.Nil(t, err)
+	defer listener.Close()
+
+	// the socket is created accessible only to the user running the signer
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	require.Zero(t, info.Mode().Perm()&0o077)
+}
+
+func TestRemoteSignerMutualTLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ca, caKey, caFile, _ := testCertificate(t, "ca", nil, nil)
+	_, _, serverCertFile, serverKeyFile := testCertificate(t, "signer", ca, caKey)
+	_, _, clientCertFile, clientKeyFile := testCertificate(t, "relayer", ca, caKey)
+	otherCA, otherCAKey, _, _ := testCertificate(t, "other-ca", nil, nil)
+	_, _, otherCertFile, otherKeyFile := testCertificate(t, "other-relayer", otherCA, otherCAKey)
+
+	serverTLS, err := NewSignerServerTLSConfig(serverCertFile, serverKeyFile, caFile)
+	require.Nil(t, err)
+	listener, err := ListenSigner("tcp://127.0.0.1:0", serverTLS)
+	require.Nil(t, err)
+	privKey, addr := GenerateFloatingKey()
+	serveTestSigner(ctx, listener, NewLocalSigner(privKey, nil))
+	signerAddr := "tcp://" + listener.Addr().String()
+
+	// a relayer with a client certificate of the CA signs through the signer
+	clientTLS, err := NewRemoteSignerTLSConfig(clientCertFile, clientKeyFile, caFile)
+	require.Nil(t, err)
+	remoteSigner, err := NewRemoteSigner(ctx, signerAddr, clientTLS)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(remoteSigner.PubKey().Address()))
+	relayRequest := pairingtypes.RelayRequest{ChainID: "LAV1", SessionId: 1, CuSum: 10, RelayNum: 1, Data: []byte("data")}
+	relayRequest.Sig, err = SignRelay(remoteSigner, relayRequest)
+	require.Nil(t, err)
+	pubKey, err := RecoverPubKeyFromRelay(relayRequest)
+	require.Nil(t, err)
+	require.Equal(t, addr, sdk.AccAddress(pubKey.Address()))
+
+	// a client certificate of another CA is rejected
+	otherTLS, err := NewRemoteSignerTLSConfig(otherCertFile, otherKeyFile, caFile)
+	require.Nil(t, err)
+	_, err = NewRemoteSigner(ctx, signerAddr, otherTLS)
+	require.NotNil(t, err)
+
+	// and so is a client without a certificate
+	noCertTLS := clientTLS.Clone()
+	noCertTLS.Certificates = nil
+	_, err = NewRemoteSigner(ctx, signerAddr, noCertTLS)
+	require.NotNil(t, err)
+}
+
+func TestRemoteSignerTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privKey, addr := GenerateFloatingKey()
+	remoteSigner, err := NewRemoteSigner(ctx, startSigner(t, ctx, NewLocalSigner(privKey, nil)), nil)
+	require.Nil(t, err)
+
+	// the keyring of the relaying process only holds the public key of the account
+	pubKey := &cosmossecp256k1.PubKey{Key: remoteSigner.PubKey()}
+	kr := keyring.NewInMemory()
+	_, err = kr.SavePubKey("provider", pubKey, hd.Secp256k1Type)
+	require.Nil(t, err)
+	txKeyring := signerKeyring{Keyring: kr, signer: remoteSigner}
+
+	cdc := signerTestCodec()
+	signDoc := func(msg sdk.Msg, fee sdk.Coins, chainID string) []byte {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.Nil(t, err)
+		bodyBytes, err := cdc.Marshal(&txtypes.TxBody{Messages: []*codectypes.Any{anyMsg}})
+		require.Nil(t, err)
+		authInfoBytes, err := cdc.Marshal(&txtypes.AuthInfo{Fee: &txtypes.Fee{Amount: fee, GasLimit: 100000}})
+		require.Nil(t, err)
+		signDocBytes, err := (&txtypes.SignDoc{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, ChainId: chainID, AccountNumber: 1}).Marshal()
+		require.Nil(t, err)
+		return signDocBytes
+	}
+	relayPayment := pairingtypes.NewMsgRelayPayment(addr.String(), []*pairingtypes.RelayRequest{}, "")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ulava", 1))
+
+	// relay payments of the account are signed like the keyring signs them
+	signBytes := signDoc(relayPayment, fee, signerTestChainID)
+	sig, signPubKey, err := txKeyring.Sign("provider", signBytes)
+	require.Nil(t, err)
+	require.True(t, signPubKey.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	// anything else isn't signed
+	_, err = remoteSigner.SignTx(DataToSignRelayResponse(&pairingtypes.RelayReply{}, &pairingtypes.RelayRequest{}))
+	require.NotNil(t, err)
+	_, err = remoteSigner.SignTx(signDoc(banktypes.NewMsgSend(addr, addr, fee), fee, signerTestChainID))
+	require.NotNil(t, err)
+	_, otherAddr := GenerateFloatingKey()
+	_, err = remoteSigner.SignTx(signDoc(pairingtypes.NewMsgRelayPayment(otherAddr.String(), []*pairingtypes.RelayRequest{}, ""), fee, signerTestChainID))
+	require.NotNil(t, err)
+	_, err = remoteSigner.SignTx(signDoc(relayPayment, sdk.NewCoins(sdk.NewInt64Coin("ulava", 101)), signerTestChainID))
+	require.NotNil(t, err)
+	_, err = remoteSigner.SignTx(signDoc(relayPayment, fee, "other-chain"))
+	require.NotNil(t, err)
+}
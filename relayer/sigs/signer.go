@@ -0,0 +1,163 @@
+package sigs
+
+import (
+	"context"
+	"strings"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/cosmos/cosmos-sdk/client"
+	cosmossecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/pflag"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const RemoteSignerFlag = "remote-signer"
+
+var NoVRFKeyError = sdkerrors.New("NoVRFKey Error", 1101, "the signer has no vrf key")
+
+// Signer signs the relay data with the account key and computes the vrf values with the account vrf key,
+// the keys themselves are never exposed so they can be kept out of the relaying process.
+// the signer hashes the payloads itself, so a remote signer never signs a hash it didn't compute
+type Signer interface {
+	PubKey() secp256k1.PubKey
+	SignRelay(request pairingtypes.RelayRequest) ([]byte, error)
+	SignRelayProof(proof pairingtypes.RelayProof) ([]byte, error)
+	SignVRFData(vrfData pairingtypes.VRFData) ([]byte, error)
+	SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error)
+	SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error)
+	ComputeVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) ([]byte, error)
+	ProveVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) (vrfRes []byte, proof []byte, err error)
+}
+
+// LocalSigner holds the keys in the process memory
+type LocalSigner struct {
+	privKey *btcSecp256k1.PrivateKey
+	vrfSk   vrf.PrivateKey
+}
+
+// NewLocalSigner creates a signer from the keys, vrfSk can be nil for a signer that is only used to sign
+func NewLocalSigner(privKey *btcSecp256k1.PrivateKey, vrfSk vrf.PrivateKey) *LocalSigner {
+	return &LocalSigner{privKey: privKey, vrfSk: vrfSk}
+}
+
+func (ls *LocalSigner) PubKey() secp256k1.PubKey {
+	return ls.privKey.PubKey().SerializeCompressed()
+}
+
+func (ls *LocalSigner) signHash(hash []byte) ([]byte, error) {
+	return btcSecp256k1.SignCompact(btcSecp256k1.S256(), ls.privKey, hash, false)
+}
+
+func (ls *LocalSigner) SignRelay(request pairingtypes.RelayRequest) ([]byte, error) {
+	request.DataReliability = nil // its not a part of the signature, its a separate part
+	request.Sig = []byte{}
+	return ls.signHash(HashMsg([]byte(request.String())))
+}
+
+func (ls *LocalSigner) SignRelayProof(proof pairingtypes.RelayProof) ([]byte, error) {
+	proof.Sig = []byte{}
+	return ls.signHash(HashMsg([]byte(proof.String())))
+}
+
+func (ls *LocalSigner) SignVRFData(vrfData pairingtypes.VRFData) ([]byte, error) {
+	return ls.signHash(HashMsg([]byte(vrfData.String())))
+}
+
+func (ls *LocalSigner) SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
+	return ls.signHash(DataToSignRelayResponse(relayResponse, relayReq))
+}
+
+func (ls *LocalSigner) SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	return ls.signHash(DataToSignResponseFinalizationData(relayResponse, relayReq, clientAddress))
+}
+
+// SignTx signs the sign bytes of a transaction of the account, like the keyring does
+func (ls *LocalSigner) SignTx(signBytes []byte) ([]byte, error) {
+	privKey := cosmossecp256k1.PrivKey{Key: ls.privKey.Serialize()}
+	return privKey.Sign(signBytes)
+}
+
+func (ls *LocalSigner) VRFPubKey() []byte {
+	if ls.vrfSk == nil {
+		return nil
+	}
+	pk, _ := ls.vrfSk.Public()
+	return pk
+}
+
+func (ls *LocalSigner) ComputeVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) ([]byte, error) {
+	if ls.vrfSk == nil {
+		return nil, NoVRFKeyError
+	}
+	return ls.vrfSk.Compute(utils.FormatDataForVrf(request, response, differentiator, currentEpoch)), nil
+}
+
+func (ls *LocalSigner) ProveVrfOnRelay(request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) (vrfRes []byte, proof []byte, err error) {
+	if ls.vrfSk == nil {
+		return nil, nil, NoVRFKeyError
+	}
+	vrfRes, proof = ls.vrfSk.Prove(utils.FormatDataForVrf(request, response, differentiator, currentEpoch))
+	return vrfRes, proof, nil
+}
+
+// GetSigner returns a remote signer when the remote signer flag is set, and otherwise a local signer with the keys of the keyring.
+// the vrf key is only loaded (or created) for a local signer when withVRF is set
+func GetSigner(ctx context.Context, clientCtx client.Context, flagSet *pflag.FlagSet, withVRF bool) (Signer, error) {
+	remoteAddr, err := flagSet.GetString(RemoteSignerFlag)
+	if err == nil && strings.TrimSpace(remoteAddr) != "" {
+		tlsConfig, err := RemoteSignerTLSConfigFromFlags(flagSet)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := NewRemoteSigner(ctx, remoteAddr, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		// a signer of another account would only produce relays nobody pays for
+		signerAddress := sdk.AccAddress(signer.PubKey().Address())
+		if !clientCtx.GetFromAddress().Equals(signerAddress) {
+			return nil, utils.LavaFormatError("remote signer account doesn't match the from account", nil, &map[string]string{"signer": signerAddress.String(), "from": clientCtx.GetFromAddress().String()})
+		}
+		return signer, nil
+	}
+
+	keyName, err := GetKeyName(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := GetPrivKey(clientCtx, keyName)
+	if err != nil {
+		return nil, err
+	}
+	var vrfSk vrf.PrivateKey
+	if withVRF {
+		vrfSk, _, err = utils.GetOrCreateVRFKey(clientCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewLocalSigner(privKey, vrfSk), nil
+}
+
+// CalculateVrfOnRelay is utils.CalculateVrfOnRelay with the vrf key of the signer
+func CalculateVrfOnRelay(signer Signer, request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, currentEpoch uint64) ([]byte, []byte, error) {
+	vrfRes0, err := signer.ComputeVrfOnRelay(request, response, false, currentEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	vrfRes1, err := signer.ComputeVrfOnRelay(request, response, true, currentEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vrfRes0, vrfRes1, nil
+}
+
+// ProveVrfOnRelay is utils.ProveVrfOnRelay with the vrf key of the signer
+func ProveVrfOnRelay(signer Signer, request *pairingtypes.RelayRequest, response *pairingtypes.RelayReply, differentiator bool, currentEpoch uint64) (vrfRes []byte, proof []byte, err error) {
+	return signer.ProveVrfOnRelay(request, response, differentiator, currentEpoch)
+}
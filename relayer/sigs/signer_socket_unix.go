@@ -0,0 +1,17 @@
+//go:build !windows
+// +build !windows
+
+package sigs
+
+import (
+	"net"
+	"syscall"
+)
+
+// listenUnixSocket creates the socket file with a umask that leaves it accessible only to the user running the signer,
+// so it is never accessible to others, not even between its creation and a chmod
+func listenUnixSocket(path string) (net.Listener, error) {
+	oldMask := syscall.Umask(0o077)
+	defer syscall.Umask(oldMask)
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package sigs
+
+import (
+	"fmt"
+	"net"
+)
+
+// listenUnixSocket isn't supported on windows, where the socket file permissions can't be restricted before it is created
+func listenUnixSocket(path string) (net.Listener, error) {
+	return nil, fmt.Errorf("the signer can't listen on a unix socket on windows, listen on tcp://host:port with mutual tls")
+}
@@ -0,0 +1,118 @@
+package sigs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/lavanet/lava/relayer/lavatls"
+	"github.com/spf13/pflag"
+)
+
+const (
+	RemoteSignerCertFlag = "remote-signer-cert"
+	RemoteSignerKeyFlag  = "remote-signer-key"
+	RemoteSignerCAFlag   = "remote-signer-ca"
+	SignerClientCAFlag   = "tls-client-ca"
+)
+
+// AddRemoteSignerFlags adds the flags of processes that sign through a remote signer
+func AddRemoteSignerFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(RemoteSignerFlag, "", "address of a remote signer (lavad signer) holding the relay keys, unix:///path/to/socket or tcp://host:port")
+	flagSet.String(RemoteSignerCertFlag, "", "pem file of the tls client certificate a tcp remote signer is connected to with")
+	flagSet.String(RemoteSignerKeyFlag, "", "pem file of the tls client certificate key")
+	flagSet.String(RemoteSignerCAFlag, "", "pem file of the CA the certificate of a tcp remote signer is validated against")
+}
+
+// AddSignerFlags adds the tls flags of the signing daemon
+func AddSignerFlags(flagSet *pflag.FlagSet) {
+	flagSet.String(lavatls.TLSCertFlag, "", "pem file of the tls certificate the signer serves tcp connections with")
+	flagSet.String(lavatls.TLSKeyFlag, "", "pem file of the tls certificate key")
+	flagSet.String(SignerClientCAFlag, "", "pem file of the CA the client certificates of the relaying processes are validated against")
+}
+
+// loadCertPool reads the certificates of a pem file into a pool
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return pool, nil
+}
+
+// NewSignerServerTLSConfig returns the tls config of a signer listening on tcp, it only accepts clients with a certificate of the client CA
+func NewSignerServerTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading the tls key pair: %w", err)
+	}
+	clientCAs, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		NextProtos:   []string{"h2"},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewRemoteSignerTLSConfig returns the tls config of a relaying process connecting to a signer over tcp,
+// it presents the client certificate and validates the signer certificate against the CA
+func NewRemoteSignerTLSConfig(certFile string, keyFile string, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading the tls key pair: %w", err)
+	}
+	rootCAs, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// tlsFilesFromFlags returns the files of mutual tls set by the flags, they are either all set or all empty
+func tlsFilesFromFlags(flagSet *pflag.FlagSet, certFlag string, keyFlag string, caFlag string) (files []string, err error) {
+	for _, flagName := range []string{certFlag, keyFlag, caFlag} {
+		file, err := flagSet.GetString(flagName)
+		if err != nil {
+			return nil, err
+		}
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	if len(files) != 0 && len(files) != 3 {
+		return nil, fmt.Errorf("mutual tls needs all of --%s, --%s and --%s", certFlag, keyFlag, caFlag)
+	}
+	return files, nil
+}
+
+// SignerServerTLSConfigFromFlags returns the tls config set by --tls-cert, --tls-key and --tls-client-ca, nil when none is set
+func SignerServerTLSConfigFromFlags(flagSet *pflag.FlagSet) (*tls.Config, error) {
+	files, err := tlsFilesFromFlags(flagSet, lavatls.TLSCertFlag, lavatls.TLSKeyFlag, SignerClientCAFlag)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	return NewSignerServerTLSConfig(files[0], files[1], files[2])
+}
+
+// RemoteSignerTLSConfigFromFlags returns the tls config set by --remote-signer-cert, --remote-signer-key and --remote-signer-ca, nil when none is set
+func RemoteSignerTLSConfigFromFlags(flagSet *pflag.FlagSet) (*tls.Config, error) {
+	files, err := tlsFilesFromFlags(flagSet, RemoteSignerCertFlag, RemoteSignerKeyFlag, RemoteSignerCAFlag)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	return NewRemoteSignerTLSConfig(files[0], files[1], files[2])
+}
@@ -51,29 +51,16 @@ func HashMsg(msgData []byte) []byte {
 	return tendermintcrypto.Sha256(msgData)
 }
 
-func SignVRFData(pkey *btcSecp256k1.PrivateKey, vrfData *pairingtypes.VRFData) ([]byte, error) {
-	msgData := []byte(vrfData.String())
-	// Sign
-	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, HashMsg(msgData), false)
-	if err != nil {
-		return nil, err
-	}
-
-	return sig, nil
+func SignVRFData(signer Signer, vrfData *pairingtypes.VRFData) ([]byte, error) {
+	return signer.SignVRFData(*vrfData)
 }
 
-func SignRelay(pkey *btcSecp256k1.PrivateKey, request pairingtypes.RelayRequest) ([]byte, error) {
-	//
-	request.DataReliability = nil // its not a part of the signature, its a separate part
-	request.Sig = []byte{}
-	msgData := []byte(request.String())
-	// Sign
-	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, HashMsg(msgData), false)
-	if err != nil {
-		return nil, err
-	}
+func SignRelay(signer Signer, request pairingtypes.RelayRequest) ([]byte, error) {
+	return signer.SignRelay(request)
+}
 
-	return sig, nil
+func SignRelayProof(signer Signer, proof pairingtypes.RelayProof) ([]byte, error) {
+	return signer.SignRelayProof(proof)
 }
 
 func AllDataHash(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) (data_hash []byte) {
@@ -116,27 +103,13 @@ func DataToSignResponseFinalizationDataInner(latestBlock int64, sessionID uint64
 	return bytes.Join([][]byte{latestBlockBytes, finalizedBlockHashes, sessionIdBytes, blockHeightBytes, relayNumBytes, clientAddress}, nil)
 }
 
-func SignRelayResponse(pkey *btcSecp256k1.PrivateKey, relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
+func SignRelayResponse(signer Signer, relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
 	relayResponse.Sig = []byte{}
-	dataToSign := DataToSignRelayResponse(relayResponse, relayReq)
-	// Sign
-	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, dataToSign, false)
-	if err != nil {
-		return nil, err
-	}
-
-	return sig, nil
+	return signer.SignRelayResponse(relayResponse, relayReq)
 }
 
-func SignResponseFinalizationData(pkey *btcSecp256k1.PrivateKey, relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
-	dataToSign := DataToSignResponseFinalizationData(relayResponse, relayReq, clientAddress)
-	// Sign
-	sig, err := btcSecp256k1.SignCompact(btcSecp256k1.S256(), pkey, dataToSign, false)
-	if err != nil {
-		return nil, err
-	}
-
-	return sig, nil
+func SignResponseFinalizationData(signer Signer, relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	return signer.SignResponseFinalizationData(relayResponse, relayReq, clientAddress)
 }
 
 func RecoverPubKey(sig []byte, msgHash []byte) (secp256k1.PubKey, error) {
@@ -218,6 +191,17 @@ func RecoverPubKeyFromRelay(in pairingtypes.RelayRequest) (secp256k1.PubKey, err
 	return pubKey, nil
 }
 
+func RecoverPubKeyFromRelayProof(in pairingtypes.RelayProof) (secp256k1.PubKey, error) {
+	signature := in.Sig
+	in.Sig = []byte{}
+	hash := HashMsg([]byte(in.String()))
+	pubKey, err := RecoverPubKey(signature, hash)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
 func RecoverPubKeyFromRelayReply(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) (secp256k1.PubKey, error) {
 	dataToSign := DataToSignRelayResponse(relayResponse, relayReq)
 	pubKey, err := RecoverPubKey(relayResponse.Sig, dataToSign)
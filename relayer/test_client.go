@@ -9,10 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/relayer/lavatls"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/relayer/testclients"
-	"github.com/lavanet/lava/utils"
 	"github.com/spf13/pflag"
 )
 
@@ -29,12 +29,13 @@ func TestClient(
 	rand.Seed(time.Now().UnixNano())
 
 	//
-	sk, _, err := utils.GetOrCreateVRFKey(clientCtx)
+	signer, err := sigs.GetSigner(ctx, clientCtx, flagSet, true)
 	if err != nil {
-		log.Fatalln("error: GetOrCreateVRFKey", err)
+		log.Fatalln("error: GetSigner", err)
 	}
+	txFactory = sigs.TxFactoryWithSigner(txFactory, signer)
 	// Start sentry
-	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, sk, flagSet, 0)
+	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, signer, flagSet, 0)
 	err = sentry.Init(ctx)
 	if err != nil {
 		log.Fatalln("error sentry.Init", err)
@@ -50,6 +51,11 @@ func TestClient(
 	if err != nil {
 		log.Fatalln("error: GetChainProxy", err)
 	}
+	providerTLS, err := lavatls.ClientConfigFromFlags(flagSet)
+	if err != nil {
+		log.Fatalln("error: ClientConfigFromFlags", err)
+	}
+	chainProxy.GetConsumerSessionManager().SetProviderTLS(providerTLS)
 	err = sentry.SetupConsumerSessionManager(ctx, chainProxy.GetConsumerSessionManager())
 	if err != nil {
 		log.Fatalln("error: SetupConsumerSessionManager", err)
@@ -59,17 +65,7 @@ func TestClient(
 	// Set up a connection to the server.
 	log.Println("TestClient connecting")
 
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		log.Fatalln("error: getKeyName", err)
-	}
-
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		log.Fatalln("error: getPrivKey", err)
-	}
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
-	log.Println("Client pubkey", clientKey.GetPubKey().Address())
+	log.Println("Client pubkey", signer.PubKey().Address())
 
 	testDuration := time.Second * time.Duration(duration)
 	// Run tests
@@ -82,21 +78,21 @@ func TestClient(
 	case "FTM250":
 		testErrors = testclients.EthTests(ctx, chainID, "http://127.0.0.1:3336/1", testDuration)
 	case "COS1":
-		testErrors = testclients.TerraTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.TerraTests(ctx, chainProxy, signer, apiInterface)
 	case "COS3", "COS4":
-		testErrors = testclients.OsmosisTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.OsmosisTests(ctx, chainProxy, signer, apiInterface)
 	case "LAV1":
-		testErrors = testclients.LavaTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.LavaTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "APT1":
-		testErrors = testclients.AptosTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.AptosTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "JUN1":
-		testErrors = testclients.JunoTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.JunoTests(ctx, chainProxy, signer, apiInterface)
 	case "COS5":
-		testErrors = testclients.CosmoshubTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.CosmoshubTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "STRK", "STRKT":
-		testErrors = testclients.StarknetTests(ctx, chainID, "http://127.0.0.1:3347/1", chainProxy, privKey, testDuration)
+		testErrors = testclients.StarknetTests(ctx, chainID, "http://127.0.0.1:3347/1", chainProxy, signer, testDuration)
 	case "POLYGON1", "POLYGON1T":
-		testErrors = testclients.PolygonTests(ctx, chainID, "http://127.0.0.1:3351/1", chainProxy, privKey, testDuration)
+		testErrors = testclients.PolygonTests(ctx, chainID, "http://127.0.0.1:3351/1", chainProxy, signer, testDuration)
 	}
 
 	if testErrors != nil {
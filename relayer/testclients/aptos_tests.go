@@ -7,10 +7,10 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/lavanet/lava/relayer/chainproxy"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
@@ -20,7 +20,7 @@ const (
 )
 
 // AptosTests
-func AptosTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *btcec.PrivateKey, apiInterface string, s *sentry.Sentry, clientCtx client.Context) error {
+func AptosTests(ctx context.Context, chainProxy chainproxy.ChainProxy, signer sigs.Signer, apiInterface string, s *sentry.Sentry, clientCtx client.Context) error {
 	errors := []string{}
 	log.Println("Aptos test")
 	if apiInterface == restString {
@@ -43,7 +43,7 @@ func AptosTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *
 		for httpMethod, api := range mostImportantApisToTest {
 			for _, api_value := range api {
 				for i := 0; i < 100; i++ {
-					reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, api_value, "", httpMethod, "aptos_test", nil)
+					reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, api_value, "", httpMethod, "aptos_test", nil)
 					if err != nil {
 						log.Println(err)
 						errors = append(errors, fmt.Sprintf("%s", err))
@@ -73,7 +73,7 @@ func AptosTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *
 					continue
 				}
 				log.Printf("%s", apiName)
-				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, apiName, "", http.MethodGet, "aptos_test", nil)
+				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, apiName, "", http.MethodGet, "aptos_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
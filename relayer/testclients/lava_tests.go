@@ -7,14 +7,14 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/lavanet/lava/relayer/chainproxy"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
 )
 
 // LavaTests
-func LavaTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *btcec.PrivateKey, apiInterface string, s *sentry.Sentry, clientCtx client.Context) error {
+func LavaTests(ctx context.Context, chainProxy chainproxy.ChainProxy, signer sigs.Signer, apiInterface string, s *sentry.Sentry, clientCtx client.Context) error {
 	errors := []string{}
 	if apiInterface == restString {
 		log.Println("starting run important apis")
@@ -37,7 +37,7 @@ func LavaTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *b
 		for httpMethod, api := range mostImportantApisToTest {
 			for _, api_value := range api {
 				for i := 0; i < 100; i++ {
-					reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, api_value, "", httpMethod, "lava_test", nil)
+					reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, api_value, "", httpMethod, "lava_test", nil)
 					if err != nil {
 						log.Println(err)
 						errors = append(errors, fmt.Sprintf("%s", err))
@@ -66,7 +66,7 @@ func LavaTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *b
 					continue
 				}
 				log.Printf("%s", apiName)
-				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, apiName, "", http.MethodGet, "lava_test", nil)
+				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, apiName, "", http.MethodGet, "lava_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
@@ -7,11 +7,11 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/relayer/sigs"
 )
 
-func OsmosisTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey *btcec.PrivateKey, apiInterface string) error {
+func OsmosisTests(ctx context.Context, chainProxy chainproxy.ChainProxy, signer sigs.Signer, apiInterface string) error {
 	errors := []string{}
 	switch apiInterface {
 	case restString:
@@ -39,7 +39,7 @@ func OsmosisTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey
 			for httpMethod, api := range mostImportantApisToTest {
 				for _, api_value := range api {
 					for i := 0; i < 20; i++ {
-						reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, api_value, "", httpMethod, "osmo_test", nil)
+						reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, api_value, "", httpMethod, "osmo_test", nil)
 						if err != nil {
 							log.Println(err)
 							errors = append(errors, fmt.Sprintf("%s", err))
@@ -52,14 +52,14 @@ func OsmosisTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey
 
 			// other osmosis tests
 			for i := 0; i < 100; i++ {
-				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, TERRA_BLOCKS_LATEST_URL_REST, TERRA_BLOCKS_LATEST_DATA_REST, http.MethodGet, "osmo_test", nil)
+				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, TERRA_BLOCKS_LATEST_URL_REST, TERRA_BLOCKS_LATEST_DATA_REST, http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println("1:" + err.Error())
 					errors = append(errors, fmt.Sprintf("%s", err))
 				} else {
 					prettyPrintReply(*reply, "TERRA_BLOCKS_LATEST_URL_REST")
 				}
-				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, privKey, OSMOSIS_NUM_POOLS_URL_REST, OSMOSIS_NUM_POOLS_DATA_REST, http.MethodGet, "osmo_test", nil)
+				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, signer, OSMOSIS_NUM_POOLS_URL_REST, OSMOSIS_NUM_POOLS_DATA_REST, http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println("1:" + err.Error())
 					errors = append(errors, fmt.Sprintf("%s", err))
@@ -71,28 +71,28 @@ func OsmosisTests(ctx context.Context, chainProxy chainproxy.ChainProxy, privKey
 	case tendermintString:
 		{
 			for i := 0; i < 100; i++ {
-				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, "", JSONRPC_TERRA_STATUS, http.MethodGet, "osmo_test", nil)
+				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, "", JSONRPC_TERRA_STATUS, http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
 				} else {
 					prettyPrintReply(*reply, "JSONRPC_TERRA_STATUS")
 				}
-				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, privKey, "", JSONRPC_TERRA_HEALTH, http.MethodGet, "osmo_test", nil)
+				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, signer, "", JSONRPC_TERRA_HEALTH, http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
 				} else {
 					prettyPrintReply(*reply, "JSONRPC_TERRA_HEALTH")
 				}
-				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, privKey, URIRPC_TERRA_STATUS, "", http.MethodGet, "osmo_test", nil)
+				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, signer, URIRPC_TERRA_STATUS, "", http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
 				} else {
 					prettyPrintReply(*reply, "URIRPC_TERRA_STATUS")
 				}
-				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, privKey, URIRPC_TERRA_HEALTH, "", http.MethodGet, "osmo_test", nil)
+				reply, _, err = chainproxy.SendRelay(ctx, chainProxy, signer, URIRPC_TERRA_HEALTH, "", http.MethodGet, "osmo_test", nil)
 				if err != nil {
 					log.Println(err)
 					errors = append(errors, fmt.Sprintf("%s", err))
@@ -7,8 +7,8 @@ import (
 
 	"github.com/lavanet/lava/utils"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/relayer/sigs"
 )
 
 const (
@@ -35,13 +35,13 @@ var polygon_tests = []struct{ name, payload string }{
 	// [NOT SUPPORTED] { "eth_getRootHash", JSONRPC_ETH_GETROOTHASH },
 }
 
-func PolygonTests(ctx context.Context, chainID string, rpcURL string, chainProxy chainproxy.ChainProxy, privKey *btcec.PrivateKey, testDuration time.Duration) error {
+func PolygonTests(ctx context.Context, chainID string, rpcURL string, chainProxy chainproxy.ChainProxy, signer sigs.Signer, testDuration time.Duration) error {
 	utils.LavaFormatInfo("Starting "+chainID+" Tests", nil)
 
 	for start := time.Now(); time.Since(start) < testDuration; {
 		for j := 0; j < 10; j++ {
 			for _, t := range polygon_tests {
-				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, privKey, rpcURL, t.payload, http.MethodGet, "polygon_test", nil)
+				reply, _, err := chainproxy.SendRelay(ctx, chainProxy, signer, rpcURL, t.payload, http.MethodGet, "polygon_test", nil)
 				if err != nil {
 					return utils.LavaFormatError("error "+t.name, err, nil)
 				}
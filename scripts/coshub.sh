@@ -16,12 +16,12 @@ go run ./testutil/e2e/proxy/. $GAIA_RPC  -p $MOCK_PORT_B -cache -id coshub_rpc
 
 echo " ::: RUNNING COS5 PROVIDERS :::"
 
-lavad server 127.0.0.1 2331 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer1 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2332 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer2 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2333 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer3 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2344 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer1 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2342 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer2 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2343 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer3 --geolocation 1 --log_level debug 
+lavad server 127.0.0.1 2331 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer1 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2332 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer2 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2333 http://0.0.0.0:$MOCK_PORT_A/ COS5 rest --from servicer3 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2344 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer1 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2342 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer2 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2343 http://0.0.0.0:$MOCK_PORT_B/ COS5 tendermintrpc --from servicer3 --allow-plaintext --geolocation 1 --log_level debug 
 
 
 echo " ::: COS5 PROVIDERS DONE! :::"
\ No newline at end of file
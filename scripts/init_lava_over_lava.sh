@@ -20,15 +20,15 @@ lavad tx pairing stake-provider "LAV1" 2050ulava "127.0.0.1:2263,tendermintrpc,1
 sleep_until_next_epoch
 
 # Lava providers
-screen -d -m -S lav1_providers zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2271 $LAVA_REST LAV1 rest --from servicer1 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2271.log"
-screen -S lav1_providers -X screen -t win1 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2272 $LAVA_REST LAV1 rest --from servicer2 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2272.log"
-screen -S lav1_providers -X screen -t win2 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2273 $LAVA_REST LAV1 rest --from servicer3 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2273.log"
-screen -S lav1_providers -X screen -t win3 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2261 $LAVA_RPC LAV1 tendermintrpc --from servicer1 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2261.log"
-screen -S lav1_providers -X screen -t win4 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2262 $LAVA_RPC LAV1 tendermintrpc --from servicer2 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2262.log"
-screen -S lav1_providers -X screen -t win5 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2263 $LAVA_RPC LAV1 tendermintrpc --from servicer3 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2263.log"
-
-screen -d -m -S portals zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3340 LAV1 rest --from user4 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3340.log"
-screen -S portals -X screen -t win17 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3341 LAV1 tendermintrpc --from user4 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3341.log"
+screen -d -m -S lav1_providers zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2271 $LAVA_REST LAV1 rest --from servicer1 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2271.log"
+screen -S lav1_providers -X screen -t win1 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2272 $LAVA_REST LAV1 rest --from servicer2 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2272.log"
+screen -S lav1_providers -X screen -t win2 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2273 $LAVA_REST LAV1 rest --from servicer3 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2273.log"
+screen -S lav1_providers -X screen -t win3 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2261 $LAVA_RPC LAV1 tendermintrpc --from servicer1 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2261.log"
+screen -S lav1_providers -X screen -t win4 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2262 $LAVA_RPC LAV1 tendermintrpc --from servicer2 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2262.log"
+screen -S lav1_providers -X screen -t win5 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2263 $LAVA_RPC LAV1 tendermintrpc --from servicer3 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/LAV1_2263.log"
+
+screen -d -m -S portals zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3340 LAV1 rest --from user4 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3340.log"
+screen -S portals -X screen -t win17 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3341 LAV1 tendermintrpc --from user4 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3341.log"
 # echo "lavad portal_server 127.0.0.1 3340 LAV1 rest --from user4"
 
 # Lava Over Lava ETH
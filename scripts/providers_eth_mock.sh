@@ -14,11 +14,11 @@ echo "---------------Setup Providers------------------"
 
 echo " ::: STARTING ETH PROVIDERS :::"
 # lavad server 127.0.0.1 2221 http://0.0.0.0:2200 ETH1 jsonrpc --from servicer1 &
-lavad server 127.0.0.1 2221 http://0.0.0.0:2001/$ETH_URL_PATH ETH1 jsonrpc --from servicer1 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2222 http://0.0.0.0:2002/$ETH_URL_PATH ETH1 jsonrpc --from servicer2 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2223 http://0.0.0.0:2003/$ETH_URL_PATH ETH1 jsonrpc --from servicer3 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2224 http://0.0.0.0:2004/$ETH_URL_PATH ETH1 jsonrpc --from servicer4 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2225 http://0.0.0.0:2005/$ETH_URL_PATH ETH1 jsonrpc --from servicer5 --geolocation 1 --log_level debug 
+lavad server 127.0.0.1 2221 http://0.0.0.0:2001/$ETH_URL_PATH ETH1 jsonrpc --from servicer1 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2222 http://0.0.0.0:2002/$ETH_URL_PATH ETH1 jsonrpc --from servicer2 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2223 http://0.0.0.0:2003/$ETH_URL_PATH ETH1 jsonrpc --from servicer3 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2224 http://0.0.0.0:2004/$ETH_URL_PATH ETH1 jsonrpc --from servicer4 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2225 http://0.0.0.0:2005/$ETH_URL_PATH ETH1 jsonrpc --from servicer5 --allow-plaintext --geolocation 1 --log_level debug 
 # lavad server 127.0.0.1 2221 $ETH_RPC_WS ETH1 jsonrpc --from servicer1 &
 # lavad server 127.0.0.1 2222 $ETH_RPC_WS ETH1 jsonrpc --from servicer2 &
 # lavad server 127.0.0.1 2223 $ETH_RPC_WS ETH1 jsonrpc --from servicer3 &
@@ -23,12 +23,12 @@ echo "---------------Setup Providers------------------"
 
 #osmosis providers
 echo " ::: STARTING OSMOSIS PROVIDERS :::"
-lavad server 127.0.0.1 2231 $OSMO_REST COS3 rest --from servicer1 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2232 $OSMO_REST COS3 rest --from servicer2 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2233 $OSMO_REST COS3 rest --from servicer3 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2241 $OSMO_RPC COS3 tendermintrpc --from servicer1 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2242 $OSMO_RPC COS3 tendermintrpc --from servicer2 --geolocation 1 --log_level debug &
-lavad server 127.0.0.1 2243 $OSMO_RPC COS3 tendermintrpc --from servicer3 --geolocation 1 --log_level debug 
+lavad server 127.0.0.1 2231 $OSMO_REST COS3 rest --from servicer1 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2232 $OSMO_REST COS3 rest --from servicer2 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2233 $OSMO_REST COS3 rest --from servicer3 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2241 $OSMO_RPC COS3 tendermintrpc --from servicer1 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2242 $OSMO_RPC COS3 tendermintrpc --from servicer2 --allow-plaintext --geolocation 1 --log_level debug &
+lavad server 127.0.0.1 2243 $OSMO_RPC COS3 tendermintrpc --from servicer3 --allow-plaintext --geolocation 1 --log_level debug 
 echo " ::: providers done! :::"
 # screen -d -m -S portals zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3333 ETH1 jsonrpc --from user1"
 # screen -S portals -X screen -t win10 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3334 COS3 rest --from user2"
@@ -4,11 +4,11 @@
 
 ### Init chain commands
 # ETH clients
-3; lavad test_client ETH1 jsonrpc --from user1&
+3; lavad test_client ETH1 jsonrpc --from user1 --allow-plaintext&
 # Osmosis clients
-3; lavad test_client COS3 tendermintrpc --from user2
+3; lavad test_client COS3 tendermintrpc --from user2 --allow-plaintext
 # Osmosis clients again to generate relay payment
 sleep 40
-3; lavad test_client COS3 tendermintrpc --from user2
+3; lavad test_client COS3 tendermintrpc --from user2 --allow-plaintext
 
 # [+]
\ No newline at end of file
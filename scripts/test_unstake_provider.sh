@@ -23,14 +23,14 @@ lavad tx pairing stake-provider "ETH1" 2000ulava "127.0.0.1:2222,jsonrpc,1" 1 -y
 sleep_until_next_epoch
 
 #ETH providers
-screen -d -m -S eth1_providers zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2221 $ETH_RPC_WS ETH1 jsonrpc --geolocation 1 --log_level debug --from servicer1 2>&1 | tee $LOGS_DIR/ETH1_2221.log"
+screen -d -m -S eth1_providers zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2221 $ETH_RPC_WS ETH1 jsonrpc --allow-plaintext --geolocation 1 --log_level debug --from servicer1 2>&1 | tee $LOGS_DIR/ETH1_2221.log"
 # screen -S eth1_providers -X screen -t win1 -X zsh -c "source ~/.zshrc; lavad server 127.0.0.1 2222 $ETH_RPC_WS ETH1 jsonrpc --from servicer2 2>&1 | tee $LOGS_DIR/ETH1_2222.log"
 
 sleep 1
-screen -d -m -S portals zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3333 ETH1 jsonrpc --from user1 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3333.log"
-screen -S portals -X screen -t win10 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3334 ETH1 jsonrpc --from user2 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
-screen -S portals -X screen -t win11 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3335 ETH1 jsonrpc --from user3 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
-screen -S portals -X screen -t win12 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3336 ETH1 jsonrpc --from user4 --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
+screen -d -m -S portals zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3333 ETH1 jsonrpc --from user1 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3333.log"
+screen -S portals -X screen -t win10 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3334 ETH1 jsonrpc --from user2 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
+screen -S portals -X screen -t win11 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3335 ETH1 jsonrpc --from user3 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
+screen -S portals -X screen -t win12 -X zsh -c "source ~/.zshrc; lavad portal_server 127.0.0.1 3336 ETH1 jsonrpc --from user4 --allow-plaintext --geolocation 1 --log_level debug 2>&1 | tee $LOGS_DIR/PORTAL_3339.log"
 
 sleep 1
 screen -ls 
\ No newline at end of file
@@ -78,7 +78,7 @@ func CreateMsgDetection(ctx context.Context, consumer Account, provider0 Account
 	msg.ResponseConflict.ConflictRelayData0.Request.DataReliability = nil
 	msg.ResponseConflict.ConflictRelayData0.Request.Sig = []byte{}
 
-	sig, err := sigs.SignRelay(consumer.SK, *msg.ResponseConflict.ConflictRelayData0.Request)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(consumer.SK, nil), *msg.ResponseConflict.ConflictRelayData0.Request)
 	if err != nil {
 		return msg, err
 	}
@@ -90,7 +90,7 @@ func CreateMsgDetection(ctx context.Context, consumer Account, provider0 Account
 	msg.ResponseConflict.ConflictRelayData1.Request.Unmarshal(temp)
 	msg.ResponseConflict.ConflictRelayData1.Request.Provider = provider1.Addr.String()
 	msg.ResponseConflict.ConflictRelayData1.Request.Sig = []byte{}
-	sig, err = sigs.SignRelay(consumer.SK, *msg.ResponseConflict.ConflictRelayData1.Request)
+	sig, err = sigs.SignRelay(sigs.NewLocalSigner(consumer.SK, nil), *msg.ResponseConflict.ConflictRelayData1.Request)
 	if err != nil {
 		return msg, err
 	}
@@ -101,12 +101,12 @@ func CreateMsgDetection(ctx context.Context, consumer Account, provider0 Account
 	msg.ResponseConflict.ConflictRelayData0.Reply.FinalizedBlocksHashes = []byte{}
 	msg.ResponseConflict.ConflictRelayData0.Reply.LatestBlock = msg.ResponseConflict.ConflictRelayData0.Request.RequestBlock + int64(spec.BlockDistanceForFinalizedData)
 	msg.ResponseConflict.ConflictRelayData0.Reply.Data = []byte("DUMMYREPLY")
-	sig, err = sigs.SignRelayResponse(provider0.SK, msg.ResponseConflict.ConflictRelayData0.Reply, msg.ResponseConflict.ConflictRelayData0.Request)
+	sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(provider0.SK, nil), msg.ResponseConflict.ConflictRelayData0.Reply, msg.ResponseConflict.ConflictRelayData0.Request)
 	if err != nil {
 		return msg, err
 	}
 	msg.ResponseConflict.ConflictRelayData0.Reply.Sig = sig
-	sigBlocks, err := sigs.SignResponseFinalizationData(provider0.SK, msg.ResponseConflict.ConflictRelayData0.Reply, msg.ResponseConflict.ConflictRelayData0.Request, consumer.Addr)
+	sigBlocks, err := sigs.SignResponseFinalizationData(sigs.NewLocalSigner(provider0.SK, nil), msg.ResponseConflict.ConflictRelayData0.Reply, msg.ResponseConflict.ConflictRelayData0.Request, consumer.Addr)
 	if err != nil {
 		return msg, err
 	}
@@ -116,12 +116,12 @@ func CreateMsgDetection(ctx context.Context, consumer Account, provider0 Account
 	temp, _ = msg.ResponseConflict.ConflictRelayData0.Reply.Marshal()
 	msg.ResponseConflict.ConflictRelayData1.Reply.Unmarshal(temp)
 	msg.ResponseConflict.ConflictRelayData1.Reply.Data = append(msg.ResponseConflict.ConflictRelayData1.Reply.Data, []byte("DIFF")...)
-	sig, err = sigs.SignRelayResponse(provider1.SK, msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request)
+	sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(provider1.SK, nil), msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request)
 	if err != nil {
 		return msg, err
 	}
 	msg.ResponseConflict.ConflictRelayData1.Reply.Sig = sig
-	sigBlocks, err = sigs.SignResponseFinalizationData(provider1.SK, msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request, consumer.Addr)
+	sigBlocks, err = sigs.SignResponseFinalizationData(sigs.NewLocalSigner(provider1.SK, nil), msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request, consumer.Addr)
 	if err != nil {
 		return msg, err
 	}
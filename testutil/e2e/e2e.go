@@ -197,11 +197,11 @@ func (lt *lavaTest) startJSONRPCProxy(ctx context.Context) {
 
 func (lt *lavaTest) startJSONRPCProvider(rpcURL string, ctx context.Context) {
 	providerCommands := []string{
-		lt.lavadPath + " server 127.0.0.1 2221 " + rpcURL + " ETH1 jsonrpc --from servicer1 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2222 " + rpcURL + " ETH1 jsonrpc --from servicer2 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2223 " + rpcURL + " ETH1 jsonrpc --from servicer3 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2224 " + rpcURL + " ETH1 jsonrpc --from servicer4 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2225 " + rpcURL + " ETH1 jsonrpc --from servicer5 --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2221 " + rpcURL + " ETH1 jsonrpc --from servicer1 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2222 " + rpcURL + " ETH1 jsonrpc --from servicer2 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2223 " + rpcURL + " ETH1 jsonrpc --from servicer3 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2224 " + rpcURL + " ETH1 jsonrpc --from servicer4 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2225 " + rpcURL + " ETH1 jsonrpc --from servicer5 --allow-plaintext --geolocation 1 --log_level debug",
 	}
 
 	for idx, providerCommand := range providerCommands {
@@ -226,7 +226,7 @@ func (lt *lavaTest) startJSONRPCProvider(rpcURL string, ctx context.Context) {
 }
 
 func (lt *lavaTest) startJSONRPCGateway(ctx context.Context) {
-	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3333 ETH1 jsonrpc --from user1 --geolocation 1 --log_level debug"
+	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3333 ETH1 jsonrpc --from user1 --allow-plaintext --geolocation 1 --log_level debug"
 	logName := "04_jsonGateway"
 	lt.logs[logName] = new(bytes.Buffer)
 
@@ -372,11 +372,11 @@ func jsonrpcTests(rpcURL string, testDuration time.Duration) error {
 
 func (lt *lavaTest) startTendermintProvider(rpcURL string, ctx context.Context) {
 	providerCommands := []string{
-		lt.lavadPath + " server 127.0.0.1 2261 " + rpcURL + " LAV1 tendermintrpc --from servicer6 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2262 " + rpcURL + " LAV1 tendermintrpc --from servicer7 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2263 " + rpcURL + " LAV1 tendermintrpc --from servicer8 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2264 " + rpcURL + " LAV1 tendermintrpc --from servicer9 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2265 " + rpcURL + " LAV1 tendermintrpc --from servicer10 --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2261 " + rpcURL + " LAV1 tendermintrpc --from servicer6 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2262 " + rpcURL + " LAV1 tendermintrpc --from servicer7 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2263 " + rpcURL + " LAV1 tendermintrpc --from servicer8 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2264 " + rpcURL + " LAV1 tendermintrpc --from servicer9 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2265 " + rpcURL + " LAV1 tendermintrpc --from servicer10 --allow-plaintext --geolocation 1 --log_level debug",
 	}
 
 	for idx, providerCommand := range providerCommands {
@@ -402,7 +402,7 @@ func (lt *lavaTest) startTendermintProvider(rpcURL string, ctx context.Context)
 }
 
 func (lt *lavaTest) startTendermintGateway(ctx context.Context) {
-	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3340 LAV1 tendermintrpc --from user2 --geolocation 1 --log_level debug"
+	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3340 LAV1 tendermintrpc --from user2 --allow-plaintext --geolocation 1 --log_level debug"
 	logName := "06_tendermintGateway"
 	lt.logs[logName] = new(bytes.Buffer)
 
@@ -516,11 +516,11 @@ func (lt *lavaTest) lavaOverLava(ctx context.Context) {
 
 func (lt *lavaTest) startRESTProvider(rpcURL string, ctx context.Context) {
 	providerCommands := []string{
-		lt.lavadPath + " server 127.0.0.1 2271 " + rpcURL + " LAV1 rest --from servicer6 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2272 " + rpcURL + " LAV1 rest --from servicer7 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2273 " + rpcURL + " LAV1 rest --from servicer8 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2274 " + rpcURL + " LAV1 rest --from servicer9 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2275 " + rpcURL + " LAV1 rest --from servicer10 --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2271 " + rpcURL + " LAV1 rest --from servicer6 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2272 " + rpcURL + " LAV1 rest --from servicer7 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2273 " + rpcURL + " LAV1 rest --from servicer8 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2274 " + rpcURL + " LAV1 rest --from servicer9 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2275 " + rpcURL + " LAV1 rest --from servicer10 --allow-plaintext --geolocation 1 --log_level debug",
 	}
 
 	for idx, providerCommand := range providerCommands {
@@ -546,7 +546,7 @@ func (lt *lavaTest) startRESTProvider(rpcURL string, ctx context.Context) {
 }
 
 func (lt *lavaTest) startRESTGateway(ctx context.Context) {
-	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3341 LAV1 rest --from user2 --geolocation 1 --log_level debug"
+	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3341 LAV1 rest --from user2 --allow-plaintext --geolocation 1 --log_level debug"
 	logName := "09_restGateway"
 	lt.logs[logName] = new(bytes.Buffer)
 
@@ -629,11 +629,11 @@ func getRequest(url string) ([]byte, error) {
 
 func (lt *lavaTest) startGRPCProvider(rpcURL string, ctx context.Context) {
 	providerCommands := []string{
-		lt.lavadPath + " server 127.0.0.1 2281 " + rpcURL + " LAV1 grpc --from servicer6 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2282 " + rpcURL + " LAV1 grpc --from servicer7 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2283 " + rpcURL + " LAV1 grpc --from servicer8 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2284 " + rpcURL + " LAV1 grpc --from servicer9 --geolocation 1 --log_level debug",
-		lt.lavadPath + " server 127.0.0.1 2285 " + rpcURL + " LAV1 grpc --from servicer10 --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2281 " + rpcURL + " LAV1 grpc --from servicer6 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2282 " + rpcURL + " LAV1 grpc --from servicer7 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2283 " + rpcURL + " LAV1 grpc --from servicer8 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2284 " + rpcURL + " LAV1 grpc --from servicer9 --allow-plaintext --geolocation 1 --log_level debug",
+		lt.lavadPath + " server 127.0.0.1 2285 " + rpcURL + " LAV1 grpc --from servicer10 --allow-plaintext --geolocation 1 --log_level debug",
 	}
 
 	for idx, providerCommand := range providerCommands {
@@ -659,7 +659,7 @@ func (lt *lavaTest) startGRPCProvider(rpcURL string, ctx context.Context) {
 }
 
 func (lt *lavaTest) startGRPCGateway(ctx context.Context) {
-	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3342 LAV1 grpc --from user2 --geolocation 1 --log_level debug"
+	providerCommand := lt.lavadPath + " portal_server 127.0.0.1 3342 LAV1 grpc --from user2 --allow-plaintext --geolocation 1 --log_level debug"
 	logName := "11_grpcGateway"
 	lt.logs[logName] = new(bytes.Buffer)
 
@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/recorder"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const requestTimeout = 20 * time.Second
+
+// consumerURL is the url of the consumer listener of the api interface
+func (consumer *Consumer) consumerURL(apiInterface string) string {
+	return consumer.ListenerURL(apiInterface) + "/1"
+}
+
+// ListenerURL is the url of the consumer listener of the api interface, without a dapp id
+func (consumer *Consumer) ListenerURL(apiInterface string) string {
+	endpoint := consumer.Endpoints[apiInterface]
+	if consumer.SharedListener {
+		return "http://" + endpoint.NetworkAddress + "/" + strings.ToLower(endpoint.ChainID) + "/" + apiInterface
+	}
+	return "http://" + endpoint.NetworkAddress
+}
+
+// RecordDir is the directory the consumer records its relays to under the record directory of the harness
+func (consumer *Consumer) RecordDir(recordDir string) string {
+	return filepath.Join(recordDir, consumer.Name)
+}
+
+// Records returns the relays the consumer recorded so far
+func (consumer *Consumer) Records(recordDir string) ([]*recorder.Record, error) {
+	files, err := recorder.RecordingFiles(consumer.RecordDir(recordDir))
+	if err != nil {
+		return nil, err
+	}
+	records := []*recorder.Record{}
+	for _, file := range files {
+		fileRecords, err := recorder.ReadRecords(file)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, fileRecords...)
+	}
+	return records, nil
+}
+
+// SendJsonRpc sends a json rpc request through the consumer and returns the raw reply
+func (consumer *Consumer) SendJsonRpc(method string, params ...interface{}) ([]byte, error) {
+	return consumer.postJsonRpc(spectypes.APIInterfaceJsonRPC, method, params)
+}
+
+// SendTendermintRpc sends a tendermint json rpc request through the consumer and returns the raw reply
+func (consumer *Consumer) SendTendermintRpc(method string, params ...interface{}) ([]byte, error) {
+	return consumer.postJsonRpc(spectypes.APIInterfaceTendermintRPC, method, params)
+}
+
+// SendTendermintURI sends a tendermint uri request, e.g. "block?height=5", through the consumer
+func (consumer *Consumer) SendTendermintURI(path string) ([]byte, error) {
+	return httpGet(consumer.consumerURL(spectypes.APIInterfaceTendermintRPC) + "/" + path)
+}
+
+// SendRest sends a rest GET request for the path through the consumer
+func (consumer *Consumer) SendRest(path string) ([]byte, error) {
+	return httpGet(consumer.consumerURL(spectypes.APIInterfaceRest) + path)
+}
+
+// GrpcConn dials the grpc listener of the consumer, the caller closes the connection
+func (consumer *Consumer) GrpcConn(ctx context.Context) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	return grpc.DialContext(ctx, consumer.Endpoints[spectypes.APIInterfaceGrpc].NetworkAddress, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+}
+
+func (consumer *Consumer) postJsonRpc(apiInterface string, method string, params []interface{}) ([]byte, error) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		return nil, err
+	}
+	client := http.Client{Timeout: requestTimeout}
+	res, err := client.Post(consumer.consumerURL(apiInterface)+"/", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return readReply(res)
+}
+
+func httpGet(url string) ([]byte, error) {
+	client := http.Client{Timeout: requestTimeout}
+	res, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	return readReply(res)
+}
+
+func readReply(res *http.Response) ([]byte, error) {
+	defer res.Body.Close()
+	reply, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return reply, fmt.Errorf("consumer replied with status %d: %s", res.StatusCode, reply)
+	}
+	return reply, nil
+}
@@ -0,0 +1,508 @@
+package integration
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/protocol/recorder"
+	"github.com/lavanet/lava/protocol/rpcconsumer"
+	"github.com/lavanet/lava/protocol/rpcprovider"
+	"github.com/lavanet/lava/protocol/rpcprovider/faults"
+	"github.com/lavanet/lava/protocol/statetracker"
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/lavatls"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/testutil/network"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Geolocation          = 1
+	ParallelConnections  = 1
+	startupTimeout       = 2 * time.Minute
+	mockChainLatestBlock = 100
+)
+
+// Config sets up the lava chain and the protocol processes of a Harness
+type Config struct {
+	NumProviders  int
+	NumConsumers  int
+	ApiInterfaces []string
+	Spec          spectypes.Spec
+	EpochBlocks   uint64
+	TimeoutCommit time.Duration
+	ProviderStake sdk.Coin
+	ConsumerStake sdk.Coin
+	AccountTokens sdk.Coin // the balance every provider and consumer account starts with
+	// ProviderFaults makes the providers of the given indexes misbehave, the other providers are honest
+	ProviderFaults map[int]*faults.Config
+	// RecordDir makes the consumers record their relays, each to a directory of its name under it
+	RecordDir string
+	// SharedConsumerListener makes every consumer serve its http api interfaces on one address, routed by the chain path prefix
+	SharedConsumerListener bool
+}
+
+func DefaultConfig() Config {
+	return Config{
+		NumProviders:  2,
+		NumConsumers:  1,
+		ApiInterfaces: []string{spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC, spectypes.APIInterfaceRest, spectypes.APIInterfaceGrpc},
+		Spec:          HarnessSpec(),
+		EpochBlocks:   5,
+		TimeoutCommit: time.Second,
+		ProviderStake: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10000000)),
+		ConsumerStake: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(10000000)),
+		AccountTokens: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000000000)),
+	}
+}
+
+// Participant is a lava account the harness sends transactions and runs a protocol process with
+type Participant struct {
+	Name      string
+	Address   sdk.AccAddress
+	ClientCtx client.Context
+	TxFactory tx.Factory
+	Signer    *sigs.LocalSigner
+	TxSender  *statetracker.TxSender
+	VrfPk     *utils.VrfPubKey
+	cancel    context.CancelFunc
+}
+
+// Provider is a staked provider, each of its endpoints relays to a mock node of its own mock chain
+type Provider struct {
+	Participant
+	Chain     *MockChain
+	Nodes     map[string]*MockNode
+	Endpoints []*lavasession.RPCProviderEndpoint
+	Faults    *faults.Config
+	TLSConfig *tls.Config // self signed certificate the relays are served with, consumers pin it through the staked endpoints
+}
+
+// Consumer is a staked consumer with an rpcconsumer endpoint for each api interface
+type Consumer struct {
+	Participant
+	Endpoints      map[string]*lavasession.RPCEndpoint
+	Recorder       *recorder.Recorder // set when the harness records relays
+	SharedListener bool               // the http api interfaces share one listener and are reached through their chain path prefix
+}
+
+// Harness runs an in process lava chain with providers and consumers staked on a spec, the providers and consumers run
+// the rpcprovider and rpcconsumer processes in process, with mock nodes behind the providers
+type Harness struct {
+	T         *testing.T
+	Config    Config
+	Network   *network.Network
+	Providers []*Provider
+	Consumers []*Consumer
+	keyring   keyring.Keyring
+	errLock   sync.Mutex
+	errors    []error // errors of the protocol processes
+}
+
+// New starts the chain, stakes the providers and the consumers and starts their protocol processes once their pairing is effective
+func New(t *testing.T, cfg Config) *Harness {
+	h := &Harness{T: t, Config: cfg, keyring: keyring.NewInMemory()}
+	for idx := 0; idx < cfg.NumProviders; idx++ {
+		h.Providers = append(h.Providers, &Provider{Participant: h.newParticipant("provider" + strconv.Itoa(idx)), Chain: NewMockChain(mockChainLatestBlock), Faults: cfg.ProviderFaults[idx]})
+	}
+	for idx := 0; idx < cfg.NumConsumers; idx++ {
+		h.Consumers = append(h.Consumers, &Consumer{Participant: h.newParticipant("consumer" + strconv.Itoa(idx))})
+	}
+	h.Network = network.New(t, h.networkConfig())
+	_, err := h.Network.WaitForHeight(1)
+	require.NoError(t, err)
+
+	for _, provider := range h.Providers {
+		h.setupParticipant(&provider.Participant, false)
+		h.stakeProvider(provider)
+	}
+	for _, consumer := range h.Consumers {
+		h.setupParticipant(&consumer.Participant, true)
+		h.stakeConsumer(consumer)
+	}
+	// stake entries are paired from the next epoch
+	h.WaitForEpochs(1)
+	for _, provider := range h.Providers {
+		h.StartProvider(provider)
+	}
+	for _, consumer := range h.Consumers {
+		h.StartConsumer(consumer)
+	}
+	t.Cleanup(h.stop)
+	h.waitForListeners()
+	return h
+}
+
+func (h *Harness) newParticipant(name string) Participant {
+	info, _, err := h.keyring.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(h.T, err)
+	return Participant{Name: name, Address: info.GetAddress()}
+}
+
+// networkConfig funds the accounts, adds the spec and shortens the epochs in the genesis
+func (h *Harness) networkConfig() network.Config {
+	cfg := network.DefaultConfig()
+	cfg.TimeoutCommit = h.Config.TimeoutCommit
+	// relay payments and conflict votes are paid in ulava
+	cfg.MinGasPrices = "0.000000001" + epochstoragetypes.TokenDenom
+
+	accounts := authtypes.GenesisAccounts{}
+	balances := []banktypes.Balance{}
+	for _, participant := range h.participants() {
+		accounts = append(accounts, authtypes.NewBaseAccount(participant.Address, nil, 0, 0))
+		balances = append(balances, banktypes.Balance{Address: participant.Address.String(), Coins: sdk.NewCoins(h.Config.AccountTokens)})
+	}
+	authGenesis := authtypes.GenesisState{}
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[authtypes.ModuleName], &authGenesis)
+	packedAccounts, err := authtypes.PackAccounts(accounts)
+	require.NoError(h.T, err)
+	authGenesis.Accounts = append(authGenesis.Accounts, packedAccounts...)
+	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenesis)
+
+	bankGenesis := banktypes.GenesisState{}
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, balances...)
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenesis)
+
+	specGenesis := spectypes.GenesisState{}
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[spectypes.ModuleName], &specGenesis)
+	specGenesis.SpecList = append(specGenesis.SpecList, h.Config.Spec)
+	cfg.GenesisState[spectypes.ModuleName] = cfg.Codec.MustMarshalJSON(&specGenesis)
+
+	epochstorageGenesis := epochstoragetypes.GenesisState{}
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[epochstoragetypes.ModuleName], &epochstorageGenesis)
+	epochstorageGenesis.Params.EpochBlocks = h.Config.EpochBlocks
+	cfg.GenesisState[epochstoragetypes.ModuleName] = cfg.Codec.MustMarshalJSON(&epochstorageGenesis)
+
+	pairingGenesis := pairingtypes.GenesisState{}
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[pairingtypes.ModuleName], &pairingGenesis)
+	// every provider is paired with every consumer, so data reliability can pick any of them
+	pairingGenesis.Params.ServicersToPairCount = uint64(h.Config.NumProviders)
+	pairingGenesis.Params.EpochBlocksOverlap = 0
+	cfg.GenesisState[pairingtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&pairingGenesis)
+	return cfg
+}
+
+func (h *Harness) participants() []*Participant {
+	participants := []*Participant{}
+	for _, provider := range h.Providers {
+		participants = append(participants, &provider.Participant)
+	}
+	for _, consumer := range h.Consumers {
+		participants = append(participants, &consumer.Participant)
+	}
+	return participants
+}
+
+// setupParticipant sets the client context, tx factory and signer of the participant on the running network
+func (h *Harness) setupParticipant(participant *Participant, withVRF bool) {
+	validatorCtx := h.Network.Validators[0].ClientCtx
+	participant.ClientCtx = validatorCtx.
+		WithKeyring(h.keyring).
+		WithKeyringDir(filepath.Join(h.T.TempDir(), participant.Name)).
+		WithFrom(participant.Name).
+		WithFromName(participant.Name).
+		WithFromAddress(participant.Address).
+		WithBroadcastMode(flags.BroadcastBlock).
+		WithSkipConfirmation(true)
+	participant.TxFactory = tx.Factory{}.
+		WithChainID(validatorCtx.ChainID).
+		WithKeybase(h.keyring).
+		WithTxConfig(validatorCtx.TxConfig).
+		WithAccountRetriever(validatorCtx.AccountRetriever)
+	privKey, err := sigs.GetPrivKey(participant.ClientCtx, participant.Name)
+	require.NoError(h.T, err)
+	if withVRF {
+		vrfSk, vrfPk, err := utils.GenerateVRFKey(participant.ClientCtx)
+		require.NoError(h.T, err)
+		participant.Signer = sigs.NewLocalSigner(privKey, vrfSk)
+		participant.VrfPk = vrfPk
+	} else {
+		participant.Signer = sigs.NewLocalSigner(privKey, nil)
+	}
+	txSender := statetracker.TxSender{}
+	participant.TxSender, err = txSender.New(context.Background(), participant.TxFactory, participant.ClientCtx)
+	require.NoError(h.T, err)
+}
+
+func (h *Harness) stakeProvider(provider *Provider) {
+	certPEM, keyPEM, err := lavatls.GenerateSelfSignedCertificate([]string{"127.0.0.1", "localhost"}, time.Hour)
+	require.NoError(h.T, err)
+	certDir := h.T.TempDir()
+	certFile, keyFile := filepath.Join(certDir, "provider.crt"), filepath.Join(certDir, "provider.key")
+	require.NoError(h.T, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(h.T, os.WriteFile(keyFile, keyPEM, 0o600))
+	var certHash string
+	provider.TLSConfig, certHash, err = lavatls.NewServerConfig(certFile, keyFile)
+	require.NoError(h.T, err)
+
+	provider.Nodes = map[string]*MockNode{}
+	stakeEndpoints := []epochstoragetypes.Endpoint{}
+	for _, apiInterface := range h.Config.ApiInterfaces {
+		node := StartMockNode(h.T, provider.Chain, apiInterface)
+		provider.Nodes[apiInterface] = node
+		endpoint := &lavasession.RPCProviderEndpoint{
+			NetworkAddress: freeAddress(h.T),
+			ChainID:        h.Config.Spec.Index,
+			ApiInterface:   apiInterface,
+			Geolocation:    Geolocation,
+			NodeUrl:        node.URL,
+		}
+		provider.Endpoints = append(provider.Endpoints, endpoint)
+		stakeEndpoints = append(stakeEndpoints, epochstoragetypes.Endpoint{IPPORT: endpoint.NetworkAddress, UseType: apiInterface, Geolocation: Geolocation, TlsCertHash: certHash})
+	}
+	msg := pairingtypes.NewMsgStakeProvider(provider.Address.String(), h.Config.Spec.Index, h.Config.ProviderStake, stakeEndpoints, Geolocation, provider.Name, "")
+	require.NoError(h.T, provider.TxSender.SimulateAndBroadCastTx(context.Background(), msg))
+}
+
+func (h *Harness) stakeConsumer(consumer *Consumer) {
+	vrfPk, err := consumer.VrfPk.EncodeBech32()
+	require.NoError(h.T, err)
+	msg := pairingtypes.NewMsgStakeClient(consumer.Address.String(), h.Config.Spec.Index, h.Config.ConsumerStake, Geolocation, vrfPk)
+	require.NoError(h.T, consumer.TxSender.SimulateAndBroadCastTx(context.Background(), msg))
+	consumer.Endpoints = map[string]*lavasession.RPCEndpoint{}
+	consumer.SharedListener = h.Config.SharedConsumerListener
+	sharedAddress := freeAddress(h.T)
+	for _, apiInterface := range h.Config.ApiInterfaces {
+		networkAddress := sharedAddress
+		if !consumer.SharedListener || apiInterface == spectypes.APIInterfaceGrpc {
+			networkAddress = freeAddress(h.T)
+		}
+		consumer.Endpoints[apiInterface] = &lavasession.RPCEndpoint{
+			NetworkAddress: networkAddress,
+			ChainID:        h.Config.Spec.Index,
+			ApiInterface:   apiInterface,
+			Geolocation:    Geolocation,
+		}
+	}
+}
+
+// StartProvider runs the rpcprovider process of the provider until the test ends or StopProvider is called
+func (h *Harness) StartProvider(provider *Provider) {
+	ctx, cancel := context.WithCancel(context.Background())
+	provider.cancel = cancel
+	go func() {
+		rpcProvider := rpcprovider.RPCProvider{Faults: provider.Faults, TLSConfig: provider.TLSConfig}
+		err := rpcProvider.Start(ctx, provider.TxFactory, provider.ClientCtx, provider.Endpoints, provider.Signer, nil, ParallelConnections)
+		h.addError(provider.Name, err)
+	}()
+}
+
+// StartConsumer runs the rpcconsumer process of the consumer until the test ends or StopConsumer is called
+func (h *Harness) StartConsumer(consumer *Consumer) {
+	ctx, cancel := context.WithCancel(context.Background())
+	consumer.cancel = cancel
+	endpoints := []*lavasession.RPCEndpoint{}
+	for _, apiInterface := range h.Config.ApiInterfaces {
+		endpoints = append(endpoints, consumer.Endpoints[apiInterface])
+	}
+	if h.Config.RecordDir != "" && consumer.Recorder == nil {
+		var err error
+		consumer.Recorder, err = recorder.NewRecorder(recorder.Config{Dir: consumer.RecordDir(h.Config.RecordDir), SampleRate: 1})
+		require.NoError(h.T, err)
+		h.T.Cleanup(func() { consumer.Recorder.Close() })
+	}
+	go func() {
+		rpcConsumer := rpcconsumer.RPCConsumer{}
+		err := rpcConsumer.Start(ctx, consumer.TxFactory, consumer.ClientCtx, endpoints, 1, consumer.Signer, nil, consumer.Recorder)
+		h.addError(consumer.Name, err)
+	}()
+}
+
+// Stop stops the protocol process of the participant
+func (participant *Participant) Stop() {
+	if participant.cancel != nil {
+		participant.cancel()
+	}
+}
+
+func (h *Harness) stop() {
+	for _, participant := range h.participants() {
+		participant.Stop()
+	}
+}
+
+func (h *Harness) addError(name string, err error) {
+	if err == nil {
+		return
+	}
+	h.errLock.Lock()
+	defer h.errLock.Unlock()
+	h.errors = append(h.errors, fmt.Errorf("%s: %w", name, err))
+}
+
+// Errors returns the errors the protocol processes failed with
+func (h *Harness) Errors() []error {
+	h.errLock.Lock()
+	defer h.errLock.Unlock()
+	return append([]error{}, h.errors...)
+}
+
+// waitForListeners waits until the endpoints of all the protocol processes accept connections
+func (h *Harness) waitForListeners() {
+	addresses := []string{}
+	for _, provider := range h.Providers {
+		for _, endpoint := range provider.Endpoints {
+			addresses = append(addresses, endpoint.NetworkAddress)
+		}
+	}
+	for _, consumer := range h.Consumers {
+		for _, endpoint := range consumer.Endpoints {
+			addresses = append(addresses, endpoint.NetworkAddress)
+		}
+	}
+	deadline := time.Now().Add(startupTimeout)
+	for _, address := range addresses {
+		for {
+			require.Empty(h.T, h.Errors(), "protocol process failed to start")
+			conn, err := net.DialTimeout("tcp", address, time.Second)
+			if err == nil {
+				conn.Close()
+				break
+			}
+			if time.Now().After(deadline) {
+				h.T.Fatalf("timed out waiting for %s to listen", address)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+}
+
+// Epoch returns the current epoch start block
+func (h *Harness) Epoch() uint64 {
+	res, err := epochstoragetypes.NewQueryClient(h.Network.Validators[0].ClientCtx).EpochDetails(context.Background(), &epochstoragetypes.QueryGetEpochDetailsRequest{})
+	require.NoError(h.T, err)
+	return res.EpochDetails.StartBlock
+}
+
+// WaitForEpochs waits until the given number of new epochs started
+func (h *Harness) WaitForEpochs(epochs int) {
+	epoch := h.Epoch()
+	for passed := 0; passed < epochs; {
+		require.NoError(h.T, h.Network.WaitForNextBlock())
+		if current := h.Epoch(); current != epoch {
+			epoch = current
+			passed++
+		}
+	}
+}
+
+// WaitFor polls the condition every block until it holds, it returns false if it didn't hold within the given number of blocks
+func (h *Harness) WaitFor(blocks int, condition func() bool) bool {
+	for idx := 0; idx < blocks; idx++ {
+		if condition() {
+			return true
+		}
+		require.NoError(h.T, h.Network.WaitForNextBlock())
+	}
+	return condition()
+}
+
+func (h *Harness) PairingQuery() pairingtypes.QueryClient {
+	return pairingtypes.NewQueryClient(h.Network.Validators[0].ClientCtx)
+}
+
+func (h *Harness) ConflictQuery() conflicttypes.QueryClient {
+	return conflicttypes.NewQueryClient(h.Network.Validators[0].ClientCtx)
+}
+
+// Height returns the latest committed height of the chain
+func (h *Harness) Height() int64 {
+	height, err := h.Network.LatestHeight()
+	require.NoError(h.T, err)
+	return height
+}
+
+// Detections returns the conflict detections of the successful txs in the blocks of the given heights
+func (h *Harness) Detections(fromHeight int64, toHeight int64) []*conflicttypes.MsgDetection {
+	clientCtx := h.Network.Validators[0].ClientCtx
+	detections := []*conflicttypes.MsgDetection{}
+	for height := fromHeight; height <= toHeight; height++ {
+		block, err := clientCtx.Client.Block(context.Background(), &height)
+		require.NoError(h.T, err)
+		blockResults, err := clientCtx.Client.BlockResults(context.Background(), &height)
+		require.NoError(h.T, err)
+		for idx, txBytes := range block.Block.Txs {
+			if !blockResults.TxsResults[idx].IsOK() {
+				continue
+			}
+			tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+			require.NoError(h.T, err)
+			for _, msg := range tx.GetMsgs() {
+				if detection, ok := msg.(*conflicttypes.MsgDetection); ok {
+					detections = append(detections, detection)
+				}
+			}
+		}
+	}
+	return detections
+}
+
+// ResolvedVotes returns the conflict votes that were resolved in the blocks of the given heights
+func (h *Harness) ResolvedVotes(fromHeight int64, toHeight int64) []*conflicttypes.EventConflictVoteResolved {
+	clientCtx := h.Network.Validators[0].ClientCtx
+	resolvedEventType := proto.MessageName(&conflicttypes.EventConflictVoteResolved{})
+	resolved := []*conflicttypes.EventConflictVoteResolved{}
+	for height := fromHeight; height <= toHeight; height++ {
+		blockResults, err := clientCtx.Client.BlockResults(context.Background(), &height)
+		require.NoError(h.T, err)
+		// votes are resolved at the start of an epoch
+		for _, event := range blockResults.BeginBlockEvents {
+			if event.Type != resolvedEventType {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			require.NoError(h.T, err)
+			resolved = append(resolved, typedEvent.(*conflicttypes.EventConflictVoteResolved))
+		}
+	}
+	return resolved
+}
+
+// StakedProviders returns the addresses of the providers currently staked on the spec
+func (h *Harness) StakedProviders() []string {
+	res, err := h.PairingQuery().Providers(context.Background(), &pairingtypes.QueryProvidersRequest{ChainID: h.Config.Spec.Index})
+	require.NoError(h.T, err)
+	staked := []string{}
+	for _, entry := range res.StakeEntry {
+		staked = append(staked, entry.Address)
+	}
+	return staked
+}
+
+// AdvanceBlocks advances the mock chains of all the providers
+func (h *Harness) AdvanceBlocks(blocks int64) {
+	for _, provider := range h.Providers {
+		provider.Chain.AdvanceBlocks(blocks)
+	}
+}
+
+// freeAddress returns a local address with a port that is free to listen on
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
@@ -0,0 +1,350 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/recorder"
+	"github.com/lavanet/lava/protocol/replay"
+	"github.com/lavanet/lava/protocol/rpcprovider/faults"
+	"github.com/lavanet/lava/relayer/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// providersCalls sums the calls for the method the nodes of all the providers got
+func providersCalls(h *Harness, apiInterface string, method string) int {
+	calls := 0
+	for _, provider := range h.Providers {
+		calls += provider.Chain.Calls(apiInterface, method)
+	}
+	return calls
+}
+
+func TestProtocolFlows(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the protocol integration test in short mode")
+	}
+	cfg := DefaultConfig()
+	cfg.RecordDir = t.TempDir()
+	h := New(t, cfg)
+	consumer := h.Consumers[0]
+	latest := h.Providers[0].Chain.LatestBlock()
+
+	t.Run("jsonrpc", func(t *testing.T) {
+		calls := providersCalls(h, spectypes.APIInterfaceJsonRPC, JsonRpcGetBlockByNumber)
+		reply, err := consumer.SendJsonRpc(JsonRpcGetBlockByNumber, fmt.Sprintf("0x%x", latest), false)
+		require.NoError(t, err)
+		result := struct {
+			Result struct {
+				Hash string `json:"hash"`
+			} `json:"result"`
+		}{}
+		require.NoError(t, json.Unmarshal(reply, &result))
+		require.Equal(t, DefaultBlockHash(latest), result.Result.Hash)
+		require.Greater(t, providersCalls(h, spectypes.APIInterfaceJsonRPC, JsonRpcGetBlockByNumber), calls)
+	})
+
+	t.Run("tendermintrpc", func(t *testing.T) {
+		reply, err := consumer.SendTendermintRpc(TendermintStatus)
+		require.NoError(t, err)
+		require.Contains(t, string(reply), "latest_block_height")
+		reply, err = consumer.SendTendermintURI(fmt.Sprintf("%s?height=%d", TendermintBlock, latest))
+		require.NoError(t, err)
+		require.Contains(t, string(reply), DefaultBlockHash(latest))
+	})
+
+	t.Run("rest", func(t *testing.T) {
+		reply, err := consumer.SendRest(RestLatestBlock)
+		require.NoError(t, err)
+		require.Contains(t, string(reply), "header")
+	})
+
+	t.Run("record and replay", func(t *testing.T) {
+		// the relays of the subtests above are recorded in the background
+		var records []*recorder.Record
+		recorded := h.WaitFor(10, func() bool {
+			var err error
+			records, err = consumer.Records(cfg.RecordDir)
+			require.NoError(t, err)
+			apis := map[string]bool{}
+			for _, record := range records {
+				apis[record.ApiInterface+" "+record.Api] = true
+			}
+			return apis[spectypes.APIInterfaceJsonRPC+" "+JsonRpcGetBlockByNumber] && apis[spectypes.APIInterfaceTendermintRPC+" "+TendermintBlock] &&
+				apis[spectypes.APIInterfaceRest+" "+RestLatestBlock]
+		})
+		require.True(t, recorded, "the consumer didn't record the relays")
+		providers := map[string]bool{}
+		for _, provider := range h.Providers {
+			providers[provider.Address.String()] = true
+		}
+		for _, record := range records {
+			require.Empty(t, record.Error)
+			require.True(t, providers[record.Provider], "unknown provider %s", record.Provider)
+			require.NotEmpty(t, record.Reply)
+			require.Equal(t, "1", record.DappID)
+		}
+
+		// the block by number and the block at a height don't change, so their replays match the recording
+		for _, apiInterface := range []string{spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC} {
+			replayed := []*recorder.Record{}
+			for _, record := range records {
+				if record.ApiInterface == apiInterface && (record.Api == JsonRpcGetBlockByNumber || record.Api == TendermintBlock) {
+					replayed = append(replayed, record)
+				}
+			}
+			output := &bytes.Buffer{}
+			summary := replay.NewReplayer(replay.NewConsumerSender(consumer.ListenerURL(apiInterface), ""), []string{"id"}).Run(context.Background(), replayed, output)
+			require.Equal(t, replay.Summary{Replayed: uint64(len(replayed)), Matched: uint64(len(replayed))}, *summary, output.String())
+		}
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		conn, err := consumer.GrpcConn(context.Background())
+		require.NoError(t, err)
+		defer conn.Close()
+		reply, err := tmservice.NewServiceClient(conn).GetLatestBlock(context.Background(), &tmservice.GetLatestBlockRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, reply.Block)
+		require.GreaterOrEqual(t, reply.Block.Header.Height, latest)
+	})
+
+	t.Run("data reliability", func(t *testing.T) {
+		// every finalized relay is checked, a relay is sent to a single provider so when both serve it one of them got the data reliability relay
+		for attempt := 0; attempt < 10; attempt++ {
+			h.WaitForEpochs(1)
+			callsBefore := map[*Provider]int{}
+			for _, provider := range h.Providers {
+				callsBefore[provider] = provider.Chain.Calls(spectypes.APIInterfaceJsonRPC, JsonRpcGetBalance)
+			}
+			_, err := consumer.SendJsonRpc(JsonRpcGetBalance, "0x0", fmt.Sprintf("0x%x", latest))
+			require.NoError(t, err)
+			servedByAll := h.WaitFor(2, func() bool {
+				for _, provider := range h.Providers {
+					if provider.Chain.Calls(spectypes.APIInterfaceJsonRPC, JsonRpcGetBalance) == callsBefore[provider] {
+						return false
+					}
+				}
+				return true
+			})
+			if servedByAll {
+				return
+			}
+			// the reliability provider is chosen by vrf and can be the original one, retry on the next epoch
+		}
+		t.Fatal("a data reliability relay never reached a second provider")
+	})
+
+	t.Run("payment", func(t *testing.T) {
+		// sessions are paid once they are stale
+		paid := h.WaitFor(60, func() bool {
+			for _, provider := range h.Providers {
+				res, err := h.PairingQuery().ProviderEarnings(context.Background(), &pairingtypes.QueryProviderEarningsRequest{Provider: provider.Address.String(), ChainID: h.Config.Spec.Index})
+				if err == nil && res.TotalCuServed > 0 {
+					return true
+				}
+			}
+			return false
+		})
+		require.True(t, paid, "no provider was paid for its relays")
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		// a provider that disagrees with the others is caught by data reliability and a conflict vote opens
+		faulty := h.Providers[len(h.Providers)-1]
+		faulty.Chain.Handle(spectypes.APIInterfaceJsonRPC, JsonRpcGetBalance, func(request *MockRequest) (interface{}, error) {
+			return "0x1", nil
+		})
+		defer faulty.Chain.Handle(spectypes.APIInterfaceJsonRPC, JsonRpcGetBalance, nil)
+		for attempt := 0; attempt < 10; attempt++ {
+			h.WaitForEpochs(1)
+			_, err := consumer.SendJsonRpc(JsonRpcGetBalance, "0x0", fmt.Sprintf("0x%x", latest))
+			require.NoError(t, err)
+			voteOpened := h.WaitFor(3, func() bool {
+				res, err := h.ConflictQuery().ConflictVoteAll(context.Background(), &conflicttypes.QueryAllConflictVoteRequest{})
+				return err == nil && len(res.ConflictVote) > 0
+			})
+			if voteOpened {
+				res, err := h.ConflictQuery().ConflictVoteAll(context.Background(), &conflicttypes.QueryAllConflictVoteRequest{})
+				require.NoError(t, err)
+				vote := res.ConflictVote[0]
+				require.Equal(t, consumer.Address.String(), vote.ClientAddress)
+				require.ElementsMatch(t, []string{h.Providers[0].Address.String(), faulty.Address.String()}, []string{vote.FirstProvider.Account, vote.SecondProvider.Account})
+				return
+			}
+		}
+		t.Fatal("no conflict vote was opened for the disagreeing providers")
+	})
+
+	require.Empty(t, h.Errors())
+}
+
+// finalizationDetection returns the finalization conflict detections whose reply signed a hash matching the given hash of the block
+func finalizationDetection(detections []*conflicttypes.MsgDetection, signedHash func(block int64) string) *conflicttypes.MsgDetection {
+	for _, detection := range detections {
+		if detection.FinalizationConflict == nil || detection.FinalizationConflict.RelayReply0 == nil {
+			continue
+		}
+		finalizedBlocks := map[int64]string{}
+		if json.Unmarshal(detection.FinalizationConflict.RelayReply0.FinalizedBlocksHashes, &finalizedBlocks) != nil {
+			continue
+		}
+		for block, hash := range finalizedBlocks {
+			if hash == signedHash(block) {
+				return detection
+			}
+		}
+	}
+	return nil
+}
+
+func TestFaultyProviders(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the protocol integration test in short mode")
+	}
+	const (
+		contradicting = 2
+		lying         = 3
+		altering      = 4
+	)
+	cfg := DefaultConfig()
+	cfg.NumProviders = 5
+	// the finalization proof is longer than the latest block offset, so the hashes the lying provider signs overlap the blocks the others signed
+	cfg.Spec.BlocksInFinalizationProof = 3
+	cfg.ProviderFaults = map[int]*faults.Config{
+		contradicting: {ContradictFinalizedHashes: true},
+		lying:         {LatestBlockOffset: 1},
+		altering:      {AlteredReplies: map[string]string{JsonRpcGetBalance: "0x1"}},
+	}
+	h := New(t, cfg)
+	consumer := h.Consumers[0]
+	requestBlock := fmt.Sprintf("0x%x", h.Providers[0].Chain.LatestBlock())
+	startHeight := h.Height()
+
+	// the consumer reports whichever reply disagrees with the first one of the epoch, so the relays are sent every block until
+	// a detection with the faulty reply lands
+	var detections []*conflicttypes.MsgDetection
+	scannedHeight := startHeight
+	relayAndScan := func() {
+		for idx := 0; idx < cfg.NumProviders; idx++ {
+			_, _ = consumer.SendJsonRpc(JsonRpcGetBalance, "0x0", requestBlock) // relays served by a faulty provider fail
+		}
+		// the results of the latest block may not be stored yet
+		height := h.Height() - 1
+		detections = append(detections, h.Detections(scannedHeight, height)...)
+		scannedHeight = height + 1
+	}
+
+	t.Run("contradicting finalized hashes", func(t *testing.T) {
+		forgedHash := func(block int64) string {
+			return hex.EncodeToString(sigs.HashMsg([]byte(DefaultBlockHash(block))))
+		}
+		detected := h.WaitFor(60, func() bool {
+			relayAndScan()
+			return finalizationDetection(detections, forgedHash) != nil
+		})
+		require.True(t, detected, "the consumer didn't report the forged finalized hashes")
+		require.Equal(t, consumer.Address.String(), finalizationDetection(detections, forgedHash).Creator)
+	})
+
+	t.Run("lying about the latest block", func(t *testing.T) {
+		shiftedHash := func(block int64) string {
+			return DefaultBlockHash(block - cfg.ProviderFaults[lying].LatestBlockOffset)
+		}
+		detected := h.WaitFor(60, func() bool {
+			relayAndScan()
+			return finalizationDetection(detections, shiftedHash) != nil
+		})
+		require.True(t, detected, "the consumer didn't report the hashes signed for a block the provider didn't reach")
+	})
+
+	t.Run("altered response", func(t *testing.T) {
+		faulty := h.Providers[altering].Address.String()
+		var resolved *conflicttypes.EventConflictVoteResolved
+		voteResolved := h.WaitFor(120, func() bool {
+			relayAndScan()
+			// when a voter misses the vote the winner's share of the stake can stay below the majority percent, and the vote is
+			// resolved without punishing anyone, so wait for a vote all the voters took part in
+			for _, vote := range h.ResolvedVotes(startHeight, h.Height()-1) {
+				if vote.NumOfNoVoters == 0 {
+					resolved = vote
+					return true
+				}
+			}
+			return false
+		})
+		require.True(t, voteResolved, "no conflict vote was resolved")
+
+		var responseConflict *conflicttypes.ResponseConflict
+		for _, detection := range detections {
+			if detection.ResponseConflict != nil {
+				responseConflict = detection.ResponseConflict
+				break
+			}
+		}
+		require.NotNil(t, responseConflict, "the consumer didn't report the altered response")
+		conflictProviders := []string{responseConflict.ConflictRelayData0.Request.Provider, responseConflict.ConflictRelayData1.Request.Provider}
+		require.Contains(t, conflictProviders, faulty)
+
+		// the other providers, faulty or not, replied honestly to the conflicting relay and voted the honest provider the winner
+		require.NotEqual(t, faulty, resolved.Winner)
+		require.Contains(t, conflictProviders, resolved.Winner)
+		staked := h.StakedProviders()
+		require.NotContains(t, staked, faulty)
+		for idx, provider := range h.Providers {
+			if idx != altering {
+				require.Contains(t, staked, provider.Address.String())
+			}
+		}
+	})
+
+	require.Empty(t, h.Errors())
+}
+
+func TestSharedConsumerListener(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the protocol integration test in short mode")
+	}
+	cfg := DefaultConfig()
+	cfg.SharedConsumerListener = true
+	h := New(t, cfg)
+	consumer := h.Consumers[0]
+	latest := h.Providers[0].Chain.LatestBlock()
+
+	reply, err := consumer.SendJsonRpc(JsonRpcGetBlockByNumber, fmt.Sprintf("0x%x", latest), false)
+	require.NoError(t, err)
+	require.Contains(t, string(reply), DefaultBlockHash(latest))
+	reply, err = consumer.SendTendermintURI(fmt.Sprintf("%s?height=%d", TendermintBlock, latest))
+	require.NoError(t, err)
+	require.Contains(t, string(reply), DefaultBlockHash(latest))
+	reply, err = consumer.SendRest(RestLatestBlock)
+	require.NoError(t, err)
+	require.Contains(t, string(reply), "header")
+
+	// the shared listener lists the api interfaces it routes
+	res, err := http.Get("http://" + consumer.Endpoints[spectypes.APIInterfaceJsonRPC].NetworkAddress + chainlib.ChainsDiscoveryPath)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	discovery := struct {
+		Chains []chainlib.ChainRoute `json:"chains"`
+	}{}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&discovery))
+	apiInterfaces := []string{}
+	for _, route := range discovery.Chains {
+		require.Equal(t, cfg.Spec.Index, route.ChainID)
+		apiInterfaces = append(apiInterfaces, route.ApiInterface)
+	}
+	require.ElementsMatch(t, []string{spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC, spectypes.APIInterfaceRest}, apiInterfaces)
+
+	require.Empty(t, h.Errors())
+}
@@ -0,0 +1,129 @@
+package integration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MockHandler produces the result of a node api, an error makes the node reply with an error instead.
+// for grpc the result has to be the reply proto message, for the other interfaces it is marshaled to json
+type MockHandler func(request *MockRequest) (result interface{}, err error)
+
+// MockRequest is a request that reached a mock node
+type MockRequest struct {
+	ApiInterface string
+	Method       string            // the json rpc method, the rest path or the grpc full method
+	Params       []byte            // the json rpc params, or the query of a rest and tendermint uri request
+	Query        map[string]string // the query arguments of rest and tendermint uri requests
+	GrpcRequest  interface{}
+}
+
+// MockChain is the state behind the mock nodes of a provider, all the api interfaces of a provider share it.
+// blocks get the same hash on every mock chain so honest providers agree with each other
+type MockChain struct {
+	lock        sync.RWMutex
+	latestBlock int64
+	hashes      map[int64]string
+	handlers    map[string]map[string]MockHandler // api interface -> method -> handler
+	calls       map[string]map[string]int
+}
+
+func NewMockChain(latestBlock int64) *MockChain {
+	return &MockChain{
+		latestBlock: latestBlock,
+		hashes:      map[int64]string{},
+		handlers:    map[string]map[string]MockHandler{},
+		calls:       map[string]map[string]int{},
+	}
+}
+
+func (mc *MockChain) LatestBlock() int64 {
+	mc.lock.RLock()
+	defer mc.lock.RUnlock()
+	return mc.latestBlock
+}
+
+func (mc *MockChain) SetLatestBlock(latestBlock int64) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.latestBlock = latestBlock
+}
+
+// AdvanceBlocks adds blocks to the chain and returns the new latest block
+func (mc *MockChain) AdvanceBlocks(blocks int64) int64 {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.latestBlock += blocks
+	return mc.latestBlock
+}
+
+// BlockHash returns the hash the chain reports for the block
+func (mc *MockChain) BlockHash(block int64) string {
+	mc.lock.RLock()
+	defer mc.lock.RUnlock()
+	if hash, ok := mc.hashes[block]; ok {
+		return hash
+	}
+	return DefaultBlockHash(block)
+}
+
+// SetBlockHash overrides the hash of a block on this chain only, making it disagree with the other chains
+func (mc *MockChain) SetBlockHash(block int64, hash string) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.hashes[block] = hash
+}
+
+// Handle overrides the reply of a method on an api interface, a nil handler restores the default reply
+func (mc *MockChain) Handle(apiInterface string, method string, handler MockHandler) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	if handler == nil {
+		delete(mc.handlers[apiInterface], method)
+		return
+	}
+	if _, ok := mc.handlers[apiInterface]; !ok {
+		mc.handlers[apiInterface] = map[string]MockHandler{}
+	}
+	mc.handlers[apiInterface][method] = handler
+}
+
+// Calls returns the number of requests for the method the nodes of the api interface got
+func (mc *MockChain) Calls(apiInterface string, method string) int {
+	mc.lock.RLock()
+	defer mc.lock.RUnlock()
+	return mc.calls[apiInterface][method]
+}
+
+// serve counts the request and runs the handler of the method, the default handler is used when the method wasn't overridden
+func (mc *MockChain) serve(request *MockRequest, defaultHandler MockHandler) (interface{}, error) {
+	mc.lock.Lock()
+	if _, ok := mc.calls[request.ApiInterface]; !ok {
+		mc.calls[request.ApiInterface] = map[string]int{}
+	}
+	mc.calls[request.ApiInterface][request.Method]++
+	handler, ok := mc.handlers[request.ApiInterface][request.Method]
+	mc.lock.Unlock()
+	if !ok {
+		handler = defaultHandler
+	}
+	return handler(request)
+}
+
+// parseBlockArg parses a requested block, latest or an empty argument is the latest block
+func (mc *MockChain) parseBlockArg(block string) (int64, error) {
+	block = strings.Trim(block, "\"")
+	if block == "" || block == "latest" {
+		return mc.LatestBlock(), nil
+	}
+	return strconv.ParseInt(block, 0, 64)
+}
+
+// DefaultBlockHash is the hash of a block on a chain that didn't override it
+func DefaultBlockHash(block int64) string {
+	hash := sha256.Sum256([]byte("block-" + strconv.FormatInt(block, 10)))
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
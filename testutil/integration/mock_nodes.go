@@ -0,0 +1,271 @@
+package integration
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	restBlocksPath = "/cosmos/base/tendermint/v1beta1/blocks/"
+)
+
+// MockNode serves the api interface of a mock chain, it is the node behind a provider endpoint
+type MockNode struct {
+	ApiInterface string
+	URL          string // the node url a provider endpoint uses
+	close        func()
+}
+
+func (mn *MockNode) Close() {
+	mn.close()
+}
+
+// StartMockNode starts a node of the api interface for the chain, the node is closed when the test ends
+func StartMockNode(t *testing.T, chain *MockChain, apiInterface string) *MockNode {
+	var node *MockNode
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC:
+		node = startHttpNode(apiInterface, jsonRpcNodeHandler(chain))
+	case spectypes.APIInterfaceTendermintRPC:
+		node = startHttpNode(apiInterface, tendermintNodeHandler(chain))
+	case spectypes.APIInterfaceRest:
+		node = startHttpNode(apiInterface, restNodeHandler(chain))
+	case spectypes.APIInterfaceGrpc:
+		node = startGrpcNode(t, chain)
+	default:
+		t.Fatalf("unsupported api interface %s", apiInterface)
+	}
+	t.Cleanup(node.Close)
+	return node
+}
+
+func startHttpNode(apiInterface string, handler http.HandlerFunc) *MockNode {
+	server := httptest.NewServer(handler)
+	return &MockNode{ApiInterface: apiInterface, URL: server.URL, close: server.Close}
+}
+
+type jsonRpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type jsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcReply struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *jsonRpcError   `json:"error,omitempty"`
+}
+
+func writeJson(writer http.ResponseWriter, statusCode int, reply interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(reply)
+}
+
+// serveJsonRpc replies to a json rpc request with the result of the chain handler
+func serveJsonRpc(writer http.ResponseWriter, httpRequest *http.Request, chain *MockChain, apiInterface string, defaultHandler MockHandler) {
+	body, err := io.ReadAll(httpRequest.Body)
+	if err != nil {
+		writeJson(writer, http.StatusBadRequest, jsonRpcReply{Version: "2.0", Error: &jsonRpcError{Code: -32700, Message: err.Error()}})
+		return
+	}
+	request := jsonRpcRequest{}
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		writeJson(writer, http.StatusOK, jsonRpcReply{Version: "2.0", Error: &jsonRpcError{Code: -32700, Message: err.Error()}})
+		return
+	}
+	result, err := chain.serve(&MockRequest{ApiInterface: apiInterface, Method: request.Method, Params: request.Params}, defaultHandler)
+	if err != nil {
+		writeJson(writer, http.StatusOK, jsonRpcReply{Version: "2.0", ID: request.ID, Error: &jsonRpcError{Code: -32000, Message: err.Error()}})
+		return
+	}
+	writeJson(writer, http.StatusOK, jsonRpcReply{Version: "2.0", ID: request.ID, Result: result})
+}
+
+func jsonRpcNodeHandler(chain *MockChain) http.HandlerFunc {
+	defaultHandler := func(request *MockRequest) (interface{}, error) {
+		switch request.Method {
+		case "eth_blockNumber":
+			return fmt.Sprintf("0x%x", chain.LatestBlock()), nil
+		case "eth_getBlockByNumber":
+			block, err := chain.parseBlockArg(firstParam(request.Params))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"number": fmt.Sprintf("0x%x", block), "hash": chain.BlockHash(block)}, nil
+		case "eth_chainId":
+			return "0x1", nil
+		case "eth_getBalance":
+			return "0x0", nil
+		}
+		return nil, fmt.Errorf("the method %s does not exist/is not available", request.Method)
+	}
+	return func(writer http.ResponseWriter, httpRequest *http.Request) {
+		serveJsonRpc(writer, httpRequest, chain, spectypes.APIInterfaceJsonRPC, defaultHandler)
+	}
+}
+
+func tendermintBlock(chain *MockChain, block int64) interface{} {
+	return map[string]interface{}{
+		"block_id": map[string]interface{}{"hash": chain.BlockHash(block)},
+		"block":    map[string]interface{}{"header": map[string]interface{}{"height": strconv.FormatInt(block, 10)}},
+	}
+}
+
+func tendermintNodeHandler(chain *MockChain) http.HandlerFunc {
+	defaultHandler := func(request *MockRequest) (interface{}, error) {
+		switch request.Method {
+		case "status":
+			return map[string]interface{}{"sync_info": map[string]interface{}{"latest_block_height": strconv.FormatInt(chain.LatestBlock(), 10)}}, nil
+		case "block":
+			height, ok := request.Query["height"]
+			if !ok {
+				height = firstParam(request.Params)
+			}
+			block, err := chain.parseBlockArg(height)
+			if err != nil {
+				return nil, err
+			}
+			return tendermintBlock(chain, block), nil
+		}
+		return nil, fmt.Errorf("method %s not found", request.Method)
+	}
+	return func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if httpRequest.Method == http.MethodPost {
+			serveJsonRpc(writer, httpRequest, chain, spectypes.APIInterfaceTendermintRPC, defaultHandler)
+			return
+		}
+		// uri requests carry the method in the path and the params in the query
+		query := map[string]string{}
+		for key, values := range httpRequest.URL.Query() {
+			query[key] = strings.Trim(values[0], "\"")
+		}
+		request := &MockRequest{ApiInterface: spectypes.APIInterfaceTendermintRPC, Method: strings.TrimPrefix(httpRequest.URL.Path, "/"), Params: []byte(httpRequest.URL.RawQuery), Query: query}
+		result, err := chain.serve(request, defaultHandler)
+		if err != nil {
+			writeJson(writer, http.StatusOK, jsonRpcReply{Version: "2.0", ID: json.RawMessage("-1"), Error: &jsonRpcError{Code: -32603, Message: err.Error()}})
+			return
+		}
+		writeJson(writer, http.StatusOK, jsonRpcReply{Version: "2.0", ID: json.RawMessage("-1"), Result: result})
+	}
+}
+
+func restNodeHandler(chain *MockChain) http.HandlerFunc {
+	defaultHandler := func(request *MockRequest) (interface{}, error) {
+		if strings.HasPrefix(request.Method, restBlocksPath) {
+			block, err := chain.parseBlockArg(strings.TrimPrefix(request.Method, restBlocksPath))
+			if err != nil {
+				return nil, err
+			}
+			return tendermintBlock(chain, block), nil
+		}
+		return nil, fmt.Errorf("Not Implemented")
+	}
+	return func(writer http.ResponseWriter, httpRequest *http.Request) {
+		query := map[string]string{}
+		for key, values := range httpRequest.URL.Query() {
+			query[key] = values[0]
+		}
+		body, _ := io.ReadAll(httpRequest.Body)
+		params := []byte(httpRequest.URL.RawQuery)
+		if len(body) > 0 {
+			params = body
+		}
+		request := &MockRequest{ApiInterface: spectypes.APIInterfaceRest, Method: httpRequest.URL.Path, Params: params, Query: query}
+		result, err := chain.serve(request, defaultHandler)
+		if err != nil {
+			writeJson(writer, http.StatusNotImplemented, map[string]interface{}{"code": 12, "message": err.Error()})
+			return
+		}
+		writeJson(writer, http.StatusOK, result)
+	}
+}
+
+// mockTendermintService is the default reply of the grpc node for the tendermint service
+type mockTendermintService struct {
+	tmservice.UnimplementedServiceServer
+	chain *MockChain
+}
+
+func (mts *mockTendermintService) blockReply(block int64) (*tmproto.BlockID, *tmproto.Block) {
+	hash, err := hex.DecodeString(mts.chain.BlockHash(block))
+	if err != nil {
+		// an overridden hash doesn't have to be hex
+		hash = []byte(mts.chain.BlockHash(block))
+	}
+	return &tmproto.BlockID{Hash: hash}, &tmproto.Block{Header: tmproto.Header{Height: block}}
+}
+
+func (mts *mockTendermintService) GetLatestBlock(ctx context.Context, request *tmservice.GetLatestBlockRequest) (*tmservice.GetLatestBlockResponse, error) {
+	blockID, block := mts.blockReply(mts.chain.LatestBlock())
+	return &tmservice.GetLatestBlockResponse{BlockId: blockID, Block: block}, nil
+}
+
+func (mts *mockTendermintService) GetBlockByHeight(ctx context.Context, request *tmservice.GetBlockByHeightRequest) (*tmservice.GetBlockByHeightResponse, error) {
+	if request.Height > mts.chain.LatestBlock() {
+		return nil, status.Errorf(codes.InvalidArgument, "requested block height %d is bigger then the chain length %d", request.Height, mts.chain.LatestBlock())
+	}
+	blockID, block := mts.blockReply(request.Height)
+	return &tmservice.GetBlockByHeightResponse{BlockId: blockID, Block: block}, nil
+}
+
+func startGrpcNode(t *testing.T, chain *MockChain) *MockNode {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed listening for the grpc mock node: %s", err)
+	}
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		defaultHandler := func(request *MockRequest) (interface{}, error) {
+			return handler(ctx, req)
+		}
+		return chain.serve(&MockRequest{ApiInterface: spectypes.APIInterfaceGrpc, Method: info.FullMethod, GrpcRequest: req}, defaultHandler)
+	}
+	server := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	tmservice.RegisterServiceServer(server, &mockTendermintService{chain: chain})
+	gogoreflection.Register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return &MockNode{ApiInterface: spectypes.APIInterfaceGrpc, URL: listener.Addr().String(), close: server.Stop}
+}
+
+// firstParam returns the first json rpc param, params can be either positional or named by height
+func firstParam(params json.RawMessage) string {
+	positional := []json.RawMessage{}
+	if err := json.Unmarshal(params, &positional); err == nil {
+		if len(positional) == 0 {
+			return ""
+		}
+		return string(positional[0])
+	}
+	named := map[string]json.RawMessage{}
+	if err := json.Unmarshal(params, &named); err == nil {
+		return string(named["height"])
+	}
+	return ""
+}
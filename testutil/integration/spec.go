@@ -0,0 +1,101 @@
+package integration
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	// the grpc consumer listener serves the protobufs registered for the chain, LAV1 has the tendermint service the mock nodes implement
+	SpecChainID = "LAV1"
+	// the compute units of every api of the spec
+	ApiComputeUnits = 10
+
+	JsonRpcBlockNumber         = "eth_blockNumber"
+	JsonRpcGetBlockByNumber    = "eth_getBlockByNumber"
+	JsonRpcGetBalance          = "eth_getBalance" // a plain api the chain trackers don't call
+	TendermintStatus           = "status"
+	TendermintBlock            = "block"
+	RestLatestBlock            = "/cosmos/base/tendermint/v1beta1/blocks/latest"
+	RestBlockByHeight          = "/cosmos/base/tendermint/v1beta1/blocks/{height}"
+	GrpcGetLatestBlock         = "cosmos.base.tendermint.v1beta1.Service/GetLatestBlock"
+	GrpcGetBlockByHeight       = "cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"
+	dataReliabilityAlwaysCheck = 0xFFFFFFFF
+)
+
+func serviceApi(name string, apiInterface string, connectionType string, blockParsing spectypes.BlockParser, parsing spectypes.Parsing) spectypes.ServiceApi {
+	return spectypes.ServiceApi{
+		Name:         name,
+		BlockParsing: blockParsing,
+		ComputeUnits: ApiComputeUnits,
+		Enabled:      true,
+		ApiInterfaces: []spectypes.ApiInterface{{
+			Interface: apiInterface,
+			Type:      connectionType,
+			Category:  &spectypes.SpecCategory{Deterministic: true},
+		}},
+		Parsing: parsing,
+	}
+}
+
+// HarnessSpec returns the spec the harness stakes on, it has the block apis of all the api interfaces so a chain tracker can run behind each of them.
+// data reliability is checked on every finalized relay so the data reliability flow runs on the first relays of an epoch
+func HarnessSpec() spectypes.Spec {
+	latest := spectypes.BlockParser{ParserArg: []string{"latest"}, ParserFunc: spectypes.PARSER_FUNC_DEFAULT}
+	return spectypes.Spec{
+		Index:                         SpecChainID,
+		Name:                          "lava integration harness",
+		Enabled:                       true,
+		ReliabilityThreshold:          dataReliabilityAlwaysCheck,
+		DataReliabilityEnabled:        true,
+		BlockDistanceForFinalizedData: 0,
+		BlocksInFinalizationProof:     1,
+		AverageBlockTime:              1000,
+		AllowedBlockLagForQosSync:     2,
+		MinStakeProvider:              sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000)),
+		MinStakeClient:                sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+		Apis: []spectypes.ServiceApi{
+			serviceApi(JsonRpcBlockNumber, spectypes.APIInterfaceJsonRPC, "GET", latest, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCKNUM,
+				FunctionTemplate: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG},
+			}),
+			serviceApi(JsonRpcGetBlockByNumber, spectypes.APIInterfaceJsonRPC, "GET", spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG}, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCK_BY_NUM,
+				FunctionTemplate: `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x%x", false],"id":1}`,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "hash"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(JsonRpcGetBalance, spectypes.APIInterfaceJsonRPC, "GET", spectypes.BlockParser{ParserArg: []string{"1"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG}, spectypes.Parsing{}),
+			serviceApi(TendermintStatus, spectypes.APIInterfaceTendermintRPC, "GET", spectypes.BlockParser{ParserArg: []string{""}, ParserFunc: spectypes.PARSER_FUNC_EMPTY}, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCKNUM,
+				FunctionTemplate: `{"jsonrpc":"2.0","method":"status","params":[],"id":1}`,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "sync_info", "latest_block_height"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(TendermintBlock, spectypes.APIInterfaceTendermintRPC, "GET", spectypes.BlockParser{ParserArg: []string{"height", "=", "0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED}, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCK_BY_NUM,
+				FunctionTemplate: `{"jsonrpc":"2.0","id":1,"method":"block","params":["%d"]}`,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "block_id", "hash"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(RestLatestBlock, spectypes.APIInterfaceRest, "GET", latest, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCKNUM,
+				FunctionTemplate: RestLatestBlock,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "block", "header", "height"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(RestBlockByHeight, spectypes.APIInterfaceRest, "GET", spectypes.BlockParser{ParserArg: []string{"0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_BY_ARG}, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCK_BY_NUM,
+				FunctionTemplate: "/cosmos/base/tendermint/v1beta1/blocks/%d",
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "block_id", "hash"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(GrpcGetLatestBlock, spectypes.APIInterfaceGrpc, "", latest, spectypes.Parsing{
+				FunctionTag:   spectypes.GET_BLOCKNUM,
+				ResultParsing: spectypes.BlockParser{ParserArg: []string{"0", "block", "header", "height"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+			serviceApi(GrpcGetBlockByHeight, spectypes.APIInterfaceGrpc, "", spectypes.BlockParser{ParserArg: []string{"height", "=", "0"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_DICTIONARY}, spectypes.Parsing{
+				FunctionTag:      spectypes.GET_BLOCK_BY_NUM,
+				FunctionTemplate: `{"height":"%d"}`,
+				ResultParsing:    spectypes.BlockParser{ParserArg: []string{"0", "blockId", "hash"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			}),
+		},
+	}
+}
@@ -228,6 +228,11 @@ func NewBlock(ctx context.Context, ks *Keepers, customTime ...time.Duration) {
 
 		ks.Pairing.RemoveOldEpochPayment(unwrapedCtx)
 		ks.Pairing.CheckUnstakingForCommit(unwrapedCtx)
+		ks.Pairing.UpdateSubscriptions(unwrapedCtx)
+		ks.Pairing.FixateSpecs(unwrapedCtx)
+		ks.Pairing.RemoveOldEpochQoSFactors(unwrapedCtx)
+		ks.Pairing.StoreEpochQoSFactors(unwrapedCtx)
+		ks.Pairing.RemoveOldFreeTxQuotas(unwrapedCtx)
 	}
 
 	ks.Conflict.CheckAndHandleAllVotes(unwrapedCtx)
@@ -85,6 +85,11 @@ func (k *mockBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts s
 	return nil
 }
 
+func (k *mockBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	acc := sdk.AccAddress([]byte(moduleName))
+	return k.SubFromBalance(acc, amounts)
+}
+
 func (k *mockBankKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
 	k.balance[addr.String()] = amounts
 	return nil
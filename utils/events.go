@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ParseTypedEvents decodes the typed events of the given type out of the events of a tendermint subscription result,
+// which are flattened to "<event type>.<attribute>" keys with a value per occurrence, in the order they were emitted.
+// typed events always carry all of their fields, so the values of every attribute line up by occurrence
+func ParseTypedEvents(events map[string][]string, eventType proto.Message) ([]proto.Message, error) {
+	typeName := proto.MessageName(eventType)
+	prefix := typeName + "."
+	attributes := []string{}
+	occurrences := 0
+	for key, values := range events {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if len(attributes) > 0 && len(values) != occurrences {
+			return nil, fmt.Errorf("typed event %s attributes don't line up: %s has %d values, expected %d", typeName, key, len(values), occurrences)
+		}
+		occurrences = len(values)
+		attributes = append(attributes, strings.TrimPrefix(key, prefix))
+	}
+	sort.Strings(attributes)
+
+	parsed := make([]proto.Message, 0, occurrences)
+	for idx := 0; idx < occurrences; idx++ {
+		event := abci.Event{Type: typeName}
+		for _, attribute := range attributes {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(attribute), Value: []byte(events[prefix+attribute][idx])})
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, msg)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,86 @@
+package utils_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// flattenEvents flattens the events the way a tendermint subscription result does
+func flattenEvents(events sdk.Events) map[string][]string {
+	flattened := map[string][]string{}
+	for _, event := range events {
+		for _, attribute := range event.Attributes {
+			key := event.Type + "." + string(attribute.Key)
+			flattened[key] = append(flattened[key], string(attribute.Value))
+		}
+	}
+	return flattened
+}
+
+func TestParseTypedEvents(t *testing.T) {
+	payments := []*pairingtypes.EventRelayPayment{
+		{
+			ChainID:           "LAV1",
+			Client:            "client1",
+			Provider:          "provider1",
+			CU:                10,
+			BasePay:           sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+			Mint:              sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+			ClientFee:         sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0)),
+			UniqueIdentifier:  1,
+			DescriptionString: "1",
+			QoSScore:          sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			ChainID:          "LAV1",
+			Client:           "client2",
+			Provider:         "provider2",
+			CU:               20,
+			BasePay:          sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(200)),
+			Mint:             sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(200)),
+			ReliabilityPay:   true,
+			ClientFee:        sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0)),
+			UniqueIdentifier: 2,
+			QoSScore:         sdk.ZeroDec(),
+			Subscription:     "client2",
+		},
+	}
+
+	eventManager := sdk.NewEventManager()
+	for _, payment := range payments {
+		require.Nil(t, eventManager.EmitTypedEvent(payment))
+		// other events in between don't get in the way
+		eventManager.EmitEvent(sdk.NewEvent("lava_relay_payment", sdk.NewAttribute("provider", payment.Provider)))
+		require.Nil(t, eventManager.EmitTypedEvent(&conflicttypes.EventConflictVoteGotCommit{VoteID: "1", Provider: payment.Provider}))
+	}
+	events := flattenEvents(eventManager.Events())
+
+	parsed, err := utils.ParseTypedEvents(events, &pairingtypes.EventRelayPayment{})
+	require.Nil(t, err)
+	require.Len(t, parsed, len(payments))
+	for idx, payment := range payments {
+		require.Equal(t, payment, parsed[idx])
+	}
+
+	commits, err := utils.ParseTypedEvents(events, &conflicttypes.EventConflictVoteGotCommit{})
+	require.Nil(t, err)
+	require.Len(t, commits, len(payments))
+
+	// no events of the type
+	parsed, err = utils.ParseTypedEvents(events, &pairingtypes.EventProviderJailed{})
+	require.Nil(t, err)
+	require.Len(t, parsed, 0)
+
+	// attributes that don't line up can't be decoded
+	cuKey := proto.MessageName(&pairingtypes.EventRelayPayment{}) + ".CU"
+	events[cuKey] = events[cuKey][:1]
+	_, err = utils.ParseTypedEvents(events, &pairingtypes.EventRelayPayment{})
+	require.NotNil(t, err)
+}
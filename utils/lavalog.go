@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	zerolog "github.com/rs/zerolog"
 	zerologlog "github.com/rs/zerolog/log"
 	"github.com/tendermint/tendermint/libs/log"
@@ -29,6 +30,16 @@ func LogLavaEvent(ctx sdk.Context, logger log.Logger, name string, attributes ma
 	ctx.EventManager().EmitEvent(sdk.NewEvent(EventPrefix+name, eventAttrs...))
 }
 
+// LogLavaTypedEvent emits a typed protobuf event and then the legacy lava_ event of the same occurrence,
+// the legacy events are kept for a deprecation period until their subscribers move to the typed events
+func LogLavaTypedEvent(ctx sdk.Context, logger log.Logger, event proto.Message, name string, attributes map[string]string, description string) {
+	err := ctx.EventManager().EmitTypedEvent(event)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed emitting typed event %s: %s", proto.MessageName(event), err))
+	}
+	LogLavaEvent(ctx, logger, name, attributes, description)
+}
+
 func LavaError(ctx sdk.Context, logger log.Logger, name string, attributes map[string]string, description string) error {
 	attributes_str := ""
 	// eventAttrs := []sdk.Attribute{}
@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/99designs/keyring"
@@ -18,6 +19,9 @@ const (
 	bechPrefix    = "vrf"
 	pk_vrf_prefix = "vrf-pk-"
 	sk_vrf_prefix = "vrf-sk-"
+	// the key replaced by the last rotation, kept as a backup until the next rotation
+	pk_vrf_previous_prefix = "vrf-previous-pk-"
+	sk_vrf_previous_prefix = "vrf-previous-sk-"
 )
 
 var VRFValueAboveReliabilityThresholdError = sdkerrors.New("VRFValueAboveReliabilityThreshold Error", 1, "calculated vrf does not result in a smaller value than threshold") // client could'nt connect to any provider.
@@ -74,6 +78,7 @@ func CalculateQueryHash(relayReq pairingtypes.RelayRequest) (queryHash []byte) {
 	relayReq.RelayNum = 0
 	relayReq.SessionId = 0
 	relayReq.Sig = nil
+	relayReq.ProofSig = nil
 	relayReq.QoSReport = nil
 	relayReq.DataReliability = nil
 	relayReq.UnresponsiveProviders = nil
@@ -132,17 +137,58 @@ func GenerateVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error
 	if err != nil {
 		return nil, nil, err
 	}
-	key := keyring.Item{Key: pk_vrf_prefix + clientCtx.FromName, Data: pk, Label: "pk", Description: "the vrf public key"}
-	err = kr.Set(key)
+	err = storeVRFKey(kr, pk_vrf_prefix, sk_vrf_prefix, clientCtx.FromName, sk, pk)
 	if err != nil {
 		return nil, nil, err
 	}
-	key = keyring.Item{Key: sk_vrf_prefix + clientCtx.FromName, Data: sk, Label: "sk", Description: "the vrf secret key"}
-	err = kr.Set(key)
+	return sk, &VrfPubKey{pk: pk}, nil
+}
+
+// RotateVRFKey replaces the vrf key of the account with a new one, the replaced key is kept as the previous key
+func RotateVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
+	sk, _, err := GeneratePrivateVRFKey()
 	if err != nil {
 		return nil, nil, err
 	}
-	return sk, &VrfPubKey{pk: pk}, nil
+	pk, err := ReplaceVRFKey(clientCtx, sk)
+	return sk, pk, err
+}
+
+// ReplaceVRFKey sets sk as the vrf key of the account, the replaced key, if there is one, is kept as the previous key
+func ReplaceVRFKey(clientCtx client.Context, sk vrf.PrivateKey) (*VrfPubKey, error) {
+	if len(sk) != vrf.PrivateKeySize {
+		return nil, fmt.Errorf("invalid vrf secret key length %d, expected %d", len(sk), vrf.PrivateKeySize)
+	}
+	pk, success := sk.Public()
+	if !success {
+		return nil, fmt.Errorf("failed deriving the vrf public key")
+	}
+	kr, err := OpenKeyring(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	currentSk, currentPk, err := LoadVRFKey(clientCtx)
+	if err == nil {
+		err = storeVRFKey(kr, pk_vrf_previous_prefix, sk_vrf_previous_prefix, clientCtx.FromName, currentSk, currentPk.pk)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = storeVRFKey(kr, pk_vrf_prefix, sk_vrf_prefix, clientCtx.FromName, sk, pk)
+	if err != nil {
+		return nil, err
+	}
+	return &VrfPubKey{pk: pk}, nil
+}
+
+func storeVRFKey(kr keyring.Keyring, pkPrefix string, skPrefix string, name string, sk vrf.PrivateKey, pk vrf.PublicKey) error {
+	key := keyring.Item{Key: pkPrefix + name, Data: pk, Label: "pk", Description: "the vrf public key"}
+	err := kr.Set(key)
+	if err != nil {
+		return err
+	}
+	key = keyring.Item{Key: skPrefix + name, Data: sk, Label: "sk", Description: "the vrf secret key"}
+	return kr.Set(key)
 }
 
 func OpenKeyring(clientCtx client.Context) (keyring.Keyring, error) {
@@ -163,15 +209,24 @@ func OpenKeyring(clientCtx client.Context) (keyring.Keyring, error) {
 }
 
 func LoadVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
+	return loadVRFKey(clientCtx, pk_vrf_prefix, sk_vrf_prefix)
+}
+
+// LoadPreviousVRFKey returns the vrf key replaced by the last rotation
+func LoadPreviousVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
+	return loadVRFKey(clientCtx, pk_vrf_previous_prefix, sk_vrf_previous_prefix)
+}
+
+func loadVRFKey(clientCtx client.Context, pkPrefix string, skPrefix string) (vrf.PrivateKey, *VrfPubKey, error) {
 	kr, err := OpenKeyring(clientCtx)
 	if err != nil {
 		return nil, nil, err
 	}
-	pkItem, err := kr.Get(pk_vrf_prefix + clientCtx.FromName)
+	pkItem, err := kr.Get(pkPrefix + clientCtx.FromName)
 	if err != nil {
 		return nil, nil, err
 	}
-	skItem, err := kr.Get(sk_vrf_prefix + clientCtx.FromName)
+	skItem, err := kr.Get(skPrefix + clientCtx.FromName)
 	return skItem.Data, &VrfPubKey{pk: pkItem.Data}, err
 }
 
@@ -242,3 +297,41 @@ func (pk *VrfPubKey) Unmarshal(bz []byte) error {
 	pk.pk = bz
 	return nil
 }
+
+// VRFKeyBackup is the exported form of a vrf key
+type VRFKeyBackup struct {
+	Vrfpk string `json:"vrfpk"`
+	Vrfsk string `json:"vrfsk"` // hex encoded
+}
+
+func NewVRFKeyBackup(sk vrf.PrivateKey, pk *VrfPubKey) (*VRFKeyBackup, error) {
+	vrfpk, err := pk.EncodeBech32()
+	if err != nil {
+		return nil, err
+	}
+	return &VRFKeyBackup{Vrfpk: vrfpk, Vrfsk: hex.EncodeToString(sk)}, nil
+}
+
+// PrivateKey returns the backed up secret key, after checking it matches the backed up public key
+func (backup *VRFKeyBackup) PrivateKey() (vrf.PrivateKey, error) {
+	skBytes, err := hex.DecodeString(backup.Vrfsk)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vrf secret key hex: %w", err)
+	}
+	sk := vrf.PrivateKey(skBytes)
+	if len(sk) != vrf.PrivateKeySize {
+		return nil, fmt.Errorf("invalid vrf secret key length %d, expected %d", len(sk), vrf.PrivateKeySize)
+	}
+	pk, success := sk.Public()
+	if !success {
+		return nil, fmt.Errorf("failed deriving the vrf public key")
+	}
+	vrfpk, err := (&VrfPubKey{pk: pk}).EncodeBech32()
+	if err != nil {
+		return nil, err
+	}
+	if vrfpk != backup.Vrfpk {
+		return nil, fmt.Errorf("vrf secret key doesn't match the public key %s", backup.Vrfpk)
+	}
+	return sk, nil
+}
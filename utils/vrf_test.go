@@ -0,0 +1,67 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateVRFKey(t *testing.T) {
+	clientCtx := client.Context{KeyringDir: t.TempDir(), FromName: "alice"}
+	_, _, err := utils.LoadVRFKey(clientCtx)
+	require.Error(t, err)
+
+	sk, pk, err := utils.GenerateVRFKey(clientCtx)
+	require.NoError(t, err)
+	_, _, err = utils.LoadPreviousVRFKey(clientCtx)
+	require.Error(t, err)
+
+	rotatedSk, rotatedPk, err := utils.RotateVRFKey(clientCtx)
+	require.NoError(t, err)
+	require.False(t, rotatedPk.Equals(*pk))
+	loadedSk, loadedPk, err := utils.LoadVRFKey(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, rotatedSk, loadedSk)
+	require.True(t, loadedPk.Equals(*rotatedPk))
+	previousSk, previousPk, err := utils.LoadPreviousVRFKey(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, sk, previousSk)
+	require.True(t, previousPk.Equals(*pk))
+
+	// the keys of other accounts are unaffected
+	_, _, err = utils.LoadVRFKey(client.Context{KeyringDir: clientCtx.KeyringDir, FromName: "bob"})
+	require.Error(t, err)
+}
+
+func TestVRFKeyBackup(t *testing.T) {
+	clientCtx := client.Context{KeyringDir: t.TempDir(), FromName: "alice"}
+	sk, pk, err := utils.GenerateVRFKey(clientCtx)
+	require.NoError(t, err)
+	backup, err := utils.NewVRFKeyBackup(sk, pk)
+	require.NoError(t, err)
+	require.Equal(t, pk.String(), backup.Vrfpk)
+
+	// restoring the backup after a rotation brings the key back
+	_, _, err = utils.RotateVRFKey(clientCtx)
+	require.NoError(t, err)
+	restoredSk, err := backup.PrivateKey()
+	require.NoError(t, err)
+	restoredPk, err := utils.ReplaceVRFKey(clientCtx, restoredSk)
+	require.NoError(t, err)
+	require.True(t, restoredPk.Equals(*pk))
+	loadedSk, _, err := utils.LoadVRFKey(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, sk, loadedSk)
+
+	otherSk, _, err := utils.GeneratePrivateVRFKey()
+	require.NoError(t, err)
+	mismatched := &utils.VRFKeyBackup{Vrfpk: backup.Vrfpk, Vrfsk: backup.Vrfsk[:10]}
+	_, err = mismatched.PrivateKey()
+	require.Error(t, err)
+	otherBackup, err := utils.NewVRFKeyBackup(otherSk, pk)
+	require.NoError(t, err)
+	_, err = otherBackup.PrivateKey()
+	require.Error(t, err)
+}
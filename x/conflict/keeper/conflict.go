@@ -58,11 +58,10 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 		return fmt.Errorf("conflict was received outside of the allowed span, current: %d, span %d - %d", ctx.BlockHeight(), epochStart, epochStart+span)
 	}
 
-	k.pairingKeeper.VerifyPairingData(ctx, chainID, clientAddr, epochStart)
-	// 2. validate signer
-	_, err = k.epochstorageKeeper.GetStakeEntryForClientEpoch(ctx, chainID, clientAddr, epochStart)
+	// 2. validate signer, the consumer is either staked or has a subscription
+	_, err = k.pairingKeeper.VerifyPairingData(ctx, chainID, clientAddr, epochStart)
 	if err != nil {
-		return fmt.Errorf("did not find a stake entry for consumer %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
+		return fmt.Errorf("did not find a stake entry or subscription for consumer %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
 	}
 	verifyClientAddrFromSignatureOnRequest := func(conflictRelayData types.ConflictRelayData) error {
 		pubKey, err := sigs.RecoverPubKeyFromRelay(*conflictRelayData.Request)
@@ -133,7 +132,7 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 			return fmt.Errorf("mismatching %s provider address signature and responseFinazalizationData %s , %s", print_st, derived_providerAccAddress, expectedAddress)
 		}
 		// validate the responses are finalized
-		if !k.specKeeper.IsFinalizedBlock(ctx, chainID, request.RequestBlock, response.LatestBlock) {
+		if !k.specKeeper.IsFinalizedBlock(ctx, chainID, uint64(request.BlockHeight), request.RequestBlock, response.LatestBlock) {
 			return fmt.Errorf("block isn't finalized on %s provider! %d,%d ", print_st, request.RequestBlock, response.LatestBlock)
 		}
 		return nil
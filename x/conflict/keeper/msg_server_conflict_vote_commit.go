@@ -31,6 +31,7 @@ func (k msgServer) ConflictVoteCommit(goCtx context.Context, msg *types.MsgConfl
 	conflictVote.Votes[index].Result = types.Commit
 	k.SetConflictVote(ctx, conflictVote)
 
-	utils.LogLavaEvent(ctx, logger, types.ConflictVoteGotCommitEventName, map[string]string{"voteID": msg.VoteID, "provider": msg.Creator}, "conflict commit received")
+	event := &types.EventConflictVoteGotCommit{VoteID: msg.VoteID, Provider: msg.Creator}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.ConflictVoteGotCommitEventName, map[string]string{"voteID": msg.VoteID, "provider": msg.Creator}, "conflict commit received")
 	return &types.MsgConflictVoteCommitResponse{}, nil
 }
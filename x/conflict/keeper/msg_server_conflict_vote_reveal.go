@@ -45,6 +45,7 @@ func (k msgServer) ConflictVoteReveal(goCtx context.Context, msg *types.MsgConfl
 	}
 
 	k.SetConflictVote(ctx, conflictVote)
-	utils.LogLavaEvent(ctx, logger, types.ConflictVoteGotRevealEventName, map[string]string{"voteID": msg.VoteID, "provider": msg.Creator}, "Simulation: conflict reveal received")
+	event := &types.EventConflictVoteGotReveal{VoteID: msg.VoteID, Provider: msg.Creator}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.ConflictVoteGotRevealEventName, map[string]string{"voteID": msg.VoteID, "provider": msg.Creator}, "Simulation: conflict reveal received")
 	return &types.MsgConflictVoteRevealResponse{}, nil
 }
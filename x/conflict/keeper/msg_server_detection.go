@@ -96,12 +96,23 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		eventData["voteDeadline"] = strconv.FormatUint(conflictVote.VoteDeadline, 10)
 		eventData["voters"] = strings.Join(voters, ",")
 
-		utils.LogLavaEvent(ctx, logger, types.ConflictVoteDetectionEventName, eventData, "Simulation: Got a new valid conflict detection from consumer, starting new vote")
+		event := &types.EventConflictVoteDetection{
+			Client:         msg.Creator,
+			VoteID:         conflictVote.Index,
+			ChainID:        conflictVote.ChainID,
+			ConnectionType: msg.ResponseConflict.ConflictRelayData0.Request.ConnectionType,
+			ApiURL:         conflictVote.ApiUrl,
+			RequestData:    conflictVote.RequestData,
+			RequestBlock:   conflictVote.RequestBlock,
+			VoteDeadline:   conflictVote.VoteDeadline,
+			Voters:         voters,
+		}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.ConflictVoteDetectionEventName, eventData, "Simulation: Got a new valid conflict detection from consumer, starting new vote")
 		return &types.MsgDetectionResponse{}, nil
 	}
 
 	eventData := map[string]string{"client": msg.Creator}
-	utils.LogLavaEvent(ctx, logger, types.ConflictDetectionRecievedEventName, eventData, "Simulation: Got a new valid conflict detection from consumer")
+	utils.LogLavaTypedEvent(ctx, logger, &types.EventConflictDetectionReceived{Client: msg.Creator}, types.ConflictDetectionRecievedEventName, eventData, "Simulation: Got a new valid conflict detection from consumer")
 	return &types.MsgDetectionResponse{}, nil
 }
 
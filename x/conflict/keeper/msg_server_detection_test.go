@@ -110,16 +110,16 @@ func TestDetection(t *testing.T) {
 			msg.ResponseConflict.ConflictRelayData1.Request.SessionId += tt.SeassionID
 			msg.ResponseConflict.ConflictRelayData1.Request.Provider = tt.Provider1.Addr.String()
 			msg.ResponseConflict.ConflictRelayData1.Request.Sig = []byte{}
-			sig, err := sigs.SignRelay(ts.consumer.SK, *msg.ResponseConflict.ConflictRelayData1.Request)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.consumer.SK, nil), *msg.ResponseConflict.ConflictRelayData1.Request)
 			require.Nil(t, err)
 			msg.ResponseConflict.ConflictRelayData1.Request.Sig = sig
 
 			//changes to reply1 according to test
 			msg.ResponseConflict.ConflictRelayData1.Reply.Data = append(msg.ResponseConflict.ConflictRelayData1.Reply.Data, tt.ReplyData...)
-			sig, err = sigs.SignRelayResponse(tt.Provider1.SK, msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request)
+			sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(tt.Provider1.SK, nil), msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request)
 			require.Nil(t, err)
 			msg.ResponseConflict.ConflictRelayData1.Reply.Sig = sig
-			sigBlocks, err := sigs.SignResponseFinalizationData(tt.Provider1.SK, msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request, ts.consumer.Addr)
+			sigBlocks, err := sigs.SignResponseFinalizationData(sigs.NewLocalSigner(tt.Provider1.SK, nil), msg.ResponseConflict.ConflictRelayData1.Reply, msg.ResponseConflict.ConflictRelayData1.Request, ts.consumer.Addr)
 			require.Nil(t, err)
 			msg.ResponseConflict.ConflictRelayData1.Reply.SigBlocks = sigBlocks
 
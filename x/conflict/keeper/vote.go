@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/conflict/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
@@ -65,6 +67,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	var winner int64
 	var winnersAddr string
 	var winnerVotersStake sdk.Int
+	winnerVotesPercentage := sdk.ZeroDec()
 
 	// count votes and punish jury that didnt vote
 	epochVoteStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, conflictVote.VoteStartBlock) // TODO check if we need to check for overlap
@@ -147,31 +150,31 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 		}
 
 		eventData["winner"] = winnersAddr
-		eventData["winnerVotes%"] = winnerVotersStake.ToDec().QuoInt(totalVotes).String()
+		winnerVotesPercentage = winnerVotersStake.ToDec().QuoInt(totalVotes)
+		eventData["winnerVotes%"] = winnerVotesPercentage.String()
 
 		// punish the frauds(the provider that was found lying and all the voters that voted for him) and fill the reward pool
 		// we need to finish the punishment before rewarding to fill up the reward pool
 		if ConsensusVote && winnerVotersStake.ToDec().QuoInt(totalVotes).GTE(k.MajorityPercent(ctx)) {
 			for _, vote := range conflictVote.Votes {
 				if vote.Result != winner && !slices.Contains(providersWithoutVote, vote.Address) { // punish those who voted wrong, voters that didnt vote already got punished
-					accAddress, err := sdk.AccAddressFromBech32(vote.Address)
-					if err != nil {
-						utils.LavaError(ctx, logger, "invalid_address", map[string]string{"error": err.Error()}, "")
-						continue
-					}
-					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0))
-					rewardPool = rewardPool.Add(slashed)
-					if err != nil {
-						utils.LavaError(ctx, logger, "slash_failed_vote", map[string]string{"error": err.Error()}, "slashing failed at vote conflict")
-					}
-
-					err = k.pairingKeeper.UnstakeEntry(ctx, true, conflictVote.ChainID, vote.Address, types.UnstakeDescriptionFraudVote)
-					if err != nil {
-						utils.LavaError(ctx, logger, "unstake_fraud_failed", map[string]string{"error": err.Error()}, "unstaking fraud voter failed")
-						continue
-					}
+					rewardPool = rewardPool.Add(k.punishFraud(ctx, conflictVote.ChainID, vote.Address))
 				}
 			}
+			// the providers that replied with the losing response are punished as well, if none of them won both lied
+			var fraudProviders []string
+			switch winner {
+			case types.Provider0:
+				fraudProviders = []string{conflictVote.SecondProvider.Account}
+			case types.Provider1:
+				fraudProviders = []string{conflictVote.FirstProvider.Account}
+			default:
+				fraudProviders = []string{conflictVote.FirstProvider.Account, conflictVote.SecondProvider.Account}
+			}
+			for _, fraudProvider := range fraudProviders {
+				rewardPool = rewardPool.Add(k.punishFraud(ctx, conflictVote.ChainID, fraudProvider))
+			}
+			eventData["fraudProviders"] = strings.Join(fraudProviders, ",")
 		}
 	} else {
 		eventName = types.ConflictVoteUnresolvedEventName
@@ -262,7 +265,60 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 
 	k.RemoveConflictVote(ctx, conflictVote.Index)
 
-	utils.LogLavaEvent(ctx, logger, eventName, eventData, "conflict detection resolved")
+	numOfNoVoters := uint64(len(providersWithoutVote))
+	numOfVoters := uint64(len(conflictVote.Votes)) - numOfNoVoters
+	var event proto.Message
+	if majorityMet {
+		event = &types.EventConflictVoteResolved{
+			VoteID:                conflictVote.Index,
+			ChainID:               conflictVote.ChainID,
+			NumOfVoters:           numOfVoters,
+			NumOfNoVoters:         numOfNoVoters,
+			TotalVotes:            totalVotes,
+			FirstProviderVotes:    firstProviderVotes,
+			SecondProviderVotes:   secondProviderVotes,
+			NoneProviderVotes:     noneProviderVotes,
+			Winner:                winnersAddr,
+			WinnerVotesPercentage: winnerVotesPercentage,
+			RewardPool:            rewardPool,
+		}
+	} else {
+		event = &types.EventConflictVoteUnresolved{
+			VoteID:              conflictVote.Index,
+			ChainID:             conflictVote.ChainID,
+			NumOfVoters:         numOfVoters,
+			NumOfNoVoters:       numOfNoVoters,
+			TotalVotes:          totalVotes,
+			FirstProviderVotes:  firstProviderVotes,
+			SecondProviderVotes: secondProviderVotes,
+			NoneProviderVotes:   noneProviderVotes,
+			VoteFailed:          eventData["voteFailed"],
+			RewardPool:          rewardPool,
+		}
+	}
+	utils.LogLavaTypedEvent(ctx, logger, event, eventName, eventData, "conflict detection resolved")
+}
+
+// punishFraud slashes the whole stake of a provider that was found lying in a vote and unstakes it, it returns the slashed amount
+func (k Keeper) punishFraud(ctx sdk.Context, chainID string, address string) sdk.Coin {
+	logger := k.Logger(ctx)
+	noSlash := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		utils.LavaError(ctx, logger, "invalid_address", map[string]string{"error": err.Error()}, "")
+		return noSlash
+	}
+	slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, true, chainID, sdk.NewDecWithPrec(1, 0))
+	if err != nil {
+		utils.LavaError(ctx, logger, "slash_failed_vote", map[string]string{"error": err.Error()}, "slashing failed at vote conflict")
+		slashed = noSlash
+	}
+
+	err = k.pairingKeeper.UnstakeEntry(ctx, true, chainID, address, types.UnstakeDescriptionFraudVote)
+	if err != nil {
+		utils.LavaError(ctx, logger, "unstake_fraud_failed", map[string]string{"error": err.Error(), "provider": address}, "unstaking fraud provider failed")
+	}
+	return slashed
 }
 
 func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.ConflictVote) {
@@ -279,7 +335,8 @@ func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.Confl
 	eventData := map[string]string{}
 	eventData["voteID"] = conflictVote.Index
 	eventData["voteDeadline"] = strconv.FormatUint(conflictVote.VoteDeadline, 10)
-	utils.LogLavaEvent(ctx, logger, types.ConflictVoteRevealEventName, eventData, "Vote is now in reveal state")
+	event := &types.EventConflictVoteRevealStarted{VoteID: conflictVote.Index, VoteDeadline: conflictVote.VoteDeadline}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.ConflictVoteRevealEventName, eventData, "Vote is now in reveal state")
 }
 
 func (k Keeper) CleanUpVote(ctx sdk.Context, index string) {
@@ -45,10 +45,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
-	conflictGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&conflictGenesis)
+	conflictGenesis := types.DefaultGenesis()
+	// this line is used by starport scaffolding # simapp/module/genesisState
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(conflictGenesis)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -81,7 +80,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgDetection,
-		conflictsimulation.SimulateMsgDetection(am.accountKeeper, am.bankKeeper, am.keeper),
+		conflictsimulation.SimulateMsgDetection(am.accountKeeper, am.bankKeeper, am.specKeeper, am.epochstorageKeeper, am.keeper),
 	))
 
 	var weightMsgConflictVoteCommit int
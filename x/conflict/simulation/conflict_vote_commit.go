@@ -6,8 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/lavanet/lava/x/conflict/keeper"
 	"github.com/lavanet/lava/x/conflict/types"
+	pairingsimulation "github.com/lavanet/lava/x/pairing/simulation"
 )
 
 func SimulateMsgConflictVoteCommit(
@@ -17,13 +19,35 @@ func SimulateMsgConflictVoteCommit(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgConflictVoteCommit{
-			Creator: simAccount.Address.String(),
+		conflictVotes := k.GetAllConflictVote(ctx)
+		if r.Intn(10) == 0 {
+			// a vote in reveal state doesn't accept commits anymore
+			voterOf, found := randomVoter(r, accs, conflictVotes, types.StateReveal, types.NoVote)
+			if !found {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConflictVoteCommit, "no vote in reveal state"), nil, nil
+			}
+			nonce, responseHash := VoteChoice(voterOf.conflictVote, voterOf.voter.Address.String())
+			msg := types.NewMsgConflictVoteCommit(voterOf.voter.Address.String(), voterOf.conflictVote.Index, types.CommitVoteData(nonce, responseHash))
+			return pairingsimulation.GenAndDeliverInvalidTx(operationInput(r, app, ctx, voterOf.voter, msg, msg.Type(), ak, bk), "conflict vote commit after the commit period")
 		}
 
-		// TODO: Handling the ConflictVoteCommit simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "ConflictVoteCommit simulation not implemented"), nil, nil
+		voterOf, found := randomVoter(r, accs, conflictVotes, types.StateCommit, types.NoVote)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConflictVoteCommit, "no simulated voter left to commit"), nil, nil
+		}
+		nonce, responseHash := VoteChoice(voterOf.conflictVote, voterOf.voter.Address.String())
+		msg := types.NewMsgConflictVoteCommit(voterOf.voter.Address.String(), voterOf.conflictVote.Index, types.CommitVoteData(nonce, responseHash))
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, voterOf.voter, msg, msg.Type(), ak, bk))
+		if err != nil || !opMsg.OK || r.Intn(3) != 0 {
+			return opMsg, nil, err
+		}
+		// the voter commits again on the next block
+		doubleCommit := simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op: func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+				return pairingsimulation.GenAndDeliverInvalidTx(operationInput(r, app, ctx, voterOf.voter, msg, msg.Type(), ak, bk), "second conflict vote commit of a voter")
+			},
+		}
+		return opMsg, []simtypes.FutureOperation{doubleCommit}, nil
 	}
 }
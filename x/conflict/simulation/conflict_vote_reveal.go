@@ -6,8 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/lavanet/lava/x/conflict/keeper"
 	"github.com/lavanet/lava/x/conflict/types"
+	pairingsimulation "github.com/lavanet/lava/x/pairing/simulation"
 )
 
 func SimulateMsgConflictVoteReveal(
@@ -17,13 +19,17 @@ func SimulateMsgConflictVoteReveal(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgConflictVoteReveal{
-			Creator: simAccount.Address.String(),
+		voterOf, found := randomVoter(r, accs, k.GetAllConflictVote(ctx), types.StateReveal, types.Commit)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConflictVoteReveal, "no simulated voter left to reveal"), nil, nil
 		}
-
-		// TODO: Handling the ConflictVoteReveal simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "ConflictVoteReveal simulation not implemented"), nil, nil
+		nonce, responseHash := VoteChoice(voterOf.conflictVote, voterOf.voter.Address.String())
+		if r.Intn(5) == 0 {
+			// a reveal that doesn't match the commit, the voter can still reveal correctly later
+			msg := types.NewMsgConflictVoteReveal(voterOf.voter.Address.String(), voterOf.conflictVote.Index, nonce+1, responseHash)
+			return pairingsimulation.GenAndDeliverInvalidTx(operationInput(r, app, ctx, voterOf.voter, msg, msg.Type(), ak, bk), "conflict vote reveal that doesn't match the commit")
+		}
+		msg := types.NewMsgConflictVoteReveal(voterOf.voter.Address.String(), voterOf.conflictVote.Index, nonce, responseHash)
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, voterOf.voter, msg, msg.Type(), ak, bk))
 	}
 }
@@ -6,24 +6,113 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/x/conflict/keeper"
 	"github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingsimulation "github.com/lavanet/lava/x/pairing/simulation"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
 func SimulateMsgDetection(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	sk types.SpecKeeper,
+	ek types.EpochstorageKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgDetection{
-			Creator: simAccount.Address.String(),
+		spec, found := randomEnabledSpec(r, ctx, sk)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDetection, "no enabled spec"), nil, nil
 		}
+		epochStart := ek.GetEpochStart(ctx)
+		clientEntries, found, _ := ek.GetEpochStakeEntries(ctx, epochStart, epochstoragetypes.ClientKey, spec.Index)
+		clients := pairingsimulation.SimAccountsOfEntries(accs, clientEntries)
+		if !found || len(clients) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDetection, "no staked simulated client"), nil, nil
+		}
+		providerEntries, found, _ := ek.GetEpochStakeEntries(ctx, epochStart, epochstoragetypes.ProviderKey, spec.Index)
+		providers := pairingsimulation.SimAccountsOfEntries(accs, providerEntries)
+		if !found || len(providers) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDetection, "less than two staked simulated providers"), nil, nil
+		}
+		client := clients[r.Intn(len(clients))]
+		first := r.Intn(len(providers))
+		second := (first + 1 + r.Intn(len(providers)-1)) % len(providers)
+
+		sameResponse := r.Intn(10) == 0
+		msg, err := newMsgDetection(r, ctx, spec, client, providers[first], providers[second], sameResponse)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDetection, "failed signing the conflict"), nil, err
+		}
+		txCtx := operationInput(r, app, ctx, client, msg, msg.Type(), ak, bk)
+		if sameResponse {
+			return pairingsimulation.GenAndDeliverInvalidTx(txCtx, "detection of providers that responded the same")
+		}
+		if _, found := k.GetConflictVote(ctx, keeper.DetectionIndex(msg, epochStart)); found {
+			return pairingsimulation.GenAndDeliverInvalidTx(txCtx, "detection of a conflict that is already voted on")
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// newMsgDetection builds a response conflict of the client on two providers that replied differently to the same request
+func newMsgDetection(r *rand.Rand, ctx sdk.Context, spec spectypes.Spec, client simtypes.Account, provider0 simtypes.Account, provider1 simtypes.Account, sameResponse bool) (*types.MsgDetection, error) {
+	api := "sim_api"
+	if len(spec.Apis) > 0 {
+		api = spec.Apis[r.Intn(len(spec.Apis))].Name
+	}
+	request := pairingtypes.RelayRequest{
+		ChainID:      spec.Index,
+		SessionId:    r.Uint64(),
+		Data:         []byte(api + simtypes.RandStringOfLength(r, 10)),
+		BlockHeight:  ctx.BlockHeight(),
+		RelayNum:     1,
+		RequestBlock: ctx.BlockHeight(),
+		QoSReport:    &pairingtypes.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()},
+	}
+	replyData := []byte(simtypes.RandStringOfLength(r, 20))
 
-		// TODO: Handling the Detection simulation
+	conflictRelayData := func(provider simtypes.Account, data []byte) (*types.ConflictRelayData, error) {
+		providerRequest := request
+		providerRequest.Provider = provider.Address.String()
+		var err error
+		providerRequest.Sig, err = sigs.SignRelay(pairingsimulation.RelaySigner(client), providerRequest)
+		if err != nil {
+			return nil, err
+		}
+		reply := &pairingtypes.RelayReply{
+			Data:                  data,
+			Nonce:                 r.Uint32(),
+			LatestBlock:           request.RequestBlock + int64(spec.BlockDistanceForFinalizedData),
+			FinalizedBlocksHashes: []byte{},
+		}
+		providerSigner := pairingsimulation.RelaySigner(provider)
+		reply.Sig, err = sigs.SignRelayResponse(providerSigner, reply, &providerRequest)
+		if err != nil {
+			return nil, err
+		}
+		reply.SigBlocks, err = sigs.SignResponseFinalizationData(providerSigner, reply, &providerRequest, client.Address)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ConflictRelayData{Request: &providerRequest, Reply: reply}, nil
+	}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "Detection simulation not implemented"), nil, nil
+	relayData0, err := conflictRelayData(provider0, replyData)
+	if err != nil {
+		return nil, err
+	}
+	if !sameResponse {
+		replyData = append(append([]byte{}, replyData...), []byte("DIFF")...)
+	}
+	relayData1, err := conflictRelayData(provider1, replyData)
+	if err != nil {
+		return nil, err
 	}
+	return types.NewMsgDetection(client.Address.String(), nil, &types.ResponseConflict{ConflictRelayData0: relayData0, ConflictRelayData1: relayData1}, nil), nil
 }
@@ -1,8 +1,17 @@
 package simulation
 
 import (
+	"encoding/binary"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/lavanet/lava/x/conflict/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	tendermintcrypto "github.com/tendermint/tendermint/crypto"
 )
 
 // FindAccount find a specific address from an account list
@@ -13,3 +22,73 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// VoteChoice is the nonce and response hash a simulated voter commits to on a vote, it is derived from the vote and the voter
+// so the reveal matches the commit without keeping state between operations
+func VoteChoice(conflictVote types.ConflictVote, voter string) (nonce int64, responseHash []byte) {
+	seed := tendermintcrypto.Sha256([]byte(conflictVote.Index + voter))
+	nonce = int64(binary.LittleEndian.Uint64(seed[:8]) >> 1)
+	switch seed[8] % 3 {
+	case 0:
+		responseHash = conflictVote.FirstProvider.Response
+	case 1:
+		responseHash = conflictVote.SecondProvider.Response
+	default:
+		responseHash = tendermintcrypto.Sha256(seed)
+	}
+	return nonce, responseHash
+}
+
+// randomEnabledSpec returns a random enabled spec
+func randomEnabledSpec(r *rand.Rand, ctx sdk.Context, sk types.SpecKeeper) (spectypes.Spec, bool) {
+	chainIDs := sk.GetAllChainIDs(ctx)
+	if len(chainIDs) == 0 {
+		return spectypes.Spec{}, false
+	}
+	spec, found := sk.GetSpec(ctx, chainIDs[r.Intn(len(chainIDs))], uint64(ctx.BlockHeight()))
+	return spec, found && spec.Enabled
+}
+
+// voterOf is a vote and one of its simulated voters
+type voterOf struct {
+	conflictVote types.ConflictVote
+	voter        simtypes.Account
+}
+
+// randomVoter returns a simulated voter with the wanted result, on a vote in the wanted state
+func randomVoter(r *rand.Rand, accs []simtypes.Account, conflictVotes []types.ConflictVote, voteState int64, result int64) (voterOf, bool) {
+	voters := []voterOf{}
+	for _, conflictVote := range conflictVotes {
+		if conflictVote.VoteState != voteState {
+			continue
+		}
+		for _, vote := range conflictVote.Votes {
+			if vote.Result != result {
+				continue
+			}
+			if acc, found := FindAccount(accs, vote.Address); found {
+				voters = append(voters, voterOf{conflictVote: conflictVote, voter: acc})
+			}
+		}
+	}
+	if len(voters) == 0 {
+		return voterOf{}, false
+	}
+	return voters[r.Intn(len(voters))], true
+}
+
+func operationInput(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, simAccount simtypes.Account, msg sdk.Msg, msgType string, ak types.AccountKeeper, bk types.BankKeeper) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	}
+}
@@ -0,0 +1,2762 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: conflict/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// emitted when a conflict detection starts a vote, the voters commit their responses to the relay
+type EventConflictVoteDetection struct {
+	Client         string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	VoteID         string   `protobuf:"bytes,2,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ChainID        string   `protobuf:"bytes,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ConnectionType string   `protobuf:"bytes,4,opt,name=connectionType,proto3" json:"connectionType,omitempty"`
+	ApiURL         string   `protobuf:"bytes,5,opt,name=apiURL,proto3" json:"apiURL,omitempty"`
+	RequestData    []byte   `protobuf:"bytes,6,opt,name=requestData,proto3" json:"requestData,omitempty"`
+	RequestBlock   uint64   `protobuf:"varint,7,opt,name=requestBlock,proto3" json:"requestBlock,omitempty"`
+	VoteDeadline   uint64   `protobuf:"varint,8,opt,name=voteDeadline,proto3" json:"voteDeadline,omitempty"`
+	Voters         []string `protobuf:"bytes,9,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *EventConflictVoteDetection) Reset()         { *m = EventConflictVoteDetection{} }
+func (m *EventConflictVoteDetection) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteDetection) ProtoMessage()    {}
+func (*EventConflictVoteDetection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{0}
+}
+func (m *EventConflictVoteDetection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteDetection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteDetection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteDetection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteDetection.Merge(m, src)
+}
+func (m *EventConflictVoteDetection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteDetection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteDetection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteDetection proto.InternalMessageInfo
+
+func (m *EventConflictVoteDetection) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *EventConflictVoteDetection) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteDetection) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteDetection) GetConnectionType() string {
+	if m != nil {
+		return m.ConnectionType
+	}
+	return ""
+}
+
+func (m *EventConflictVoteDetection) GetApiURL() string {
+	if m != nil {
+		return m.ApiURL
+	}
+	return ""
+}
+
+func (m *EventConflictVoteDetection) GetRequestData() []byte {
+	if m != nil {
+		return m.RequestData
+	}
+	return nil
+}
+
+func (m *EventConflictVoteDetection) GetRequestBlock() uint64 {
+	if m != nil {
+		return m.RequestBlock
+	}
+	return 0
+}
+
+func (m *EventConflictVoteDetection) GetVoteDeadline() uint64 {
+	if m != nil {
+		return m.VoteDeadline
+	}
+	return 0
+}
+
+func (m *EventConflictVoteDetection) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+type EventConflictDetectionReceived struct {
+	Client string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+}
+
+func (m *EventConflictDetectionReceived) Reset()         { *m = EventConflictDetectionReceived{} }
+func (m *EventConflictDetectionReceived) String() string { return proto.CompactTextString(m) }
+func (*EventConflictDetectionReceived) ProtoMessage()    {}
+func (*EventConflictDetectionReceived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{1}
+}
+func (m *EventConflictDetectionReceived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictDetectionReceived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictDetectionReceived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictDetectionReceived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictDetectionReceived.Merge(m, src)
+}
+func (m *EventConflictDetectionReceived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictDetectionReceived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictDetectionReceived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictDetectionReceived proto.InternalMessageInfo
+
+func (m *EventConflictDetectionReceived) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+type EventConflictVoteGotCommit struct {
+	VoteID   string `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *EventConflictVoteGotCommit) Reset()         { *m = EventConflictVoteGotCommit{} }
+func (m *EventConflictVoteGotCommit) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteGotCommit) ProtoMessage()    {}
+func (*EventConflictVoteGotCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{2}
+}
+func (m *EventConflictVoteGotCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteGotCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteGotCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteGotCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteGotCommit.Merge(m, src)
+}
+func (m *EventConflictVoteGotCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteGotCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteGotCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteGotCommit proto.InternalMessageInfo
+
+func (m *EventConflictVoteGotCommit) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteGotCommit) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type EventConflictVoteGotReveal struct {
+	VoteID   string `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *EventConflictVoteGotReveal) Reset()         { *m = EventConflictVoteGotReveal{} }
+func (m *EventConflictVoteGotReveal) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteGotReveal) ProtoMessage()    {}
+func (*EventConflictVoteGotReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{3}
+}
+func (m *EventConflictVoteGotReveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteGotReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteGotReveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteGotReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteGotReveal.Merge(m, src)
+}
+func (m *EventConflictVoteGotReveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteGotReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteGotReveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteGotReveal proto.InternalMessageInfo
+
+func (m *EventConflictVoteGotReveal) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteGotReveal) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type EventConflictVoteRevealStarted struct {
+	VoteID       string `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	VoteDeadline uint64 `protobuf:"varint,2,opt,name=voteDeadline,proto3" json:"voteDeadline,omitempty"`
+}
+
+func (m *EventConflictVoteRevealStarted) Reset()         { *m = EventConflictVoteRevealStarted{} }
+func (m *EventConflictVoteRevealStarted) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteRevealStarted) ProtoMessage()    {}
+func (*EventConflictVoteRevealStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{4}
+}
+func (m *EventConflictVoteRevealStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteRevealStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteRevealStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteRevealStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteRevealStarted.Merge(m, src)
+}
+func (m *EventConflictVoteRevealStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteRevealStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteRevealStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteRevealStarted proto.InternalMessageInfo
+
+func (m *EventConflictVoteRevealStarted) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteRevealStarted) GetVoteDeadline() uint64 {
+	if m != nil {
+		return m.VoteDeadline
+	}
+	return 0
+}
+
+type EventConflictVoteResolved struct {
+	VoteID                string                                 `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ChainID               string                                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	NumOfVoters           uint64                                 `protobuf:"varint,3,opt,name=numOfVoters,proto3" json:"numOfVoters,omitempty"`
+	NumOfNoVoters         uint64                                 `protobuf:"varint,4,opt,name=numOfNoVoters,proto3" json:"numOfNoVoters,omitempty"`
+	TotalVotes            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalVotes"`
+	FirstProviderVotes    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=firstProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"firstProviderVotes"`
+	SecondProviderVotes   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=secondProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"secondProviderVotes"`
+	NoneProviderVotes     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=noneProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"noneProviderVotes"`
+	Winner                string                                 `protobuf:"bytes,9,opt,name=winner,proto3" json:"winner,omitempty"`
+	WinnerVotesPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=winnerVotesPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"winnerVotesPercentage"`
+	RewardPool            types.Coin                             `protobuf:"bytes,11,opt,name=rewardPool,proto3" json:"rewardPool"`
+}
+
+func (m *EventConflictVoteResolved) Reset()         { *m = EventConflictVoteResolved{} }
+func (m *EventConflictVoteResolved) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteResolved) ProtoMessage()    {}
+func (*EventConflictVoteResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{5}
+}
+func (m *EventConflictVoteResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteResolved.Merge(m, src)
+}
+func (m *EventConflictVoteResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteResolved proto.InternalMessageInfo
+
+func (m *EventConflictVoteResolved) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteResolved) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteResolved) GetNumOfVoters() uint64 {
+	if m != nil {
+		return m.NumOfVoters
+	}
+	return 0
+}
+
+func (m *EventConflictVoteResolved) GetNumOfNoVoters() uint64 {
+	if m != nil {
+		return m.NumOfNoVoters
+	}
+	return 0
+}
+
+func (m *EventConflictVoteResolved) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *EventConflictVoteResolved) GetRewardPool() types.Coin {
+	if m != nil {
+		return m.RewardPool
+	}
+	return types.Coin{}
+}
+
+// emitted when a vote ends without a majority
+type EventConflictVoteUnresolved struct {
+	VoteID              string                                 `protobuf:"bytes,1,opt,name=voteID,proto3" json:"voteID,omitempty"`
+	ChainID             string                                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	NumOfVoters         uint64                                 `protobuf:"varint,3,opt,name=numOfVoters,proto3" json:"numOfVoters,omitempty"`
+	NumOfNoVoters       uint64                                 `protobuf:"varint,4,opt,name=numOfNoVoters,proto3" json:"numOfNoVoters,omitempty"`
+	TotalVotes          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalVotes"`
+	FirstProviderVotes  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=firstProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"firstProviderVotes"`
+	SecondProviderVotes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=secondProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"secondProviderVotes"`
+	NoneProviderVotes   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=noneProviderVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"noneProviderVotes"`
+	VoteFailed          string                                 `protobuf:"bytes,9,opt,name=voteFailed,proto3" json:"voteFailed,omitempty"`
+	RewardPool          types.Coin                             `protobuf:"bytes,10,opt,name=rewardPool,proto3" json:"rewardPool"`
+}
+
+func (m *EventConflictVoteUnresolved) Reset()         { *m = EventConflictVoteUnresolved{} }
+func (m *EventConflictVoteUnresolved) String() string { return proto.CompactTextString(m) }
+func (*EventConflictVoteUnresolved) ProtoMessage()    {}
+func (*EventConflictVoteUnresolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3d47630cabfa12c, []int{6}
+}
+func (m *EventConflictVoteUnresolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConflictVoteUnresolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConflictVoteUnresolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConflictVoteUnresolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConflictVoteUnresolved.Merge(m, src)
+}
+func (m *EventConflictVoteUnresolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConflictVoteUnresolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConflictVoteUnresolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConflictVoteUnresolved proto.InternalMessageInfo
+
+func (m *EventConflictVoteUnresolved) GetVoteID() string {
+	if m != nil {
+		return m.VoteID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteUnresolved) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventConflictVoteUnresolved) GetNumOfVoters() uint64 {
+	if m != nil {
+		return m.NumOfVoters
+	}
+	return 0
+}
+
+func (m *EventConflictVoteUnresolved) GetNumOfNoVoters() uint64 {
+	if m != nil {
+		return m.NumOfNoVoters
+	}
+	return 0
+}
+
+func (m *EventConflictVoteUnresolved) GetVoteFailed() string {
+	if m != nil {
+		return m.VoteFailed
+	}
+	return ""
+}
+
+func (m *EventConflictVoteUnresolved) GetRewardPool() types.Coin {
+	if m != nil {
+		return m.RewardPool
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EventConflictVoteDetection)(nil), "lavanet.lava.conflict.EventConflictVoteDetection")
+	proto.RegisterType((*EventConflictDetectionReceived)(nil), "lavanet.lava.conflict.EventConflictDetectionReceived")
+	proto.RegisterType((*EventConflictVoteGotCommit)(nil), "lavanet.lava.conflict.EventConflictVoteGotCommit")
+	proto.RegisterType((*EventConflictVoteGotReveal)(nil), "lavanet.lava.conflict.EventConflictVoteGotReveal")
+	proto.RegisterType((*EventConflictVoteRevealStarted)(nil), "lavanet.lava.conflict.EventConflictVoteRevealStarted")
+	proto.RegisterType((*EventConflictVoteResolved)(nil), "lavanet.lava.conflict.EventConflictVoteResolved")
+	proto.RegisterType((*EventConflictVoteUnresolved)(nil), "lavanet.lava.conflict.EventConflictVoteUnresolved")
+}
+
+func init() { proto.RegisterFile("conflict/events.proto", fileDescriptor_c3d47630cabfa12c) }
+
+var fileDescriptor_c3d47630cabfa12c = []byte{
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0xcb, 0xb2, 0xc0, 0x5b, 0x34, 0x71, 0x14, 0x53, 0xd6, 0xa4, 0x34, 0x1b, 0x43,
+	0xf6, 0x62, 0x1b, 0xf4, 0xe2, 0xcd, 0x64, 0x59, 0x35, 0x24, 0x06, 0x37, 0x55, 0x38, 0x18, 0x62,
+	0x9c, 0x9d, 0x3e, 0x96, 0x09, 0xdd, 0x99, 0xb5, 0x33, 0x14, 0xf9, 0x16, 0x7e, 0x0c, 0x3f, 0x88,
+	0x07, 0x8e, 0x1c, 0x8d, 0x07, 0x62, 0xe0, 0xe2, 0xc7, 0x30, 0xed, 0x74, 0x49, 0xcb, 0x2e, 0x26,
+	0xec, 0x55, 0x4f, 0xd3, 0xf7, 0xfa, 0xe7, 0xf7, 0x3a, 0xff, 0xf9, 0xd3, 0x2d, 0xac, 0x30, 0x29,
+	0xf6, 0x23, 0xce, 0xb4, 0x8f, 0x09, 0x0a, 0xad, 0xbc, 0x51, 0x2c, 0xb5, 0x24, 0x2b, 0x11, 0x4d,
+	0xa8, 0x40, 0xed, 0xa5, 0xab, 0x37, 0xd6, 0x34, 0x1f, 0x0c, 0xe4, 0x40, 0x66, 0x0a, 0x3f, 0xbd,
+	0x32, 0xe2, 0xa6, 0xc3, 0xa4, 0x1a, 0x4a, 0xe5, 0xf7, 0xa9, 0x42, 0x3f, 0xd9, 0xe8, 0xa3, 0xa6,
+	0x1b, 0x3e, 0x93, 0x5c, 0x98, 0xfb, 0xad, 0x6f, 0x55, 0x68, 0xbe, 0x4c, 0xe9, 0x9b, 0x39, 0x67,
+	0x57, 0x6a, 0xec, 0xa2, 0x46, 0xa6, 0xb9, 0x14, 0xe4, 0x21, 0xd4, 0x59, 0xc4, 0x51, 0x68, 0xdb,
+	0x72, 0xad, 0xf6, 0x52, 0x90, 0x57, 0x69, 0x3f, 0x91, 0x1a, 0xb7, 0xba, 0x76, 0xd5, 0xf4, 0x4d,
+	0x45, 0x6c, 0x58, 0x60, 0x07, 0x94, 0x8b, 0xad, 0xae, 0x3d, 0x97, 0xdd, 0x18, 0x97, 0x64, 0x1d,
+	0xee, 0x32, 0x29, 0x84, 0xe1, 0xbe, 0x3f, 0x19, 0xa1, 0x5d, 0xcb, 0x04, 0xd7, 0xba, 0x29, 0x99,
+	0x8e, 0xf8, 0x4e, 0xf0, 0xc6, 0x9e, 0x37, 0x64, 0x53, 0x11, 0x17, 0x1a, 0x31, 0x7e, 0x3e, 0x42,
+	0xa5, 0xbb, 0x54, 0x53, 0xbb, 0xee, 0x5a, 0xed, 0xe5, 0xa0, 0xd8, 0x22, 0x2d, 0x58, 0xce, 0xcb,
+	0x4e, 0x24, 0xd9, 0xa1, 0xbd, 0xe0, 0x5a, 0xed, 0x5a, 0x50, 0xea, 0xa5, 0x9a, 0x24, 0xdb, 0x20,
+	0x0d, 0x23, 0x2e, 0xd0, 0x5e, 0x34, 0x9a, 0x62, 0x6f, 0xbc, 0xb7, 0x58, 0xd9, 0x4b, 0xee, 0xdc,
+	0x78, 0x6f, 0xb1, 0x6a, 0x3d, 0x07, 0xa7, 0xe4, 0xd4, 0x95, 0x4b, 0x01, 0x32, 0xe4, 0x09, 0x86,
+	0x37, 0xb9, 0xd5, 0xea, 0x4d, 0xf1, 0xf8, 0xb5, 0xd4, 0x9b, 0x72, 0x38, 0xe4, 0x45, 0x2f, 0xad,
+	0x92, 0x97, 0x4d, 0x58, 0x1c, 0xc5, 0x32, 0xe1, 0x21, 0xc6, 0xb9, 0xcb, 0x57, 0xf5, 0x4d, 0xc4,
+	0x00, 0x13, 0xa4, 0xd1, 0x4c, 0xc4, 0x3d, 0x70, 0x26, 0x88, 0x06, 0xf7, 0x4e, 0xd3, 0x58, 0x9b,
+	0xdd, 0x4d, 0xa5, 0x5e, 0xf7, 0xb4, 0x3a, 0xe9, 0x69, 0xeb, 0xfb, 0x3c, 0xac, 0x4e, 0xc1, 0x2b,
+	0x19, 0x25, 0x7f, 0x21, 0x17, 0xd2, 0x54, 0x2d, 0xa7, 0xc9, 0x85, 0x86, 0x38, 0x1a, 0xbe, 0xdd,
+	0xdf, 0x35, 0x07, 0x35, 0x97, 0x8d, 0x2c, 0xb6, 0xc8, 0x63, 0xb8, 0x93, 0x95, 0xdb, 0x32, 0xd7,
+	0xd4, 0x32, 0x4d, 0xb9, 0x49, 0xb6, 0x01, 0xb4, 0xd4, 0x34, 0x4a, 0x4b, 0x65, 0x12, 0xd7, 0xf1,
+	0x4e, 0xcf, 0xd7, 0x2a, 0x3f, 0xcf, 0xd7, 0xd6, 0x07, 0x5c, 0x1f, 0x1c, 0xf5, 0x3d, 0x26, 0x87,
+	0x7e, 0xfe, 0x5f, 0x64, 0x96, 0x27, 0x2a, 0x3c, 0xf4, 0xf5, 0xc9, 0x08, 0x95, 0xb7, 0x25, 0x74,
+	0x50, 0x20, 0x90, 0x8f, 0x40, 0xf6, 0x79, 0xac, 0x74, 0x2f, 0xb7, 0xd5, 0x70, 0xeb, 0x33, 0x71,
+	0xa7, 0x90, 0xc8, 0x27, 0xb8, 0xaf, 0x90, 0x49, 0x11, 0x96, 0x07, 0x2c, 0xcc, 0x34, 0x60, 0x1a,
+	0x8a, 0xec, 0xc1, 0x3d, 0x21, 0x05, 0x96, 0xf9, 0x8b, 0x33, 0xf1, 0x27, 0x41, 0xe9, 0x49, 0x1f,
+	0x73, 0x21, 0x30, 0xb6, 0x97, 0xcc, 0x49, 0x9b, 0x8a, 0x84, 0xb0, 0x62, 0xae, 0x32, 0x59, 0x0f,
+	0x63, 0x86, 0x42, 0xd3, 0x01, 0xda, 0x70, 0xeb, 0xc9, 0x5d, 0x64, 0xc1, 0x74, 0x18, 0x79, 0x01,
+	0x10, 0xe3, 0x31, 0x8d, 0xc3, 0x9e, 0x94, 0x91, 0xdd, 0x70, 0xad, 0x76, 0xe3, 0xe9, 0xaa, 0x67,
+	0x08, 0x5e, 0xfa, 0x86, 0xf4, 0xf2, 0x37, 0xa4, 0xb7, 0x29, 0xb9, 0xe8, 0xd4, 0xd2, 0xa9, 0x41,
+	0xe1, 0x4f, 0x5a, 0xbf, 0x6b, 0xf0, 0x68, 0x22, 0xc6, 0x3b, 0x22, 0xfe, 0x1f, 0xe4, 0x7f, 0x2d,
+	0xc8, 0x0e, 0x40, 0x7a, 0xb6, 0xaf, 0x28, 0x8f, 0x30, 0xcc, 0xc3, 0x5c, 0xe8, 0x5c, 0x8b, 0x1a,
+	0xdc, 0x3a, 0x6a, 0x9d, 0xce, 0xe9, 0x85, 0x63, 0x9d, 0x5d, 0x38, 0xd6, 0xaf, 0x0b, 0xc7, 0xfa,
+	0x7a, 0xe9, 0x54, 0xce, 0x2e, 0x9d, 0xca, 0x8f, 0x4b, 0xa7, 0xf2, 0xa1, 0x5d, 0x78, 0xea, 0xfc,
+	0x53, 0x20, 0x5b, 0xfd, 0x2f, 0xfe, 0xd5, 0x07, 0x43, 0xf6, 0xec, 0xfd, 0x7a, 0xf6, 0x1b, 0xff,
+	0xec, 0xcf, 0x00, 0x3f, 0xb6, 0x8d, 0xaf, 0x49, 0x08, 0x00, 0x00,
+}
+
+func (m *EventConflictVoteDetection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteDetection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteDetection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.VoteDeadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VoteDeadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RequestBlock != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RequestBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RequestData) > 0 {
+		i -= len(m.RequestData)
+		copy(dAtA[i:], m.RequestData)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RequestData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiURL) > 0 {
+		i -= len(m.ApiURL)
+		copy(dAtA[i:], m.ApiURL)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ApiURL)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionType) > 0 {
+		i -= len(m.ConnectionType)
+		copy(dAtA[i:], m.ConnectionType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConnectionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictDetectionReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictDetectionReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictDetectionReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictVoteGotCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteGotCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteGotCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictVoteGotReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteGotReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteGotReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictVoteRevealStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteRevealStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteRevealStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteDeadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VoteDeadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictVoteResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.WinnerVotesPercentage.Size()
+		i -= size
+		if _, err := m.WinnerVotesPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.NoneProviderVotes.Size()
+		i -= size
+		if _, err := m.NoneProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SecondProviderVotes.Size()
+		i -= size
+		if _, err := m.SecondProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FirstProviderVotes.Size()
+		i -= size
+		if _, err := m.FirstProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NumOfNoVoters != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfNoVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumOfVoters != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConflictVoteUnresolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConflictVoteUnresolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConflictVoteUnresolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.VoteFailed) > 0 {
+		i -= len(m.VoteFailed)
+		copy(dAtA[i:], m.VoteFailed)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteFailed)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.NoneProviderVotes.Size()
+		i -= size
+		if _, err := m.NoneProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SecondProviderVotes.Size()
+		i -= size
+		if _, err := m.SecondProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FirstProviderVotes.Size()
+		i -= size
+		if _, err := m.FirstProviderVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NumOfNoVoters != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfNoVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumOfVoters != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumOfVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteID) > 0 {
+		i -= len(m.VoteID)
+		copy(dAtA[i:], m.VoteID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VoteID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventConflictVoteDetection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ConnectionType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ApiURL)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RequestData)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RequestBlock != 0 {
+		n += 1 + sovEvents(uint64(m.RequestBlock))
+	}
+	if m.VoteDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.VoteDeadline))
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventConflictDetectionReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConflictVoteGotCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConflictVoteGotReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConflictVoteRevealStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VoteDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.VoteDeadline))
+	}
+	return n
+}
+
+func (m *EventConflictVoteResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NumOfVoters != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfVoters))
+	}
+	if m.NumOfNoVoters != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfNoVoters))
+	}
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.FirstProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SecondProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NoneProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.WinnerVotesPercentage.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RewardPool.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventConflictVoteUnresolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NumOfVoters != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfVoters))
+	}
+	if m.NumOfNoVoters != 0 {
+		n += 1 + sovEvents(uint64(m.NumOfNoVoters))
+	}
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.FirstProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SecondProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NoneProviderVotes.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.VoteFailed)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RewardPool.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventConflictVoteDetection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteDetection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteDetection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestData = append(m.RequestData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestData == nil {
+				m.RequestData = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBlock", wireType)
+			}
+			m.RequestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDeadline", wireType)
+			}
+			m.VoteDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictDetectionReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictDetectionReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictDetectionReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictVoteGotCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteGotCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteGotCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictVoteGotReveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteGotReveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteGotReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictVoteRevealStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteRevealStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteRevealStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDeadline", wireType)
+			}
+			m.VoteDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictVoteResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfVoters", wireType)
+			}
+			m.NumOfVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfNoVoters", wireType)
+			}
+			m.NumOfNoVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfNoVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FirstProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoneProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoneProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerVotesPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WinnerVotesPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConflictVoteUnresolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConflictVoteUnresolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConflictVoteUnresolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfVoters", wireType)
+			}
+			m.NumOfVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfNoVoters", wireType)
+			}
+			m.NumOfNoVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfNoVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FirstProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoneProviderVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoneProviderVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFailed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteFailed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
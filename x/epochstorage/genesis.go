@@ -21,6 +21,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.FixatedParamsList {
 		k.SetFixatedParams(ctx, elem)
 	}
+	// Set all the stakeEntryCurrent
+	var lastOrder uint64
+	for _, elem := range genState.StakeEntryCurrentList {
+		k.SetStakeEntryCurrent(ctx, elem)
+		if elem.Order > lastOrder {
+			lastOrder = elem.Order
+		}
+	}
+	k.SetStakeEntryOrder(ctx, lastOrder)
+	// Set all the stakeStorageEpoch
+	for _, elem := range genState.StakeStorageEpochList {
+		k.SetStakeStorageEpoch(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -37,6 +50,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.EpochDetails = &epochDetails
 	}
 	genesis.FixatedParamsList = k.GetAllFixatedParams(ctx)
+	genesis.StakeEntryCurrentList = k.GetAllStakeEntryCurrent(ctx)
+	genesis.StakeStorageEpochList = k.GetAllStakeStorageEpoch(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
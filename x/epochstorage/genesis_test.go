@@ -34,6 +34,32 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		StakeEntryCurrentList: []types.StakeEntryCurrent{
+			{
+				StorageType: types.ProviderKey,
+				ChainID:     "0",
+				StakeEntry:  types.StakeEntry{Address: "0"},
+				Order:       1,
+			},
+			{
+				StorageType: types.ProviderKey,
+				ChainID:     "0",
+				StakeEntry:  types.StakeEntry{Address: "1"},
+				Order:       2,
+			},
+		},
+		StakeStorageEpochList: []types.StakeStorageEpoch{
+			{
+				StorageType: types.ProviderKey,
+				ChainID:     "0",
+				Epoch:       0,
+			},
+			{
+				StorageType: types.ProviderKey,
+				ChainID:     "0",
+				Epoch:       1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -48,5 +74,7 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StakeStorageList, got.StakeStorageList)
 	require.Equal(t, genesisState.EpochDetails, got.EpochDetails)
 	require.ElementsMatch(t, genesisState.FixatedParamsList, got.FixatedParamsList)
+	require.ElementsMatch(t, genesisState.StakeEntryCurrentList, got.StakeEntryCurrentList)
+	require.ElementsMatch(t, genesisState.StakeStorageEpochList, got.StakeStorageEpochList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
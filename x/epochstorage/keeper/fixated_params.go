@@ -126,7 +126,8 @@ func (k Keeper) PushFixatedParams(ctx sdk.Context, block uint64, limit uint64) {
 			}
 			fixatedParamsToPush = olderParams
 		}
-		utils.LogLavaEvent(ctx, k.Logger(ctx), types.FixatedParamChangeEventName, map[string]string{"moduleName": types.ModuleName, "block": strconv.FormatUint(block, 10), "fixatedParametersListLen": strconv.FormatUint(idx, 10), "fixationKey": fixationKey}, "params fixated after a change")
+		event := &types.EventFixatedParamsChange{FixationKey: fixationKey, Block: block, FixatedParametersListLen: idx}
+		utils.LogLavaTypedEvent(ctx, k.Logger(ctx), event, types.FixatedParamChangeEventName, map[string]string{"moduleName": types.ModuleName, "block": strconv.FormatUint(block, 10), "fixatedParametersListLen": strconv.FormatUint(idx, 10), "fixationKey": fixationKey}, "params fixated after a change")
 	}
 }
 
@@ -147,7 +148,8 @@ func (k Keeper) CleanOlderFixatedParams(ctx sdk.Context, fixationKey string, sta
 		}
 		k.RemoveFixatedParams(ctx, thisIdxKey)
 	}
-	utils.LogLavaEvent(ctx, k.Logger(ctx), types.FixatedParamCleanedEventName, map[string]string{"moduleName": types.ModuleName, "fixatedParametersListLen": thisIdxKey}, "fixation cleaned")
+	event := &types.EventFixatedParamsClean{FixationKey: fixationKey, FixatedParametersListLen: startIdx}
+	utils.LogLavaTypedEvent(ctx, k.Logger(ctx), event, types.FixatedParamCleanedEventName, map[string]string{"moduleName": types.ModuleName, "fixatedParametersListLen": thisIdxKey}, "fixation cleaned")
 }
 
 func (k Keeper) GetFixatedParamsForBlock(ctx sdk.Context, fixationKey string, block uint64) (fixated types.FixatedParams, err error) {
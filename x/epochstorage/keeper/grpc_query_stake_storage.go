@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,9 +50,33 @@ func (k Keeper) StakeStorage(c context.Context, req *types.QueryGetStakeStorageR
 		ctx,
 		req.Index,
 	)
+	if !found {
+		// current and epoch stake storages are not saved as a whole, resolve them from their index
+		val, found = k.resolveStakeStorage(ctx, req.Index)
+	}
 	if !found {
 		return nil, status.Error(codes.InvalidArgument, "not found")
 	}
 
 	return &types.QueryGetStakeStorageResponse{StakeStorage: val}, nil
 }
+
+// resolveStakeStorage builds a stake storage from an index in the format of the current (storageType + chainID) and epoch (storageType + block + chainID) keys
+func (k Keeper) resolveStakeStorage(ctx sdk.Context, index string) (types.StakeStorage, bool) {
+	for _, storageType := range []string{types.ProviderKey, types.ClientKey} {
+		if !strings.HasPrefix(index, storageType) {
+			continue
+		}
+		remainder := strings.TrimPrefix(index, storageType)
+		blockDigits := len(remainder) - len(strings.TrimLeft(remainder, "0123456789"))
+		if blockDigits == 0 {
+			return k.GetStakeStorageCurrent(ctx, storageType, remainder)
+		}
+		block, err := strconv.ParseUint(remainder[:blockDigits], 10, 64)
+		if err != nil {
+			return types.StakeStorage{}, false
+		}
+		return k.getStakeStorageEpoch(ctx, block, storageType, remainder[blockDigits:])
+	}
+	return types.StakeStorage{}, false
+}
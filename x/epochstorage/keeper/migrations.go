@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 implements store migration from v2 to v3:
+// - the current stake storages are split to stake entries stored by address
+// - every epoch stake storage gets an epoch reference to its snapshot
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.MigrateStakeStorages(ctx)
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
 	"strconv"
@@ -106,7 +107,7 @@ func (k *Keeper) UpdateEarliestEpochstart(ctx sdk.Context) {
 
 	logger := k.Logger(ctx)
 	// now update the earliest epoch start
-	utils.LogLavaEvent(ctx, logger, types.EarliestEpochEventName, map[string]string{"block": strconv.FormatUint(earliestEpochBlock, 10)}, "updated earliest epoch block")
+	utils.LogLavaTypedEvent(ctx, logger, &types.EventEarliestEpoch{Block: earliestEpochBlock}, types.EarliestEpochEventName, map[string]string{"block": strconv.FormatUint(earliestEpochBlock, 10)}, "updated earliest epoch block")
 	k.SetEarliestEpochStart(ctx, earliestEpochBlock, deletedEpochs)
 }
 
@@ -115,9 +116,26 @@ func (k Keeper) StakeStorageKey(storageType string, block uint64, chainID string
 }
 
 func (k Keeper) removeAllEntriesPriorToBlockNumber(ctx sdk.Context, storageType string, block uint64, allChainID []string) {
-	allStorage := k.GetAllStakeStorage(ctx)
 	for _, chainId := range allChainID {
-		for _, entry := range allStorage {
+		// remove the epochs references, the snapshot of the first epoch left is kept even if it was taken before block
+		epochStore := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix)), types.StakeStorageChainKey(storageType, chainId))
+		blockBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(blockBytes, block)
+		iterator := epochStore.Iterator(nil, blockBytes)
+		epochKeys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			epochKeys = append(epochKeys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range epochKeys {
+			epochStore.Delete(key)
+		}
+		next, nextFound := k.getAdjacentStakeStorageEpoch(ctx, storageType, chainId, block, true)
+		if current, found := k.GetStakeStorageEpoch(ctx, storageType, chainId, block); found {
+			next, nextFound = current, true
+		}
+
+		for _, entry := range k.GetAllStakeStorage(ctx) {
 			if strings.Contains(entry.Index, storageType) && strings.Contains(entry.Index, chainId) {
 				if (len(storageType) + len(chainId)) > len(entry.Index) {
 					panic(fmt.Sprintf("storageType + chainId length out of range %d vs %d\n more info: entry.Index: %s, storageType: %s, chainId: %s", (len(storageType) + len(chainId)), len(entry.Index), entry.Index, storageType, chainId))
@@ -132,7 +150,7 @@ func (k Keeper) removeAllEntriesPriorToBlockNumber(ctx sdk.Context, storageType
 					}
 					panic("failed to convert storage block to int: " + storageBlock)
 				}
-				if blockHeight < block {
+				if blockHeight < block && !(nextFound && next.SnapshotBlock == blockHeight) {
 					k.RemoveStakeStorage(ctx, entry.Index)
 				}
 			}
@@ -146,40 +164,182 @@ func (k Keeper) RemoveAllEntriesPriorToBlockNumber(ctx sdk.Context, block uint64
 }
 
 func (k Keeper) RemoveStakeStorageByBlockAndChain(ctx sdk.Context, storageType string, block uint64, chainID string) {
-	key := k.StakeStorageKey(storageType, block, chainID)
-	k.RemoveStakeStorage(ctx, key)
+	stakeStorageEpoch, found := k.GetStakeStorageEpoch(ctx, storageType, chainID, block)
+	if !found {
+		return
+	}
+	k.RemoveStakeStorageEpoch(ctx, storageType, chainID, block)
+
+	// epochs sharing a snapshot are consecutive, so if the next epoch doesn't share it no epoch does
+	next, found := k.getAdjacentStakeStorageEpoch(ctx, storageType, chainID, block, true)
+	if found && next.SnapshotBlock == stakeStorageEpoch.SnapshotBlock {
+		return
+	}
+	k.RemoveStakeStorage(ctx, k.StakeStorageKey(storageType, stakeStorageEpoch.SnapshotBlock, chainID))
 }
 
 // -------------------------------------------------- current staking list --------------------------------------------
 
+// current stake entries are stored by address, with an index ordered by stake that is used to build the epoch snapshots
+
 func (k Keeper) stakeStorageKeyCurrent(storageType string, chainID string) string {
 	return storageType + chainID
 }
 
-// used to get the latest
-func (k Keeper) GetStakeStorageCurrent(ctx sdk.Context, storageType string, chainID string) (types.StakeStorage, bool) {
-	return k.GetStakeStorage(ctx, k.stakeStorageKeyCurrent(storageType, chainID))
+// SetStakeEntryCurrent set a specific stakeEntryCurrent and its stake index in the store
+func (k Keeper) SetStakeEntryCurrent(ctx sdk.Context, stakeEntryCurrent types.StakeEntryCurrent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentKeyPrefix))
+	b := k.cdc.MustMarshal(&stakeEntryCurrent)
+	store.Set(types.StakeEntryCurrentKey(
+		stakeEntryCurrent.StorageType,
+		stakeEntryCurrent.ChainID,
+		stakeEntryCurrent.StakeEntry.Address,
+	), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentByStakeKeyPrefix))
+	indexStore.Set(types.StakeEntryCurrentByStakeKey(
+		stakeEntryCurrent.StorageType,
+		stakeEntryCurrent.ChainID,
+		stakeEntryCurrent.StakeEntry.Stake.Amount,
+		stakeEntryCurrent.Order,
+	), []byte(stakeEntryCurrent.StakeEntry.Address))
+
+	k.setStakeStorageChanged(ctx, stakeEntryCurrent.StorageType, stakeEntryCurrent.ChainID)
 }
 
-func (k Keeper) SetStakeStorageCurrent(ctx sdk.Context, storageType string, chainID string, stakeStorage types.StakeStorage) {
-	stakeStorage.Index = k.stakeStorageKeyCurrent(storageType, chainID)
-	k.SetStakeStorage(ctx, stakeStorage)
+// GetStakeEntryCurrent returns a stakeEntryCurrent from its index
+func (k Keeper) GetStakeEntryCurrent(
+	ctx sdk.Context,
+	storageType string,
+	chainID string,
+	address string,
+) (val types.StakeEntryCurrent, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentKeyPrefix))
+
+	b := store.Get(types.StakeEntryCurrentKey(
+		storageType,
+		chainID,
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveStakeEntryCurrentFromStore removes a stakeEntryCurrent and its stake index from the store
+func (k Keeper) RemoveStakeEntryCurrentFromStore(ctx sdk.Context, stakeEntryCurrent types.StakeEntryCurrent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentKeyPrefix))
+	store.Delete(types.StakeEntryCurrentKey(
+		stakeEntryCurrent.StorageType,
+		stakeEntryCurrent.ChainID,
+		stakeEntryCurrent.StakeEntry.Address,
+	))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentByStakeKeyPrefix))
+	indexStore.Delete(types.StakeEntryCurrentByStakeKey(
+		stakeEntryCurrent.StorageType,
+		stakeEntryCurrent.ChainID,
+		stakeEntryCurrent.StakeEntry.Stake.Amount,
+		stakeEntryCurrent.Order,
+	))
+
+	k.setStakeStorageChanged(ctx, stakeEntryCurrent.StorageType, stakeEntryCurrent.ChainID)
+}
+
+// GetAllStakeEntryCurrent returns all stakeEntryCurrent
+func (k Keeper) GetAllStakeEntryCurrent(ctx sdk.Context) (list []types.StakeEntryCurrent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StakeEntryCurrent
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// nextStakeEntryOrder returns a new order for a stake entry, so it is placed after the existing entries with the same stake
+func (k Keeper) nextStakeEntryOrder(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	order := k.GetStakeEntryOrder(ctx) + 1
+	orderBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(orderBytes, order)
+	store.Set(types.KeyPrefix(types.StakeEntryCurrentOrderKey), orderBytes)
+	return order
+}
+
+// GetStakeEntryOrder returns the last order given to a stake entry
+func (k Keeper) GetStakeEntryOrder(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.StakeEntryCurrentOrderKey))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// SetStakeEntryOrder sets the last order given to a stake entry
+func (k Keeper) SetStakeEntryOrder(ctx sdk.Context, order uint64) {
+	orderBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(orderBytes, order)
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.StakeEntryCurrentOrderKey), orderBytes)
+}
+
+func (k Keeper) setStakeStorageChanged(ctx sdk.Context, storageType string, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageChangedKeyPrefix))
+	store.Set(types.StakeStorageChainKey(storageType, chainID), []byte{1})
+}
+
+func (k Keeper) isStakeStorageChanged(ctx sdk.Context, storageType string, chainID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageChangedKeyPrefix))
+	return store.Has(types.StakeStorageChainKey(storageType, chainID))
+}
+
+func (k Keeper) clearStakeStorageChanged(ctx sdk.Context, storageType string, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageChangedKeyPrefix))
+	store.Delete(types.StakeStorageChainKey(storageType, chainID))
+}
+
+// getStakeEntriesCurrent returns the current stake entries of a chain sorted by stake
+func (k Keeper) getStakeEntriesCurrent(ctx sdk.Context, storageType string, chainID string) []types.StakeEntry {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeEntryCurrentByStakeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.StakeStorageChainKey(storageType, chainID))
+
+	defer iterator.Close()
+
+	entries := []types.StakeEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		stakeEntryCurrent, found := k.GetStakeEntryCurrent(ctx, storageType, chainID, string(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("stake index points to a missing stake entry: %s, storageType: %s, chainID: %s", string(iterator.Value()), storageType, chainID))
+		}
+		entries = append(entries, stakeEntryCurrent.StakeEntry)
+	}
+	return entries
+}
+
+// used to get the latest
+func (k Keeper) GetStakeStorageCurrent(ctx sdk.Context, storageType string, chainID string) (types.StakeStorage, bool) {
+	entries := k.getStakeEntriesCurrent(ctx, storageType, chainID)
+	if len(entries) == 0 {
+		return types.StakeStorage{}, false
+	}
+	return types.StakeStorage{Index: k.stakeStorageKeyCurrent(storageType, chainID), StakeEntries: entries, EpochBlockHash: nil}, true
 }
 
 func (k Keeper) stakeEntryIndexByAddress(ctx sdk.Context, stakeStorage types.StakeStorage, address sdk.AccAddress) (index uint64, found bool) {
 	// the following finds the address of stakeEntry and returns it
-	entries := stakeStorage.StakeEntries
-	for idx, entry := range entries {
-		entryAddr, err := sdk.AccAddressFromBech32(entry.Address)
-		if err != nil {
-			panic("invalid account address inside StakeStorage: " + entry.Address)
-		}
-		if entryAddr.Equals(address) {
-			// found the right thing
-			index = uint64(idx)
-			found = true
-			// remove from the stakeStorage, i checked it supports idx == length-1
-			return
+	// bech32 addresses are case insensitive, so comparing the strings is enough
+	addressStr := address.String()
+	for idx, entry := range stakeStorage.StakeEntries {
+		if strings.EqualFold(entry.Address, addressStr) {
+			return uint64(idx), true
 		}
 	}
 	return 0, false
@@ -197,91 +357,42 @@ func (k Keeper) GetStakeEntryByAddressFromStorage(ctx sdk.Context, stakeStorage
 	return
 }
 
-func (k Keeper) GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value types.StakeEntry, found bool, index uint64) {
-	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
-	if !found {
-		return types.StakeEntry{}, false, 0
-	}
-	// the following finds the address of stakeEntry and returns it
-	idx, found := k.stakeEntryIndexByAddress(ctx, stakeStorage, address)
+func (k Keeper) GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value types.StakeEntry, found bool) {
+	stakeEntryCurrent, found := k.GetStakeEntryCurrent(ctx, storageType, chainID, address.String())
 	if !found {
-		return types.StakeEntry{}, false, 0
+		return types.StakeEntry{}, false
 	}
-	// found the right thing
-	value = stakeStorage.StakeEntries[idx]
-	found = true
-	index = idx
-	return
+	return stakeEntryCurrent.StakeEntry, true
 }
 
-func (k Keeper) RemoveStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, idx uint64) error {
-	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
+func (k Keeper) RemoveStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) error {
+	stakeEntryCurrent, found := k.GetStakeEntryCurrent(ctx, storageType, chainID, address.String())
 	if !found {
 		return errors.ErrNotFound
 	}
-	if idx >= uint64(len(stakeStorage.StakeEntries)) {
-		return errors.ErrNotFound
-	}
-	stakeStorage.StakeEntries = append(stakeStorage.StakeEntries[:idx], stakeStorage.StakeEntries[idx+1:]...)
-	k.SetStakeStorageCurrent(ctx, storageType, chainID, stakeStorage)
+	k.RemoveStakeEntryCurrentFromStore(ctx, stakeEntryCurrent)
 	return nil
 }
 
+// AppendStakeEntryCurrent adds a stake entry to the current entries, it is ordered after the existing entries with the same stake
 func (k Keeper) AppendStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry types.StakeEntry) {
-	// this stake storage entries are sorted by stake amount
-	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
-	var entries []types.StakeEntry
-	if !found {
-		entries = []types.StakeEntry{stakeEntry}
-		// create a new one
-		stakeStorage = types.StakeStorage{Index: k.stakeStorageKeyCurrent(storageType, chainID), StakeEntries: entries, EpochBlockHash: nil}
-	} else {
-		// the following code inserts stakeEntry into the existing entries by stake
-		entries = stakeStorage.StakeEntries
-		// sort func needs to return true if the inserted entry is less than the existing entry
-		sortFunc := func(i int) bool {
-			return stakeEntry.Stake.Amount.LT(entries[i].Stake.Amount)
-		}
-		// returns the smallest index in which the sort func is true
-		index := sort.Search(len(entries), sortFunc)
-		if index < len(entries) {
-			entries = append(entries[:index+1], entries[index:]...)
-			entries[index] = stakeEntry
-		} else {
-			// put in the end
-			entries = append(entries, stakeEntry)
-		}
+	if existing, found := k.GetStakeEntryCurrent(ctx, storageType, chainID, stakeEntry.Address); found {
+		k.RemoveStakeEntryCurrentFromStore(ctx, existing)
 	}
-	stakeStorage.StakeEntries = entries
-	k.SetStakeStorageCurrent(ctx, storageType, chainID, stakeStorage)
+	k.SetStakeEntryCurrent(ctx, types.StakeEntryCurrent{
+		StorageType: storageType,
+		ChainID:     chainID,
+		StakeEntry:  stakeEntry,
+		Order:       k.nextStakeEntryOrder(ctx),
+	})
 }
 
-func (k Keeper) ModifyStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry types.StakeEntry, removeIndex uint64) {
-	// this stake storage entries are sorted by stake amount
-	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
-	if !found {
-		panic("called modify when there is no stakeStorage")
-	}
-	// TODO: more efficient: only create a new list once, after the second index is identified
-	// remove the given index, then store the new entry in the sorted list at the right place
-	entries := []types.StakeEntry{}
-	entries = append(entries, stakeStorage.StakeEntries[:removeIndex]...)
-	entries = append(entries, stakeStorage.StakeEntries[removeIndex+1:]...)
-	// the following code inserts stakeEntry into the existing entries by stake
-	// sort func needs to return true if the inserted entry is less than the existing entry
-	sortFunc := func(i int) bool {
-		return stakeEntry.Stake.Amount.LT(entries[i].Stake.Amount)
+// ModifyStakeEntryCurrent replaces an existing stake entry, it is reordered as if it was removed and appended again
+func (k Keeper) ModifyStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry types.StakeEntry) {
+	if _, found := k.GetStakeEntryCurrent(ctx, storageType, chainID, stakeEntry.Address); !found {
+		panic("called modify when there is no stake entry")
 	}
-	// returns the smallest index in which the sort func is true
-	index := sort.Search(len(entries), sortFunc)
-	if index < len(entries) {
-		entries = append(entries[:index+1], entries[index:]...)
-		entries[index] = stakeEntry
-	} else {
-		entries = append(entries, stakeEntry)
-	}
-	stakeStorage.StakeEntries = entries
-	k.SetStakeStorageCurrent(ctx, storageType, chainID, stakeStorage)
+	k.AppendStakeEntryCurrent(ctx, storageType, chainID, stakeEntry)
 }
 
 // -------------------------------------------------- unstaking list --------------------------------------------
@@ -382,6 +493,29 @@ func (k Keeper) AppendUnstakeEntry(ctx sdk.Context, storageType string, stakeEnt
 	return nil
 }
 
+// Returns and removes all the unstaking entries of an address on a chain
+func (k Keeper) PopUnstakeEntriesByAddress(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value []types.StakeEntry) {
+	stakeStorage, found := k.GetStakeStorageUnstake(ctx, storageType)
+	if !found {
+		return nil
+	}
+	addressStr := address.String()
+	entries := []types.StakeEntry{}
+	for _, entry := range stakeStorage.StakeEntries {
+		if entry.Chain == chainID && strings.EqualFold(entry.Address, addressStr) {
+			value = append(value, entry)
+		} else {
+			entries = append(entries, entry)
+		}
+	}
+	if len(value) > 0 {
+		// the remaining entries stay sorted by deadline
+		stakeStorage.StakeEntries = entries
+		k.SetStakeStorageUnstake(ctx, storageType, stakeStorage)
+	}
+	return value
+}
+
 // Returns the unstaking Entry if its deadline is lower than the provided block
 func (k Keeper) PopUnstakeEntries(ctx sdk.Context, storageType string, block uint64) (value []types.StakeEntry) {
 	stakeStorage, found := k.GetStakeStorageUnstake(ctx, storageType)
@@ -412,25 +546,136 @@ func (k Keeper) PopUnstakeEntries(ctx sdk.Context, storageType string, block uin
 
 // ------------------------------------------------
 
+// SetStakeStorageEpoch set a specific stakeStorageEpoch in the store from its index
+func (k Keeper) SetStakeStorageEpoch(ctx sdk.Context, stakeStorageEpoch types.StakeStorageEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix))
+	b := k.cdc.MustMarshal(&stakeStorageEpoch)
+	store.Set(types.StakeStorageEpochKey(
+		stakeStorageEpoch.StorageType,
+		stakeStorageEpoch.ChainID,
+		stakeStorageEpoch.Epoch,
+	), b)
+}
+
+// GetStakeStorageEpoch returns a stakeStorageEpoch from its index
+func (k Keeper) GetStakeStorageEpoch(
+	ctx sdk.Context,
+	storageType string,
+	chainID string,
+	epoch uint64,
+) (val types.StakeStorageEpoch, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix))
+
+	b := store.Get(types.StakeStorageEpochKey(
+		storageType,
+		chainID,
+		epoch,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveStakeStorageEpoch removes a stakeStorageEpoch from the store
+func (k Keeper) RemoveStakeStorageEpoch(
+	ctx sdk.Context,
+	storageType string,
+	chainID string,
+	epoch uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix))
+	store.Delete(types.StakeStorageEpochKey(
+		storageType,
+		chainID,
+		epoch,
+	))
+}
+
+// GetAllStakeStorageEpoch returns all stakeStorageEpoch
+func (k Keeper) GetAllStakeStorageEpoch(ctx sdk.Context) (list []types.StakeStorageEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StakeStorageEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getAdjacentStakeStorageEpoch returns the closest stakeStorageEpoch of a chain before or after the given epoch
+func (k Keeper) getAdjacentStakeStorageEpoch(ctx sdk.Context, storageType string, chainID string, epoch uint64, after bool) (val types.StakeStorageEpoch, found bool) {
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeStorageEpochKeyPrefix)), types.StakeStorageChainKey(storageType, chainID))
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, epoch)
+
+	var iterator sdk.Iterator
+	if after {
+		iterator = store.Iterator(sdk.PrefixEndBytes(epochBytes), nil)
+	} else {
+		iterator = store.ReverseIterator(nil, epochBytes)
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
 // takes the current stake storage and puts it in epoch storage
+// a new snapshot is saved only if the current stake entries changed since the previous epoch, otherwise the epoch shares the previous snapshot
 func (k Keeper) StoreCurrentEpochStakeStorage(ctx sdk.Context, block uint64, storageType string) {
 	allChainIDs := k.specKeeper.GetAllChainIDs(ctx)
 	for _, chainID := range allChainIDs {
-		tmpStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
-		if !found {
-			// no storage for this spec yet
-			continue
+		previous, previousFound := k.getAdjacentStakeStorageEpoch(ctx, storageType, chainID, block, false)
+		snapshotBlock := block
+		if previousFound && !k.isStakeStorageChanged(ctx, storageType, chainID) {
+			snapshotBlock = previous.SnapshotBlock
+		} else {
+			entries := k.getStakeEntriesCurrent(ctx, storageType, chainID)
+			if !previousFound && len(entries) == 0 {
+				// no storage for this spec yet
+				continue
+			}
+			newStorage := types.StakeStorage{
+				Index:          k.StakeStorageKey(storageType, block, chainID),
+				StakeEntries:   entries,
+				EpochBlockHash: ctx.HeaderHash(), // set the current block hash for pairing to work without accessing history
+			}
+			k.SetStakeStorage(ctx, newStorage)
+			k.clearStakeStorageChanged(ctx, storageType, chainID)
 		}
-		newStorage := tmpStorage.Copy()
-		newStorage.Index = k.StakeStorageKey(storageType, block, chainID)
-		newStorage.EpochBlockHash = ctx.HeaderHash() // set the current block hash for pairing to work without accessing history
-		k.SetStakeStorage(ctx, newStorage)
+		k.SetStakeStorageEpoch(ctx, types.StakeStorageEpoch{
+			StorageType:    storageType,
+			ChainID:        chainID,
+			Epoch:          block,
+			SnapshotBlock:  snapshotBlock,
+			EpochBlockHash: ctx.HeaderHash(),
+		})
 	}
 }
 
 func (k Keeper) getStakeStorageEpoch(ctx sdk.Context, block uint64, storageType string, chainID string) (stakeStorage types.StakeStorage, found bool) {
-	key := k.StakeStorageKey(storageType, block, chainID)
-	return k.GetStakeStorage(ctx, key)
+	stakeStorageEpoch, found := k.GetStakeStorageEpoch(ctx, storageType, chainID, block)
+	if !found {
+		return stakeStorage, false
+	}
+	stakeStorage, found = k.GetStakeStorage(ctx, k.StakeStorageKey(storageType, stakeStorageEpoch.SnapshotBlock, chainID))
+	if !found {
+		return stakeStorage, false
+	}
+	stakeStorage.Index = k.StakeStorageKey(storageType, block, chainID)
+	stakeStorage.EpochBlockHash = stakeStorageEpoch.EpochBlockHash
+	return stakeStorage, true
 }
 
 // gets chainID, clientAddress, and epoch
@@ -471,8 +716,7 @@ func (k Keeper) GetStakeEntryForAllProvidersEpoch(ctx sdk.Context, chainID strin
 }
 
 func (k Keeper) GetEpochStakeEntries(ctx sdk.Context, block uint64, storageType string, chainID string) (entries []types.StakeEntry, found bool, epochHash []byte) {
-	key := k.StakeStorageKey(storageType, block, chainID)
-	stakeStorage, found := k.GetStakeStorage(ctx, key)
+	stakeStorage, found := k.getStakeStorageEpoch(ctx, block, storageType, chainID)
 	if !found {
 		return nil, false, nil
 	}
@@ -494,14 +738,8 @@ func (k Keeper) BypassCurrentAndAppendNewEpochStakeEntry(ctx sdk.Context, storag
 		return false, err
 	}
 
-	for _, clientStakeEntry := range storage.StakeEntries {
-		clientAddr, err := sdk.AccAddressFromBech32(clientStakeEntry.Address)
-		if err != nil {
-			panic(fmt.Sprintf("invalid user address saved in keeper %s, err: %s", clientStakeEntry.Address, err))
-		}
-		if clientAddr.Equals(entryAddr) {
-			return false, nil // stake already exists in this epoch
-		}
+	if _, found := k.stakeEntryIndexByAddress(ctx, storage, entryAddr); found {
+		return false, nil // stake already exists in this epoch
 	}
 
 	// put it in the right place
@@ -519,7 +757,54 @@ func (k Keeper) BypassCurrentAndAppendNewEpochStakeEntry(ctx sdk.Context, storag
 		entries = append(entries, stakeEntry)
 	}
 
+	// the epoch gets its own snapshot, the snapshot it shared with previous epochs is left as it was
 	storage.StakeEntries = entries
 	k.SetStakeStorage(ctx, storage)
+	// the next epoch must not share this snapshot, it is taken from the current entries
+	k.setStakeStorageChanged(ctx, storageType, chainID)
+	k.SetStakeStorageEpoch(ctx, types.StakeStorageEpoch{
+		StorageType:    storageType,
+		ChainID:        chainID,
+		Epoch:          epoch,
+		SnapshotBlock:  epoch,
+		EpochBlockHash: storage.EpochBlockHash,
+	})
 	return true, nil
 }
+
+// -------------------------------------------------- migration --------------------------------------------
+
+// MigrateStakeStorages converts stake storages saved as a whole to the current stake entries and the epoch references
+func (k Keeper) MigrateStakeStorages(ctx sdk.Context) {
+	allChainIDs := k.specKeeper.GetAllChainIDs(ctx)
+	for _, stakeStorage := range k.GetAllStakeStorage(ctx) {
+		for _, storageType := range []string{types.ProviderKey, types.ClientKey} {
+			for _, chainID := range allChainIDs {
+				if stakeStorage.Index == k.stakeStorageKeyCurrent(storageType, chainID) {
+					// keep the order of the entries with the same stake
+					for _, stakeEntry := range stakeStorage.StakeEntries {
+						k.AppendStakeEntryCurrent(ctx, storageType, chainID, stakeEntry)
+					}
+					k.RemoveStakeStorage(ctx, stakeStorage.Index)
+					continue
+				}
+				if !strings.HasPrefix(stakeStorage.Index, storageType) || !strings.HasSuffix(stakeStorage.Index, chainID) || len(stakeStorage.Index) <= len(storageType)+len(chainID) {
+					continue
+				}
+				block, err := strconv.ParseUint(stakeStorage.Index[len(storageType):len(stakeStorage.Index)-len(chainID)], 10, 64)
+				if err != nil {
+					continue
+				}
+				if _, found := k.GetStakeStorageEpoch(ctx, storageType, chainID, block); !found {
+					k.SetStakeStorageEpoch(ctx, types.StakeStorageEpoch{
+						StorageType:    storageType,
+						ChainID:        chainID,
+						Epoch:          block,
+						SnapshotBlock:  block,
+						EpochBlockHash: stakeStorage.EpochBlockHash,
+					})
+				}
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/testutil/nullify"
 	"github.com/lavanet/lava/x/epochstorage/keeper"
@@ -115,3 +116,154 @@ func TestStakeStorageGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllStakeStorage(ctx)),
 	)
 }
+
+func createStakeEntry(address string, stake int64) epochstoragetypes.StakeEntry {
+	return epochstoragetypes.StakeEntry{Address: address, Stake: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake))}
+}
+
+func stakeEntriesAddresses(entries []epochstoragetypes.StakeEntry) []string {
+	addresses := []string{}
+	for _, entry := range entries {
+		addresses = append(addresses, entry.Address)
+	}
+	return addresses
+}
+
+func TestStakeEntryCurrentOrder(t *testing.T) {
+	_, allkeepers, ctxx := testkeeper.InitAllKeepers(t)
+	keeper := allkeepers.Epochstorage
+	ctx := sdk.UnwrapSDKContext(ctxx)
+	spec := common.CreateMockSpec()
+	allkeepers.Spec.SetSpec(ctx, spec)
+	addresses := []sdk.AccAddress{}
+	for i := 0; i < 3; i++ {
+		addresses = append(addresses, common.CreateNewAccount(ctxx, *allkeepers, 10000).Addr)
+	}
+	storageType := epochstoragetypes.ProviderKey
+
+	keeper.AppendStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[0].String(), 10))
+	keeper.AppendStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[1].String(), 5))
+	keeper.AppendStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[2].String(), 10))
+
+	// entries are sorted by stake, equal stakes keep the order they were added in
+	stakeStorage, found := keeper.GetStakeStorageCurrent(ctx, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String(), addresses[2].String()}, stakeEntriesAddresses(stakeStorage.StakeEntries))
+
+	// a modified entry is placed after the entries with the same stake
+	keeper.ModifyStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[0].String(), 10))
+	stakeStorage, found = keeper.GetStakeStorageCurrent(ctx, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[2].String(), addresses[0].String()}, stakeEntriesAddresses(stakeStorage.StakeEntries))
+
+	entry, found := keeper.GetStakeEntryByAddressCurrent(ctx, storageType, spec.Index, addresses[2])
+	require.True(t, found)
+	require.Equal(t, addresses[2].String(), entry.Address)
+
+	require.Nil(t, keeper.RemoveStakeEntryCurrent(ctx, storageType, spec.Index, addresses[2]))
+	require.NotNil(t, keeper.RemoveStakeEntryCurrent(ctx, storageType, spec.Index, addresses[2]))
+	_, found = keeper.GetStakeEntryByAddressCurrent(ctx, storageType, spec.Index, addresses[2])
+	require.False(t, found)
+	stakeStorage, found = keeper.GetStakeStorageCurrent(ctx, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String()}, stakeEntriesAddresses(stakeStorage.StakeEntries))
+}
+
+func TestEpochStakeStorageSnapshots(t *testing.T) {
+	_, allkeepers, ctxx := testkeeper.InitAllKeepers(t)
+	keeper := allkeepers.Epochstorage
+	ctx := sdk.UnwrapSDKContext(ctxx)
+	spec := common.CreateMockSpec()
+	allkeepers.Spec.SetSpec(ctx, spec)
+	addresses := []sdk.AccAddress{}
+	for i := 0; i < 3; i++ {
+		addresses = append(addresses, common.CreateNewAccount(ctxx, *allkeepers, 10000).Addr)
+	}
+	storageType := epochstoragetypes.ClientKey
+
+	keeper.AppendStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[0].String(), 10))
+	keeper.AppendStakeEntryCurrent(ctx, storageType, spec.Index, createStakeEntry(addresses[1].String(), 5))
+
+	ctxx = testkeeper.AdvanceEpoch(ctxx, allkeepers)
+	ctx = sdk.UnwrapSDKContext(ctxx)
+	firstEpoch := keeper.GetEpochStart(ctx)
+	entries, found, epochHash := keeper.GetEpochStakeEntries(ctx, firstEpoch, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String()}, stakeEntriesAddresses(entries))
+	require.Equal(t, ctx.HeaderHash().Bytes(), epochHash)
+
+	// nothing changed, the epoch shares the previous snapshot but keeps its own hash
+	ctxx = testkeeper.AdvanceEpoch(ctxx, allkeepers)
+	ctx = sdk.UnwrapSDKContext(ctxx)
+	secondEpoch := keeper.GetEpochStart(ctx)
+	stakeStorageEpoch, found := keeper.GetStakeStorageEpoch(ctx, storageType, spec.Index, secondEpoch)
+	require.True(t, found)
+	require.Equal(t, firstEpoch, stakeStorageEpoch.SnapshotBlock)
+	entries, found, epochHash = keeper.GetEpochStakeEntries(ctx, secondEpoch, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String()}, stakeEntriesAddresses(entries))
+	require.Equal(t, ctx.HeaderHash().Bytes(), epochHash)
+
+	// appending to the epoch copies the snapshot, the previous epoch is unchanged
+	added, err := keeper.BypassCurrentAndAppendNewEpochStakeEntry(ctx, storageType, spec.Index, createStakeEntry(addresses[2].String(), 7))
+	require.Nil(t, err)
+	require.True(t, added)
+	added, err = keeper.BypassCurrentAndAppendNewEpochStakeEntry(ctx, storageType, spec.Index, createStakeEntry(addresses[2].String(), 7))
+	require.Nil(t, err)
+	require.False(t, added)
+	entries, found, _ = keeper.GetEpochStakeEntries(ctx, secondEpoch, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[2].String(), addresses[0].String()}, stakeEntriesAddresses(entries))
+	entries, found, _ = keeper.GetEpochStakeEntries(ctx, firstEpoch, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String()}, stakeEntriesAddresses(entries))
+
+	// removing an epoch keeps the snapshots of the epochs left
+	ctxx = testkeeper.AdvanceEpoch(ctxx, allkeepers)
+	ctx = sdk.UnwrapSDKContext(ctxx)
+	thirdEpoch := keeper.GetEpochStart(ctx)
+	keeper.RemoveStakeStorageByBlockAndChain(ctx, storageType, firstEpoch, spec.Index)
+	_, found, _ = keeper.GetEpochStakeEntries(ctx, firstEpoch, storageType, spec.Index)
+	require.False(t, found)
+	keeper.RemoveStakeStorageByBlockAndChain(ctx, storageType, secondEpoch, spec.Index)
+	entries, found, _ = keeper.GetEpochStakeEntries(ctx, thirdEpoch, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, []string{addresses[1].String(), addresses[0].String()}, stakeEntriesAddresses(entries))
+	_, found = keeper.GetStakeStorage(ctx, keeper.StakeStorageKey(storageType, firstEpoch, spec.Index))
+	require.False(t, found)
+}
+
+func TestMigrateStakeStorages(t *testing.T) {
+	_, allkeepers, ctxx := testkeeper.InitAllKeepers(t)
+	keeper := allkeepers.Epochstorage
+	ctx := sdk.UnwrapSDKContext(ctxx)
+	spec := common.CreateMockSpec()
+	allkeepers.Spec.SetSpec(ctx, spec)
+	addresses := []sdk.AccAddress{}
+	for i := 0; i < 3; i++ {
+		addresses = append(addresses, common.CreateNewAccount(ctxx, *allkeepers, 10000).Addr)
+	}
+	storageType := epochstoragetypes.ProviderKey
+	stakeEntries := []epochstoragetypes.StakeEntry{
+		createStakeEntry(addresses[0].String(), 5),
+		createStakeEntry(addresses[1].String(), 10),
+		createStakeEntry(addresses[2].String(), 10),
+	}
+	epochBlockHash := []byte("hash")
+
+	// stake storages in the format they were saved in before the migration
+	keeper.SetStakeStorage(ctx, epochstoragetypes.StakeStorage{Index: storageType + spec.Index, StakeEntries: stakeEntries})
+	keeper.SetStakeStorage(ctx, epochstoragetypes.StakeStorage{Index: keeper.StakeStorageKey(storageType, 20, spec.Index), StakeEntries: stakeEntries[:2], EpochBlockHash: epochBlockHash})
+	keeper.MigrateStakeStorages(ctx)
+
+	_, found := keeper.GetStakeStorage(ctx, storageType+spec.Index)
+	require.False(t, found)
+	stakeStorage, found := keeper.GetStakeStorageCurrent(ctx, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, stakeEntriesAddresses(stakeEntries), stakeEntriesAddresses(stakeStorage.StakeEntries))
+
+	entries, found, epochHash := keeper.GetEpochStakeEntries(ctx, 20, storageType, spec.Index)
+	require.True(t, found)
+	require.Equal(t, stakeEntriesAddresses(stakeEntries[:2]), stakeEntriesAddresses(entries))
+	require.Equal(t, epochBlockHash, epochHash)
+}
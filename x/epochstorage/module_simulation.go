@@ -33,10 +33,11 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
-	epochstorageGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&epochstorageGenesis)
+	epochstorageGenesis := types.DefaultGenesis()
+	// short epochs so stakes, payments and votes go through several epochs in a simulation
+	epochstorageGenesis.Params.EpochBlocks = uint64(simtypes.RandIntBetween(simState.Rand, 5, 11))
+	// this line is used by starport scaffolding # simapp/module/genesisState
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochstorageGenesis)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
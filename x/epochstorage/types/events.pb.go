@@ -0,0 +1,905 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: epochstorage/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventNewEpoch struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventNewEpoch) Reset()         { *m = EventNewEpoch{} }
+func (m *EventNewEpoch) String() string { return proto.CompactTextString(m) }
+func (*EventNewEpoch) ProtoMessage()    {}
+func (*EventNewEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054db1609a54c320, []int{0}
+}
+func (m *EventNewEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNewEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewEpoch.Merge(m, src)
+}
+func (m *EventNewEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNewEpoch proto.InternalMessageInfo
+
+func (m *EventNewEpoch) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type EventEarliestEpoch struct {
+	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *EventEarliestEpoch) Reset()         { *m = EventEarliestEpoch{} }
+func (m *EventEarliestEpoch) String() string { return proto.CompactTextString(m) }
+func (*EventEarliestEpoch) ProtoMessage()    {}
+func (*EventEarliestEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054db1609a54c320, []int{1}
+}
+func (m *EventEarliestEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEarliestEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEarliestEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEarliestEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEarliestEpoch.Merge(m, src)
+}
+func (m *EventEarliestEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEarliestEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEarliestEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEarliestEpoch proto.InternalMessageInfo
+
+func (m *EventEarliestEpoch) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+type EventFixatedParamsChange struct {
+	FixationKey              string `protobuf:"bytes,1,opt,name=fixationKey,proto3" json:"fixationKey,omitempty"`
+	Block                    uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	FixatedParametersListLen uint64 `protobuf:"varint,3,opt,name=fixatedParametersListLen,proto3" json:"fixatedParametersListLen,omitempty"`
+}
+
+func (m *EventFixatedParamsChange) Reset()         { *m = EventFixatedParamsChange{} }
+func (m *EventFixatedParamsChange) String() string { return proto.CompactTextString(m) }
+func (*EventFixatedParamsChange) ProtoMessage()    {}
+func (*EventFixatedParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054db1609a54c320, []int{2}
+}
+func (m *EventFixatedParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFixatedParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFixatedParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFixatedParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFixatedParamsChange.Merge(m, src)
+}
+func (m *EventFixatedParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFixatedParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFixatedParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFixatedParamsChange proto.InternalMessageInfo
+
+func (m *EventFixatedParamsChange) GetFixationKey() string {
+	if m != nil {
+		return m.FixationKey
+	}
+	return ""
+}
+
+func (m *EventFixatedParamsChange) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *EventFixatedParamsChange) GetFixatedParametersListLen() uint64 {
+	if m != nil {
+		return m.FixatedParametersListLen
+	}
+	return 0
+}
+
+type EventFixatedParamsClean struct {
+	FixationKey              string `protobuf:"bytes,1,opt,name=fixationKey,proto3" json:"fixationKey,omitempty"`
+	FixatedParametersListLen uint64 `protobuf:"varint,2,opt,name=fixatedParametersListLen,proto3" json:"fixatedParametersListLen,omitempty"`
+}
+
+func (m *EventFixatedParamsClean) Reset()         { *m = EventFixatedParamsClean{} }
+func (m *EventFixatedParamsClean) String() string { return proto.CompactTextString(m) }
+func (*EventFixatedParamsClean) ProtoMessage()    {}
+func (*EventFixatedParamsClean) Descriptor() ([]byte, []int) {
+	return fileDescriptor_054db1609a54c320, []int{3}
+}
+func (m *EventFixatedParamsClean) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFixatedParamsClean) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFixatedParamsClean.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFixatedParamsClean) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFixatedParamsClean.Merge(m, src)
+}
+func (m *EventFixatedParamsClean) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFixatedParamsClean) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFixatedParamsClean.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFixatedParamsClean proto.InternalMessageInfo
+
+func (m *EventFixatedParamsClean) GetFixationKey() string {
+	if m != nil {
+		return m.FixationKey
+	}
+	return ""
+}
+
+func (m *EventFixatedParamsClean) GetFixatedParametersListLen() uint64 {
+	if m != nil {
+		return m.FixatedParametersListLen
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventNewEpoch)(nil), "lavanet.lava.epochstorage.EventNewEpoch")
+	proto.RegisterType((*EventEarliestEpoch)(nil), "lavanet.lava.epochstorage.EventEarliestEpoch")
+	proto.RegisterType((*EventFixatedParamsChange)(nil), "lavanet.lava.epochstorage.EventFixatedParamsChange")
+	proto.RegisterType((*EventFixatedParamsClean)(nil), "lavanet.lava.epochstorage.EventFixatedParamsClean")
+}
+
+func init() { proto.RegisterFile("epochstorage/events.proto", fileDescriptor_054db1609a54c320) }
+
+var fileDescriptor_054db1609a54c320 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x2d, 0xc8, 0x4f,
+	0xce, 0x28, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcc, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03,
+	0xd1, 0x7a, 0xc8, 0xea, 0x94, 0xd4, 0xb9, 0x78, 0x5d, 0x41, 0x4a, 0xfd, 0x52, 0xcb, 0x5d, 0x41,
+	0xe2, 0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x2c, 0x41, 0x50, 0x9e, 0x92, 0x16, 0x97, 0x10, 0x58, 0xa1, 0x6b, 0x62, 0x51, 0x4e, 0x66, 0x6a,
+	0x71, 0x09, 0x44, 0xb5, 0x08, 0x17, 0x6b, 0x52, 0x4e, 0x7e, 0x72, 0x36, 0x54, 0x31, 0x84, 0xa3,
+	0xd4, 0xc7, 0xc8, 0x25, 0x01, 0x56, 0xec, 0x96, 0x59, 0x91, 0x58, 0x92, 0x9a, 0x12, 0x90, 0x58,
+	0x94, 0x98, 0x5b, 0xec, 0x9c, 0x91, 0x98, 0x97, 0x9e, 0x2a, 0xa4, 0xc0, 0xc5, 0x9d, 0x06, 0x12,
+	0xce, 0xcc, 0xcf, 0xf3, 0x4e, 0xad, 0x04, 0x6b, 0xe4, 0x0c, 0x42, 0x16, 0x42, 0x18, 0xca, 0x84,
+	0x64, 0xa8, 0x90, 0x15, 0x97, 0x44, 0x1a, 0x92, 0x71, 0xa9, 0x25, 0xa9, 0x45, 0xc5, 0x3e, 0x99,
+	0xc5, 0x25, 0x3e, 0xa9, 0x79, 0x12, 0xcc, 0x60, 0x85, 0x38, 0xe5, 0x95, 0xca, 0xb9, 0xc4, 0xb1,
+	0xb8, 0x27, 0x27, 0x35, 0x31, 0x8f, 0x08, 0xe7, 0xe0, 0xb3, 0x98, 0x09, 0xbf, 0xc5, 0x4e, 0x6e,
+	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
+	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51,
+	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x8d, 0x1e, 0x30, 0xad, 0x5f, 0xa1, 0x8f, 0x12, 0x91,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x88, 0x34, 0x06, 0x0c, 0x00, 0x0a, 0x1a, 0x2f,
+	0xfa, 0xe5, 0x01, 0x00, 0x00,
+}
+
+func (m *EventNewEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEarliestEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEarliestEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEarliestEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFixatedParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFixatedParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFixatedParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FixatedParametersListLen != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FixatedParametersListLen))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FixationKey) > 0 {
+		i -= len(m.FixationKey)
+		copy(dAtA[i:], m.FixationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FixationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFixatedParamsClean) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFixatedParamsClean) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFixatedParamsClean) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FixatedParametersListLen != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FixatedParametersListLen))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FixationKey) > 0 {
+		i -= len(m.FixationKey)
+		copy(dAtA[i:], m.FixationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FixationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventNewEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventEarliestEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovEvents(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *EventFixatedParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FixationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovEvents(uint64(m.Block))
+	}
+	if m.FixatedParametersListLen != 0 {
+		n += 1 + sovEvents(uint64(m.FixatedParametersListLen))
+	}
+	return n
+}
+
+func (m *EventFixatedParamsClean) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FixationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FixatedParametersListLen != 0 {
+		n += 1 + sovEvents(uint64(m.FixatedParametersListLen))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventNewEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEarliestEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEarliestEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEarliestEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFixatedParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFixatedParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFixatedParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParametersListLen", wireType)
+			}
+			m.FixatedParametersListLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixatedParametersListLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFixatedParamsClean) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFixatedParamsClean: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFixatedParamsClean: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixatedParametersListLen", wireType)
+			}
+			m.FixatedParametersListLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixatedParametersListLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,9 +10,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		StakeStorageList:  []StakeStorage{},
-		EpochDetails:      &EpochDetails{StartBlock: 0, EarliestStart: 0, DeletedEpochs: []uint64{}},
-		FixatedParamsList: []FixatedParams{},
+		StakeStorageList:      []StakeStorage{},
+		EpochDetails:          &EpochDetails{StartBlock: 0, EarliestStart: 0, DeletedEpochs: []uint64{}},
+		FixatedParamsList:     []FixatedParams{},
+		StakeEntryCurrentList: []StakeEntryCurrent{},
+		StakeStorageEpochList: []StakeStorageEpoch{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -41,6 +43,26 @@ func (gs GenesisState) Validate() error {
 		}
 		fixatedParamsIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in stakeEntryCurrent
+	stakeEntryCurrentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.StakeEntryCurrentList {
+		index := string(StakeEntryCurrentKey(elem.StorageType, elem.ChainID, elem.StakeEntry.Address))
+		if _, ok := stakeEntryCurrentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for stakeEntryCurrent")
+		}
+		stakeEntryCurrentIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in stakeStorageEpoch
+	stakeStorageEpochIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.StakeStorageEpochList {
+		index := string(StakeStorageEpochKey(elem.StorageType, elem.ChainID, elem.Epoch))
+		if _, ok := stakeStorageEpochIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for stakeStorageEpoch")
+		}
+		stakeStorageEpochIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
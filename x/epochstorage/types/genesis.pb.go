@@ -25,10 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epochstorage module's genesis state.
 type GenesisState struct {
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	StakeStorageList  []StakeStorage  `protobuf:"bytes,2,rep,name=stakeStorageList,proto3" json:"stakeStorageList"`
-	EpochDetails      *EpochDetails   `protobuf:"bytes,3,opt,name=epochDetails,proto3" json:"epochDetails,omitempty"`
-	FixatedParamsList []FixatedParams `protobuf:"bytes,4,rep,name=fixatedParamsList,proto3" json:"fixatedParamsList"`
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StakeStorageList      []StakeStorage      `protobuf:"bytes,2,rep,name=stakeStorageList,proto3" json:"stakeStorageList"`
+	EpochDetails          *EpochDetails       `protobuf:"bytes,3,opt,name=epochDetails,proto3" json:"epochDetails,omitempty"`
+	FixatedParamsList     []FixatedParams     `protobuf:"bytes,4,rep,name=fixatedParamsList,proto3" json:"fixatedParamsList"`
+	StakeEntryCurrentList []StakeEntryCurrent `protobuf:"bytes,5,rep,name=stakeEntryCurrentList,proto3" json:"stakeEntryCurrentList"`
+	StakeStorageEpochList []StakeStorageEpoch `protobuf:"bytes,6,rep,name=stakeStorageEpochList,proto3" json:"stakeStorageEpochList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +94,20 @@ func (m *GenesisState) GetFixatedParamsList() []FixatedParams {
 	return nil
 }
 
+func (m *GenesisState) GetStakeEntryCurrentList() []StakeEntryCurrent {
+	if m != nil {
+		return m.StakeEntryCurrentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeStorageEpochList() []StakeStorageEpoch {
+	if m != nil {
+		return m.StakeStorageEpochList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.epochstorage.GenesisState")
 }
@@ -99,27 +115,30 @@ func init() {
 func init() { proto.RegisterFile("epochstorage/genesis.proto", fileDescriptor_ab3aafc0665578df) }
 
 var fileDescriptor_ab3aafc0665578df = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2d, 0xc8, 0x4f,
-	0xce, 0x28, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xcc, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1,
-	0x03, 0xd1, 0x7a, 0xc8, 0x0a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c,
-	0x88, 0x06, 0x29, 0x49, 0x14, 0xc3, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0x66, 0x49, 0x29, 0xa0,
-	0x48, 0x15, 0x97, 0x24, 0x66, 0xa7, 0xc6, 0x43, 0x79, 0x58, 0x55, 0x80, 0x39, 0xf1, 0x29, 0xa9,
-	0x25, 0x89, 0x99, 0x39, 0x30, 0x33, 0x14, 0x51, 0x54, 0xa4, 0x65, 0x56, 0x24, 0x96, 0xa4, 0xa6,
-	0xc4, 0x23, 0x5b, 0xa3, 0x74, 0x95, 0x89, 0x8b, 0xc7, 0x1d, 0xe2, 0x89, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x7b, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x45, 0x3d,
-	0x9c, 0x9e, 0xd2, 0x0b, 0x00, 0x2b, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x4d,
-	0x28, 0x92, 0x4b, 0x00, 0xec, 0xda, 0x60, 0x88, 0x22, 0x9f, 0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05,
-	0x66, 0x0d, 0x6e, 0x23, 0x75, 0x3c, 0x46, 0x05, 0x23, 0x69, 0x81, 0x1a, 0x88, 0x61, 0x8c, 0x90,
-	0x37, 0x17, 0x0f, 0x58, 0x93, 0x0b, 0xc4, 0x97, 0x12, 0xcc, 0x60, 0x17, 0xe2, 0x33, 0xd6, 0x15,
-	0x49, 0x79, 0x10, 0x8a, 0x66, 0xa1, 0x18, 0x2e, 0x41, 0x68, 0x88, 0x40, 0xbc, 0x01, 0x76, 0x28,
-	0x0b, 0xd8, 0xa1, 0x1a, 0x78, 0x4c, 0x74, 0x43, 0xd6, 0x03, 0x75, 0x29, 0xa6, 0x41, 0x4e, 0x6e,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5, 0x06, 0x4c, 0xeb, 0x57, 0xe8, 0xa3, 0x44, 0x57,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x9a, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x15, 0x92, 0x08, 0x98, 0x77, 0x02, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x5b, 0x41, 0x16, 0x03, 0x0b, 0x6d, 0x34, 0x81, 0x2e, 0x6a, 0x71, 0x23, 0x0b, 0xd2,
+	0x26, 0x78, 0x00, 0x13, 0x14, 0x5c, 0xe8, 0xc2, 0xc0, 0x4a, 0x63, 0x42, 0x06, 0x18, 0x4b, 0x23,
+	0x74, 0x9a, 0xf6, 0x61, 0xe0, 0x16, 0x5e, 0xc3, 0x9b, 0xb0, 0x64, 0xe9, 0xca, 0x18, 0xb8, 0x88,
+	0xe1, 0xcd, 0x68, 0x66, 0x04, 0xc1, 0xd5, 0x74, 0xd2, 0xff, 0xff, 0xfe, 0xf7, 0xbf, 0x0c, 0xb1,
+	0x59, 0xcc, 0x7b, 0x83, 0x14, 0x78, 0x42, 0x03, 0xe6, 0x07, 0x2c, 0x62, 0x69, 0x98, 0x7a, 0x71,
+	0xc2, 0x81, 0x5b, 0xa5, 0x21, 0x7d, 0xa1, 0x11, 0x03, 0x6f, 0x75, 0x7a, 0xaa, 0xd0, 0x3e, 0x0a,
+	0x78, 0xc0, 0x51, 0xe5, 0xaf, 0xbe, 0x84, 0xc1, 0x2e, 0x69, 0xb0, 0x98, 0x26, 0x74, 0x24, 0x59,
+	0xb6, 0xab, 0xfd, 0x4a, 0x81, 0x3e, 0xb3, 0x8e, 0xbc, 0x6d, 0x54, 0xe0, 0xa5, 0xd3, 0x67, 0x40,
+	0xc3, 0xe1, 0x37, 0xa3, 0xac, 0x29, 0x9e, 0xc2, 0x09, 0x05, 0xd6, 0xef, 0xa8, 0x31, 0xa7, 0x6f,
+	0x59, 0x52, 0xb8, 0x16, 0x25, 0xda, 0x40, 0x81, 0x59, 0x17, 0x24, 0x27, 0x04, 0x45, 0xd3, 0x35,
+	0x2b, 0xf9, 0x5a, 0xd9, 0xfb, 0xb3, 0x94, 0x77, 0x87, 0xc2, 0x7a, 0x76, 0xf6, 0x71, 0x62, 0xb4,
+	0xa4, 0xcd, 0xba, 0x27, 0x07, 0x38, 0x6d, 0x5b, 0x88, 0x6e, 0xc3, 0x14, 0x8a, 0x7b, 0x6e, 0xa6,
+	0x92, 0xaf, 0x9d, 0x6d, 0x41, 0xb5, 0x15, 0x8b, 0x04, 0xae, 0x61, 0xac, 0x1b, 0x52, 0x40, 0xd3,
+	0x95, 0x68, 0x59, 0xcc, 0xb8, 0xe6, 0x0e, 0x6c, 0x43, 0x91, 0xb7, 0x34, 0xb3, 0xf5, 0x48, 0x0e,
+	0xe5, 0x46, 0x44, 0x0d, 0x1c, 0x34, 0x8b, 0x83, 0x56, 0xb6, 0x10, 0x9b, 0xaa, 0x47, 0x4e, 0xba,
+	0x0e, 0xb2, 0x06, 0xe4, 0x18, 0xc7, 0x6f, 0x44, 0x90, 0x4c, 0x2f, 0xc7, 0x49, 0xc2, 0x22, 0xc0,
+	0x84, 0x7d, 0x4c, 0xa8, 0xee, 0x5a, 0x85, 0xea, 0x93, 0x29, 0x9b, 0x81, 0x3f, 0x49, 0x72, 0x51,
+	0xd8, 0x18, 0x93, 0x72, 0xff, 0x4b, 0x52, 0x7d, 0x5a, 0xd2, 0x6f, 0x60, 0xbd, 0x39, 0x5b, 0x38,
+	0xe6, 0x7c, 0xe1, 0x98, 0x9f, 0x0b, 0xc7, 0x7c, 0x5d, 0x3a, 0xc6, 0x7c, 0xe9, 0x18, 0xef, 0x4b,
+	0xc7, 0x78, 0xa8, 0x06, 0x21, 0x0c, 0xc6, 0x5d, 0xaf, 0xc7, 0x47, 0xbe, 0x8c, 0xc3, 0xd3, 0x9f,
+	0xf8, 0xda, 0x13, 0x84, 0x69, 0xcc, 0xd2, 0x6e, 0x0e, 0x9f, 0xde, 0xf9, 0xd7, 0x00, 0x04, 0xdb,
+	0x8e, 0x56, 0x4b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -142,6 +161,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StakeStorageEpochList) > 0 {
+		for iNdEx := len(m.StakeStorageEpochList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeStorageEpochList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StakeEntryCurrentList) > 0 {
+		for iNdEx := len(m.StakeEntryCurrentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeEntryCurrentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FixatedParamsList) > 0 {
 		for iNdEx := len(m.FixatedParamsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,6 +277,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StakeEntryCurrentList) > 0 {
+		for _, e := range m.StakeEntryCurrentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakeStorageEpochList) > 0 {
+		for _, e := range m.StakeStorageEpochList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -405,6 +464,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntryCurrentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeEntryCurrentList = append(m.StakeEntryCurrentList, StakeEntryCurrent{})
+			if err := m.StakeEntryCurrentList[len(m.StakeEntryCurrentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeStorageEpochList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeStorageEpochList = append(m.StakeStorageEpochList, StakeStorageEpoch{})
+			if err := m.StakeStorageEpochList[len(m.StakeStorageEpochList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,30 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				StakeEntryCurrentList: []types.StakeEntryCurrent{
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						StakeEntry:  types.StakeEntry{Address: "0"},
+					},
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						StakeEntry:  types.StakeEntry{Address: "1"},
+					},
+				},
+				StakeStorageEpochList: []types.StakeStorageEpoch{
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						Epoch:       0,
+					},
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						Epoch:       1,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -76,6 +100,44 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated stakeEntryCurrent",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StakeEntryCurrentList: []types.StakeEntryCurrent{
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						StakeEntry:  types.StakeEntry{Address: "0"},
+					},
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						StakeEntry:  types.StakeEntry{Address: "0"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stakeStorageEpoch",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StakeStorageEpochList: []types.StakeStorageEpoch{
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						Epoch:       0,
+					},
+					{
+						StorageType: types.ProviderKey,
+						ChainID:     "0",
+						Epoch:       0,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
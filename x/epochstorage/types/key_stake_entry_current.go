@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StakeEntryCurrentKeyPrefix is the prefix to retrieve all StakeEntryCurrent
+	StakeEntryCurrentKeyPrefix = "StakeEntryCurrent/value/"
+	// StakeEntryCurrentByStakeKeyPrefix is the prefix of the stake ordered index of StakeEntryCurrent
+	StakeEntryCurrentByStakeKeyPrefix = "StakeEntryCurrent/stake/"
+	// StakeEntryCurrentOrderKey is the key of the last order given to a StakeEntryCurrent
+	StakeEntryCurrentOrderKey = "StakeEntryCurrent/order/"
+	// StakeStorageChangedKeyPrefix is the prefix to retrieve the chains whose current stake entries changed since the last epoch snapshot
+	StakeStorageChangedKeyPrefix = "StakeStorageChanged/value/"
+	// StakeStorageEpochKeyPrefix is the prefix to retrieve all StakeStorageEpoch
+	StakeStorageEpochKeyPrefix = "StakeStorageEpoch/value/"
+
+	// stakeKeyLength is the length of an encoded stake amount, sdk.Int is limited to 256 bits
+	stakeKeyLength = 32
+)
+
+// StakeStorageChainKey returns the store key prefix of a storage type on a chain
+func StakeStorageChainKey(
+	storageType string,
+	chainID string,
+) []byte {
+	var key []byte
+
+	storageTypeBytes := []byte(storageType)
+	key = append(key, storageTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	chainIDBytes := []byte(chainID)
+	key = append(key, chainIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StakeEntryCurrentKey returns the store key to retrieve a StakeEntryCurrent from the index fields
+func StakeEntryCurrentKey(
+	storageType string,
+	chainID string,
+	address string,
+) []byte {
+	key := StakeStorageChainKey(storageType, chainID)
+
+	// bech32 addresses are case insensitive
+	addressBytes := []byte(strings.ToLower(address))
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StakeEntryCurrentByStakeKey returns the key of a StakeEntryCurrent in the stake ordered index, keys are ordered by stake and then by order
+func StakeEntryCurrentByStakeKey(
+	storageType string,
+	chainID string,
+	stake sdk.Int,
+	order uint64,
+) []byte {
+	key := StakeStorageChainKey(storageType, chainID)
+
+	stakeBytes := make([]byte, stakeKeyLength)
+	if !stake.IsNil() {
+		stake.BigInt().FillBytes(stakeBytes)
+	}
+	key = append(key, stakeBytes...)
+
+	orderBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(orderBytes, order)
+	key = append(key, orderBytes...)
+
+	return key
+}
+
+// StakeStorageEpochKey returns the store key to retrieve a StakeStorageEpoch from the index fields, keys of a chain are ordered by epoch
+func StakeStorageEpochKey(
+	storageType string,
+	chainID string,
+	epoch uint64,
+) []byte {
+	key := StakeStorageChainKey(storageType, chainID)
+
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, epoch)
+	key = append(key, epochBytes...)
+
+	return key
+}
@@ -33,6 +33,7 @@ type StakeEntry struct {
 	Chain       string     `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
 	Vrfpk       string     `protobuf:"bytes,7,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
 	Moniker     string     `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Operator    string     `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
 func (m *StakeEntry) Reset()         { *m = StakeEntry{} }
@@ -124,6 +125,13 @@ func (m *StakeEntry) GetMoniker() string {
 	return ""
 }
 
+func (m *StakeEntry) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StakeEntry)(nil), "lavanet.lava.epochstorage.StakeEntry")
 }
@@ -131,29 +139,30 @@ func init() {
 func init() { proto.RegisterFile("epochstorage/stake_entry.proto", fileDescriptor_1250f7eaa46b63b0) }
 
 var fileDescriptor_1250f7eaa46b63b0 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0x6d, 0xf9, 0x67, 0xd8, 0x35, 0x2c, 0x06, 0xbe, 0x64, 0xbe, 0x46, 0x37, 0x5d, 0x98, 0x99,
-	0x80, 0xf1, 0x05, 0x30, 0xe8, 0x1e, 0x77, 0x6e, 0xcc, 0xb4, 0xbd, 0x96, 0x09, 0x30, 0xb7, 0xe9,
-	0x8c, 0x44, 0xde, 0xc2, 0xe7, 0xf0, 0x49, 0x58, 0xb2, 0x74, 0x65, 0x0c, 0xbc, 0x88, 0xe9, 0x0f,
-	0x0a, 0x0b, 0x57, 0xf7, 0x9e, 0xd3, 0x73, 0x72, 0xcf, 0xe9, 0x10, 0x06, 0x29, 0x46, 0x73, 0x63,
-	0x31, 0x93, 0x09, 0x08, 0x63, 0xe5, 0x02, 0x9e, 0x40, 0xdb, 0x6c, 0xc3, 0xd3, 0x0c, 0x2d, 0x7a,
-	0x83, 0xa5, 0x5c, 0x4b, 0x0d, 0x96, 0xe7, 0x93, 0x9f, 0x8a, 0x87, 0xff, 0xce, 0xac, 0xa0, 0xe3,
-	0x14, 0x95, 0xb6, 0xa5, 0x6f, 0xd8, 0x4f, 0x30, 0xc1, 0x62, 0x15, 0xf9, 0x56, 0xb1, 0x2c, 0x42,
-	0xb3, 0x42, 0x23, 0x42, 0x69, 0x40, 0xac, 0x47, 0x21, 0x58, 0x39, 0x12, 0x11, 0x2a, 0x5d, 0x7e,
-	0xbf, 0x78, 0xaf, 0x11, 0xf2, 0x90, 0x67, 0x98, 0xe6, 0x11, 0xbc, 0x1b, 0xd2, 0x2c, 0x12, 0x51,
-	0xd7, 0x77, 0x83, 0xde, 0x78, 0xc0, 0x4b, 0x3b, 0xcf, 0xed, 0xbc, 0xb2, 0xf3, 0x5b, 0x54, 0x7a,
-	0xd2, 0xd8, 0x7e, 0xfe, 0x77, 0x66, 0xa5, 0xda, 0xa3, 0xa4, 0x2d, 0xe3, 0x38, 0x03, 0x63, 0x68,
-	0xcd, 0x77, 0x83, 0xee, 0xec, 0x08, 0xbd, 0x21, 0xe9, 0xc4, 0x20, 0xe3, 0xa5, 0xd2, 0x40, 0xeb,
-	0xbe, 0x1b, 0x34, 0x66, 0x3f, 0xd8, 0xbb, 0x27, 0xdd, 0x63, 0x07, 0x43, 0x1b, 0x7e, 0x3d, 0xe8,
-	0x8d, 0x2f, 0xf9, 0x9f, 0xed, 0xf9, 0xb4, 0xd2, 0x56, 0xa7, 0x7f, 0xbd, 0x9e, 0x4f, 0x7a, 0x09,
-	0xe0, 0x12, 0x23, 0x69, 0x15, 0x6a, 0xda, 0x2c, 0xee, 0x9c, 0x52, 0x5e, 0x9f, 0x34, 0xa3, 0xb9,
-	0x54, 0x9a, 0xb6, 0x8a, 0x78, 0x25, 0xc8, 0xd9, 0x75, 0xf6, 0x9c, 0x2e, 0x68, 0xbb, 0x64, 0x0b,
-	0x90, 0x97, 0x59, 0xa1, 0x56, 0x0b, 0xc8, 0x68, 0xa7, 0x2c, 0x53, 0xc1, 0xc9, 0xdd, 0x76, 0xcf,
-	0xdc, 0xdd, 0x9e, 0xb9, 0x5f, 0x7b, 0xe6, 0xbe, 0x1d, 0x98, 0xb3, 0x3b, 0x30, 0xe7, 0xe3, 0xc0,
-	0x9c, 0xc7, 0xab, 0x44, 0xd9, 0xf9, 0x4b, 0xc8, 0x23, 0x5c, 0x89, 0xaa, 0x41, 0x31, 0xc5, 0xab,
-	0x38, 0x7b, 0x33, 0xbb, 0x49, 0xc1, 0x84, 0xad, 0xe2, 0xdf, 0x5f, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xea, 0xd0, 0x77, 0x0b, 0x02, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0x3d, 0x8f, 0x1a, 0x31,
+	0x10, 0xdd, 0xe5, 0x1b, 0xd3, 0xad, 0x28, 0x0c, 0x91, 0x9c, 0x55, 0xd2, 0x6c, 0x11, 0xd9, 0x82,
+	0x28, 0x7f, 0x80, 0x88, 0xa4, 0x27, 0x5d, 0x9a, 0x93, 0x77, 0xd7, 0xb7, 0x58, 0x80, 0x67, 0x65,
+	0xfb, 0xd0, 0xf1, 0x2f, 0xee, 0x57, 0x9d, 0x28, 0x29, 0xaf, 0x3a, 0x9d, 0xe0, 0x8f, 0x9c, 0xec,
+	0x5d, 0x38, 0x28, 0xae, 0x9a, 0x79, 0x6f, 0xe6, 0x69, 0xde, 0xd3, 0x20, 0x22, 0x4a, 0xc8, 0x96,
+	0xc6, 0x82, 0xe6, 0x85, 0x60, 0xc6, 0xf2, 0x95, 0xb8, 0x13, 0xca, 0xea, 0x1d, 0x2d, 0x35, 0x58,
+	0x88, 0x46, 0x6b, 0xbe, 0xe5, 0x4a, 0x58, 0xea, 0x2a, 0xbd, 0x5e, 0x1e, 0x7f, 0xb9, 0x91, 0x0a,
+	0x95, 0x97, 0x20, 0x95, 0xad, 0x74, 0xe3, 0x61, 0x01, 0x05, 0xf8, 0x96, 0xb9, 0xae, 0x66, 0x49,
+	0x06, 0x66, 0x03, 0x86, 0xa5, 0xdc, 0x08, 0xb6, 0x9d, 0xa4, 0xc2, 0xf2, 0x09, 0xcb, 0x40, 0xaa,
+	0x6a, 0xfe, 0xed, 0xb9, 0x81, 0xd0, 0x3f, 0xe7, 0x61, 0xee, 0x2c, 0x44, 0xbf, 0x50, 0xdb, 0x3b,
+	0xc2, 0x61, 0x1c, 0x26, 0x83, 0xe9, 0x88, 0x56, 0x72, 0xea, 0xe4, 0xb4, 0x96, 0xd3, 0xdf, 0x20,
+	0xd5, 0xac, 0xb5, 0x7f, 0xfd, 0x1a, 0x2c, 0xaa, 0xed, 0x08, 0xa3, 0x2e, 0xcf, 0x73, 0x2d, 0x8c,
+	0xc1, 0x8d, 0x38, 0x4c, 0xfa, 0x8b, 0x33, 0x8c, 0xc6, 0xa8, 0x97, 0x0b, 0x9e, 0xaf, 0xa5, 0x12,
+	0xb8, 0x19, 0x87, 0x49, 0x6b, 0x71, 0xc1, 0xd1, 0x5f, 0xd4, 0x3f, 0x67, 0x30, 0xb8, 0x15, 0x37,
+	0x93, 0xc1, 0xf4, 0x3b, 0xfd, 0x34, 0x3d, 0x9d, 0xd7, 0xbb, 0xf5, 0xe9, 0x0f, 0x6d, 0x14, 0xa3,
+	0x41, 0x21, 0x60, 0x0d, 0x19, 0xb7, 0x12, 0x14, 0x6e, 0xfb, 0x3b, 0xd7, 0x54, 0x34, 0x44, 0xed,
+	0x6c, 0xc9, 0xa5, 0xc2, 0x1d, 0x6f, 0xaf, 0x02, 0x8e, 0xdd, 0xea, 0xfb, 0x72, 0x85, 0xbb, 0x15,
+	0xeb, 0x81, 0x0b, 0xb3, 0x01, 0x25, 0x57, 0x42, 0xe3, 0x5e, 0x15, 0xa6, 0x86, 0x2e, 0x0c, 0x94,
+	0x42, 0x73, 0x0b, 0x1a, 0xf7, 0xfd, 0xe8, 0x82, 0x67, 0x7f, 0xf6, 0x47, 0x12, 0x1e, 0x8e, 0x24,
+	0x7c, 0x3b, 0x92, 0xf0, 0xe9, 0x44, 0x82, 0xc3, 0x89, 0x04, 0x2f, 0x27, 0x12, 0xfc, 0xff, 0x51,
+	0x48, 0xbb, 0x7c, 0x48, 0x69, 0x06, 0x1b, 0x56, 0xa7, 0xf3, 0x95, 0x3d, 0xb2, 0x9b, 0x7f, 0xda,
+	0x5d, 0x29, 0x4c, 0xda, 0xf1, 0x7f, 0xf9, 0xf9, 0x3e, 0x00, 0x21, 0xe0, 0xc8, 0x75, 0x27, 0x02,
+	0x00, 0x00,
 }
 
 func (m *StakeEntry) Marshal() (dAtA []byte, err error) {
@@ -176,6 +185,13 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintStakeEntry(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Moniker) > 0 {
 		i -= len(m.Moniker)
 		copy(dAtA[i:], m.Moniker)
@@ -288,6 +304,10 @@ func (m *StakeEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStakeEntry(uint64(l))
 	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovStakeEntry(uint64(l))
+	}
 	return n
 }
 
@@ -559,6 +579,38 @@ func (m *StakeEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakeEntry(dAtA[iNdEx:])
@@ -83,30 +83,185 @@ func (m *StakeStorage) GetEpochBlockHash() []byte {
 	return nil
 }
 
+// StakeEntryCurrent is a current stake entry, stored by its address and indexed by its stake for pairing
+type StakeEntryCurrent struct {
+	StorageType string     `protobuf:"bytes,1,opt,name=storageType,proto3" json:"storageType,omitempty"`
+	ChainID     string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	StakeEntry  StakeEntry `protobuf:"bytes,3,opt,name=stakeEntry,proto3" json:"stakeEntry"`
+	Order       uint64     `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *StakeEntryCurrent) Reset()         { *m = StakeEntryCurrent{} }
+func (m *StakeEntryCurrent) String() string { return proto.CompactTextString(m) }
+func (*StakeEntryCurrent) ProtoMessage()    {}
+func (*StakeEntryCurrent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac9ba31a1cd8653b, []int{1}
+}
+func (m *StakeEntryCurrent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeEntryCurrent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeEntryCurrent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeEntryCurrent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeEntryCurrent.Merge(m, src)
+}
+func (m *StakeEntryCurrent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeEntryCurrent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeEntryCurrent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeEntryCurrent proto.InternalMessageInfo
+
+func (m *StakeEntryCurrent) GetStorageType() string {
+	if m != nil {
+		return m.StorageType
+	}
+	return ""
+}
+
+func (m *StakeEntryCurrent) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *StakeEntryCurrent) GetStakeEntry() StakeEntry {
+	if m != nil {
+		return m.StakeEntry
+	}
+	return StakeEntry{}
+}
+
+func (m *StakeEntryCurrent) GetOrder() uint64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+// StakeStorageEpoch points an epoch to the StakeStorage snapshot of its stake entries, epochs without stake changes share a snapshot
+type StakeStorageEpoch struct {
+	StorageType    string `protobuf:"bytes,1,opt,name=storageType,proto3" json:"storageType,omitempty"`
+	ChainID        string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Epoch          uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SnapshotBlock  uint64 `protobuf:"varint,4,opt,name=snapshotBlock,proto3" json:"snapshotBlock,omitempty"`
+	EpochBlockHash []byte `protobuf:"bytes,5,opt,name=epochBlockHash,proto3" json:"epochBlockHash,omitempty"`
+}
+
+func (m *StakeStorageEpoch) Reset()         { *m = StakeStorageEpoch{} }
+func (m *StakeStorageEpoch) String() string { return proto.CompactTextString(m) }
+func (*StakeStorageEpoch) ProtoMessage()    {}
+func (*StakeStorageEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac9ba31a1cd8653b, []int{2}
+}
+func (m *StakeStorageEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakeStorageEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeStorageEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakeStorageEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeStorageEpoch.Merge(m, src)
+}
+func (m *StakeStorageEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakeStorageEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeStorageEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeStorageEpoch proto.InternalMessageInfo
+
+func (m *StakeStorageEpoch) GetStorageType() string {
+	if m != nil {
+		return m.StorageType
+	}
+	return ""
+}
+
+func (m *StakeStorageEpoch) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *StakeStorageEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *StakeStorageEpoch) GetSnapshotBlock() uint64 {
+	if m != nil {
+		return m.SnapshotBlock
+	}
+	return 0
+}
+
+func (m *StakeStorageEpoch) GetEpochBlockHash() []byte {
+	if m != nil {
+		return m.EpochBlockHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StakeStorage)(nil), "lavanet.lava.epochstorage.StakeStorage")
+	proto.RegisterType((*StakeEntryCurrent)(nil), "lavanet.lava.epochstorage.StakeEntryCurrent")
+	proto.RegisterType((*StakeStorageEpoch)(nil), "lavanet.lava.epochstorage.StakeStorageEpoch")
 }
 
 func init() { proto.RegisterFile("epochstorage/stake_storage.proto", fileDescriptor_ac9ba31a1cd8653b) }
 
 var fileDescriptor_ac9ba31a1cd8653b = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2d, 0xc8, 0x4f,
-	0xce, 0x28, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x2f, 0x2e, 0x49, 0xcc, 0x4e, 0x8d, 0x87,
-	0xf2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0x73, 0x12, 0xcb, 0x12, 0xf3, 0x52, 0x4b,
-	0xf4, 0x40, 0xb4, 0x1e, 0xb2, 0x72, 0x29, 0x39, 0x2c, 0x9a, 0x53, 0xf3, 0x4a, 0x8a, 0x2a, 0x21,
-	0x5a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x34, 0x97,
-	0x91, 0x8b, 0x27, 0x18, 0xa4, 0x36, 0x18, 0xa2, 0x51, 0x48, 0x84, 0x8b, 0x35, 0x33, 0x2f, 0x25,
-	0xb5, 0x42, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0xf2, 0xe7, 0xe2, 0x01, 0x9b,
-	0xe8, 0x9a, 0x57, 0x52, 0x94, 0x99, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xaa,
-	0x87, 0xd3, 0x39, 0x7a, 0xc1, 0x30, 0xe5, 0x95, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xa1,
-	0x18, 0x20, 0xa4, 0xc6, 0xc5, 0x07, 0x56, 0xee, 0x94, 0x93, 0x9f, 0x9c, 0xed, 0x91, 0x58, 0x9c,
-	0x21, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x13, 0x84, 0x26, 0xea, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
-	0xb9, 0xfa, 0x50, 0x67, 0x80, 0x69, 0xfd, 0x0a, 0x7d, 0x94, 0x90, 0x28, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0x7b, 0xd7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x51, 0xc5, 0x71, 0x49, 0x63,
-	0x01, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x9b, 0xad, 0x53, 0xcc, 0xa6, 0x60, 0xd8, 0xa1, 0xee, 0x10, 0xcb, 0x50, 0xe9, 0x41,
+	0x5a, 0xd0, 0x37, 0x98, 0x4e, 0x14, 0x0f, 0x42, 0xe7, 0xc9, 0x8b, 0x64, 0x5d, 0x68, 0xcb, 0x66,
+	0x52, 0x92, 0x4c, 0xd6, 0xb7, 0xf0, 0x05, 0x7c, 0x09, 0x7d, 0x89, 0x1d, 0x77, 0xf4, 0x24, 0xb2,
+	0xbd, 0x88, 0x34, 0xed, 0x74, 0x93, 0x7a, 0x10, 0x4f, 0xc9, 0xff, 0xeb, 0xf7, 0xfd, 0xf3, 0xeb,
+	0x9f, 0x0f, 0xda, 0x34, 0xe1, 0x41, 0x24, 0x15, 0x17, 0x24, 0xa4, 0x9e, 0x54, 0x64, 0x48, 0xef,
+	0x0b, 0xe5, 0x26, 0x82, 0x2b, 0x8e, 0xf6, 0x46, 0xe4, 0x91, 0x30, 0xaa, 0xdc, 0xec, 0x74, 0x57,
+	0xdb, 0x5b, 0xb8, 0x64, 0x98, 0x32, 0x25, 0xd2, 0x7c, 0xb4, 0xd5, 0x0c, 0x79, 0xc8, 0xf5, 0xd5,
+	0xcb, 0x6e, 0x79, 0xb5, 0xfd, 0x0c, 0x60, 0xa3, 0x97, 0xf5, 0xf6, 0xf2, 0x41, 0xd4, 0x84, 0xb5,
+	0x98, 0x0d, 0xe8, 0xc4, 0x02, 0x36, 0x70, 0xb6, 0xfc, 0x5c, 0xa0, 0x1b, 0xd8, 0xd0, 0x8e, 0x5d,
+	0xa6, 0x44, 0x4c, 0xa5, 0x55, 0xb1, 0xab, 0x4e, 0xfd, 0xe4, 0xd0, 0xfd, 0x15, 0xc7, 0xed, 0x2d,
+	0xdb, 0xd3, 0x8e, 0x39, 0x7d, 0xdf, 0x37, 0xfc, 0x35, 0x03, 0x74, 0x04, 0x77, 0x74, 0x7b, 0x67,
+	0xc4, 0x83, 0xe1, 0x25, 0x91, 0x91, 0x55, 0xb5, 0x81, 0xd3, 0xf0, 0x7f, 0x54, 0xdb, 0x2f, 0x00,
+	0xee, 0x7e, 0x5b, 0x9d, 0x8d, 0x85, 0xa0, 0x4c, 0x21, 0x1b, 0xd6, 0x8b, 0x77, 0x6e, 0xd3, 0x84,
+	0x16, 0xa8, 0xab, 0x25, 0x64, 0xc1, 0xcd, 0x20, 0x22, 0x31, 0xbb, 0x3a, 0xb7, 0x2a, 0xfa, 0xeb,
+	0x52, 0xa2, 0x6b, 0x08, 0xbf, 0x48, 0x52, 0xfd, 0xea, 0x1f, 0x7f, 0x64, 0x65, 0x3c, 0x4b, 0x8b,
+	0x8b, 0x01, 0x15, 0x96, 0x69, 0x03, 0xc7, 0xf4, 0x73, 0xd1, 0x7e, 0x5d, 0x42, 0x17, 0xa1, 0x76,
+	0x33, 0xbf, 0x7f, 0x41, 0x37, 0x61, 0x4d, 0x43, 0x69, 0x5e, 0xd3, 0xcf, 0x05, 0x3a, 0x80, 0xdb,
+	0x92, 0x91, 0x44, 0x46, 0x5c, 0xe9, 0xc4, 0x0a, 0x8a, 0xf5, 0x62, 0x49, 0xd4, 0xb5, 0xb2, 0xa8,
+	0x3b, 0x17, 0xd3, 0x39, 0x06, 0xb3, 0x39, 0x06, 0x1f, 0x73, 0x0c, 0x9e, 0x16, 0xd8, 0x98, 0x2d,
+	0xb0, 0xf1, 0xb6, 0xc0, 0xc6, 0xdd, 0x71, 0x18, 0xab, 0x68, 0xdc, 0x77, 0x03, 0xfe, 0xe0, 0x15,
+	0x41, 0xe9, 0xd3, 0x9b, 0x78, 0x6b, 0x4b, 0xa7, 0xd2, 0x84, 0xca, 0xfe, 0x86, 0xde, 0xac, 0xd3,
+	0xcf, 0x01, 0x00, 0x4d, 0x5b, 0xa9, 0xfd, 0xce, 0x02, 0x00, 0x00,
 }
 
 func (m *StakeStorage) Marshal() (dAtA []byte, err error) {
@@ -160,6 +315,112 @@ func (m *StakeStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakeEntryCurrent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeEntryCurrent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeEntryCurrent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintStakeStorage(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.StakeEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStakeStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintStakeStorage(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StorageType) > 0 {
+		i -= len(m.StorageType)
+		copy(dAtA[i:], m.StorageType)
+		i = encodeVarintStakeStorage(dAtA, i, uint64(len(m.StorageType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeStorageEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeStorageEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeStorageEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochBlockHash) > 0 {
+		i -= len(m.EpochBlockHash)
+		copy(dAtA[i:], m.EpochBlockHash)
+		i = encodeVarintStakeStorage(dAtA, i, uint64(len(m.EpochBlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SnapshotBlock != 0 {
+		i = encodeVarintStakeStorage(dAtA, i, uint64(m.SnapshotBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStakeStorage(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintStakeStorage(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StorageType) > 0 {
+		i -= len(m.StorageType)
+		copy(dAtA[i:], m.StorageType)
+		i = encodeVarintStakeStorage(dAtA, i, uint64(len(m.StorageType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStakeStorage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStakeStorage(v)
 	base := offset
@@ -194,6 +455,55 @@ func (m *StakeStorage) Size() (n int) {
 	return n
 }
 
+func (m *StakeEntryCurrent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageType)
+	if l > 0 {
+		n += 1 + l + sovStakeStorage(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovStakeStorage(uint64(l))
+	}
+	l = m.StakeEntry.Size()
+	n += 1 + l + sovStakeStorage(uint64(l))
+	if m.Order != 0 {
+		n += 1 + sovStakeStorage(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *StakeStorageEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageType)
+	if l > 0 {
+		n += 1 + l + sovStakeStorage(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovStakeStorage(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStakeStorage(uint64(m.Epoch))
+	}
+	if m.SnapshotBlock != 0 {
+		n += 1 + sovStakeStorage(uint64(m.SnapshotBlock))
+	}
+	l = len(m.EpochBlockHash)
+	if l > 0 {
+		n += 1 + l + sovStakeStorage(uint64(l))
+	}
+	return n
+}
+
 func sovStakeStorage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -350,6 +660,358 @@ func (m *StakeStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StakeEntryCurrent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakeStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeEntryCurrent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeEntryCurrent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakeStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeStorageEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakeStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeStorageEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeStorageEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBlock", wireType)
+			}
+			m.SnapshotBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBlockHash = append(m.EpochBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochBlockHash == nil {
+				m.EpochBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakeStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakeStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStakeStorage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
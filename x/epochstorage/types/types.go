@@ -1,5 +1,11 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
 const TokenDenom = "ulava"
 
 const (
@@ -23,8 +29,28 @@ func (stksto StakeStorage) Copy() (returnedStorage StakeStorage) {
 			Geolocation: stakeEntry.Geolocation,
 			Chain:       stakeEntry.Chain,
 			Vrfpk:       stakeEntry.Vrfpk,
+			Moniker:     stakeEntry.Moniker,
+			Operator:    stakeEntry.Operator,
 		}
 		returnedStorage.StakeEntries = append(returnedStorage.StakeEntries, newStakeEntry)
 	}
 	return
 }
+
+// OperatorIdentity returns the identity used to group providers run by the same operator,
+// the operator address that approved the provider, empty for providers that aren't grouped
+func (stakeEntry StakeEntry) OperatorIdentity() string {
+	return stakeEntry.Operator
+}
+
+// ValidateTLSCertHash checks the endpoint's certificate hash is either empty or a hex encoded sha256
+func (endpoint Endpoint) ValidateTLSCertHash() error {
+	if endpoint.TlsCertHash == "" {
+		return nil
+	}
+	hash, err := hex.DecodeString(endpoint.TlsCertHash)
+	if err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("endpoint %s tls certificate hash must be a hex encoded sha256, got: %s", endpoint.IPPORT, endpoint.TlsCertHash)
+	}
+	return nil
+}
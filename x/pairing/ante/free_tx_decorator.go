@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+type PairingKeeper interface {
+	UseFreeTx(ctx sdk.Context, address string) bool
+}
+
+type EpochstorageKeeper interface {
+	GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value epochstoragetypes.StakeEntry, found bool)
+}
+
+type ConflictKeeper interface {
+	GetConflictVote(ctx sdk.Context, index string) (val conflicttypes.ConflictVote, found bool)
+}
+
+type MsgServiceRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// FreeTxDecorator waives the fees of relay payment and conflict vote transactions that staked providers send without fees,
+// up to FreeTxsPerEpoch transactions per provider in an epoch. Any other transaction goes through the fee decorators.
+// In CheckTx the messages of a fee-free transaction are executed on a cached state before the quota is used, so transactions that would fail are rejected without using it.
+type FreeTxDecorator struct {
+	pairingKeeper      PairingKeeper
+	epochstorageKeeper EpochstorageKeeper
+	conflictKeeper     ConflictKeeper
+	router             MsgServiceRouter
+	feeDecorators      []sdk.AnteDecorator
+}
+
+func NewFreeTxDecorator(pairingKeeper PairingKeeper, epochstorageKeeper EpochstorageKeeper, conflictKeeper ConflictKeeper, router MsgServiceRouter, feeDecorators ...sdk.AnteDecorator) FreeTxDecorator {
+	return FreeTxDecorator{
+		pairingKeeper:      pairingKeeper,
+		epochstorageKeeper: epochstorageKeeper,
+		conflictKeeper:     conflictKeeper,
+		router:             router,
+		feeDecorators:      feeDecorators,
+	}
+}
+
+func (ftd FreeTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !feeTx.GetFee().IsZero() {
+		return ftd.chargeFees(ctx, tx, simulate, next, 0)
+	}
+
+	sender, chainIDs, ok := ftd.freeTxSender(ctx, tx.GetMsgs())
+	if !ok || !ftd.isStakedProvider(ctx, sender, chainIDs) {
+		return ftd.chargeFees(ctx, tx, simulate, next, 0)
+	}
+
+	// messages aren't executed in CheckTx, so a fee-free transaction that would fail has to be caught here before it uses the quota
+	if ctx.IsCheckTx() && !simulate {
+		if err := ftd.dryRun(ctx, tx.GetMsgs()); err != nil {
+			return ctx, sdkerrors.Wrap(err, "fee-free transaction would fail")
+		}
+	}
+
+	if !ftd.pairingKeeper.UseFreeTx(ctx, sender.String()) {
+		return ftd.chargeFees(ctx, tx, simulate, next, 0)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// freeTxSender returns the single signer of a transaction made only of fee-free messages and the chains of the messages
+func (ftd FreeTxDecorator) freeTxSender(ctx sdk.Context, msgs []sdk.Msg) (sender sdk.AccAddress, chainIDs []string, ok bool) {
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || (sender != nil && !sender.Equals(signers[0])) {
+			return nil, nil, false
+		}
+		sender = signers[0]
+
+		switch msg := msg.(type) {
+		case *pairingtypes.MsgRelayPayment:
+			for _, relay := range msg.Relays {
+				chainIDs = append(chainIDs, relay.ChainID)
+			}
+			for _, proof := range msg.Proofs {
+				chainIDs = append(chainIDs, proof.ChainID)
+			}
+		case *conflicttypes.MsgConflictVoteCommit:
+			conflictVote, found := ftd.conflictKeeper.GetConflictVote(ctx, msg.VoteID)
+			if !found {
+				return nil, nil, false
+			}
+			chainIDs = append(chainIDs, conflictVote.ChainID)
+		case *conflicttypes.MsgConflictVoteReveal:
+			conflictVote, found := ftd.conflictKeeper.GetConflictVote(ctx, msg.VoteID)
+			if !found {
+				return nil, nil, false
+			}
+			chainIDs = append(chainIDs, conflictVote.ChainID)
+		default:
+			return nil, nil, false
+		}
+	}
+	return sender, chainIDs, len(chainIDs) > 0
+}
+
+// isStakedProvider returns true if the address is a staked provider on all of the chains
+func (ftd FreeTxDecorator) isStakedProvider(ctx sdk.Context, address sdk.AccAddress, chainIDs []string) bool {
+	for _, chainID := range chainIDs {
+		if _, found := ftd.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, address); !found {
+			return false
+		}
+	}
+	return true
+}
+
+// dryRun executes the messages on a cached state that is thrown away
+func (ftd FreeTxDecorator) dryRun(ctx sdk.Context, msgs []sdk.Msg) error {
+	cacheCtx, _ := ctx.CacheContext()
+	for _, msg := range msgs {
+		handler := ftd.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler for %s", sdk.MsgTypeURL(msg))
+		}
+		if _, err := handler(cacheCtx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chargeFees runs the fee decorators before the rest of the chain
+func (ftd FreeTxDecorator) chargeFees(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler, idx int) (sdk.Context, error) {
+	if idx == len(ftd.feeDecorators) {
+		return next(ctx, tx, simulate)
+	}
+	return ftd.feeDecorators[idx].AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ftd.chargeFees(ctx, tx, simulate, next, idx+1)
+	})
+}
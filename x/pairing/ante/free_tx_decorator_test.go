@@ -0,0 +1,193 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/ante"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx testTx) ValidateBasic() error       { return nil }
+func (tx testTx) GetGas() uint64             { return 0 }
+func (tx testTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx testTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+func (tx testTx) FeeGranter() sdk.AccAddress { return nil }
+
+// testRouter routes the relay payments to the pairing msg server
+type testRouter struct {
+	servers *testkeeper.Servers
+}
+
+func (r testRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	if _, ok := msg.(*pairingtypes.MsgRelayPayment); !ok {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		_, err := r.servers.PairingServer.RelayPayment(sdk.WrapSDKContext(ctx), msg.(*pairingtypes.MsgRelayPayment))
+		return &sdk.Result{}, err
+	}
+}
+
+// chargeFeeDecorator records that the fees were charged
+type chargeFeeDecorator struct {
+	charged *bool
+}
+
+func (cfd chargeFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*cfd.charged = true
+	return next(ctx, tx, simulate)
+}
+
+type testStruct struct {
+	ctx      context.Context
+	keepers  *testkeeper.Keepers
+	servers  *testkeeper.Servers
+	provider common.Account
+	client   common.Account
+	charged  bool
+	handler  sdk.AnteHandler
+}
+
+func setupForFreeTxTest(t *testing.T) *testStruct {
+	ts := &testStruct{}
+	ts.servers, ts.keepers, ts.ctx = testkeeper.InitAllKeepers(t)
+	spec := common.CreateMockSpec()
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), spec)
+
+	ts.provider = common.CreateNewAccount(ts.ctx, *ts.keepers, 10000)
+	common.StakeAccount(t, ts.ctx, *ts.keepers, *ts.servers, ts.provider, spec, 1000, true)
+	ts.client = common.CreateNewAccount(ts.ctx, *ts.keepers, 10000)
+	common.StakeAccount(t, ts.ctx, *ts.keepers, *ts.servers, ts.client, spec, 1000, false)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	decorator := ante.NewFreeTxDecorator(ts.keepers.Pairing, ts.keepers.Epochstorage, ts.keepers.Conflict, testRouter{servers: ts.servers}, chargeFeeDecorator{charged: &ts.charged})
+	ts.handler = sdk.ChainAnteDecorators(decorator)
+	return ts
+}
+
+// relayPayment returns a valid relay payment of the provider for a new session of the client
+func (ts *testStruct) relayPayment(t *testing.T, sessionID uint64) *pairingtypes.MsgRelayPayment {
+	relayRequest := &pairingtypes.RelayRequest{
+		Provider:     ts.provider.Addr.String(),
+		Data:         []byte("mockSpecAPI"),
+		SessionId:    sessionID,
+		ChainID:      "mockSpec",
+		CuSum:        100,
+		BlockHeight:  sdk.UnwrapSDKContext(ts.ctx).BlockHeight(),
+		RelayNum:     1,
+		RequestBlock: -1,
+	}
+	var err error
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.client.SK, nil), *relayRequest)
+	require.Nil(t, err)
+	return &pairingtypes.MsgRelayPayment{Creator: ts.provider.Addr.String(), Relays: []*pairingtypes.RelayRequest{relayRequest}}
+}
+
+// anteHandle runs the decorator on the tx and returns true if the fees were charged
+func (ts *testStruct) anteHandle(ctx sdk.Context, tx testTx) (charged bool, err error) {
+	ts.charged = false
+	_, err = ts.handler(ctx, tx, false)
+	return ts.charged, err
+}
+
+func TestFreeTxDecorator(t *testing.T) {
+	ts := setupForFreeTxTest(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	// a staked provider doesn't pay for a relay payment
+	charged, err := ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{ts.relayPayment(t, 1)}})
+	require.Nil(t, err)
+	require.False(t, charged)
+	quota, found := ts.keepers.Pairing.GetFreeTxQuota(ctx, ts.provider.Addr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(1), quota.Count)
+
+	// transactions with fees are charged as usual and don't use the quota
+	charged, err = ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{ts.relayPayment(t, 1)}, fee: sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1)))})
+	require.Nil(t, err)
+	require.True(t, charged)
+
+	// other messages pay fees, also when sent along with a relay payment
+	unstakeMsg := &pairingtypes.MsgUnstakeProvider{Creator: ts.provider.Addr.String(), ChainID: "mockSpec"}
+	charged, err = ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{ts.relayPayment(t, 1), unstakeMsg}})
+	require.Nil(t, err)
+	require.True(t, charged)
+
+	// only staked providers get fee-free relay payments
+	notStaked := common.CreateNewAccount(ts.ctx, *ts.keepers, 10000)
+	msg := ts.relayPayment(t, 1)
+	msg.Creator = notStaked.Addr.String()
+	charged, err = ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{msg}})
+	require.Nil(t, err)
+	require.True(t, charged)
+
+	quota, _ = ts.keepers.Pairing.GetFreeTxQuota(ctx, ts.provider.Addr.String())
+	require.Equal(t, uint64(1), quota.Count)
+}
+
+func TestFreeTxDecoratorQuota(t *testing.T) {
+	ts := setupForFreeTxTest(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	freeTxs := ts.keepers.Pairing.FreeTxsPerEpoch(ctx)
+
+	for i := uint64(0); i < freeTxs; i++ {
+		charged, err := ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{ts.relayPayment(t, i+1)}})
+		require.Nil(t, err)
+		require.False(t, charged)
+	}
+
+	// the quota is used up
+	charged, err := ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{ts.relayPayment(t, freeTxs+1)}})
+	require.Nil(t, err)
+	require.True(t, charged)
+
+	// and starts over in the next epoch, the count of the previous epoch is removed
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	_, found := ts.keepers.Pairing.GetFreeTxQuota(sdk.UnwrapSDKContext(ts.ctx), ts.provider.Addr.String())
+	require.False(t, found)
+	charged, err = ts.anteHandle(sdk.UnwrapSDKContext(ts.ctx), testTx{msgs: []sdk.Msg{ts.relayPayment(t, freeTxs+1)}})
+	require.Nil(t, err)
+	require.False(t, charged)
+}
+
+func TestFreeTxDecoratorCheckTx(t *testing.T) {
+	ts := setupForFreeTxTest(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx).WithIsCheckTx(true)
+
+	// a valid relay payment passes and isn't executed on the state
+	msg := ts.relayPayment(t, 1)
+	charged, err := ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{msg}})
+	require.Nil(t, err)
+	require.False(t, charged)
+	quota, found := ts.keepers.Pairing.GetFreeTxQuota(ctx, ts.provider.Addr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(1), quota.Count)
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, msg)
+	require.Nil(t, err)
+
+	// a relay payment that would fail is rejected without using the quota
+	charged, err = ts.anteHandle(ctx, testTx{msgs: []sdk.Msg{msg}})
+	require.NotNil(t, err)
+	require.False(t, charged)
+	quota, _ = ts.keepers.Pairing.GetFreeTxQuota(ctx, ts.provider.Addr.String())
+	require.Equal(t, uint64(1), quota.Count)
+
+	// DeliverTx has no dry run, the messages run and fail after the ante handler
+	charged, err = ts.anteHandle(ctx.WithIsCheckTx(false), testTx{msgs: []sdk.Msg{msg}})
+	require.Nil(t, err)
+	require.False(t, charged)
+}
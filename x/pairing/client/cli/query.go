@@ -43,6 +43,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdStaticProvidersList())
 
+	cmd.AddCommand(CmdListPlans())
+	cmd.AddCommand(CmdShowSubscription())
+	cmd.AddCommand(CmdProviderQoS())
+	cmd.AddCommand(CmdProviderJail())
+	cmd.AddCommand(CmdProviderEarnings())
+	cmd.AddCommand(CmdConsumerUsage())
+
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdConsumerUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-usage [consumer] [chain-id]",
+		Short: "Query the CU a consumer used and has left with each of its paired providers in the current epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqConsumer := args[0]
+			reqChainID := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryConsumerUsageRequest{
+				Consumer: reqConsumer,
+				ChainID:  reqChainID,
+			}
+
+			res, err := queryClient.ConsumerUsage(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
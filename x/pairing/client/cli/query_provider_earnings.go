@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdProviderEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-earnings [provider] [chain-id] [epochs]",
+		Short: "Query the CU served and the rewards of a provider on a chain per epoch, going back [epochs] epochs (all the epochs in memory when omitted)",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqProvider := args[0]
+			reqChainID := args[1]
+			var reqEpochs uint64
+			if len(args) == 3 {
+				reqEpochs, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProviderEarningsRequest{
+				Provider: reqProvider,
+				ChainID:  reqChainID,
+				Epochs:   reqEpochs,
+			}
+
+			res, err := queryClient.ProviderEarnings(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
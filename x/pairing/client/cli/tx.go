@@ -33,6 +33,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnstakeProvider())
 	cmd.AddCommand(CmdUnstakeClient())
 	cmd.AddCommand(CmdRelayPayment())
+	cmd.AddCommand(CmdBuySubscription())
+	cmd.AddCommand(CmdModifyProvider())
+	cmd.AddCommand(CmdModifyClient())
+	cmd.AddCommand(CmdCancelUnstake())
+	cmd.AddCommand(CmdUpdateClientVrfpk())
+	cmd.AddCommand(CmdSetOperatorProviders())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdBuySubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-subscription [plan-index] [geolocation]",
+		Short: "Broadcast message buySubscription",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIndex := args[0]
+			argGeolocation, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, vrfpk, err := utils.GetOrCreateVRFKey(clientCtx)
+			if err != nil {
+				return err
+			}
+			vrfpkStr, err := vrfpk.EncodeBech32()
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgBuySubscription(
+				clientCtx.GetFromAddress().String(),
+				argIndex,
+				argGeolocation,
+				vrfpkStr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const FlagClient = "client"
+
+func CmdCancelUnstake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unstake [chain-id]",
+		Short: "Broadcast message cancelUnstake",
+		Long: `returns a pending unstake of the provider (or the client, with --client) to the stake entries at its original stake,
+the entry is paired again from the next epoch`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			isClient, err := cmd.Flags().GetBool(FlagClient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelUnstake(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				!isClient,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagClient, false, "cancel the unstake of a client entry instead of a provider entry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdModifyProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-provider [chain-id] [amount] [endpoint endpoint ...] [geolocation] [optional: moniker/name]",
+		Short: "Broadcast message modifyProvider",
+		Long: `modifies an existing provider stake entry, the changes take effect at the next epoch.
+a lower amount than the current stake withdraws the difference after the unstake hold period`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+			argAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argEndpoints, err := parseEndpoints(args[2])
+			if err != nil {
+				return err
+			}
+			argGeolocation, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			operator, _ := cmd.Flags().GetString(FlagOperator)
+
+			msg := types.NewMsgModifyProvider(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				argAmount,
+				argEndpoints,
+				argGeolocation,
+				moniker,
+				operator,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMoniker, "", "The provider's name")
+	cmd.Flags().String(FlagOperator, "", "The address of the operator running the provider, it must approve the provider first with set-operator-providers")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lavanet/lava/x/pairing/client/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewSubmitPlansAddProposalTxCmd returns a CLI command handler for creating
+// a plans add proposal governance transaction.
+func NewSubmitPlansAddProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "plans-add [proposal-file,proposal-file,...]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a subscription plans add proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a subscription plans add proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. A plan with an existing
+index replaces the existing plan, consumers who already bought it keep the
+plan as it was at the time of purchase.
+
+Example:
+$ %s tx gov submit-proposal plans-add <path/to/proposal.json> --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParsePlansAddProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := &proposal.Proposal
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetOperatorProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-operator-providers [providers]",
+		Short: "Broadcast message setOperatorProviders",
+		Long: `replaces the list of provider addresses the account approves as their operator, comma separated, no list clears it.
+a provider is grouped with the operator in pairing once it also declares the account with --operator on stake or modify,
+providers removed from the list have the operator cleared from their stake entries`,
+		Example: `lavad tx pairing set-operator-providers lava@1xxx,lava@1yyy --from operator`,
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			providers := []string{}
+			if len(args) > 0 {
+				providers = strings.Split(args[0], listSeparator)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOperatorProviders(
+				clientCtx.GetFromAddress().String(),
+				providers,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,7 +17,10 @@ import (
 
 var _ = strconv.Itoa(0)
 
-const FlagMoniker = "moniker"
+const (
+	FlagMoniker  = "moniker"
+	FlagOperator = "operator"
+)
 
 func CmdStakeProvider() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,19 +33,9 @@ func CmdStakeProvider() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			tmpArg := strings.Fields(args[2])
-			argEndpoints := []epochstoragetypes.Endpoint{}
-			for _, endpointStr := range tmpArg {
-				splitted := strings.Split(endpointStr, ",")
-				if len(splitted) != 3 {
-					return fmt.Errorf("invalid argument format in endpoints, must be: IP:PORT,useType,geolocation IP:PORT,useType,geolocation")
-				}
-				geoloc, err := strconv.ParseUint(splitted[2], 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid argument format in endpoints, geolocation must be a number")
-				}
-				endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc}
-				argEndpoints = append(argEndpoints, endpoint)
+			argEndpoints, err := parseEndpoints(args[2])
+			if err != nil {
+				return err
 			}
 			argGeolocation, err := cast.ToUint64E(args[3])
 			if err != nil {
@@ -55,6 +48,7 @@ func CmdStakeProvider() *cobra.Command {
 			}
 
 			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			operator, _ := cmd.Flags().GetString(FlagOperator)
 
 			msg := types.NewMsgStakeProvider(
 				clientCtx.GetFromAddress().String(),
@@ -63,6 +57,7 @@ func CmdStakeProvider() *cobra.Command {
 				argEndpoints,
 				argGeolocation,
 				moniker,
+				operator,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -71,7 +66,30 @@ func CmdStakeProvider() *cobra.Command {
 		},
 	}
 	cmd.Flags().String(FlagMoniker, "", "The provider's name")
+	cmd.Flags().String(FlagOperator, "", "The address of the operator running the provider, it must approve the provider first with set-operator-providers")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseEndpoints parses space separated endpoints of the form IP:PORT,useType,geolocation[,tlsCertHash]
+func parseEndpoints(arg string) ([]epochstoragetypes.Endpoint, error) {
+	endpoints := []epochstoragetypes.Endpoint{}
+	for _, endpointStr := range strings.Fields(arg) {
+		splitted := strings.Split(endpointStr, ",")
+		if len(splitted) != 3 && len(splitted) != 4 {
+			return nil, fmt.Errorf("invalid argument format in endpoints, must be: IP:PORT,useType,geolocation[,tlsCertHash] IP:PORT,useType,geolocation[,tlsCertHash]")
+		}
+		geoloc, err := strconv.ParseUint(splitted[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument format in endpoints, geolocation must be a number")
+		}
+		endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc}
+		if len(splitted) == 4 {
+			// hash of the tls certificate the endpoint serves relays with, consumers pin the endpoint to it
+			endpoint.TlsCertHash = strings.ToLower(splitted[3])
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const FlagRotate = "rotate"
+
+func CmdUpdateClientVrfpk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-client-vrfpk",
+		Short: "Broadcast message updateClientVrfpk",
+		Long: `sets the vrf public key of all the client stake entries and the subscription of the account to the current local vrf key,
+relays are verified with the new key from the next epoch, restart the consumers once it begins.
+with --rotate a new local vrf key is generated first, the replaced key is kept as the previous key (see lavad vrf)`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rotate, err := cmd.Flags().GetBool(FlagRotate)
+			if err != nil {
+				return err
+			}
+			var vrfpk *utils.VrfPubKey
+			if rotate {
+				_, vrfpk, err = utils.RotateVRFKey(clientCtx)
+				if err == nil {
+					fmt.Printf("Rotated VRF Key: %s\n", vrfpk)
+				}
+			} else {
+				_, vrfpk, err = utils.LoadVRFKey(clientCtx)
+			}
+			if err != nil {
+				return err
+			}
+			vrfpkStr, err := vrfpk.EncodeBech32()
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdateClientVrfpk(
+				clientCtx.GetFromAddress().String(),
+				vrfpkStr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRotate, false, "generate a new local vrf key before broadcasting it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
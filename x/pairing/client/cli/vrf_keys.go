@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+const FlagPrevious = "previous"
+
+// CmdVRFKeys returns the commands managing the local vrf key of an account, the key consumers sign data reliability with
+func CmdVRFKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vrf",
+		Short: "Manage the local vrf key of a consumer account",
+		Long: `the vrf key is stored in the vrf keyring of --keyring-dir under the --from account name.
+a rotation keeps the replaced key as the previous key, so it can still be exported until the next rotation.
+the chain verifies relays with the key registered by stake-client, buy-subscription or update-client-vrfpk`,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(cmdVRFShow())
+	cmd.AddCommand(cmdVRFGenerate())
+	cmd.AddCommand(cmdVRFRotate())
+	cmd.AddCommand(cmdVRFExport())
+	cmd.AddCommand(cmdVRFImport())
+
+	return cmd
+}
+
+func cmdVRFShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the vrf public key of the account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, vrfpk, err := loadVRFKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			fmt.Println(vrfpk)
+			return nil
+		},
+	}
+	cmd.Flags().Bool(FlagPrevious, false, "show the key replaced by the last rotation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVRFGenerate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a vrf key for an account that has none",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, vrfpk, err := utils.LoadVRFKey(clientCtx); err == nil {
+				return fmt.Errorf("account %s already has a vrf key %s, use rotate to replace it", clientCtx.FromName, vrfpk)
+			}
+			_, vrfpk, err := utils.GenerateVRFKey(clientCtx)
+			if err != nil {
+				return err
+			}
+			fmt.Println(vrfpk)
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVRFRotate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replace the vrf key of the account with a new one",
+		Long: `generates a new vrf key and keeps the replaced key as the previous key.
+the new key isn't used by the chain until it is registered with tx pairing update-client-vrfpk`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, vrfpk, err := utils.RotateVRFKey(clientCtx)
+			if err != nil {
+				return err
+			}
+			fmt.Println(vrfpk)
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVRFExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Back up the vrf key of the account to a file",
+		Long:  `writes the vrf key pair to a new file readable only by the current user, the file holds the secret key unencrypted`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			vrfsk, vrfpk, err := loadVRFKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			backup, err := utils.NewVRFKeyBackup(vrfsk, vrfpk)
+			if err != nil {
+				return err
+			}
+			data, err := json.MarshalIndent(backup, "", "  ")
+			if err != nil {
+				return err
+			}
+			file, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			_, err = file.Write(data)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			return err
+		},
+	}
+	cmd.Flags().Bool(FlagPrevious, false, "export the key replaced by the last rotation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVRFImport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Restore the vrf key of the account from a file written by export",
+		Long:  `sets the backed up key as the vrf key of the account, an existing key is kept as the previous key`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			backup := &utils.VRFKeyBackup{}
+			err = json.Unmarshal(data, backup)
+			if err != nil {
+				return err
+			}
+			vrfsk, err := backup.PrivateKey()
+			if err != nil {
+				return err
+			}
+			vrfpk, err := utils.ReplaceVRFKey(clientCtx, vrfsk)
+			if err != nil {
+				return err
+			}
+			fmt.Println(vrfpk)
+			return nil
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func loadVRFKey(cmd *cobra.Command, clientCtx client.Context) (vrfsk []byte, vrfpk *utils.VrfPubKey, err error) {
+	previous, err := cmd.Flags().GetBool(FlagPrevious)
+	if err != nil {
+		return nil, nil, err
+	}
+	if previous {
+		vrfsk, vrfpk, err = utils.LoadPreviousVRFKey(clientCtx)
+	} else {
+		vrfsk, vrfpk, err = utils.LoadVRFKey(clientCtx)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("no vrf key for %s: %w", clientCtx.FromName, err)
+	}
+	return vrfsk, vrfpk, nil
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/lavanet/lava/x/pairing/client/cli"
+	"github.com/lavanet/lava/x/pairing/client/rest"
+)
+
+// PlansAddProposalHandler is the subscription plans add proposal handler.
+var PlansAddProposalHandler = govclient.NewProposalHandler(cli.NewSubmitPlansAddProposalTxCmd, rest.ProposalRESTHandler)
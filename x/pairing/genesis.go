@@ -21,6 +21,42 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.EpochPaymentsList {
 		k.SetEpochPayments(ctx, elem)
 	}
+	// Set all the plans
+	for _, elem := range genState.PlansList {
+		k.SetPlan(ctx, elem)
+	}
+	// Set all the subscriptions
+	for _, elem := range genState.SubscriptionList {
+		k.SetSubscription(ctx, elem)
+	}
+	// Set all the provider reputations
+	for _, elem := range genState.ProviderQoSList {
+		k.SetProviderQoS(ctx, elem)
+	}
+	// Set all the epoch QoS factors
+	for _, elem := range genState.EpochQoSFactorsList {
+		k.SetEpochQoSFactors(ctx, elem)
+	}
+	// Set all the provider jails
+	for _, elem := range genState.ProviderJailList {
+		k.SetProviderJail(ctx, elem)
+	}
+	// Set all the fee-free transaction counts
+	for _, elem := range genState.FreeTxQuotaList {
+		k.SetFreeTxQuota(ctx, elem)
+	}
+	// Set all the expired subscriptions
+	for _, elem := range genState.ExpiredSubscriptionList {
+		k.SetExpiredSubscription(ctx, elem)
+	}
+	// Set all the operator approved providers
+	for _, elem := range genState.OperatorProvidersList {
+		k.SetOperatorProviders(ctx, elem)
+	}
+	// Set all the epoch QoS reports
+	for _, elem := range genState.EpochProviderQoSList {
+		k.SetEpochProviderQoS(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -33,6 +69,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.UniquePaymentStorageClientProviderList = k.GetAllUniquePaymentStorageClientProvider(ctx)
 	genesis.ProviderPaymentStorageList = k.GetAllProviderPaymentStorage(ctx)
 	genesis.EpochPaymentsList = k.GetAllEpochPayments(ctx)
+	genesis.PlansList = k.GetAllPlan(ctx)
+	genesis.SubscriptionList = k.GetAllSubscription(ctx)
+	genesis.ProviderQoSList = k.GetAllProviderQoS(ctx)
+	genesis.EpochQoSFactorsList = k.GetAllEpochQoSFactors(ctx)
+	genesis.ProviderJailList = k.GetAllProviderJail(ctx)
+	genesis.FreeTxQuotaList = k.GetAllFreeTxQuota(ctx)
+	genesis.ExpiredSubscriptionList = k.GetAllExpiredSubscription(ctx)
+	genesis.OperatorProvidersList = k.GetAllOperatorProviders(ctx)
+	genesis.EpochProviderQoSList = k.GetAllEpochProviderQoS(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
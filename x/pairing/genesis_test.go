@@ -38,6 +38,96 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		PlansList: []types.Plan{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		SubscriptionList: []types.Subscription{
+			{
+				Consumer: "0",
+			},
+			{
+				Consumer: "1",
+			},
+		},
+		ProviderQoSList: []types.ProviderQoS{
+			{
+				Provider: "0",
+				ChainID:  "0",
+			},
+			{
+				Provider: "0",
+				ChainID:  "1",
+			},
+		},
+		EpochQoSFactorsList: []types.EpochQoSFactors{
+			{
+				Epoch:   0,
+				ChainID: "0",
+			},
+			{
+				Epoch:   1,
+				ChainID: "0",
+			},
+		},
+		ProviderJailList: []types.ProviderJail{
+			{
+				Provider: "0",
+				ChainID:  "0",
+			},
+			{
+				Provider: "1",
+				ChainID:  "0",
+			},
+		},
+		FreeTxQuotaList: []types.FreeTxQuota{
+			{
+				Address: "0",
+				Epoch:   0,
+				Count:   1,
+			},
+			{
+				Address: "1",
+				Epoch:   0,
+				Count:   2,
+			},
+		},
+		ExpiredSubscriptionList: []types.Subscription{
+			{
+				Consumer:    "0",
+				ExpiryBlock: 10,
+			},
+			{
+				Consumer:    "0",
+				ExpiryBlock: 20,
+			},
+		},
+		OperatorProvidersList: []types.OperatorProviders{
+			{
+				Operator:  "0",
+				Providers: []string{"1", "2"},
+			},
+			{
+				Operator:  "1",
+				Providers: []string{"3"},
+			},
+		},
+		EpochProviderQoSList: []types.EpochProviderQoS{
+			{
+				Epoch:    0,
+				ChainID:  "0",
+				Provider: "0",
+			},
+			{
+				Epoch:    1,
+				ChainID:  "0",
+				Provider: "0",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -52,5 +142,14 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.UniquePaymentStorageClientProviderList, got.UniquePaymentStorageClientProviderList)
 	require.ElementsMatch(t, genesisState.ProviderPaymentStorageList, got.ProviderPaymentStorageList)
 	require.ElementsMatch(t, genesisState.EpochPaymentsList, got.EpochPaymentsList)
+	require.ElementsMatch(t, genesisState.PlansList, got.PlansList)
+	require.ElementsMatch(t, genesisState.SubscriptionList, got.SubscriptionList)
+	require.ElementsMatch(t, genesisState.ProviderQoSList, got.ProviderQoSList)
+	require.ElementsMatch(t, genesisState.EpochQoSFactorsList, got.EpochQoSFactorsList)
+	require.ElementsMatch(t, genesisState.ProviderJailList, got.ProviderJailList)
+	require.ElementsMatch(t, genesisState.FreeTxQuotaList, got.FreeTxQuotaList)
+	require.ElementsMatch(t, genesisState.ExpiredSubscriptionList, got.ExpiredSubscriptionList)
+	require.ElementsMatch(t, genesisState.OperatorProvidersList, got.OperatorProvidersList)
+	require.ElementsMatch(t, genesisState.EpochProviderQoSList, got.EpochProviderQoSList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -32,6 +32,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgRelayPayment:
 			res, err := msgServer.RelayPayment(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBuySubscription:
+			res, err := msgServer.BuySubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgModifyProvider:
+			res, err := msgServer.ModifyProvider(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgModifyClient:
+			res, err := msgServer.ModifyClient(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelUnstake:
+			res, err := msgServer.CancelUnstake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateClientVrfpk:
+			res, err := msgServer.UpdateClientVrfpk(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetOperatorProviders:
+			res, err := msgServer.SetOperatorProviders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetEpochProviderQoS set a specific epochProviderQoS in the store from its index
+func (k Keeper) SetEpochProviderQoS(ctx sdk.Context, epochProviderQoS types.EpochProviderQoS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochProviderQoSKeyPrefix))
+	b := k.cdc.MustMarshal(&epochProviderQoS)
+	store.Set(types.EpochProviderQoSKey(
+		epochProviderQoS.Epoch,
+		epochProviderQoS.ChainID,
+		epochProviderQoS.Provider,
+	), b)
+}
+
+// GetEpochProviderQoS returns an epochProviderQoS from its index
+func (k Keeper) GetEpochProviderQoS(
+	ctx sdk.Context,
+	epoch uint64,
+	chainID string,
+	provider string,
+) (val types.EpochProviderQoS, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochProviderQoSKeyPrefix))
+
+	b := store.Get(types.EpochProviderQoSKey(
+		epoch,
+		chainID,
+		provider,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAllEpochProviderQoSForBlock removes the epochProviderQoS of all the chains and providers of an epoch from the store
+func (k Keeper) RemoveAllEpochProviderQoSForBlock(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochProviderQoSKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochProviderQoSEpochKey(epoch))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllEpochProviderQoS returns all epochProviderQoS
+func (k Keeper) GetAllEpochProviderQoS(ctx sdk.Context) (list []types.EpochProviderQoS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochProviderQoSKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EpochProviderQoS
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getChainEpochProviderQoS returns the epochProviderQoS of all the providers of a chain in an epoch
+func (k Keeper) getChainEpochProviderQoS(ctx sdk.Context, epoch uint64, chainID string) (list []types.EpochProviderQoS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochProviderQoSKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochProviderQoSChainKey(epoch, chainID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EpochProviderQoS
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddEpochProviderQoS adds a QoS report covering cu to the reports of the provider for the relays of the epoch
+func (k Keeper) AddEpochProviderQoS(ctx sdk.Context, epoch uint64, provider string, chainID string, report types.QualityOfServiceReport, cu uint64) {
+	epochProviderQoS, found := k.GetEpochProviderQoS(ctx, epoch, chainID, provider)
+	if !found {
+		epochProviderQoS = types.EpochProviderQoS{
+			Epoch:    epoch,
+			ChainID:  chainID,
+			Provider: provider,
+			Score:    types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()},
+		}
+	}
+	if cu == 0 {
+		return
+	}
+
+	epochProviderQoS.Score = weightedQoSAverage(epochProviderQoS.Score, epochProviderQoS.Cu, report, cu)
+	epochProviderQoS.Cu += cu
+	k.SetEpochProviderQoS(ctx, epochProviderQoS)
+}
+
+// weightedQoSAverage returns the average of two QoS scores weighted by the CU they cover
+func weightedQoSAverage(score types.QualityOfServiceReport, scoreCU uint64, report types.QualityOfServiceReport, reportCU uint64) types.QualityOfServiceReport {
+	scoreWeight := sdk.NewDecFromInt(sdk.NewIntFromUint64(scoreCU))
+	reportWeight := sdk.NewDecFromInt(sdk.NewIntFromUint64(reportCU))
+	totalWeight := scoreWeight.Add(reportWeight)
+	if totalWeight.IsZero() {
+		return score
+	}
+	weightedAverage := func(current sdk.Dec, reported sdk.Dec) sdk.Dec {
+		return current.Mul(scoreWeight).Add(reported.Mul(reportWeight)).Quo(totalWeight)
+	}
+	return types.QualityOfServiceReport{
+		Latency:      weightedAverage(score.Latency, report.Latency),
+		Availability: weightedAverage(score.Availability, report.Availability),
+		Sync:         weightedAverage(score.Sync, report.Sync),
+	}
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetEpochQoSFactors set a specific epochQoSFactors in the store from its index
+func (k Keeper) SetEpochQoSFactors(ctx sdk.Context, epochQoSFactors types.EpochQoSFactors) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochQoSFactorsKeyPrefix))
+	b := k.cdc.MustMarshal(&epochQoSFactors)
+	store.Set(types.EpochQoSFactorsKey(
+		epochQoSFactors.Epoch,
+		epochQoSFactors.ChainID,
+	), b)
+}
+
+// GetEpochQoSFactors returns an epochQoSFactors from its index
+func (k Keeper) GetEpochQoSFactors(
+	ctx sdk.Context,
+	epoch uint64,
+	chainID string,
+) (val types.EpochQoSFactors, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochQoSFactorsKeyPrefix))
+
+	b := store.Get(types.EpochQoSFactorsKey(
+		epoch,
+		chainID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAllEpochQoSFactorsForBlock removes the epochQoSFactors of all the chains of an epoch from the store
+func (k Keeper) RemoveAllEpochQoSFactorsForBlock(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochQoSFactorsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochQoSFactorsEpochKey(epoch))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllEpochQoSFactors returns all epochQoSFactors
+func (k Keeper) GetAllEpochQoSFactors(ctx sdk.Context) (list []types.EpochQoSFactors) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochQoSFactorsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EpochQoSFactors
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveOldEpochQoSFactors removes the QoS factors and reports of the epochs that were deleted from the stake storage
+func (k Keeper) RemoveOldEpochQoSFactors(ctx sdk.Context) {
+	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
+		k.RemoveAllEpochQoSFactorsForBlock(ctx, epoch)
+		k.RemoveAllEpochProviderQoSForBlock(ctx, epoch)
+	}
+}
+
+// StoreEpochQoSFactors snapshots the QoS factors of the providers of every chain with QoS pairing, next to the epoch stake storage.
+// pairing reads the factors from the snapshot so it stays the same for the whole epoch and can be validated at any saved epoch
+func (k Keeper) StoreEpochQoSFactors(ctx sdk.Context) {
+	block := uint64(ctx.BlockHeight())
+	qosPairingEpochs := k.getQoSPairingEpochs(ctx)
+	for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+		spec, found := k.specKeeper.GetSpec(ctx, chainID, block)
+		if !found || !spec.QosPairing {
+			continue
+		}
+		providers, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, block, epochstoragetypes.ProviderKey, chainID)
+		if !found {
+			continue
+		}
+		scores := k.getProvidersQoSScores(ctx, qosPairingEpochs, chainID)
+		epochQoSFactors := types.EpochQoSFactors{Epoch: block, ChainID: chainID, Factors: []types.ProviderQoSFactor{}}
+		for _, provider := range providers {
+			score, found := scores[provider.Address]
+			if !found {
+				continue
+			}
+			factor, err := k.getProviderQoSFactor(ctx, score)
+			if err != nil {
+				continue
+			}
+			epochQoSFactors.Factors = append(epochQoSFactors.Factors, types.ProviderQoSFactor{Provider: provider.Address, Factor: factor})
+		}
+		k.SetEpochQoSFactors(ctx, epochQoSFactors)
+	}
+}
+
+// getQoSPairingEpochs returns the last QoSPairingEpochs epochs before the current one that are still saved,
+// their QoS reports make the pairing factors of the current epoch
+func (k Keeper) getQoSPairingEpochs(ctx sdk.Context) []uint64 {
+	epochs := []uint64{}
+	earliestEpochStart := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+	epoch := uint64(ctx.BlockHeight())
+	for i := uint64(0); i < k.QoSPairingEpochs(ctx) && epoch > 0; i++ {
+		previousEpoch, err := k.epochStorageKeeper.GetPreviousEpochStartForBlock(ctx, epoch)
+		if err != nil || previousEpoch >= epoch || previousEpoch < earliestEpochStart {
+			break
+		}
+		epochs = append(epochs, previousEpoch)
+		epoch = previousEpoch
+	}
+	return epochs
+}
+
+// getProvidersQoSScores aggregates the QoS reports of the providers of a chain over the epochs by provider address, weighted by the CU they cover.
+// a provider without reports in the epochs has no score
+func (k Keeper) getProvidersQoSScores(ctx sdk.Context, epochs []uint64, chainID string) map[string]types.QualityOfServiceReport {
+	scores := map[string]types.QualityOfServiceReport{}
+	scoresCU := map[string]uint64{}
+	for _, epoch := range epochs {
+		for _, epochProviderQoS := range k.getChainEpochProviderQoS(ctx, epoch, chainID) {
+			if epochProviderQoS.Cu == 0 {
+				continue
+			}
+			score, found := scores[epochProviderQoS.Provider]
+			if !found {
+				score = types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+			}
+			scores[epochProviderQoS.Provider] = weightedQoSAverage(score, scoresCU[epochProviderQoS.Provider], epochProviderQoS.Score, epochProviderQoS.Cu)
+			scoresCU[epochProviderQoS.Provider] += epochProviderQoS.Cu
+		}
+	}
+	return scores
+}
+
+// getProviderQoSFactor maps the provider QoS score to a factor between the QoSPairingMinFactor param and 1
+func (k Keeper) getProviderQoSFactor(ctx sdk.Context, score types.QualityOfServiceReport) (factor sdk.Dec, err error) {
+	qos, err := score.ComputeQoS()
+	if err != nil {
+		return sdk.OneDec(), err
+	}
+	minFactor := k.QoSPairingMinFactor(ctx)
+	factor = minFactor.Add(sdk.OneDec().Sub(minFactor).Mul(qos))
+	if factor.GT(sdk.OneDec()) {
+		factor = sdk.OneDec()
+	}
+	return factor, nil
+}
+
+// getEpochQoSFactors returns the QoS factors snapshotted at the epoch by provider address
+func (k Keeper) getEpochQoSFactors(ctx sdk.Context, epoch uint64, chainID string) map[string]sdk.Dec {
+	factors := map[string]sdk.Dec{}
+	epochQoSFactors, found := k.GetEpochQoSFactors(ctx, epoch, chainID)
+	if !found {
+		return factors
+	}
+	for _, providerFactor := range epochQoSFactors.Factors {
+		factors[providerFactor.Provider] = providerFactor.Factor
+	}
+	return factors
+}
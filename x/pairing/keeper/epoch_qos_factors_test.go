@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochQoSFactorsSnapshot(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	ts.spec = common.CreateMockSpec()
+	ts.spec.QosPairing = true
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	err = ts.addProvider(2)
+	require.Nil(t, err)
+
+	badProvider := ts.providers[0].address.String()
+	goodProvider := ts.providers[1].address.String()
+	badReport := types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), badProvider, ts.spec.Index, badReport, 10)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+
+	// only the provider with a reputation has a factor, the lowest one for a zero score
+	epochQoSFactors, found := ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), epoch, ts.spec.Index)
+	require.True(t, found)
+	require.Len(t, epochQoSFactors.Factors, 1)
+	require.Equal(t, badProvider, epochQoSFactors.Factors[0].Provider)
+	require.Equal(t, types.DefaultQoSPairingMinFactor, epochQoSFactors.Factors[0].Factor)
+
+	// new reports change the next snapshot, not the one of the current epoch
+	goodReport := types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), epoch, goodProvider, ts.spec.Index, goodReport, 10)
+	epochQoSFactors, found = ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), epoch, ts.spec.Index)
+	require.True(t, found)
+	require.Len(t, epochQoSFactors.Factors, 1)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	nextEpochQoSFactors, found := ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), ts.spec.Index)
+	require.True(t, found)
+	require.Len(t, nextEpochQoSFactors.Factors, 2)
+
+	// reports older than the last epochs don't count
+	qosPairingEpochs := ts.keepers.Pairing.QoSPairingEpochs(sdk.UnwrapSDKContext(ts.ctx))
+	for i := uint64(0); i < qosPairingEpochs; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	staleEpochQoSFactors, found := ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), ts.spec.Index)
+	require.True(t, found)
+	require.Empty(t, staleEpochQoSFactors.Factors)
+
+	// snapshots are removed with the epoch stake storage
+	_, found = ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), epoch, ts.spec.Index)
+	require.Equal(t, epoch >= ts.keepers.Epochstorage.GetEarliestEpochStart(sdk.UnwrapSDKContext(ts.ctx)), found)
+}
+
+func TestQoSPairingWeights(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.QosPairingMinFactor = sdk.ZeroDec()
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+
+	ts.spec = common.CreateMockSpec()
+	ts.spec.QosPairing = true
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addClient(10)
+	require.Nil(t, err)
+	err = ts.addProvider(3)
+	require.Nil(t, err)
+
+	// a provider with a zero score has no pairing weight
+	badProvider := ts.providers[0].address.String()
+	badReport := types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), badProvider, ts.spec.Index, badReport, 10)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	for _, client := range ts.clients {
+		providers, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, client.address)
+		require.Nil(t, err)
+		require.NotEmpty(t, providers)
+		for _, provider := range providers {
+			require.NotEqual(t, badProvider, provider.Address)
+		}
+		valid, _, _, err := ts.keepers.Pairing.ValidatePairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, client.address, ts.providers[0].address, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+		require.Nil(t, err)
+		require.False(t, valid)
+	}
+
+	// the reports recovering mid epoch don't change the pairing of the epoch
+	goodReport := types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), badProvider, ts.spec.Index, goodReport, 1000000)
+	for _, client := range ts.clients {
+		providers, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, client.address)
+		require.Nil(t, err)
+		for _, provider := range providers {
+			require.NotEqual(t, badProvider, provider.Address)
+		}
+	}
+}
+
+func TestQoSPairingWindow(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.QosPairingEpochs = 2
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+
+	ts.spec = common.CreateMockSpec()
+	ts.spec.QosPairing = true
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	err = ts.addProvider(1)
+	require.Nil(t, err)
+	provider := ts.providers[0].address.String()
+
+	getFactor := func() sdk.Dec {
+		epochQoSFactors, found := ts.keepers.Pairing.GetEpochQoSFactors(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), ts.spec.Index)
+		require.True(t, found)
+		require.Len(t, epochQoSFactors.Factors, 1)
+		require.Equal(t, provider, epochQoSFactors.Factors[0].Provider)
+		return epochQoSFactors.Factors[0].Factor
+	}
+
+	// a bad report followed by a good one an epoch later
+	badEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	badReport := types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), badEpoch, provider, ts.spec.Index, badReport, 1000)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, types.DefaultQoSPairingMinFactor, getFactor())
+
+	goodReport := types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()}
+	ts.keepers.Pairing.AddEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), provider, ts.spec.Index, goodReport, 10)
+
+	// both reports are in the window, the bad one weighs more
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	factor := getFactor()
+	require.True(t, factor.GT(types.DefaultQoSPairingMinFactor))
+	require.True(t, factor.LT(sdk.OneDec()))
+
+	// the bad report left the window, it no longer changes the weight although it is still stored
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, sdk.OneDec(), getFactor())
+	_, found := ts.keepers.Pairing.GetEpochProviderQoS(sdk.UnwrapSDKContext(ts.ctx), badEpoch, ts.spec.Index, provider)
+	require.True(t, found)
+}
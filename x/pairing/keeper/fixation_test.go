@@ -116,7 +116,7 @@ func TestEpochPaymentDeletionWithMemoryShortening(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -134,7 +134,7 @@ func TestEpochPaymentDeletionWithMemoryShortening(t *testing.T) {
 	//make another request
 	relayRequest.SessionId++
 
-	sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
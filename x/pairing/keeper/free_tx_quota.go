@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetFreeTxQuota set a specific freeTxQuota in the store from its index
+func (k Keeper) SetFreeTxQuota(ctx sdk.Context, freeTxQuota types.FreeTxQuota) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FreeTxQuotaKeyPrefix))
+	b := k.cdc.MustMarshal(&freeTxQuota)
+	store.Set(types.FreeTxQuotaKey(
+		freeTxQuota.Address,
+	), b)
+}
+
+// GetFreeTxQuota returns a freeTxQuota from its index
+func (k Keeper) GetFreeTxQuota(
+	ctx sdk.Context,
+	address string,
+) (val types.FreeTxQuota, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FreeTxQuotaKeyPrefix))
+
+	b := store.Get(types.FreeTxQuotaKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFreeTxQuota removes a freeTxQuota from the store
+func (k Keeper) RemoveFreeTxQuota(
+	ctx sdk.Context,
+	address string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FreeTxQuotaKeyPrefix))
+	store.Delete(types.FreeTxQuotaKey(
+		address,
+	))
+}
+
+// GetAllFreeTxQuota returns all freeTxQuota
+func (k Keeper) GetAllFreeTxQuota(ctx sdk.Context) (list []types.FreeTxQuota) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FreeTxQuotaKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FreeTxQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveOldFreeTxQuotas removes the counts of the previous epochs, called at the epoch start
+func (k Keeper) RemoveOldFreeTxQuotas(ctx sdk.Context) {
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	for _, freeTxQuota := range k.GetAllFreeTxQuota(ctx) {
+		if freeTxQuota.Epoch < epoch {
+			k.RemoveFreeTxQuota(ctx, freeTxQuota.Address)
+		}
+	}
+}
+
+// UseFreeTx counts a fee-free transaction of the address in the current epoch,
+// it returns false without counting if the address used up its FreeTxsPerEpoch quota
+func (k Keeper) UseFreeTx(ctx sdk.Context, address string) bool {
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	freeTxQuota, found := k.GetFreeTxQuota(ctx, address)
+	if !found || freeTxQuota.Epoch != epoch {
+		// the count starts over every epoch
+		freeTxQuota = types.FreeTxQuota{Address: address, Epoch: epoch}
+	}
+	if freeTxQuota.Count >= k.FreeTxsPerEpoch(ctx) {
+		return false
+	}
+	freeTxQuota.Count++
+	k.SetFreeTxQuota(ctx, freeTxQuota)
+	return true
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ConsumerUsage(goCtx context.Context, req *types.QueryConsumerUsageRequest) (*types.QueryConsumerUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerAddr, err := sdk.AccAddressFromBech32(req.Consumer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consumer address")
+	}
+
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	consumerStakeEntry, err := k.VerifyPairingData(ctx, req.ChainID, consumerAddr, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consumer for pairing: %s", err)
+	}
+	providers, err := k.GetPairingForClient(ctx, req.ChainID, consumerAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pairing for chainID: %s, consumer addr: %s, err: %s", req.ChainID, consumerAddr, err)
+	}
+
+	// the same limit relay payments of the epoch are enforced against
+	maxCU, err := k.ClientMaxCUProviderForBlock(ctx, epoch, consumerStakeEntry)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := types.QueryConsumerUsageResponse{Epoch: epoch, MaxCUPerProvider: maxCU, Providers: []types.ProviderCUUsage{}}
+	for _, provider := range providers {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		usage := types.ProviderCUUsage{Provider: provider.Address, RemainingCU: maxCU}
+		providerPaymentStorage, found := k.GetProviderPaymentStorage(ctx, k.GetProviderPaymentStorageKey(ctx, req.ChainID, epoch, providerAddr))
+		if found {
+			usage.UsedCU, err = k.GetTotalUsedCUForConsumerPerEpoch(ctx, req.Consumer, providerPaymentStorage.UniquePaymentStorageClientProviderKeys, provider.Address)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		if usage.UsedCU >= maxCU {
+			usage.RemainingCU = 0
+		} else {
+			usage.RemainingCU = maxCU - usage.UsedCU
+		}
+		res.Providers = append(res.Providers, usage)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumerUsage(t *testing.T) {
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 4, 2)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	consumer := ts.clients[0].address
+
+	consumerEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, ts.spec.Name, consumer)
+	require.True(t, found)
+	maxCU, err := ts.keepers.Pairing.ClientMaxCUProviderForBlock(ctx, ts.keepers.Epochstorage.GetEpochStart(ctx), &consumerEntry)
+	require.Nil(t, err)
+
+	// nothing used yet
+	res, err := ts.keepers.Pairing.ConsumerUsage(ts.ctx, &types.QueryConsumerUsageRequest{Consumer: consumer.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	require.Equal(t, ts.keepers.Epochstorage.GetEpochStart(ctx), res.Epoch)
+	require.Equal(t, maxCU, res.MaxCUPerProvider)
+	require.Len(t, res.Providers, len(ts.providers))
+	for _, usage := range res.Providers {
+		require.Zero(t, usage.UsedCU)
+		require.Equal(t, maxCU, usage.RemainingCU)
+	}
+
+	// the relay payment of providers[0] is counted only for it
+	ts.complainOnProvider(t, ts.providers[1].address)
+	relayCU := ts.spec.Apis[0].ComputeUnits * 10
+	res, err = ts.keepers.Pairing.ConsumerUsage(ts.ctx, &types.QueryConsumerUsageRequest{Consumer: consumer.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	for _, usage := range res.Providers {
+		if usage.Provider == ts.providers[0].address.String() {
+			require.Equal(t, relayCU, usage.UsedCU)
+			require.Equal(t, maxCU-relayCU, usage.RemainingCU)
+		} else {
+			require.Zero(t, usage.UsedCU)
+			require.Equal(t, maxCU, usage.RemainingCU)
+		}
+	}
+
+	// not a staked consumer
+	_, err = ts.keepers.Pairing.ConsumerUsage(ts.ctx, &types.QueryConsumerUsageRequest{Consumer: ts.providers[0].address.String(), ChainID: ts.spec.Name})
+	require.NotNil(t, err)
+}
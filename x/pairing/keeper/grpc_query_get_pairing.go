@@ -51,7 +51,7 @@ func (k Keeper) GetPairing(goCtx context.Context, req *types.QueryGetPairingRequ
 	currentEpoch := k.epochStorageKeeper.GetEpochStart(ctx)
 
 	// Get the block in which there was the latest change for the current spec
-	spec, found := k.specKeeper.GetSpec(ctx, req.GetChainID())
+	spec, found := k.specKeeper.GetLatestSpec(ctx, req.GetChainID())
 	if !found {
 		return nil, errors.New("spec not found or not enabled")
 	}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderEarnings(goCtx context.Context, req *types.QueryProviderEarningsRequest) (*types.QueryProviderEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	res := types.QueryProviderEarningsResponse{Epochs: []types.ProviderEpochEarnings{}, TotalRewards: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())}
+	earliestEpoch := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+	epoch := k.epochStorageKeeper.GetEpochStart(ctx)
+	for {
+		earnings, err := k.getProviderEpochEarnings(ctx, req.ChainID, epoch, providerAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Epochs = append(res.Epochs, earnings)
+		res.TotalCuServed += earnings.CuServed
+		res.TotalRewards = res.TotalRewards.Add(earnings.Rewards)
+
+		// payments of epochs older than the chain's memory are deleted
+		if uint64(len(res.Epochs)) == req.Epochs || epoch <= earliestEpoch {
+			break
+		}
+		epoch, err = k.epochStorageKeeper.GetPreviousEpochStartForBlock(ctx, epoch)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &res, nil
+}
+
+// getProviderEpochEarnings sums up the payments of a provider on a chain in an epoch
+func (k Keeper) getProviderEpochEarnings(ctx sdk.Context, chainID string, epoch uint64, providerAddr sdk.AccAddress) (types.ProviderEpochEarnings, error) {
+	earnings := types.ProviderEpochEarnings{
+		Epoch:              epoch,
+		Rewards:            sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
+		ReliabilityRewards: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
+	}
+	providerPaymentStorage, found := k.GetProviderPaymentStorage(ctx, k.GetProviderPaymentStorageKey(ctx, chainID, epoch, providerAddr))
+	if !found {
+		return earnings, nil
+	}
+
+	for _, uniquePaymentKey := range providerPaymentStorage.UniquePaymentStorageClientProviderKeys {
+		uniquePayment, found := k.GetUniquePaymentStorageClientProvider(ctx, uniquePaymentKey)
+		if !found {
+			return earnings, fmt.Errorf("could not find uniquePaymentStorageClientProvider object %s of %s", uniquePaymentKey, providerPaymentStorage.Index)
+		}
+		earnings.CuServed += uniquePayment.UsedCU
+	}
+	earnings.Payments = uint64(len(providerPaymentStorage.UniquePaymentStorageClientProviderKeys))
+	earnings.Complaints = uint64(len(providerPaymentStorage.UnresponsivenessComplaints))
+	earnings.Rewards = addRewardCoin(earnings.Rewards, providerPaymentStorage.Rewards)
+	earnings.ReliabilityRewards = addRewardCoin(earnings.ReliabilityRewards, providerPaymentStorage.ReliabilityRewards)
+	return earnings, nil
+}
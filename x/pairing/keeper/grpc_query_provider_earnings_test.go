@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderEarnings(t *testing.T) {
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 4, 2)
+	for i := 0; i < 2; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	provider := ts.providers[0].address.String()
+	relayCU := ts.spec.Apis[0].ComputeUnits * 10
+	relayReward := ts.keepers.Pairing.MintCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(relayCU)).TruncateInt()
+
+	// every client pays providers[0] and complains on providers[1]
+	firstEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	ts.complainOnProvider(t, ts.providers[1].address)
+
+	res, err := ts.keepers.Pairing.ProviderEarnings(ts.ctx, &types.QueryProviderEarningsRequest{Provider: provider, ChainID: ts.spec.Name, Epochs: 1})
+	require.Nil(t, err)
+	require.Len(t, res.Epochs, 1)
+	require.Equal(t, firstEpoch, res.Epochs[0].Epoch)
+	require.Equal(t, uint64(len(ts.clients))*relayCU, res.Epochs[0].CuServed)
+	require.Equal(t, uint64(len(ts.clients)), res.Epochs[0].Payments)
+	require.Equal(t, relayReward.MulRaw(int64(len(ts.clients))), res.Epochs[0].Rewards.Amount)
+	require.True(t, res.Epochs[0].ReliabilityRewards.IsZero())
+	require.Zero(t, res.Epochs[0].Complaints)
+
+	// the complaints are counted for the unresponsive provider, which earned nothing
+	res, err = ts.keepers.Pairing.ProviderEarnings(ts.ctx, &types.QueryProviderEarningsRequest{Provider: ts.providers[1].address.String(), ChainID: ts.spec.Name, Epochs: 1})
+	require.Nil(t, err)
+	require.NotZero(t, res.Epochs[0].Complaints)
+	require.Zero(t, res.Epochs[0].CuServed)
+	require.True(t, res.TotalRewards.IsZero())
+
+	// a second epoch of payments adds up in the totals
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ts.complainOnProvider(t, ts.providers[1].address)
+	res, err = ts.keepers.Pairing.ProviderEarnings(ts.ctx, &types.QueryProviderEarningsRequest{Provider: provider, ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	require.Equal(t, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)), res.Epochs[0].Epoch)
+	require.Equal(t, 2*uint64(len(ts.clients))*relayCU, res.TotalCuServed)
+	require.Equal(t, relayReward.MulRaw(2*int64(len(ts.clients))), res.TotalRewards.Amount)
+	foundFirstEpoch := false
+	for _, earnings := range res.Epochs {
+		if earnings.Epoch == firstEpoch {
+			foundFirstEpoch = true
+			require.Equal(t, uint64(len(ts.clients))*relayCU, earnings.CuServed)
+		}
+	}
+	require.True(t, foundFirstEpoch)
+
+	_, err = ts.keepers.Pairing.ProviderEarnings(ts.ctx, &types.QueryProviderEarningsRequest{Provider: "invalid", ChainID: ts.spec.Name})
+	require.NotNil(t, err)
+}
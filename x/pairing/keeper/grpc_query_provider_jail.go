@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderJail(goCtx context.Context, req *types.QueryProviderJailRequest) (*types.QueryProviderJailResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetProviderJail(ctx, req.Provider, req.ChainID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryProviderJailResponse{ProviderJail: val, Jailed: val.JailedUntil > uint64(ctx.BlockHeight())}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderQoS(goCtx context.Context, req *types.QueryProviderQoSRequest) (*types.QueryProviderQoSResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetProviderQoSDecayed(ctx, req.Provider, req.ChainID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	score, err := val.Score.ComputeQoS()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderQoSResponse{ProviderQoS: val, Score: score}, nil
+}
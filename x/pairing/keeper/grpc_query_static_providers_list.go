@@ -19,7 +19,7 @@ func (k Keeper) StaticProvidersList(goCtx context.Context, req *types.QueryStati
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	spec, found := k.specKeeper.GetSpec(ctx, req.GetChainID())
+	spec, found := k.specKeeper.GetLatestSpec(ctx, req.GetChainID())
 	if !found || !spec.Enabled {
 		return nil, fmt.Errorf("spec %s is not found or exist", req.GetChainID())
 	}
@@ -29,7 +29,12 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 
 	existingEntry, err := k.epochStorageKeeper.GetStakeEntryForClientEpoch(ctx, req.ChainID, userAddr, epochStart)
 	if err != nil {
-		return nil, err
+		subscription, found := k.GetSubscriptionForBlock(ctx, userAddr, req.ChainID, epochStart)
+		if !found {
+			return nil, err
+		}
+		subscriptionEntry := SubscriptionStakeEntry(subscription, req.ChainID, epochStart)
+		existingEntry = &subscriptionEntry
 	}
 
 	maxCU, err := k.ClientMaxCUProviderForBlock(ctx, req.Block, existingEntry)
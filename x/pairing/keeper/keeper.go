@@ -47,6 +47,7 @@ func NewKeeper(
 	}
 	epochStorageKeeper.AddFixationRegistry(string(types.KeyServicersToPairCount), func(ctx sdk.Context) any { return keeper.ServicersToPairCountRaw(ctx) })
 	epochStorageKeeper.AddFixationRegistry(string(types.KeyStakeToMaxCUList), func(ctx sdk.Context) any { return keeper.StakeToMaxCUListRaw(ctx) })
+	epochStorageKeeper.AddFixationRegistry(string(types.KeyMaxProvidersPerOperator), func(ctx sdk.Context) any { return keeper.MaxProvidersPerOperatorRaw(ctx) })
 
 	return keeper
 }
@@ -55,6 +56,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// FixateSpecs saves the specs that are active on this epoch, so relays can be validated against the spec they were served with
+func (k Keeper) FixateSpecs(ctx sdk.Context) {
+	k.specKeeper.FixateSpecs(ctx, uint64(ctx.BlockHeight()), k.epochStorageKeeper.GetEarliestEpochStart(ctx))
+}
+
 // we dont want to do the calculation here too, epochStorage keeper did it
 func (k Keeper) IsEpochStart(ctx sdk.Context) (res bool) {
 	return k.epochStorageKeeper.GetEpochStart(ctx) == uint64(ctx.BlockHeight())
@@ -8,6 +8,7 @@ import (
 
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
 func (k Keeper) GetAllowedCUForBlock(ctx sdk.Context, blockHeight uint64, entry *epochstoragetypes.StakeEntry) (uint64, error) {
@@ -27,6 +28,8 @@ func (k Keeper) GetAllowedCUForBlock(ctx sdk.Context, blockHeight uint64, entry
 	return allowedCU, nil
 }
 
+// EnforceClientCUsUsageInEpoch limits the cu a consumer uses with a provider in an epoch to ClientMaxCUProviderForBlock, for staked clients and subscriptions alike.
+// the monthly quota of a subscription is also charged in full when the relay is paid
 func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, cuSum uint64, entry *epochstoragetypes.StakeEntry, totalCUInEpochForUserProvider uint64, epochStart uint64) error {
 	allowedCUConsumer, err := k.ClientMaxCUProviderForBlock(ctx, epochStart, entry)
 	if err != nil {
@@ -40,10 +43,17 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, cuSum uint64, entr
 	return nil
 }
 
+// ClientMaxCUProviderForBlock returns the cu a consumer can use with each of its providers in the epoch, the cu allowed by its stake or the monthly quota its subscription had left at the epoch start, split between the providers it is paired with
 func (k Keeper) ClientMaxCUProviderForBlock(ctx sdk.Context, blockHeight uint64, clientEntry *epochstoragetypes.StakeEntry) (uint64, error) {
-	allowedCU, err := k.GetAllowedCUForBlock(ctx, blockHeight, clientEntry)
-	if err != nil {
-		return 0, fmt.Errorf("user %s, MaxCU was not found for stake of: %d", clientEntry, clientEntry.Stake.Amount.Int64())
+	var allowedCU uint64
+	if subscription, found := k.getSubscriptionForEntry(ctx, clientEntry, blockHeight); found {
+		allowedCU = subscription.EpochCuLeft
+	} else {
+		var err error
+		allowedCU, err = k.GetAllowedCUForBlock(ctx, blockHeight, clientEntry)
+		if err != nil {
+			return 0, fmt.Errorf("user %s, MaxCU was not found for stake of: %d", clientEntry, clientEntry.Stake.Amount.Int64())
+		}
 	}
 
 	servicersToPairCount, err := k.ServicersToPairCount(ctx, blockHeight)
@@ -54,3 +64,15 @@ func (k Keeper) ClientMaxCUProviderForBlock(ctx sdk.Context, blockHeight uint64,
 	allowedCU /= servicersToPairCount
 	return allowedCU, nil
 }
+
+func (k Keeper) getSubscriptionForEntry(ctx sdk.Context, entry *epochstoragetypes.StakeEntry, blockHeight uint64) (types.Subscription, bool) {
+	consumerAddr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return types.Subscription{}, false
+	}
+	epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, blockHeight)
+	if err != nil {
+		return types.Subscription{}, false
+	}
+	return k.GetSubscriptionForBlock(ctx, consumerAddr, entry.Chain, epochStart)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) BuySubscription(goCtx context.Context, msg *types.MsgBuySubscription) (*types.MsgBuySubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	details := map[string]string{"consumer": msg.Creator, "plan": msg.Index}
+	consumerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_addr", details, "invalid consumer address")
+	}
+
+	plan, found := k.GetPlan(ctx, msg.Index)
+	if !found || !plan.Enabled {
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_plan", details, "plan not found or not enabled")
+	}
+
+	// expired subscriptions are kept apart, so they don't block buying a new one
+	existing, found := k.GetSubscription(ctx, msg.Creator)
+	if found {
+		details["existingPlan"] = existing.Plan.Index
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_exists", details, "consumer already has an active subscription")
+	}
+
+	geolocations := k.specKeeper.GeolocationCount(ctx)
+	if msg.Geolocation == 0 || msg.Geolocation > (1<<geolocations) {
+		details["geolocation"] = strconv.FormatUint(msg.Geolocation, 10)
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_geolocation", details, "can't subscribe for no geolocation or geolocation outside zones")
+	}
+	// consumers need to provide their VRF PK to limit brute forcing the random functions
+	err = utils.VerifyVRF(msg.Vrfpk)
+	if err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_vrfpk", details, "invalid vrf pk, must provide a valid verification key")
+	}
+
+	// the plan price is paid in full and burned
+	if k.bankKeeper.GetBalance(ctx, consumerAddr, plan.Price.Denom).IsLT(plan.Price) {
+		details["price"] = plan.Price.String()
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_funds", details, "insufficient funds to buy plan")
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, consumerAddr, types.ModuleName, sdk.NewCoins(plan.Price))
+	if err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_funds", details, "failed paying for plan")
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(plan.Price))
+	if err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "buy_subscription_burn", details, "failed burning plan price")
+	}
+
+	block := uint64(ctx.BlockHeight())
+	subscription := types.Subscription{
+		Consumer:        msg.Creator,
+		Plan:            plan,
+		Geolocation:     msg.Geolocation,
+		Vrfpk:           msg.Vrfpk,
+		StartBlock:      block,
+		MonthsLeft:      plan.DurationMonths - 1,
+		MonthExpiryTime: nextMonthTime(uint64(ctx.BlockTime().UTC().Unix())),
+		MonthCuLeft:     plan.MonthlyComputeUnits,
+		EpochCuLeft:     plan.MonthlyComputeUnits,
+	}
+	k.SetSubscription(ctx, subscription)
+
+	details["price"] = plan.Price.String()
+	details["startBlock"] = strconv.FormatUint(block, 10)
+	details["monthCU"] = strconv.FormatUint(plan.MonthlyComputeUnits, 10)
+	event := &types.EventBuySubscription{Consumer: msg.Creator, Plan: plan.Index, Price: plan.Price, StartBlock: block, MonthCU: plan.MonthlyComputeUnits}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.BuySubscriptionEventName, details, "Consumer Bought Subscription")
+	return &types.MsgBuySubscriptionResponse{}, nil
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) CancelUnstake(goCtx context.Context, msg *types.MsgCancelUnstake) (*types.MsgCancelUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// returns the unstaking entry to the stake entries
+	err := k.Keeper.CancelUnstake(ctx, msg.Provider, msg.ChainID, msg.Creator)
+
+	return &types.MsgCancelUnstakeResponse{}, err
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a provider that unstaked by mistake gets its entry back at the original stake from the next epoch
+func TestCancelUnstakeProvider(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	provider := ts.providers[0].address
+	balanceBefore := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64()
+
+	// nothing to cancel
+	_, err := ts.servers.PairingServer.CancelUnstake(ts.ctx, &types.MsgCancelUnstake{Creator: provider.String(), ChainID: ts.spec.Name, Provider: true})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.UnstakeProvider(ts.ctx, &types.MsgUnstakeProvider{Creator: provider.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	_, err = ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.NotNil(t, err)
+
+	// the client entry of the same address is not canceled
+	_, err = ts.servers.PairingServer.CancelUnstake(ts.ctx, &types.MsgCancelUnstake{Creator: provider.String(), ChainID: ts.spec.Name, Provider: false})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.CancelUnstake(ts.ctx, &types.MsgCancelUnstake{Creator: provider.String(), ChainID: ts.spec.Name, Provider: true})
+	require.Nil(t, err)
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.True(t, found)
+	require.Equal(t, stake, stakeEntry.Stake.Amount.Int64())
+	_, found, _ = ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, provider)
+	require.False(t, found)
+
+	// the entry is paired from the next epoch
+	_, err = ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.NotNil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochEntry, err := ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.Equal(t, stake, epochEntry.Stake.Amount.Int64())
+
+	// the stake is not returned after the hold period
+	unstakeHoldBlocks := ts.keepers.Epochstorage.UnstakeHoldBlocks(sdk.UnwrapSDKContext(ts.ctx), uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	ts.ctx = testkeeper.AdvanceBlocks(ts.ctx, ts.keepers, int(unstakeHoldBlocks))
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, balanceBefore, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64())
+}
+
+// Test that canceling a partial stake withdrawal adds the withdrawn stake back to the entry
+func TestCancelUnstakeWithdrawnStake(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	client := ts.clients[0]
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Name, client.address)
+	require.True(t, found)
+
+	_, err := ts.servers.PairingServer.ModifyClient(ts.ctx, &types.MsgModifyClient{Creator: client.address.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake/2)), Geolocation: 1, Vrfpk: stakeEntry.Vrfpk})
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.ModifyClient(ts.ctx, &types.MsgModifyClient{Creator: client.address.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake/4)), Geolocation: 1, Vrfpk: stakeEntry.Vrfpk})
+	require.Nil(t, err)
+
+	_, err = ts.servers.PairingServer.CancelUnstake(ts.ctx, &types.MsgCancelUnstake{Creator: client.address.String(), ChainID: ts.spec.Name, Provider: false})
+	require.Nil(t, err)
+	stakeEntry, found = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Name, client.address)
+	require.True(t, found)
+	require.Equal(t, stake, stakeEntry.Stake.Amount.Int64())
+	_, found, _ = ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, client.address)
+	require.False(t, found)
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) ModifyClient(goCtx context.Context, msg *types.MsgModifyClient) (*types.MsgModifyClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// modifies an existing client entry
+	err := k.Keeper.ModifyStakeEntry(ctx, false, msg.Creator, msg.ChainID, msg.Amount, nil, msg.Geolocation, msg.Vrfpk, "", "")
+
+	return &types.MsgModifyClientResponse{}, err
+}
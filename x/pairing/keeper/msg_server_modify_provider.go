@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) ModifyProvider(goCtx context.Context, msg *types.MsgModifyProvider) (*types.MsgModifyProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// modifies an existing provider entry
+	err := k.Keeper.ModifyStakeEntry(ctx, true, msg.Creator, msg.ChainID, msg.Amount, msg.Endpoints, msg.Geolocation, "", msg.Moniker, msg.Operator)
+
+	return &types.MsgModifyProviderResponse{}, err
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a provider can change its endpoints and withdraw part of its stake without unstaking
+func TestModifyProvider(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	provider := ts.providers[0].address
+
+	balanceBefore := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64()
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "456", UseType: ts.spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	_, err := ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake/2)), Geolocation: 1, Endpoints: endpoints, Moniker: "modified"})
+	require.Nil(t, err)
+
+	// the current entry is modified, the epoch entry is unchanged until the next epoch
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.True(t, found)
+	require.Equal(t, stake/2, stakeEntry.Stake.Amount.Int64())
+	require.Equal(t, "456", stakeEntry.Endpoints[0].IPPORT)
+	require.Equal(t, "modified", stakeEntry.Moniker)
+	epochEntry, err := ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.Equal(t, stake, epochEntry.Stake.Amount.Int64())
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochEntry, err = ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.Equal(t, stake/2, epochEntry.Stake.Amount.Int64())
+	require.Equal(t, "456", epochEntry.Endpoints[0].IPPORT)
+
+	// the withdrawn stake is held like an unstaked entry
+	unstakeEntry, found, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, provider)
+	require.True(t, found)
+	require.Equal(t, stake/2, unstakeEntry.Stake.Amount.Int64())
+	require.Equal(t, balanceBefore, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64())
+
+	for uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()) < unstakeEntry.Deadline {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	require.Equal(t, balanceBefore+stake/2, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64())
+	_, found = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.True(t, found)
+
+	// increasing the stake is paid immediately
+	_, err = ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Endpoints: endpoints})
+	require.Nil(t, err)
+	require.Equal(t, balanceBefore, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64())
+}
+
+func TestModifyProviderInvalid(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "456", UseType: ts.spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+
+	tests := []struct {
+		name      string
+		creator   string
+		amount    sdk.Coin
+		endpoints []epochstoragetypes.Endpoint
+	}{
+		{"BelowMinStake", ts.providers[0].address.String(), sdk.NewCoin(epochstoragetypes.TokenDenom, ts.spec.MinStakeProvider.Amount.SubRaw(1)), endpoints},
+		{"NotStaked", ts.clients[0].address.String(), sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), endpoints},
+		{"MissingEndpoints", ts.providers[0].address.String(), sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), []epochstoragetypes.Endpoint{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: tt.creator, ChainID: ts.spec.Name, Amount: tt.amount, Geolocation: 1, Endpoints: tt.endpoints})
+			require.NotNil(t, err)
+		})
+	}
+
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[0].address)
+	require.True(t, found)
+	require.Equal(t, stake, stakeEntry.Stake.Amount.Int64())
+	require.Equal(t, "123", stakeEntry.Endpoints[0].IPPORT)
+}
+
+// Test that a client can rotate its vrf public key
+func TestModifyClientVrfpk(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	client := ts.clients[0].address
+
+	_, vrfPk, err := utils.GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	vrfPkStr := &utils.VrfPubKey{}
+	vrfPkStr.Unmarshal(vrfPk)
+
+	_, err = ts.servers.PairingServer.ModifyClient(ts.ctx, &types.MsgModifyClient{Creator: client.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Vrfpk: ""})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.ModifyClient(ts.ctx, &types.MsgModifyClient{Creator: client.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Vrfpk: vrfPkStr.String()})
+	require.Nil(t, err)
+
+	epochEntry, err := ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.NotEqual(t, vrfPkStr.String(), epochEntry.Vrfpk)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochEntry, err = ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.Equal(t, vrfPkStr.String(), epochEntry.Vrfpk)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	"golang.org/x/exp/slices"
 )
@@ -32,12 +33,23 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 	errorLogAndFormat := func(name string, attrs map[string]string, details string) (*types.MsgRelayPaymentResponse, error) {
 		return nil, utils.LavaError(ctx, logger, name, attrs, details)
 	}
-	for _, relay := range msg.Relays {
+	// compact proofs are paid like relays without a payload
+	relays := make([]*types.RelayRequest, 0, len(msg.Relays)+len(msg.Proofs))
+	relays = append(relays, msg.Relays...)
+	for _, proof := range msg.Proofs {
+		relays = append(relays, proof.RelayRequest())
+	}
+	for idx, relay := range relays {
 		if relay.BlockHeight > ctx.BlockHeight() {
 			return errorLogAndFormat("relay_future_block", map[string]string{"blockheight": string(relay.Sig)}, "relay request for a block in the future")
 		}
 
-		pubKey, err := sigs.RecoverPubKeyFromRelay(*relay)
+		var pubKey secp256k1.PubKey
+		if idx < len(msg.Relays) {
+			pubKey, err = sigs.RecoverPubKeyFromRelay(*relay)
+		} else {
+			pubKey, err = sigs.RecoverPubKeyFromRelayProof(*msg.Proofs[idx-len(msg.Relays)])
+		}
 		if err != nil {
 			return errorLogAndFormat("relay_payment_sig", map[string]string{"sig": string(relay.Sig)}, "recover PubKey from relay failed")
 		}
@@ -53,8 +65,8 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return errorLogAndFormat("relay_payment_addr", map[string]string{"provider": relay.Provider, "creator": msg.Creator}, "invalid provider address in relay msg, creator and signed provider mismatch")
 		}
 
-		// TODO: add support for spec changes
-		spec, found := k.specKeeper.GetSpec(ctx, relay.ChainID)
+		// the relay is validated against the spec that was active when it was served
+		spec, found := k.specKeeper.GetSpec(ctx, relay.ChainID, uint64(relay.BlockHeight))
 		if !found || !spec.Enabled {
 			return errorLogAndFormat("relay_payment_spec", map[string]string{"chainID": relay.ChainID}, "invalid spec ID specified in proof")
 		}
@@ -187,13 +199,22 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return errorLogAndFormat("relay_payment_user_limit", details, "user bypassed CU limit")
 		}
 
-		// pairing is valid, we can pay provider for work
+		// pairing is valid, we can pay provider for work.
+		// a zero reward only skips the minting, the consumer is still charged and the QoS and complaints recorded
 		reward := k.Keeper.MintCoinsPerCU(ctx).MulInt64(int64(relay.CuSum))
-		if reward.IsZero() {
-			continue
-		}
 
 		rewardCoins := sdk.Coins{sdk.Coin{Denom: epochstoragetypes.TokenDenom, Amount: reward.TruncateInt()}}
+		relayPaymentEvent := types.EventRelayPayment{
+			ChainID:          relay.ChainID,
+			Client:           clientAddr.String(),
+			Provider:         providerAddr.String(),
+			CU:               relay.CuSum,
+			BasePay:          sdk.NewCoin(epochstoragetypes.TokenDenom, reward.TruncateInt()),
+			UniqueIdentifier: relay.SessionId,
+			RelayNumber:      relay.RelayNum,
+			QoSScore:         sdk.ZeroDec(),
+			TotalCUInEpoch:   totalCUInEpochForUserProvider,
+		}
 
 		if len(msg.DescriptionString) > 20 {
 			msg.DescriptionString = msg.DescriptionString[:20]
@@ -208,33 +229,52 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			}
 			details["QoSReport"] = "Latency: " + relay.QoSReport.Latency.String() + ", Availability: " + relay.QoSReport.Availability.String() + ", Sync: " + relay.QoSReport.Sync.String()
 			details["QoSScore"] = QoS.String()
+			relayPaymentEvent.QoSScore = QoS
+
+			// keep the report in the provider reputation and in the reports of the epoch that make the pairing factors, weighted by the CU it covers
+			k.UpdateProviderQoS(ctx, providerAddr.String(), relay.ChainID, *relay.QoSReport, relay.CuSum)
+			k.AddEpochProviderQoS(ctx, epochStart, providerAddr.String(), relay.ChainID, *relay.QoSReport, relay.CuSum)
 
 			reward = reward.Mul(QoS.Mul(k.QoSWeight(ctx)).Add(sdk.OneDec().Sub(k.QoSWeight(ctx)))) // reward*QOSScore*QOSWeight + reward*(1-QOSWeight) = reward*(QOSScore*QOSWeight + (1-QOSWeight))
 			rewardCoins = sdk.Coins{sdk.Coin{Denom: epochstoragetypes.TokenDenom, Amount: reward.TruncateInt()}}
 		}
 
-		// first check we can burn user before we give money to the provider
-		amountToBurnClient := k.Keeper.BurnCoinsPerCU(ctx).MulInt64(int64(relay.CuSum))
+		burnAmount := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+		if subscription, found := k.GetSubscriptionForBlock(ctx, clientAddr, relay.ChainID, epochStart); found {
+			// subscription consumers already paid for the plan, we charge their monthly quota instead of burning stake
+			details["subscription"] = subscription.Plan.Index
+			relayPaymentEvent.Subscription = subscription.Plan.Index
+			err = k.ChargeSubscriptionCU(ctx, subscription, relay.CuSum)
+			if err != nil {
+				details["error"] = err.Error()
+				return errorLogAndFormat("relay_payment_subscription", details, "failed charging subscription CU")
+			}
+		} else {
+			// first check we can burn user before we give money to the provider
+			amountToBurnClient := k.Keeper.BurnCoinsPerCU(ctx).MulInt64(int64(relay.CuSum))
 
-		burnAmount := sdk.Coin{Amount: amountToBurnClient.TruncateInt(), Denom: epochstoragetypes.TokenDenom}
-		burnSucceeded, err2 := k.BurnClientStake(ctx, relay.ChainID, clientAddr, burnAmount, false)
+			burnAmount = sdk.Coin{Amount: amountToBurnClient.TruncateInt(), Denom: epochstoragetypes.TokenDenom}
+			burnSucceeded, err2 := k.BurnClientStake(ctx, relay.ChainID, clientAddr, burnAmount, false)
 
-		if err2 != nil {
-			details["amountToBurn"] = burnAmount.String()
-			details["error"] = err2.Error()
-			return errorLogAndFormat("relay_payment_burn", details, "BurnUserStake failed on user")
-		}
-		if !burnSucceeded {
-			details["amountToBurn"] = burnAmount.String()
-			details["error"] = "insufficient funds or didn't find user"
-			return errorLogAndFormat("relay_payment_burn", details, "BurnUserStake failed on user, did not find user, or insufficient funds")
+			if err2 != nil {
+				details["amountToBurn"] = burnAmount.String()
+				details["error"] = err2.Error()
+				return errorLogAndFormat("relay_payment_burn", details, "BurnUserStake failed on user")
+			}
+			if !burnSucceeded {
+				details["amountToBurn"] = burnAmount.String()
+				details["error"] = "insufficient funds or didn't find user"
+				return errorLogAndFormat("relay_payment_burn", details, "BurnUserStake failed on user, did not find user, or insufficient funds")
+			}
 		}
 
+		reliabilityReward := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
 		if payReliability {
 			details["reliabilityPay"] = "true"
 			rewardAddition := reward.Mul(k.Keeper.DataReliabilityReward(ctx))
 			reward = reward.Add(rewardAddition)
 			rewardCoins = sdk.Coins{sdk.Coin{Denom: epochstoragetypes.TokenDenom, Amount: reward.TruncateInt()}}
+			reliabilityReward.Amount = rewardAddition.TruncateInt()
 			details["Mint"] = rewardCoins.String()
 		} else {
 			details["reliabilityPay"] = "false"
@@ -257,16 +297,21 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				utils.LavaError(ctx, logger, types.RelayPaymentEventName, details, "SendCoinsFromModuleToAccount Failed,")
 				panic(fmt.Sprintf("failed to transfer minted new coins to provider, %s account: %s", err, providerAddr))
 			}
+			k.Keeper.AddProviderRewardInEpoch(ctx, relay.ChainID, epochStart, providerAddr, sdk.NewCoin(epochstoragetypes.TokenDenom, rewardCoins.AmountOf(epochstoragetypes.TokenDenom)), reliabilityReward)
 		}
 		details["clientFee"] = burnAmount.String()
 		details["relayNumber"] = strconv.FormatUint(relay.RelayNum, 10)
-		utils.LogLavaEvent(ctx, logger, types.RelayPaymentEventName, details, "New Proof Of Work Was Accepted")
+		relayPaymentEvent.Mint = sdk.NewCoin(epochstoragetypes.TokenDenom, rewardCoins.AmountOf(epochstoragetypes.TokenDenom))
+		relayPaymentEvent.ReliabilityPay = payReliability
+		relayPaymentEvent.ClientFee = burnAmount
+		relayPaymentEvent.DescriptionString = msg.DescriptionString
+		utils.LogLavaTypedEvent(ctx, logger, &relayPaymentEvent, types.RelayPaymentEventName, details, "New Proof Of Work Was Accepted")
 
 		//
 		// deal with unresponsive providers
 		err = k.dealWithUnresponsiveProviders(ctx, relay.UnresponsiveProviders, logger, clientAddr, epochStart, relay.ChainID)
 		if err != nil {
-			utils.LogLavaEvent(ctx, logger, types.UnresponsiveProviderUnstakeFailedEventName, map[string]string{"err:": err.Error()}, "Error Unresponsive Providers could not unstake")
+			utils.LogLavaTypedEvent(ctx, logger, &types.EventUnresponsiveProvider{Error: err.Error()}, types.UnresponsiveProviderUnstakeFailedEventName, map[string]string{"err:": err.Error()}, "Error Unresponsive Providers could not unstake")
 		}
 	}
 	return &types.MsgRelayPaymentResponse{}, nil
@@ -291,7 +336,7 @@ func (k msgServer) dealWithUnresponsiveProviders(ctx sdk.Context, unresponsiveDa
 			utils.LavaFormatError("unable to sdk.AccAddressFromBech32(unresponsive_provider)", err, &map[string]string{"unresponsive_provider_address": unresponsiveProvider})
 			continue
 		}
-		existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, sdkUnresponsiveProviderAddress)
+		existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, sdkUnresponsiveProviderAddress)
 		// if !entryExists provider is alraedy unstaked
 		if !entryExists {
 			continue // if provider is not staked, nothing to do.
@@ -327,11 +372,10 @@ func (k msgServer) dealWithUnresponsiveProviders(ctx sdk.Context, unresponsiveDa
 			if err != nil {
 				utils.LavaFormatError("lava_unresponsive_providers: couldnt fetch getTotalPaymentsForPreviousEpochs", err, nil)
 			} else if totalPaymentRequests*providerPaymentMultiplier < len(providerPaymentStorage.UnresponsivenessComplaints) {
-				// unstake provider
-				utils.LogLavaEvent(ctx, logger, types.ProviderJailedEventName, map[string]string{"provider_address": sdkUnresponsiveProviderAddress.String(), "chain_id": chainID}, "Unresponsive provider was unstaked from the chain due to unresponsiveness")
-				err = k.unSafeUnstakeProviderEntry(ctx, epochstoragetypes.ProviderKey, chainID, indexInStakeStorage, existingEntry)
+				// jail the provider, or unstake it after repeated offenses
+				err = k.punishUnresponsiveProvider(ctx, chainID, epoch, sdkUnresponsiveProviderAddress, existingEntry, uint64(len(providerPaymentStorage.UnresponsivenessComplaints)), uint64(totalPaymentRequests))
 				if err != nil {
-					utils.LavaFormatError("unable to unstake provider entry (unsafe method)", err, &map[string]string{"chainID": chainID, "indexInStakeStorage": strconv.FormatUint(indexInStakeStorage, 10), "existingEntry": existingEntry.GetStake().String()})
+					utils.LavaFormatError("unable to punish unresponsive provider", err, &map[string]string{"chainID": chainID, "provider": sdkUnresponsiveProviderAddress.String(), "existingEntry": existingEntry.GetStake().String()})
 					continue
 				}
 			}
@@ -360,17 +404,28 @@ func (k msgServer) getTotalPaymentsForPreviousEpochs(ctx sdk.Context, numberOfEp
 	return totalPaymentRequests, nil
 }
 
-func (k msgServer) unSafeUnstakeProviderEntry(ctx sdk.Context, providerKey string, chainID string, indexInStakeStorage uint64, existingEntry epochstoragetypes.StakeEntry) error {
-	err := k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, indexInStakeStorage)
+// unSafeUnstakeProviderEntry moves a provider entry to the unstake storage without checks, slashFactor of the stake is burned
+func (k Keeper) unSafeUnstakeProviderEntry(ctx sdk.Context, providerKey string, chainID string, providerAddress sdk.AccAddress, existingEntry epochstoragetypes.StakeEntry, slashFactor sdk.Dec) (slashed sdk.Coin, unstakeHoldBlocks uint64, err error) {
+	slashed = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	err = k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddress)
 	if err != nil {
-		return utils.LavaError(ctx, k.Logger(ctx), "relay_payment_unstake", map[string]string{"existingEntry": fmt.Sprintf("%+v", existingEntry)}, "tried to unstake unsafe but didnt find entry")
+		return slashed, 0, utils.LavaError(ctx, k.Logger(ctx), "relay_payment_unstake", map[string]string{"existingEntry": fmt.Sprintf("%+v", existingEntry)}, "tried to unstake unsafe but didnt find entry")
 	}
 
-	unstakeHoldBlocks, err := k.unstakeHoldBlocks(ctx, existingEntry.Chain, true)
+	unstakeHoldBlocks, err = k.unstakeHoldBlocks(ctx, existingEntry.Chain, true)
 	if err != nil {
-		return err
+		return slashed, 0, err
+	}
+
+	slashed.Amount = slashFactor.MulInt(existingEntry.Stake.Amount).TruncateInt()
+	if slashed.IsPositive() {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed))
+		if err != nil {
+			return slashed, 0, err
+		}
+		existingEntry.Stake = existingEntry.Stake.Sub(slashed)
 	}
 
 	k.epochStorageKeeper.AppendUnstakeEntry(ctx, epochstoragetypes.ProviderKey, existingEntry, unstakeHoldBlocks)
-	return nil
+	return slashed, unstakeHoldBlocks, nil
 }
@@ -81,7 +81,7 @@ func TestRelayPaymentGovQosWeightChange(t *testing.T) {
 			}
 
 			// Sign and send the payment requests for block 0 tx
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -91,7 +91,7 @@ func TestRelayPaymentGovQosWeightChange(t *testing.T) {
 
 			// Get provider's and consumer's balance before payment
 			providerBalance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-			stakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+			stakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 
 			// Make the payment
 			_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &pairingtypes.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
@@ -99,7 +99,7 @@ func TestRelayPaymentGovQosWeightChange(t *testing.T) {
 
 			// Check that the consumer's balance decreased correctly
 			burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(relayRequest.CuSum))
-			newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+			newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 			require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
 			// Compute the relay request's QoS score
@@ -187,7 +187,7 @@ func TestRelayPaymentGovEpochBlocksDecrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -272,7 +272,7 @@ func TestRelayPaymentGovEpochBlocksIncrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -362,7 +362,7 @@ func TestRelayPaymentGovEpochToSaveDecrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -441,7 +441,7 @@ func TestRelayPaymentGovEpochToSaveIncrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -536,7 +536,7 @@ func TestRelayPaymentGovStakeToMaxCUListMaxCUDecrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests for block 20 (=epochBeforeChange)
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -632,7 +632,7 @@ func TestRelayPaymentGovStakeToMaxCUListStakeThresholdIncrease(t *testing.T) {
 			}
 
 			// Sign and send the payment requests for block 20 (=epochBeforeChange)
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -730,7 +730,7 @@ func TestRelayPaymentGovEpochBlocksMultipleChanges(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -862,7 +862,7 @@ func TestStakePaymentUnstake(t *testing.T) {
 	}
 
 	// Sign and send the payment requests for block 20 (=epochBeforeChange)
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -940,7 +940,7 @@ func TestRelayPaymentMemoryTransferAfterEpochChangeWithGovParamChange(t *testing
 		}
 
 		// Sign the payment request
-		sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 
@@ -1043,3 +1043,63 @@ func verifyRelayPaymentObjects(t *testing.T, ts *testStruct, relayRequest *pairi
 	}
 	require.Equal(t, relayRequest.GetCuSum(), uniquePaymentStorageClientProvider.GetUsedCU())
 }
+
+// Test that a spec change doesn't affect the payment of relays that were served before it, specs are fixated per epoch so
+// a relay is validated against the spec that was active on its epoch
+func TestRelayPaymentGovSpecChange(t *testing.T) {
+	// setup testnet with mock spec, a staked client and a staked provider
+	ts := setupForPaymentTest(t)
+
+	// Advance an epoch so the spec is fixated and get the epoch the relay is served in
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochBeforeChange := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+
+	// disable the spec in the middle of the epoch (the spec proposal handler sets the spec the same way)
+	ts.ctx = testkeeper.AdvanceBlock(ts.ctx, ts.keepers)
+	disabledSpec := ts.spec
+	disabledSpec.Enabled = false
+	disabledSpec.BlockLastUpdated = uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), disabledSpec)
+
+	// the spec is still enabled for the current epoch
+	spec, found := ts.keepers.Spec.GetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	require.True(t, found)
+	require.True(t, spec.Enabled)
+
+	// Advance an epoch, the change is applied on the new epoch
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochAfterChange := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+
+	tests := []struct {
+		name  string
+		epoch uint64
+		valid bool
+	}{
+		{"PaymentBeforeSpecChange", epochBeforeChange, true}, // the relay was served when the spec was enabled
+		{"PaymentAfterSpecChange", epochAfterChange, false},  // the spec is disabled on this epoch
+	}
+
+	for ti, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relayRequest := &pairingtypes.RelayRequest{
+				Provider:        ts.providers[0].address.String(),
+				ApiUrl:          "",
+				Data:            []byte(ts.spec.Apis[0].Name),
+				SessionId:       uint64(ti),
+				ChainID:         ts.spec.Name,
+				CuSum:           ts.spec.Apis[0].ComputeUnits * 10,
+				BlockHeight:     int64(tt.epoch),
+				RelayNum:        0,
+				RequestBlock:    -1,
+				DataReliability: nil,
+			}
+
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
+			relayRequest.Sig = sig
+			require.Nil(t, err)
+
+			relayPaymentMessage := pairingtypes.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*pairingtypes.RelayRequest{relayRequest}}
+			payAndVerifyBalance(t, ts, relayPaymentMessage, tt.valid, ts.clients[0].address, ts.providers[0].address)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/coniks-sys/coniks-go/crypto/vrf"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/relayer/sigs"
 	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
@@ -16,6 +17,7 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 var (
@@ -152,7 +154,7 @@ func TestRelayPaymentMemoryTransferAfterEpochChange(t *testing.T) {
 			}
 
 			// Sign and send the payment requests
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -225,7 +227,7 @@ func TestRelayPaymentBlockHeight(t *testing.T) {
 				DataReliability: nil,
 			}
 
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -233,7 +235,7 @@ func TestRelayPaymentBlockHeight(t *testing.T) {
 			Relays = append(Relays, relayRequest)
 
 			balanceProvider := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-			stakeClient, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+			stakeClient, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 			require.Equal(t, true, found)
 
 			_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
@@ -248,7 +250,7 @@ func TestRelayPaymentBlockHeight(t *testing.T) {
 					ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64())
 
 				burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(ts.spec.GetApis()[0].ComputeUnits * 10))
-				newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+				newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 				require.Nil(t, err)
 				require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
@@ -291,7 +293,7 @@ func TestRelayPaymentOverUse(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -329,11 +331,15 @@ func TestRelayPaymentUnstakingProviderForUnresponsiveness(t *testing.T) {
 	testClientAmount := 4
 	testProviderAmount := 2
 	ts := setupClientsAndProvidersForUnresponsiveness(t, testClientAmount, testProviderAmount)
+	// unstake on the first offense instead of jailing
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.UnresponsiveOffensesToUnstake = 1
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
 
 	for i := 0; i < 2; i++ { // move to epoch 3 so we can check enough epochs in the past
 		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
 	}
-	staked_amount, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	staked_amount, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	balanceProvideratBeforeStake := staked_amount.Stake.Amount.Int64() + ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[1].address, epochstoragetypes.TokenDenom).Amount.Int64()
 
 	unresponsiveProvidersData, err := json.Marshal([]string{ts.providers[1].address.String()})
@@ -354,7 +360,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsiveness(t *testing.T) {
 			UnresponsiveProviders: unresponsiveProvidersData, // create the complaint
 		}
 
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		Relays = append(Relays, relayRequest)
@@ -364,7 +370,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsiveness(t *testing.T) {
 	// testing that the provider was unstaked. and checking his balance after many epochs
 	_, unStakeStoragefound, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.True(t, unStakeStoragefound)
-	_, stakeStorageFound, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.False(t, stakeStorageFound)
 
 	OriginalBlockHeight := uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
@@ -381,7 +387,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsiveness(t *testing.T) {
 	_, unStakeStoragefound, _ = ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.False(t, unStakeStoragefound)
 	// also that the provider wasnt returned to stake pool
-	_, stakeStorageFound, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.False(t, stakeStorageFound)
 
 	balanceProviderAfterUnstakeMoneyReturned := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[1].address, epochstoragetypes.TokenDenom).Amount.Int64()
@@ -392,6 +398,10 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessContinueComplainingAfte
 	testClientAmount := 4
 	testProviderAmount := 2
 	ts := setupClientsAndProvidersForUnresponsiveness(t, testClientAmount, testProviderAmount)
+	// unstake on the first offense instead of jailing
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.UnresponsiveOffensesToUnstake = 1
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
 	for i := 0; i < 2; i++ { // move to epoch 3 so we can check enough epochs in the past
 		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
 	}
@@ -415,7 +425,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessContinueComplainingAfte
 			UnresponsiveProviders: unresponsiveProvidersData, // create the complaint
 		}
 
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		Relays = append(Relays, relayRequest)
@@ -425,7 +435,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessContinueComplainingAfte
 	// testing that the provider wasnt unstaked.
 	_, unStakeStoragefound, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.True(t, unStakeStoragefound)
-	_, stakeStorageFound, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.False(t, stakeStorageFound)
 
 	// continue reporting provider after unstake
@@ -449,7 +459,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessContinueComplainingAfte
 			DataReliability:       nil,
 			UnresponsiveProviders: unresponsiveProvidersData, // create the complaint
 		}
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		RelaysAfter = append(RelaysAfter, relayRequest)
@@ -457,7 +467,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessContinueComplainingAfte
 	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: RelaysAfter})
 	require.Nil(t, err)
 
-	_, stakeStorageFound, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.False(t, stakeStorageFound)
 	_, unStakeStoragefound, _ = ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.True(t, unStakeStoragefound)
@@ -500,7 +510,7 @@ func TestRelayPaymentNotUnstakingProviderForUnresponsivenessIfNoEpochInformation
 			UnresponsiveProviders: unresponsiveProvidersData, // create the complaint
 		}
 
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		Relays = append(Relays, relayRequest)
@@ -510,7 +520,7 @@ func TestRelayPaymentNotUnstakingProviderForUnresponsivenessIfNoEpochInformation
 	// testing that the provider wasnt unstaked.
 	_, unStakeStoragefound, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.False(t, unStakeStoragefound)
-	_, stakeStorageFound, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.True(t, stakeStorageFound)
 }
 
@@ -556,7 +566,7 @@ func TestRelayPaymentUnstakingProviderForUnresponsivenessWithBadDataInput(t *tes
 		}
 		totalCu += relayRequest.CuSum
 
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		Relays = append(Relays, relayRequest)
@@ -593,7 +603,7 @@ func TestRelayPaymentNotUnstakingProviderForUnresponsivenessBecauseOfServices(t
 			DataReliability: nil,
 		}
 
-		sig, err := sigs.SignRelay(ts.clients[i].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[i].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		RelaysForUnresponsiveProviderInFirstTwoEpochs = []*types.RelayRequest{relayRequest} // each epoch get one service
@@ -621,7 +631,7 @@ func TestRelayPaymentNotUnstakingProviderForUnresponsivenessBecauseOfServices(t
 			UnresponsiveProviders: unresponsiveProvidersData, // create the complaint
 		}
 
-		sig, err := sigs.SignRelay(ts.clients[clientIndex].secretKey, *relayRequest)
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[clientIndex].secretKey, nil), *relayRequest)
 		relayRequest.Sig = sig
 		require.Nil(t, err)
 		Relays = append(Relays, relayRequest)
@@ -632,7 +642,7 @@ func TestRelayPaymentNotUnstakingProviderForUnresponsivenessBecauseOfServices(t
 	// testing that the provider wasnt unstaked.
 	_, unStakeStoragefound, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.providers[1].address)
 	require.False(t, unStakeStoragefound)
-	_, stakeStorageFound, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
+	_, stakeStorageFound := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, ts.providers[1].address)
 	require.True(t, stakeStorageFound)
 }
 
@@ -661,7 +671,7 @@ func TestRelayPaymentDoubleSpending(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -671,7 +681,7 @@ func TestRelayPaymentDoubleSpending(t *testing.T) {
 	Relays = append(Relays, &relayRequest2)
 
 	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-	stakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+	stakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 
 	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
 	require.NotNil(t, err)
@@ -681,11 +691,59 @@ func TestRelayPaymentDoubleSpending(t *testing.T) {
 	require.Equal(t, balance+want.TruncateInt64(),
 		ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64())
 	burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum))
-	newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+	newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 	require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
 }
 
+// Test that a relay payment emits a typed event with the payment details alongside the legacy event
+func TestRelayPaymentTypedEvent(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	relayRequest := &types.RelayRequest{
+		Provider:        ts.providers[0].address.String(),
+		Data:            []byte(ts.spec.Apis[0].Name),
+		SessionId:       uint64(1),
+		ChainID:         ts.spec.Name,
+		CuSum:           cuSum,
+		BlockHeight:     sdk.UnwrapSDKContext(ts.ctx).BlockHeight(),
+		RelayNum:        0,
+		RequestBlock:    -1,
+		DataReliability: nil,
+	}
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
+	require.Nil(t, err)
+	relayRequest.Sig = sig
+
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*types.RelayRequest{relayRequest}, DescriptionString: "7"})
+	require.Nil(t, err)
+
+	var payments []*types.EventRelayPayment
+	legacyEmitted := false
+	for _, event := range sdk.UnwrapSDKContext(ts.ctx).EventManager().Events() {
+		switch event.Type {
+		case proto.MessageName(&types.EventRelayPayment{}):
+			parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.Nil(t, err)
+			payments = append(payments, parsed.(*types.EventRelayPayment))
+		case utils.EventPrefix + types.RelayPaymentEventName:
+			legacyEmitted = true
+		}
+	}
+	require.True(t, legacyEmitted)
+	require.Len(t, payments, 1)
+	payment := payments[0]
+	require.Equal(t, ts.spec.Index, payment.ChainID)
+	require.Equal(t, ts.clients[0].address.String(), payment.Client)
+	require.Equal(t, ts.providers[0].address.String(), payment.Provider)
+	require.Equal(t, cuSum, payment.CU)
+	require.Equal(t, "7", payment.DescriptionString)
+	mint := ts.keepers.Pairing.MintCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum)).TruncateInt()
+	require.Equal(t, mint, payment.Mint.Amount)
+}
+
 func TestRelayPaymentDataModification(t *testing.T) {
 	ts := setupForPaymentTest(t)
 
@@ -710,7 +768,7 @@ func TestRelayPaymentDataModification(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -766,7 +824,7 @@ func TestRelayPaymentDelayedDoubleSpending(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -856,7 +914,7 @@ func TestRelayPaymentOldEpochs(t *testing.T) {
 				DataReliability: nil,
 			}
 
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -864,7 +922,7 @@ func TestRelayPaymentOldEpochs(t *testing.T) {
 			Relays = append(Relays, relayRequest)
 
 			balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-			stakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+			stakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 
 			_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
 			if tt.valid {
@@ -874,7 +932,7 @@ func TestRelayPaymentOldEpochs(t *testing.T) {
 					ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64())
 
 				burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum))
-				newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+				newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 				require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
 			} else {
@@ -930,7 +988,7 @@ func TestRelayPaymentQoS(t *testing.T) {
 				DataReliability: nil,
 			}
 			QoS.ComputeQoS()
-			sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			relayRequest.Sig = sig
 			require.Nil(t, err)
 
@@ -939,7 +997,7 @@ func TestRelayPaymentQoS(t *testing.T) {
 			Relays = append(Relays, &relay)
 
 			balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-			stakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+			stakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 
 			_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
 			if tt.valid {
@@ -955,7 +1013,7 @@ func TestRelayPaymentQoS(t *testing.T) {
 					ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64())
 
 				burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum))
-				newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+				newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 				require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
 			} else {
@@ -1020,7 +1078,7 @@ func TestRelayPaymentDataReliability(t *testing.T) {
 			}
 			QoS.ComputeQoS()
 
-			relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+			relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 			require.Nil(t, err)
 
 			currentEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
@@ -1033,7 +1091,7 @@ func TestRelayPaymentDataReliability(t *testing.T) {
 				relayReply = &types.RelayReply{
 					Nonce: nonce,
 				}
-				relayReply.Sig, err = sigs.SignRelayResponse(ts.providers[0].secretKey, relayReply, relayRequest)
+				relayReply.Sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(ts.providers[0].secretKey, nil), relayReply, relayRequest)
 				require.Nil(t, err)
 
 				vrfRes0, _ := utils.CalculateVrfOnRelay(relayRequest, relayReply, ts.clients[0].vrfSk, currentEpoch)
@@ -1061,7 +1119,7 @@ func TestRelayPaymentDataReliability(t *testing.T) {
 				QueryHash:      utils.CalculateQueryHash(*relayRequest),
 				Sig:            nil,
 			}
-			dataReliability0.Sig, err = sigs.SignVRFData(ts.clients[0].secretKey, dataReliability0)
+			dataReliability0.Sig, err = sigs.SignVRFData(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), dataReliability0)
 			require.Nil(t, err)
 
 			switch tt.name {
@@ -1106,7 +1164,7 @@ func TestRelayPaymentDataReliability(t *testing.T) {
 				QoSReport:       QoSDR,
 			}
 			QoSDR.ComputeQoS()
-			relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequestWithDataReliability0)
+			relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequestWithDataReliability0)
 			require.Nil(t, err)
 
 			provider := ts.getProvider(providers[index0].Address)
@@ -1164,7 +1222,7 @@ func TestRelayPaymentDataReliabilityWrongProvider(t *testing.T) {
 	}
 	QoS.ComputeQoS()
 
-	relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	require.Nil(t, err)
 
 	currentEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
@@ -1179,7 +1237,7 @@ GetWrongProvider:
 		relayReply = &types.RelayReply{
 			Nonce: nonce,
 		}
-		relayReply.Sig, err = sigs.SignRelayResponse(ts.providers[0].secretKey, relayReply, relayRequest)
+		relayReply.Sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(ts.providers[0].secretKey, nil), relayReply, relayRequest)
 		require.Nil(t, err)
 
 		vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(relayRequest, relayReply, ts.clients[0].vrfSk, currentEpoch)
@@ -1219,7 +1277,7 @@ GetWrongProvider:
 		QueryHash:      utils.CalculateQueryHash(*relayRequest),
 		Sig:            nil,
 	}
-	dataReliability0.Sig, err = sigs.SignVRFData(ts.clients[0].secretKey, dataReliability0)
+	dataReliability0.Sig, err = sigs.SignVRFData(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), dataReliability0)
 	require.Nil(t, err)
 
 	QoSDR := &types.QualityOfServiceReport{Latency: sdk.NewDecWithPrec(1, 0), Availability: sdk.NewDecWithPrec(1, 0), Sync: sdk.NewDecWithPrec(1, 0)}
@@ -1237,7 +1295,7 @@ GetWrongProvider:
 		QoSReport:       QoSDR,
 	}
 	QoSDR.ComputeQoS()
-	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequestWithDataReliability0)
+	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequestWithDataReliability0)
 	require.Nil(t, err)
 
 	provider := ts.getProvider(providers[wrongProviderIndex].Address)
@@ -1280,7 +1338,7 @@ func TestRelayPaymentDataReliabilityBelowReliabilityThreshold(t *testing.T) {
 		QoSReport:       QoS,
 	}
 	QoS.ComputeQoS()
-	relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	require.Nil(t, err)
 
 	var relayReply *types.RelayReply
@@ -1288,7 +1346,7 @@ func TestRelayPaymentDataReliabilityBelowReliabilityThreshold(t *testing.T) {
 	relayReply = &types.RelayReply{
 		Nonce: nonce,
 	}
-	relayReply.Sig, err = sigs.SignRelayResponse(ts.providers[0].secretKey, relayReply, relayRequest)
+	relayReply.Sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(ts.providers[0].secretKey, nil), relayReply, relayRequest)
 	require.Nil(t, err)
 
 	currentEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
@@ -1309,7 +1367,7 @@ func TestRelayPaymentDataReliabilityBelowReliabilityThreshold(t *testing.T) {
 		QueryHash:      utils.CalculateQueryHash(*relayRequest),
 		Sig:            nil,
 	}
-	dataReliability0.Sig, err = sigs.SignVRFData(ts.clients[0].secretKey, dataReliability0)
+	dataReliability0.Sig, err = sigs.SignVRFData(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), dataReliability0)
 	require.Nil(t, err)
 
 	// make all providers send a datareliability payment request. Everyone should fail
@@ -1329,7 +1387,7 @@ func TestRelayPaymentDataReliabilityBelowReliabilityThreshold(t *testing.T) {
 			QoSReport:       QoSDR,
 		}
 		QoSDR.ComputeQoS()
-		relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequestWithDataReliability0)
+		relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequestWithDataReliability0)
 		require.Nil(t, err)
 
 		relaysRequests := []*types.RelayRequest{relayRequestWithDataReliability0}
@@ -1371,7 +1429,7 @@ func TestRelayPaymentDataReliabilityDifferentClientSign(t *testing.T) {
 		QoSReport:       QoS,
 	}
 	QoS.ComputeQoS()
-	relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	require.Nil(t, err)
 
 	currentEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
@@ -1383,7 +1441,7 @@ func TestRelayPaymentDataReliabilityDifferentClientSign(t *testing.T) {
 		relayReply = &types.RelayReply{
 			Nonce: nonce,
 		}
-		relayReply.Sig, err = sigs.SignRelayResponse(ts.providers[0].secretKey, relayReply, relayRequest)
+		relayReply.Sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(ts.providers[0].secretKey, nil), relayReply, relayRequest)
 		require.Nil(t, err)
 
 		vrfRes0, _ := utils.CalculateVrfOnRelay(relayRequest, relayReply, ts.clients[0].vrfSk, currentEpoch)
@@ -1409,7 +1467,7 @@ func TestRelayPaymentDataReliabilityDifferentClientSign(t *testing.T) {
 		QueryHash:      utils.CalculateQueryHash(*relayRequest),
 		Sig:            nil,
 	}
-	dataReliability0.Sig, err = sigs.SignVRFData(ts.clients[1].secretKey, dataReliability0)
+	dataReliability0.Sig, err = sigs.SignVRFData(sigs.NewLocalSigner(ts.clients[1].secretKey, nil), dataReliability0)
 	require.Nil(t, err)
 
 	QoSDR := &types.QualityOfServiceReport{Latency: sdk.NewDecWithPrec(1, 0), Availability: sdk.NewDecWithPrec(1, 0), Sync: sdk.NewDecWithPrec(1, 0)}
@@ -1427,7 +1485,7 @@ func TestRelayPaymentDataReliabilityDifferentClientSign(t *testing.T) {
 		QoSReport:       QoSDR,
 	}
 	QoSDR.ComputeQoS()
-	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[1].secretKey, *relayRequestWithDataReliability0)
+	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[1].secretKey, nil), *relayRequestWithDataReliability0)
 	require.Nil(t, err)
 
 	provider := ts.getProvider(providers[index0].Address)
@@ -1470,7 +1528,7 @@ func TestRelayPaymentDataReliabilityDoubleSpendDifferentEpoch(t *testing.T) {
 	}
 	QoS.ComputeQoS()
 
-	relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	require.Nil(t, err)
 
 	currentEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
@@ -1482,7 +1540,7 @@ func TestRelayPaymentDataReliabilityDoubleSpendDifferentEpoch(t *testing.T) {
 		relayReply = &types.RelayReply{
 			Nonce: nonce,
 		}
-		relayReply.Sig, err = sigs.SignRelayResponse(ts.providers[0].secretKey, relayReply, relayRequest)
+		relayReply.Sig, err = sigs.SignRelayResponse(sigs.NewLocalSigner(ts.providers[0].secretKey, nil), relayReply, relayRequest)
 		require.Nil(t, err)
 
 		vrfRes0, _ := utils.CalculateVrfOnRelay(relayRequest, relayReply, ts.clients[0].vrfSk, currentEpoch)
@@ -1509,7 +1567,7 @@ func TestRelayPaymentDataReliabilityDoubleSpendDifferentEpoch(t *testing.T) {
 		QueryHash:      utils.CalculateQueryHash(*relayRequest),
 		Sig:            nil,
 	}
-	dataReliability0.Sig, err = sigs.SignVRFData(ts.clients[0].secretKey, dataReliability0)
+	dataReliability0.Sig, err = sigs.SignVRFData(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), dataReliability0)
 	require.Nil(t, err)
 
 	QoSDR := &types.QualityOfServiceReport{Latency: sdk.NewDecWithPrec(1, 0), Availability: sdk.NewDecWithPrec(1, 0), Sync: sdk.NewDecWithPrec(1, 0)}
@@ -1527,7 +1585,7 @@ func TestRelayPaymentDataReliabilityDoubleSpendDifferentEpoch(t *testing.T) {
 		QoSReport:       QoSDR,
 	}
 	QoSDR.ComputeQoS()
-	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequestWithDataReliability0)
+	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequestWithDataReliability0)
 	require.Nil(t, err)
 
 	provider := ts.getProvider(providers[index0].Address)
@@ -1541,7 +1599,7 @@ func TestRelayPaymentDataReliabilityDoubleSpendDifferentEpoch(t *testing.T) {
 
 	relayRequestWithDataReliability0.BlockHeight = sdk.UnwrapSDKContext(ts.ctx).BlockHeight()
 	relayRequestWithDataReliability0.SessionId = uint64(2)
-	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequestWithDataReliability0)
+	relayRequestWithDataReliability0.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequestWithDataReliability0)
 	require.Nil(t, err)
 
 	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider.address.String(), Relays: relaysRequests})
@@ -1553,7 +1611,7 @@ func payAndVerifyBalance(t *testing.T, ts *testStruct, relayPaymentMessage types
 
 	// Get provider's and consumer's before payment
 	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), providerAddress, epochstoragetypes.TokenDenom).Amount.Int64()
-	stakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, clientAddress)
+	stakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, clientAddress)
 
 	// perform payment
 	_, err := ts.servers.PairingServer.RelayPayment(ts.ctx, &relayPaymentMessage)
@@ -1566,7 +1624,7 @@ func payAndVerifyBalance(t *testing.T, ts *testStruct, relayPaymentMessage types
 
 		// payment is valid, consumer's balance should decrease
 		burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(relayPaymentMessage.GetRelays()[0].CuSum))
-		newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, clientAddress)
+		newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, clientAddress)
 		require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
 	} else {
@@ -1600,7 +1658,7 @@ func TestEpochPaymentDeletion(t *testing.T) {
 		DataReliability: nil,
 	}
 
-	sig, err := sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+	sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
 	relayRequest.Sig = sig
 	require.Nil(t, err)
 
@@ -1608,7 +1666,7 @@ func TestEpochPaymentDeletion(t *testing.T) {
 	Relays = append(Relays, relayRequest)
 
 	balanceProvider := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64()
-	stakeClient, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+	stakeClient, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 	require.Equal(t, true, found)
 
 	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: Relays})
@@ -1622,7 +1680,7 @@ func TestEpochPaymentDeletion(t *testing.T) {
 		ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount.Int64())
 
 	burn := ts.keepers.Pairing.BurnCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(ts.spec.GetApis()[0].ComputeUnits * 10))
-	newStakeClient, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
+	newStakeClient, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Index, ts.clients[0].address)
 	require.Nil(t, err)
 	require.Equal(t, stakeClient.Stake.Amount.Int64()-burn.TruncateInt64(), newStakeClient.Stake.Amount.Int64())
 
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// signedRelayWithProof creates a relay request of the client signed in both the full and the compact format
+func (ts *testStruct) signedRelayWithProof(t *testing.T, client *account, sessionID uint64, cuSum uint64) *types.RelayRequest {
+	relayRequest := &types.RelayRequest{
+		Provider:     ts.providers[0].address.String(),
+		Data:         []byte(ts.spec.Apis[0].Name),
+		SessionId:    sessionID,
+		ChainID:      ts.spec.Name,
+		CuSum:        cuSum,
+		BlockHeight:  sdk.UnwrapSDKContext(ts.ctx).BlockHeight(),
+		RelayNum:     1,
+		RequestBlock: -1,
+		QoSReport:    &types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()},
+	}
+	var err error
+	relayRequest.ProofSig, err = sigs.SignRelayProof(sigs.NewLocalSigner(client.secretKey, nil), *relayRequest.RelayProof())
+	require.Nil(t, err)
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(client.secretKey, nil), *relayRequest)
+	require.Nil(t, err)
+	return relayRequest
+}
+
+// Test that compact proofs are paid like full relays, also when both formats are sent in the same message
+func TestRelayPaymentCompactProof(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	fullRelay := ts.signedRelayWithProof(t, ts.clients[0], 1, cuSum)
+	proof := ts.signedRelayWithProof(t, ts.clients[1], 1, cuSum).RelayProof()
+
+	// the compact proof recovers the same consumer as the full relay
+	pubKey, err := sigs.RecoverPubKeyFromRelayProof(*proof)
+	require.Nil(t, err)
+	require.Equal(t, ts.clients[1].address.String(), sdk.AccAddress(pubKey.Address()).String())
+	require.Less(t, proof.Size(), ts.signedRelayWithProof(t, ts.clients[1], 1, cuSum).Size())
+
+	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*types.RelayRequest{fullRelay}, Proofs: []*types.RelayProof{proof}})
+	require.Nil(t, err)
+
+	reward := ts.keepers.Pairing.MintCoinsPerCU(sdk.UnwrapSDKContext(ts.ctx)).MulInt64(int64(cuSum)).TruncateInt()
+	require.Equal(t, balance.Add(reward.MulRaw(2)), ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount)
+}
+
+// Test that a session paid in one format can't be paid again in the other
+func TestRelayPaymentCompactProofDoubleSpending(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	relayRequest := ts.signedRelayWithProof(t, ts.clients[0], 1, cuSum)
+
+	_, err := ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*types.RelayRequest{relayRequest}})
+	require.Nil(t, err)
+
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Proofs: []*types.RelayProof{relayRequest.RelayProof()}})
+	require.NotNil(t, err)
+}
+
+// Test that a compact proof modified after the consumer signed it isn't paid
+func TestRelayPaymentCompactProofModified(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	cuSum := ts.spec.GetApis()[0].ComputeUnits * 10
+	proof := ts.signedRelayWithProof(t, ts.clients[0], 1, cuSum).RelayProof()
+	proof.CuSum *= 2
+
+	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount
+	_, err := ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Proofs: []*types.RelayProof{proof}})
+	require.NotNil(t, err)
+	require.Equal(t, balance, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), ts.providers[0].address, epochstoragetypes.TokenDenom).Amount)
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) SetOperatorProviders(goCtx context.Context, msg *types.MsgSetOperatorProviders) (*types.MsgSetOperatorProvidersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the creator is the operator, providers declaring it are only grouped with it once approved here
+	k.Keeper.UpdateOperatorProviders(ctx, msg.Creator, msg.Providers)
+
+	return &types.MsgSetOperatorProvidersResponse{}, nil
+}
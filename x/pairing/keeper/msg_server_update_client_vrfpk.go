@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) UpdateClientVrfpk(goCtx context.Context, msg *types.MsgUpdateClientVrfpk) (*types.MsgUpdateClientVrfpkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// rotates the vrf pk of the client, effective from the next epoch
+	effectiveEpoch, err := k.Keeper.UpdateClientVrfpk(ctx, msg.Creator, msg.Vrfpk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClientVrfpkResponse{EffectiveEpoch: effectiveEpoch}, nil
+}
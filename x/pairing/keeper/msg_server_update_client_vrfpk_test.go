@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newVrfpk(t *testing.T) string {
+	_, pk, err := utils.GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	vrfPk := &utils.VrfPubKey{}
+	vrfPk.Unmarshal(pk)
+	return vrfPk.String()
+}
+
+// Test that a client rotates its vrf pk without unstaking, and relays are verified with the key of their epoch
+func TestUpdateClientVrfpk(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	client := ts.clients[0].address
+	oldVrfpk := &utils.VrfPubKey{}
+	oldVrfpk.Unmarshal(ts.clients[0].vrfPk)
+
+	for _, invalid := range []string{"", "invalid", "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"} {
+		_, err := ts.servers.PairingServer.UpdateClientVrfpk(ts.ctx, &types.MsgUpdateClientVrfpk{Creator: client.String(), Vrfpk: invalid})
+		require.NotNil(t, err)
+	}
+	// a provider has no client entry to update
+	_, err := ts.servers.PairingServer.UpdateClientVrfpk(ts.ctx, &types.MsgUpdateClientVrfpk{Creator: ts.providers[0].address.String(), Vrfpk: newVrfpk(t)})
+	require.NotNil(t, err)
+
+	vrfpk := newVrfpk(t)
+	res, err := ts.servers.PairingServer.UpdateClientVrfpk(ts.ctx, &types.MsgUpdateClientVrfpk{Creator: client.String(), Vrfpk: vrfpk})
+	require.Nil(t, err)
+	oldEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	nextEpoch, err := ts.keepers.Epochstorage.GetNextEpoch(sdk.UnwrapSDKContext(ts.ctx), oldEpoch)
+	require.Nil(t, err)
+	require.Equal(t, nextEpoch, res.EffectiveEpoch)
+
+	// the key of the current epoch is unchanged
+	userStake, err := ts.keepers.Pairing.VerifyPairingData(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client, oldEpoch)
+	require.Nil(t, err)
+	require.Equal(t, oldVrfpk.String(), userStake.Vrfpk)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, res.EffectiveEpoch, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	userStake, err = ts.keepers.Pairing.VerifyPairingData(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client, res.EffectiveEpoch)
+	require.Nil(t, err)
+	require.Equal(t, vrfpk, userStake.Vrfpk)
+	// relays of the previous epoch are still verified with the old key
+	userStake, err = ts.keepers.Pairing.VerifyPairingData(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client, oldEpoch)
+	require.Nil(t, err)
+	require.Equal(t, oldVrfpk.String(), userStake.Vrfpk)
+}
+
+// Test that a subscription consumer rotates its vrf pk and the replaced keys stay valid for their epochs
+func TestUpdateClientVrfpkSubscription(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+	plan := createMockPlan(nil)
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.Nil(t, err)
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	subscription, found := ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.True(t, found)
+	epochKeys := map[uint64]string{ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)): subscription.Vrfpk}
+
+	update := func() string {
+		vrfpk := newVrfpk(t)
+		_, err := ts.servers.PairingServer.UpdateClientVrfpk(ts.ctx, &types.MsgUpdateClientVrfpk{Creator: consumer.Addr.String(), Vrfpk: vrfpk})
+		require.Nil(t, err)
+		return vrfpk
+	}
+	// a key rotated again in the same epoch never took effect and isn't kept
+	update()
+	vrfpk := update()
+	subscription, _ = ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.Len(t, subscription.ReplacedVrfpks, 1)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochKeys[ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))] = vrfpk
+	vrfpk = update()
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochKeys[ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))] = vrfpk
+
+	for epoch, expected := range epochKeys {
+		userStake, err := ts.keepers.Pairing.VerifyPairingData(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, consumer.Addr, epoch)
+		require.Nil(t, err)
+		require.Equal(t, expected, userStake.Vrfpk)
+		res, err := ts.keepers.Pairing.UserEntry(ts.ctx, &types.QueryUserEntryRequest{Address: consumer.Addr.String(), ChainID: ts.spec.Name, Block: epoch})
+		require.Nil(t, err)
+		require.Equal(t, expected, res.Consumer.Vrfpk)
+	}
+
+	// replaced keys are dropped once their epochs can't be paid for
+	lastEffectiveEpoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	for ts.keepers.Epochstorage.GetEarliestEpochStart(sdk.UnwrapSDKContext(ts.ctx)) < lastEffectiveEpoch {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	update()
+	subscription, _ = ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.Len(t, subscription.ReplacedVrfpks, 1)
+	require.Equal(t, vrfpk, subscription.ReplacedVrfpks[0].Vrfpk)
+}
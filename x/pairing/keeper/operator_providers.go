@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/slices"
+)
+
+// SetOperatorProviders set a specific operatorProviders in the store from its operator
+func (k Keeper) SetOperatorProviders(ctx sdk.Context, operatorProviders types.OperatorProviders) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OperatorProvidersKeyPrefix))
+	b := k.cdc.MustMarshal(&operatorProviders)
+	store.Set(types.OperatorProvidersKey(
+		operatorProviders.Operator,
+	), b)
+}
+
+// GetOperatorProviders returns an operatorProviders from its operator
+func (k Keeper) GetOperatorProviders(
+	ctx sdk.Context,
+	operator string,
+) (val types.OperatorProviders, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OperatorProvidersKeyPrefix))
+
+	b := store.Get(types.OperatorProvidersKey(
+		operator,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOperatorProviders removes an operatorProviders from the store
+func (k Keeper) RemoveOperatorProviders(
+	ctx sdk.Context,
+	operator string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OperatorProvidersKeyPrefix))
+	store.Delete(types.OperatorProvidersKey(
+		operator,
+	))
+}
+
+// GetAllOperatorProviders returns all operatorProviders
+func (k Keeper) GetAllOperatorProviders(ctx sdk.Context) (list []types.OperatorProviders) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OperatorProvidersKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OperatorProviders
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsOperatorProvider returns true if the operator approved the provider
+func (k Keeper) IsOperatorProvider(ctx sdk.Context, operator string, provider string) bool {
+	operatorProviders, found := k.GetOperatorProviders(ctx, operator)
+	return found && slices.Contains(operatorProviders.Providers, provider)
+}
+
+// UpdateOperatorProviders replaces the providers the operator approves. providers that are no longer approved
+// have the operator cleared from their stake entries, it takes effect at the next epoch snapshot like any stake change
+func (k Keeper) UpdateOperatorProviders(ctx sdk.Context, operator string, providers []string) {
+	logger := k.Logger(ctx)
+	removedProviders := []string{}
+	if existing, found := k.GetOperatorProviders(ctx, operator); found {
+		for _, provider := range existing.Providers {
+			if !slices.Contains(providers, provider) {
+				removedProviders = append(removedProviders, provider)
+			}
+		}
+	}
+
+	if len(providers) == 0 {
+		k.RemoveOperatorProviders(ctx, operator)
+	} else {
+		k.SetOperatorProviders(ctx, types.OperatorProviders{Operator: operator, Providers: providers})
+	}
+
+	for _, provider := range removedProviders {
+		providerAddr, err := sdk.AccAddressFromBech32(provider)
+		if err != nil {
+			continue
+		}
+		for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+			stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, chainID, providerAddr)
+			if !found || stakeEntry.Operator != operator {
+				continue
+			}
+			stakeEntry.Operator = ""
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, stakeEntry)
+		}
+	}
+
+	details := map[string]string{"operator": operator, "providers": strings.Join(providers, ","), "removedProviders": strings.Join(removedProviders, ",")}
+	event := &types.EventOperatorProvidersSet{Operator: operator, Providers: providers, RemovedProviders: removedProviders}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.OperatorProvidersSetEventName, details, "Operator Providers Set")
+}
@@ -16,9 +16,9 @@ const INVALID_INDEX = -2
 
 func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress, block uint64) (clientStakeEntryRet *epochstoragetypes.StakeEntry, errorRet error) {
 	logger := k.Logger(ctx)
-	// TODO: add support for spec changes
-	foundAndActive, _ := k.specKeeper.IsSpecFoundAndActive(ctx, chainID)
-	if !foundAndActive {
+	// the spec might have changed since the requested block, so we check the one that was active on it
+	spec, found := k.specKeeper.GetSpec(ctx, chainID, block)
+	if !found || !spec.Enabled {
 		return nil, fmt.Errorf("spec not found and active for chainID given: %s", chainID)
 	}
 	earliestSavedEpoch := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
@@ -46,6 +46,12 @@ func (k Keeper) VerifyPairingData(ctx sdk.Context, chainID string, clientAddress
 	}
 	verifiedUser := false
 
+	// a consumer with an active subscription covering this chain doesn't need a stake entry
+	if subscription, found := k.GetSubscriptionForBlock(ctx, clientAddress, chainID, requestedEpochStart); found {
+		subscriptionEntry := SubscriptionStakeEntry(subscription, chainID, requestedEpochStart)
+		return &subscriptionEntry, nil
+	}
+
 	// we get the user stakeEntries at the time of check. for unstaking users, we make sure users can't unstake sooner than blocksToSave so we can charge them if the pairing is valid
 	userStakedEntries, found, _ := k.epochStorageKeeper.GetEpochStakeEntries(ctx, requestedEpochStart, epochstoragetypes.ClientKey, chainID)
 	if !found {
@@ -129,7 +135,7 @@ func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstor
 		panic(fmt.Sprintf("invalid session start saved in keeper %d, current block was %d", epochStartBlock, uint64(ctx.BlockHeight())))
 	}
 
-	spec, found := k.specKeeper.GetSpec(ctx, chainID)
+	spec, found := k.specKeeper.GetSpec(ctx, chainID, epochStartBlock)
 	if !found {
 		return nil, nil, fmt.Errorf("spec not found or not enabled")
 	}
@@ -142,9 +148,18 @@ func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstor
 	}
 
 	if spec.ProvidersTypes == spectypes.Spec_dynamic {
+		maxProvidersPerOperator, err := k.MaxProvidersPerOperator(ctx, epochStartBlock)
+		if err != nil {
+			// the param wasn't fixated at this epoch yet, operators weren't capped then
+			maxProvidersPerOperator = 0
+		}
 		// calculates a hash and randomly chooses the providers
 
-		validProviders = k.returnSubsetOfProvidersByStake(ctx, clientAddress, validProviders, servicersToPairCount, epochStartBlock, chainID, epochHash)
+		var qosFactors map[string]sdk.Dec
+		if spec.QosPairing {
+			qosFactors = k.getEpochQoSFactors(ctx, epochStartBlock, chainID)
+		}
+		validProviders = k.returnSubsetOfProvidersByStake(ctx, clientAddress, validProviders, servicersToPairCount, epochStartBlock, chainID, epochHash, maxProvidersPerOperator, qosFactors)
 	} else {
 		validProviders = k.returnSubsetOfProvidersByHighestStake(ctx, validProviders, servicersToPairCount)
 	}
@@ -179,14 +194,21 @@ func (k Keeper) getGeolocationProviders(ctx sdk.Context, providers []epochstorag
 	return validProviders
 }
 
-// this function randomly chooses count providers by weight
-func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress sdk.AccAddress, providersMaps []epochstoragetypes.StakeEntry, count uint64, block uint64, chainID string, epochHash []byte) (returnedProviders []epochstoragetypes.StakeEntry) {
-	stakeSum := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0))
+// this function randomly chooses count providers by weight, the weight is the stake multiplied by the provider qos factor if it has one
+// when maxProvidersPerOperator is set, an operator that filled its slots is removed from the random pool,
+// and if there aren't enough distinct operators to fill count the removed providers are drawn from again
+func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress sdk.AccAddress, providersMaps []epochstoragetypes.StakeEntry, count uint64, block uint64, chainID string, epochHash []byte, maxProvidersPerOperator uint64, qosFactors map[string]sdk.Dec) (returnedProviders []epochstoragetypes.StakeEntry) {
+	weightSum := sdk.ZeroInt()
+	weights := make([]sdk.Int, len(providersMaps))
 	hashData := make([]byte, 0)
-	for _, stakedProvider := range providersMaps {
-		stakeSum = stakeSum.Add(stakedProvider.Stake)
+	for idx, stakedProvider := range providersMaps {
+		weights[idx] = stakedProvider.Stake.Amount
+		if factor, ok := qosFactors[stakedProvider.Address]; ok {
+			weights[idx] = factor.MulInt(stakedProvider.Stake.Amount).TruncateInt()
+		}
+		weightSum = weightSum.Add(weights[idx])
 	}
-	if stakeSum.IsZero() {
+	if weightSum.IsZero() {
 		// list is empty
 		return
 	}
@@ -196,43 +218,78 @@ func (k Keeper) returnSubsetOfProvidersByStake(ctx sdk.Context, clientAddress sd
 	hashData = append(hashData, chainID...)       // to make this pairing unique per chainID
 	hashData = append(hashData, clientAddress...) // to make this pairing unique per consumer
 
-	indexToSkip := make(map[int]bool) // a trick to create a unique set in golang
-	for it := 0; it < int(count); it++ {
+	indexToSkip := make(map[int]bool)   // a trick to create a unique set in golang
+	cappedIndexes := make(map[int]bool) // providers out of the pool because their operator filled its slots
+	operatorSlots := make(map[string]uint64)
+	for it := 0; uint64(len(returnedProviders)) < count; it++ {
+		if weightSum.IsZero() {
+			if len(cappedIndexes) == 0 {
+				break
+			}
+			// not enough distinct operators, return the capped providers to the pool and keep drawing without a cap
+			for idx := range cappedIndexes {
+				weightSum = weightSum.Add(weights[idx])
+			}
+			cappedIndexes = map[int]bool{}
+			maxProvidersPerOperator = 0
+			if weightSum.IsZero() {
+				break
+			}
+		}
 		hash := tendermintcrypto.Sha256(hashData) // TODO: we use cheaper algo for speed
 		bigIntNum := new(big.Int).SetBytes(hash)
 		hashAsNumber := sdk.NewIntFromBigInt(bigIntNum)
-		modRes := hashAsNumber.Mod(stakeSum.Amount)
+		modRes := hashAsNumber.Mod(weightSum)
 
-		newStakeSum := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(0))
+		newWeightSum := sdk.ZeroInt()
 		// we loop the servicers list form the end because the list is sorted, biggest is last,
 		// and statistically this will have less iterations
 
 		for idx := len(providersMaps) - 1; idx >= 0; idx-- {
 			stakedProvider := providersMaps[idx]
-			if indexToSkip[idx] {
+			if indexToSkip[idx] || cappedIndexes[idx] {
 				// this is an index we added
 				continue
 			}
-			newStakeSum = newStakeSum.Add(stakedProvider.Stake)
-			if modRes.LT(newStakeSum.Amount) {
+			newWeightSum = newWeightSum.Add(weights[idx])
+			if modRes.LT(newWeightSum) {
 				// we hit our chosen provider
 				returnedProviders = append(returnedProviders, stakedProvider)
-				stakeSum = stakeSum.Sub(stakedProvider.Stake) // we remove this provider from the random pool, so the sum is lower now
+				weightSum = weightSum.Sub(weights[idx]) // we remove this provider from the random pool, so the sum is lower now
 				indexToSkip[idx] = true
+				if maxProvidersPerOperator > 0 {
+					weightSum = weightSum.Sub(k.capOperatorSlots(providersMaps, weights, stakedProvider.OperatorIdentity(), operatorSlots, maxProvidersPerOperator, indexToSkip, cappedIndexes))
+				}
 				break
 			}
 		}
-		if uint64(len(returnedProviders)) >= count {
-			return returnedProviders
-		}
-		if stakeSum.IsZero() {
-			break
-		}
 		hashData = append(hashData, []byte{uint8(it)}...)
 	}
 	return returnedProviders
 }
 
+// capOperatorSlots counts a pairing slot for the operator, once the operator reaches its limit
+// its remaining providers are moved out of the random pool and their total weight is returned
+func (k Keeper) capOperatorSlots(providersMaps []epochstoragetypes.StakeEntry, weights []sdk.Int, operator string, operatorSlots map[string]uint64, maxProvidersPerOperator uint64, indexToSkip map[int]bool, cappedIndexes map[int]bool) (removedWeight sdk.Int) {
+	removedWeight = sdk.ZeroInt()
+	if operator == "" {
+		// providers without an operator identity aren't grouped
+		return
+	}
+	operatorSlots[operator]++
+	if operatorSlots[operator] < maxProvidersPerOperator {
+		return
+	}
+	for idx, stakedProvider := range providersMaps {
+		if indexToSkip[idx] || cappedIndexes[idx] || stakedProvider.OperatorIdentity() != operator {
+			continue
+		}
+		cappedIndexes[idx] = true
+		removedWeight = removedWeight.Add(weights[idx])
+	}
+	return
+}
+
 func (k Keeper) returnSubsetOfProvidersByHighestStake(ctx sdk.Context, providersEntries []epochstoragetypes.StakeEntry, count uint64) (returnedProviders []epochstoragetypes.StakeEntry) {
 	if uint64(len(providersEntries)) <= count {
 		return providersEntries
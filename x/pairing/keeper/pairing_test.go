@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"context"
+	"strconv"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
@@ -258,3 +261,153 @@ func TestPairingStatic(t *testing.T) {
 	}
 
 }
+
+// stakeProviderWithOperator stakes a new provider, when operator is set it approves the provider before the provider declares it
+func stakeProviderWithOperator(t *testing.T, ctx context.Context, keepers *testkeeper.Keepers, servers *testkeeper.Servers, spec spectypes.Spec, stake int64, ipport string, operator *common.Account) common.Account {
+	provider := common.CreateNewAccount(ctx, *keepers, balance)
+	operatorAddr := ""
+	if operator != nil {
+		operatorAddr = operator.Addr.String()
+		approveOperatorProvider(t, ctx, keepers, servers, *operator, provider.Addr.String())
+	}
+	err := stakeProviderDeclaringOperator(ctx, servers, spec, provider, stake, ipport, operatorAddr)
+	require.Nil(t, err)
+	return provider
+}
+
+func stakeProviderDeclaringOperator(ctx context.Context, servers *testkeeper.Servers, spec spectypes.Spec, provider common.Account, stake int64, ipport string, operator string) error {
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: ipport, UseType: spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	_, err := servers.PairingServer.StakeProvider(ctx, &types.MsgStakeProvider{Creator: provider.Addr.String(), ChainID: spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Endpoints: endpoints, Operator: operator})
+	return err
+}
+
+func approveOperatorProvider(t *testing.T, ctx context.Context, keepers *testkeeper.Keepers, servers *testkeeper.Servers, operator common.Account, provider string) {
+	operatorProviders, _ := keepers.Pairing.GetOperatorProviders(sdk.UnwrapSDKContext(ctx), operator.Addr.String())
+	_, err := servers.PairingServer.SetOperatorProviders(ctx, &types.MsgSetOperatorProviders{Creator: operator.Addr.String(), Providers: append(operatorProviders.Providers, provider)})
+	require.Nil(t, err)
+}
+
+// setupForOperatorCapTest pairs 3 providers with a cap of 1 provider per operator and stakes a consumer
+func setupForOperatorCapTest(t *testing.T) (*testkeeper.Servers, *testkeeper.Keepers, context.Context, spectypes.Spec, common.Account) {
+	servers, keepers, ctx := testkeeper.InitAllKeepers(t)
+
+	//init keepers state
+	spec := common.CreateMockSpec()
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), spec)
+
+	// a param change is fixated from the block after genesis
+	ctx = testkeeper.AdvanceBlock(ctx, keepers)
+	err := testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ctx), keepers.ParamsKeeper, types.ModuleName, string(types.KeyServicersToPairCount), "\"3\"")
+	require.NoError(t, err)
+	err = testkeeper.SimulateParamChange(sdk.UnwrapSDKContext(ctx), keepers.ParamsKeeper, types.ModuleName, string(types.KeyMaxProvidersPerOperator), "\"1\"")
+	require.NoError(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	consumer := common.CreateNewAccount(ctx, *keepers, balance)
+	common.StakeAccount(t, ctx, *keepers, *servers, consumer, spec, stake, false)
+	return servers, keepers, ctx, spec, consumer
+}
+
+func TestPairingOperatorCap(t *testing.T) {
+	servers, keepers, ctx, spec, consumer := setupForOperatorCapTest(t)
+
+	// one operator with most of the stake, every provider approved by the operator address
+	bigOperator := common.CreateNewAccount(ctx, *keepers, balance)
+	for i := 0; i < 10; i++ {
+		stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake*10, "big.operator.com:"+strconv.Itoa(2000+i), &bigOperator)
+	}
+	// two small providers without an operator
+	stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "small1.com:2000", nil)
+	stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "small2.com:2000", nil)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	providers, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, 3)
+
+	operators := map[string]int{}
+	for _, provider := range providers {
+		operators[provider.OperatorIdentity()]++
+	}
+	require.Equal(t, map[string]int{bigOperator.Addr.String(): 1, "": 2}, operators)
+
+	// the pairing is validated with the same cap
+	for _, provider := range providers {
+		providerAddr, err := sdk.AccAddressFromBech32(provider.Address)
+		require.Nil(t, err)
+		valid, _, _, err := keepers.Pairing.ValidatePairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr, providerAddr, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+		require.Nil(t, err)
+		require.True(t, valid)
+	}
+}
+
+func TestPairingOperatorCapFallback(t *testing.T) {
+	servers, keepers, ctx, spec, consumer := setupForOperatorCapTest(t)
+
+	bigOperator := common.CreateNewAccount(ctx, *keepers, balance)
+	for i := 0; i < 5; i++ {
+		stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "big.operator.com:"+strconv.Itoa(2000+i), &bigOperator)
+	}
+	stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "small.com:2000", nil)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// only two operators, the remaining slot is filled from the capped operator
+	providers, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, 3)
+
+	operators := map[string]int{}
+	for _, provider := range providers {
+		operators[provider.OperatorIdentity()]++
+	}
+	require.Equal(t, map[string]int{bigOperator.Addr.String(): 2, "": 1}, operators)
+
+	// the fallback is deterministic
+	providersAgain, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Equal(t, providers, providersAgain)
+}
+
+func TestPairingOperatorSpoofing(t *testing.T) {
+	servers, keepers, ctx, spec, consumer := setupForOperatorCapTest(t)
+
+	// the victim operator runs two providers
+	victimOperator := common.CreateNewAccount(ctx, *keepers, balance)
+	victim1 := stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "victim.com:2000", &victimOperator)
+	victim2 := stakeProviderWithOperator(t, ctx, keepers, servers, spec, stake, "victim.com:2001", nil)
+
+	// the attacker can't declare the victim operator to get capped together with it
+	attacker := common.CreateNewAccount(ctx, *keepers, balance)
+	err := stakeProviderDeclaringOperator(ctx, servers, spec, attacker, stake*10, "attacker.com:2000", victimOperator.Addr.String())
+	require.NotNil(t, err)
+
+	// nor approve a victim provider as its own, the provider has to declare the operator as well
+	approveOperatorProvider(t, ctx, keepers, servers, attacker, victim2.Addr.String())
+	approveOperatorProvider(t, ctx, keepers, servers, attacker, attacker.Addr.String())
+	err = stakeProviderDeclaringOperator(ctx, servers, spec, attacker, stake*10, "attacker.com:2000", attacker.Addr.String())
+	require.Nil(t, err)
+
+	ctx = testkeeper.AdvanceEpoch(ctx, keepers)
+
+	// all three providers are paired, the victims weren't grouped with the attacker
+	providers, err := keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ctx), spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, 3)
+	operators := map[string]string{}
+	for _, provider := range providers {
+		operators[provider.Address] = provider.OperatorIdentity()
+	}
+	require.Equal(t, map[string]string{victim1.Addr.String(): victimOperator.Addr.String(), victim2.Addr.String(): "", attacker.Addr.String(): attacker.Addr.String()}, operators)
+
+	// an operator that stops approving a provider ungroups it
+	_, err = servers.PairingServer.SetOperatorProviders(ctx, &types.MsgSetOperatorProviders{Creator: victimOperator.Addr.String()})
+	require.Nil(t, err)
+	stakeEntry, found := keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ctx), epochstoragetypes.ProviderKey, spec.Name, victim1.Addr)
+	require.True(t, found)
+	require.Equal(t, "", stakeEntry.Operator)
+	_, found = keepers.Pairing.GetOperatorProviders(sdk.UnwrapSDKContext(ctx), victimOperator.Addr.String())
+	require.False(t, found)
+}
@@ -19,6 +19,14 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.SlashLimit(ctx),
 		k.DataReliabilityReward(ctx),
 		k.QoSWeight(ctx),
+		k.ReputationDecayFactor(ctx),
+		k.MaxProvidersPerOperatorRaw(ctx),
+		k.QoSPairingMinFactor(ctx),
+		k.QoSPairingEpochs(ctx),
+		k.UnresponsiveJailEpochs(ctx),
+		k.UnresponsiveOffensesToUnstake(ctx),
+		k.UnresponsiveSlashFactor(ctx),
+		k.FreeTxsPerEpoch(ctx),
 	)
 }
 
@@ -96,3 +104,63 @@ func (k Keeper) QoSWeight(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyQoSWeight, &res)
 	return
 }
+
+// ReputationDecayFactor returns the ReputationDecayFactor param, chains upgraded from before it was added use the default
+func (k Keeper) ReputationDecayFactor(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultReputationDecayFactor
+	k.paramstore.GetIfExists(ctx, types.KeyReputationDecayFactor, &res)
+	return
+}
+
+func (k Keeper) MaxProvidersPerOperator(ctx sdk.Context, block uint64) (res uint64, err error) {
+	err = k.epochStorageKeeper.GetParamForBlock(ctx, string(types.KeyMaxProvidersPerOperator), block, &res)
+	return
+}
+
+// MaxProvidersPerOperatorRaw returns the MaxProvidersPerOperator param, chains that never set it have no limit
+func (k Keeper) MaxProvidersPerOperatorRaw(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, types.KeyMaxProvidersPerOperator, &res)
+	return
+}
+
+// QoSPairingMinFactor returns the QoSPairingMinFactor param, chains upgraded from before it was added use the default
+func (k Keeper) QoSPairingMinFactor(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultQoSPairingMinFactor
+	k.paramstore.GetIfExists(ctx, types.KeyQoSPairingMinFactor, &res)
+	return
+}
+
+// QoSPairingEpochs returns the QoSPairingEpochs param, chains upgraded from before it was added use the default
+func (k Keeper) QoSPairingEpochs(ctx sdk.Context) (res uint64) {
+	res = types.DefaultQoSPairingEpochs
+	k.paramstore.GetIfExists(ctx, types.KeyQoSPairingEpochs, &res)
+	return
+}
+
+// UnresponsiveJailEpochs returns the UnresponsiveJailEpochs param, chains upgraded from before it was added use the default
+func (k Keeper) UnresponsiveJailEpochs(ctx sdk.Context) (res uint64) {
+	res = types.DefaultUnresponsiveJailEpochs
+	k.paramstore.GetIfExists(ctx, types.KeyUnresponsiveJailEpochs, &res)
+	return
+}
+
+// UnresponsiveOffensesToUnstake returns the UnresponsiveOffensesToUnstake param, chains upgraded from before it was added use the default
+func (k Keeper) UnresponsiveOffensesToUnstake(ctx sdk.Context) (res uint64) {
+	res = types.DefaultUnresponsiveOffensesToUnstake
+	k.paramstore.GetIfExists(ctx, types.KeyUnresponsiveOffensesToUnstake, &res)
+	return
+}
+
+// UnresponsiveSlashFactor returns the UnresponsiveSlashFactor param, chains upgraded from before it was added use the default
+func (k Keeper) UnresponsiveSlashFactor(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultUnresponsiveSlashFactor
+	k.paramstore.GetIfExists(ctx, types.KeyUnresponsiveSlashFactor, &res)
+	return
+}
+
+// FreeTxsPerEpoch returns the FreeTxsPerEpoch param, chains upgraded from before it was added use the default
+func (k Keeper) FreeTxsPerEpoch(ctx sdk.Context) (res uint64) {
+	res = types.DefaultFreeTxsPerEpoch
+	k.paramstore.GetIfExists(ctx, types.KeyFreeTxsPerEpoch, &res)
+	return
+}
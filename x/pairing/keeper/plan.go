@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetPlan set a specific plan in the store from its index
+func (k Keeper) SetPlan(ctx sdk.Context, plan types.Plan) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlanKeyPrefix))
+	b := k.cdc.MustMarshal(&plan)
+	store.Set(types.PlanKey(
+		plan.Index,
+	), b)
+}
+
+// GetPlan returns a plan from its index
+func (k Keeper) GetPlan(
+	ctx sdk.Context,
+	index string,
+) (val types.Plan, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlanKeyPrefix))
+
+	b := store.Get(types.PlanKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPlan returns all plan
+func (k Keeper) GetAllPlan(ctx sdk.Context) (list []types.Plan) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlanKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Plan
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ValidatePlanChains checks the plan is valid and all of the chains it covers exist
+func (k Keeper) ValidatePlanChains(ctx sdk.Context, plan types.Plan) error {
+	err := plan.ValidatePlan()
+	if err != nil {
+		return err
+	}
+	for _, chainID := range plan.ChainIds {
+		if _, found := k.specKeeper.GetLatestSpec(ctx, chainID); !found {
+			return fmt.Errorf("plan %s covers chain %s which doesn't have a spec", plan.Index, chainID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderJail set a specific providerJail in the store from its index
+func (k Keeper) SetProviderJail(ctx sdk.Context, providerJail types.ProviderJail) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	b := k.cdc.MustMarshal(&providerJail)
+	store.Set(types.ProviderJailKey(
+		providerJail.Provider,
+		providerJail.ChainID,
+	), b)
+}
+
+// GetProviderJail returns a providerJail from its index
+func (k Keeper) GetProviderJail(
+	ctx sdk.Context,
+	provider string,
+	chainID string,
+) (val types.ProviderJail, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+
+	b := store.Get(types.ProviderJailKey(
+		provider,
+		chainID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderJail removes a providerJail from the store
+func (k Keeper) RemoveProviderJail(
+	ctx sdk.Context,
+	provider string,
+	chainID string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	store.Delete(types.ProviderJailKey(
+		provider,
+		chainID,
+	))
+}
+
+// GetAllProviderJail returns all providerJail
+func (k Keeper) GetAllProviderJail(ctx sdk.Context) (list []types.ProviderJail) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderJailKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderJail
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsProviderJailed returns true if the provider is serving a jail or a forced unstake on the chain
+func (k Keeper) IsProviderJailed(ctx sdk.Context, provider string, chainID string) (jailedUntil uint64, jailed bool) {
+	providerJail, found := k.GetProviderJail(ctx, provider, chainID)
+	if !found || providerJail.JailedUntil <= uint64(ctx.BlockHeight()) {
+		return 0, false
+	}
+	return providerJail.JailedUntil, true
+}
+
+// punishUnresponsiveProvider escalates the penalty of a provider that got enough unresponsiveness complaints:
+// the first offenses jail the provider out of the pairing for UnresponsiveJailEpochs epochs times the number of offenses,
+// after UnresponsiveOffensesToUnstake offenses the provider is unstaked and slashed by UnresponsiveSlashFactor.
+// offenses are forgotten after blocksToSave without a new one
+func (k Keeper) punishUnresponsiveProvider(ctx sdk.Context, chainID string, epoch uint64, providerAddress sdk.AccAddress, existingEntry epochstoragetypes.StakeEntry, complaints uint64, payments uint64) error {
+	logger := k.Logger(ctx)
+	block := uint64(ctx.BlockHeight())
+	provider := providerAddress.String()
+
+	providerJail, found := k.GetProviderJail(ctx, provider, chainID)
+	if !found {
+		providerJail = types.ProviderJail{Provider: provider, ChainID: chainID, History: []types.ProviderJailRecord{}}
+	}
+	if providerJail.JailedUntil > block {
+		// already serving a penalty for the complaints
+		return nil
+	}
+	blocksToSave, err := k.epochStorageKeeper.BlocksToSave(ctx, block)
+	if err != nil {
+		return err
+	}
+	if providerJail.LastOffenseBlock+blocksToSave < block {
+		providerJail.Offenses = 0
+	}
+	providerJail.Offenses++
+	providerJail.LastOffenseBlock = block
+
+	record := types.ProviderJailRecord{Block: block, Epoch: epoch, Complaints: complaints, Payments: payments, Slashed: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())}
+	details := map[string]string{"provider_address": provider, "chain_id": chainID, "offenses": strconv.FormatUint(providerJail.Offenses, 10), "complaints": strconv.FormatUint(complaints, 10)}
+	if providerJail.Offenses >= k.UnresponsiveOffensesToUnstake(ctx) {
+		slashed, unstakeHoldBlocks, err := k.unSafeUnstakeProviderEntry(ctx, epochstoragetypes.ProviderKey, chainID, providerAddress, existingEntry, k.UnresponsiveSlashFactor(ctx))
+		if err != nil {
+			return err
+		}
+		record.Unstaked = true
+		record.Slashed = slashed
+		// the forced unstake can't be canceled until the stake is returned
+		providerJail.JailedUntil = block + unstakeHoldBlocks
+		details["slashed"] = slashed.String()
+		details["jailed_until"] = strconv.FormatUint(providerJail.JailedUntil, 10)
+		event := &types.EventProviderUnresponsiveUnstake{Provider: provider, ChainID: chainID, Offenses: providerJail.Offenses, Complaints: complaints, Slashed: slashed, JailedUntil: providerJail.JailedUntil}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.ProviderUnresponsiveUnstakeEventName, details, "Unresponsive provider was unstaked from the chain due to repeated unresponsiveness")
+	} else {
+		nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, k.epochStorageKeeper.GetEpochStart(ctx))
+		if err != nil {
+			return err
+		}
+		epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, block)
+		if err != nil {
+			return err
+		}
+		// the provider stays out of the pairing until its deadline, starting from the next epoch
+		providerJail.JailedUntil = nextEpoch + k.UnresponsiveJailEpochs(ctx)*providerJail.Offenses*epochBlocks
+		if existingEntry.Deadline < providerJail.JailedUntil {
+			existingEntry.Deadline = providerJail.JailedUntil
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, chainID, existingEntry)
+		}
+		details["jailed_until"] = strconv.FormatUint(providerJail.JailedUntil, 10)
+		event := &types.EventProviderJailed{Provider: provider, ChainID: chainID, Offenses: providerJail.Offenses, Complaints: complaints, JailedUntil: providerJail.JailedUntil}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.ProviderJailedEventName, details, "Unresponsive provider was jailed due to unresponsiveness")
+	}
+	record.JailedUntil = providerJail.JailedUntil
+
+	providerJail.History = append(providerJail.History, record)
+	if len(providerJail.History) > types.MaxProviderJailHistory {
+		providerJail.History = providerJail.History[len(providerJail.History)-types.MaxProviderJailHistory:]
+	}
+	k.SetProviderJail(ctx, providerJail)
+	return nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// complainOnProvider sends a relay payment for providers[0] in which every client complains about the unresponsive provider
+func (ts *testStruct) complainOnProvider(t *testing.T, unresponsive sdk.AccAddress) {
+	unresponsiveProvidersData, err := json.Marshal([]string{unresponsive.String()})
+	require.Nil(t, err)
+	var relays []*types.RelayRequest
+	for _, client := range ts.clients {
+		relayRequest := &types.RelayRequest{
+			Provider:              ts.providers[0].address.String(),
+			Data:                  []byte(ts.spec.Apis[0].Name),
+			SessionId:             uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()), // unique per complaint round
+			ChainID:               ts.spec.Name,
+			CuSum:                 ts.spec.Apis[0].ComputeUnits * 10,
+			BlockHeight:           sdk.UnwrapSDKContext(ts.ctx).BlockHeight(),
+			RelayNum:              0,
+			RequestBlock:          -1,
+			UnresponsiveProviders: unresponsiveProvidersData,
+		}
+		relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(client.secretKey, nil), *relayRequest)
+		require.Nil(t, err)
+		relays = append(relays, relayRequest)
+	}
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: relays})
+	require.Nil(t, err)
+}
+
+// advanceUntilBlock advances epochs until the block height reaches the given block
+func (ts *testStruct) advanceUntilBlock(block uint64) {
+	for uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()) < block {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+}
+
+// Test that the first unresponsiveness offense jails the provider out of the pairing without unstaking it
+func TestUnresponsiveProviderJail(t *testing.T) {
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 4, 2)
+	for i := 0; i < 2; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	provider := ts.providers[1].address
+	ts.complainOnProvider(t, provider)
+
+	// the provider is still staked, with its deadline pushed to the end of the jail
+	res, err := ts.keepers.Pairing.ProviderJail(ts.ctx, &types.QueryProviderJailRequest{Provider: provider.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	require.True(t, res.Jailed)
+	require.Equal(t, uint64(1), res.ProviderJail.Offenses)
+	require.Len(t, res.ProviderJail.History, 1)
+	require.False(t, res.ProviderJail.History[0].Unstaked)
+	jailedUntil := res.ProviderJail.JailedUntil
+
+	_, found, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, provider)
+	require.False(t, found)
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.True(t, found)
+	require.Equal(t, jailedUntil, stakeEntry.Deadline)
+	require.Equal(t, stake, stakeEntry.Stake.Amount.Int64())
+
+	// the jailed provider is not paired from the next epoch
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	pairing, err := ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].address)
+	require.Nil(t, err)
+	for _, entry := range pairing {
+		require.NotEqual(t, provider.String(), entry.Address)
+	}
+
+	// complaints while serving the jail don't add offenses
+	ts.complainOnProvider(t, provider)
+	providerJail, found := ts.keepers.Pairing.GetProviderJail(sdk.UnwrapSDKContext(ts.ctx), provider.String(), ts.spec.Name)
+	require.True(t, found)
+	require.Equal(t, uint64(1), providerJail.Offenses)
+	require.Equal(t, jailedUntil, providerJail.JailedUntil)
+
+	// the provider is paired again once the jail is served
+	ts.advanceUntilBlock(jailedUntil)
+	_, jailed := ts.keepers.Pairing.IsProviderJailed(sdk.UnwrapSDKContext(ts.ctx), provider.String(), ts.spec.Name)
+	require.False(t, jailed)
+	pairing, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, ts.clients[0].address)
+	require.Nil(t, err)
+	providerPaired := false
+	for _, entry := range pairing {
+		if entry.Address == provider.String() {
+			providerPaired = true
+		}
+	}
+	require.True(t, providerPaired)
+}
+
+// Test that repeated offenses extend the jail and end with a slashed unstake that can't be canceled
+func TestUnresponsiveProviderJailEscalation(t *testing.T) {
+	ts := setupClientsAndProvidersForUnresponsiveness(t, 4, 2)
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.UnresponsiveSlashFactor = sdk.NewDecWithPrec(5, 1)
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+	for i := 0; i < 2; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	provider := ts.providers[1].address
+	balanceBefore := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64()
+	epochBlocks := ts.keepers.Epochstorage.EpochBlocksRaw(sdk.UnwrapSDKContext(ts.ctx))
+
+	// every jail is longer than the one before
+	for offense := uint64(1); offense < params.UnresponsiveOffensesToUnstake; offense++ {
+		ts.complainOnProvider(t, provider)
+		providerJail, found := ts.keepers.Pairing.GetProviderJail(sdk.UnwrapSDKContext(ts.ctx), provider.String(), ts.spec.Name)
+		require.True(t, found)
+		require.Equal(t, offense, providerJail.Offenses)
+		nextEpoch, err := ts.keepers.Epochstorage.GetNextEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+		require.Nil(t, err)
+		require.Equal(t, nextEpoch+params.UnresponsiveJailEpochs*offense*epochBlocks, providerJail.JailedUntil)
+		ts.advanceUntilBlock(providerJail.JailedUntil)
+	}
+
+	// the last offense unstakes the provider and burns the slashed stake
+	ts.complainOnProvider(t, provider)
+	providerJail, found := ts.keepers.Pairing.GetProviderJail(sdk.UnwrapSDKContext(ts.ctx), provider.String(), ts.spec.Name)
+	require.True(t, found)
+	require.Len(t, providerJail.History, int(params.UnresponsiveOffensesToUnstake))
+	lastRecord := providerJail.History[len(providerJail.History)-1]
+	require.True(t, lastRecord.Unstaked)
+	require.Equal(t, stake/2, lastRecord.Slashed.Amount.Int64())
+
+	_, found = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.False(t, found)
+	unstakeEntry, found, _ := ts.keepers.Epochstorage.UnstakeEntryByAddress(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, provider)
+	require.True(t, found)
+	require.Equal(t, stake/2, unstakeEntry.Stake.Amount.Int64())
+
+	// the forced unstake can't be canceled
+	_, err := ts.servers.PairingServer.CancelUnstake(ts.ctx, &types.MsgCancelUnstake{Creator: provider.String(), ChainID: ts.spec.Name, Provider: true})
+	require.NotNil(t, err)
+
+	// staking again doesn't bypass the jail
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: ts.spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	_, err = ts.servers.PairingServer.StakeProvider(ts.ctx, &types.MsgStakeProvider{Creator: provider.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Endpoints: endpoints})
+	require.Nil(t, err)
+	stakeEntry, found := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider)
+	require.True(t, found)
+	require.Equal(t, providerJail.JailedUntil, stakeEntry.Deadline)
+
+	// only the unslashed part of the forced unstake is returned, the new stake stays locked
+	ts.advanceUntilBlock(providerJail.JailedUntil)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	require.Equal(t, balanceBefore+stake/2-stake, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider, epochstoragetypes.TokenDenom).Amount.Int64())
+}
@@ -121,3 +121,26 @@ func (k Keeper) GetTotalUsedCUForConsumerPerEpoch(ctx sdk.Context, consumerAddre
 	}
 	return usedCUProviderTotal, nil
 }
+
+// Function to add the coins minted for a payment to the providerPaymentStorage object of its epoch, reliabilityReward is the part of the reward paid as a data reliability bonus
+func (k Keeper) AddProviderRewardInEpoch(ctx sdk.Context, chainID string, epoch uint64, providerAddress sdk.AccAddress, reward sdk.Coin, reliabilityReward sdk.Coin) {
+	providerPaymentStorage, found := k.GetProviderPaymentStorage(ctx, k.GetProviderPaymentStorageKey(ctx, chainID, epoch, providerAddress))
+	if !found {
+		// the payment is added to the providerPaymentStorage object before it is rewarded
+		return
+	}
+	providerPaymentStorage.Rewards = addRewardCoin(providerPaymentStorage.Rewards, reward)
+	providerPaymentStorage.ReliabilityRewards = addRewardCoin(providerPaymentStorage.ReliabilityRewards, reliabilityReward)
+	k.SetProviderPaymentStorage(ctx, providerPaymentStorage)
+}
+
+// addRewardCoin adds to a reward that might not be set yet (objects from before rewards were kept in the storage)
+func addRewardCoin(total sdk.Coin, add sdk.Coin) sdk.Coin {
+	if add.Denom == "" {
+		return total
+	}
+	if total.Denom == "" {
+		return add
+	}
+	return total.Add(add)
+}
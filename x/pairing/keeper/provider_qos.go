@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderQoS set a specific providerQoS in the store from its index
+func (k Keeper) SetProviderQoS(ctx sdk.Context, providerQoS types.ProviderQoS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSKeyPrefix))
+	b := k.cdc.MustMarshal(&providerQoS)
+	store.Set(types.ProviderQoSKey(
+		providerQoS.Provider,
+		providerQoS.ChainID,
+	), b)
+}
+
+// GetProviderQoS returns a providerQoS from its index
+func (k Keeper) GetProviderQoS(
+	ctx sdk.Context,
+	provider string,
+	chainID string,
+) (val types.ProviderQoS, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSKeyPrefix))
+
+	b := store.Get(types.ProviderQoSKey(
+		provider,
+		chainID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderQoS removes a providerQoS from the store
+func (k Keeper) RemoveProviderQoS(
+	ctx sdk.Context,
+	provider string,
+	chainID string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSKeyPrefix))
+	store.Delete(types.ProviderQoSKey(
+		provider,
+		chainID,
+	))
+}
+
+// GetAllProviderQoS returns all providerQoS
+func (k Keeper) GetAllProviderQoS(ctx sdk.Context) (list []types.ProviderQoS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProviderQoSKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderQoS
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// decayProviderQoS lowers the weight of the reputation by the decay factor for every epoch that passed since it was last updated
+func (k Keeper) decayProviderQoS(ctx sdk.Context, providerQoS *types.ProviderQoS) {
+	block := uint64(ctx.BlockHeight())
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, block)
+	if err != nil || epochBlocks == 0 || block <= providerQoS.BlockLastUpdated {
+		return
+	}
+	epochsPassed := (block - providerQoS.BlockLastUpdated) / epochBlocks
+	providerQoS.Weight = providerQoS.Weight.Mul(k.ReputationDecayFactor(ctx).Power(epochsPassed))
+}
+
+// GetProviderQoSDecayed returns the provider reputation on a chain with its weight decayed up to the current block
+func (k Keeper) GetProviderQoSDecayed(ctx sdk.Context, provider string, chainID string) (val types.ProviderQoS, found bool) {
+	val, found = k.GetProviderQoS(ctx, provider, chainID)
+	if !found {
+		return val, false
+	}
+	k.decayProviderQoS(ctx, &val)
+	return val, true
+}
+
+// UpdateProviderQoS adds a QoS report covering cu to the provider reputation, older reports weigh less as their weight decays
+func (k Keeper) UpdateProviderQoS(ctx sdk.Context, provider string, chainID string, report types.QualityOfServiceReport, cu uint64) {
+	providerQoS, found := k.GetProviderQoSDecayed(ctx, provider, chainID)
+	if !found {
+		providerQoS = types.ProviderQoS{
+			Provider: provider,
+			ChainID:  chainID,
+			Score:    types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()},
+			Weight:   sdk.ZeroDec(),
+		}
+	}
+
+	reportWeight := sdk.NewDecFromInt(sdk.NewIntFromUint64(cu))
+	totalWeight := providerQoS.Weight.Add(reportWeight)
+	if totalWeight.IsZero() {
+		return
+	}
+	weightedAverage := func(current sdk.Dec, reported sdk.Dec) sdk.Dec {
+		return current.Mul(providerQoS.Weight).Add(reported.Mul(reportWeight)).Quo(totalWeight)
+	}
+	providerQoS.Score = types.QualityOfServiceReport{
+		Latency:      weightedAverage(providerQoS.Score.Latency, report.Latency),
+		Availability: weightedAverage(providerQoS.Score.Availability, report.Availability),
+		Sync:         weightedAverage(providerQoS.Score.Sync, report.Sync),
+	}
+	providerQoS.Weight = totalWeight
+	providerQoS.BlockLastUpdated = uint64(ctx.BlockHeight())
+	k.SetProviderQoS(ctx, providerQoS)
+}
+
+// GetProviderQoSScore returns the combined reputation score of a provider on a chain, it can be used as an input to the pairing weight
+func (k Keeper) GetProviderQoSScore(ctx sdk.Context, provider string, chainID string) (score sdk.Dec, found bool) {
+	providerQoS, found := k.GetProviderQoS(ctx, provider, chainID)
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	score, err := providerQoS.Score.ComputeQoS()
+	if err != nil {
+		return sdk.ZeroDec(), false
+	}
+	return score, true
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderQoSReputation(t *testing.T) {
+	ts := setupForPaymentTest(t)
+
+	ts.spec = common.CreateMockSpec()
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addClient(1)
+	require.Nil(t, err)
+	err = ts.addProvider(1)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	provider := ts.providers[0].address.String()
+	cuSum := ts.spec.Apis[0].ComputeUnits * 10
+	sendRelay := func(sessionID uint64, QoS *types.QualityOfServiceReport) {
+		relayRequest := &types.RelayRequest{
+			Provider:        provider,
+			ApiUrl:          "",
+			Data:            []byte(ts.spec.Apis[0].Name),
+			SessionId:       sessionID,
+			ChainID:         ts.spec.Name,
+			CuSum:           cuSum,
+			BlockHeight:     sdk.UnwrapSDKContext(ts.ctx).BlockHeight(),
+			RelayNum:        0,
+			RequestBlock:    -1,
+			QoSReport:       QoS,
+			DataReliability: nil,
+		}
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(ts.clients[0].secretKey, nil), *relayRequest)
+		require.Nil(t, err)
+		relayRequest.Sig = sig
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: provider, Relays: []*types.RelayRequest{relayRequest}})
+		require.Nil(t, err)
+	}
+
+	// relays without a report don't create a reputation
+	sendRelay(1, nil)
+	_, found := ts.keepers.Pairing.GetProviderQoS(sdk.UnwrapSDKContext(ts.ctx), provider, ts.spec.Index)
+	require.False(t, found)
+
+	firstReport := &types.QualityOfServiceReport{Latency: sdk.OneDec(), Availability: sdk.OneDec(), Sync: sdk.OneDec()}
+	sendRelay(2, firstReport)
+	providerQoS, found := ts.keepers.Pairing.GetProviderQoS(sdk.UnwrapSDKContext(ts.ctx), provider, ts.spec.Index)
+	require.True(t, found)
+	require.Equal(t, *firstReport, providerQoS.Score)
+	require.Equal(t, sdk.NewDec(int64(cuSum)), providerQoS.Weight)
+
+	// after an epoch the first report weighs less than a new one covering the same CU
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	decay := ts.keepers.Pairing.ReputationDecayFactor(ctx)
+	secondReport := &types.QualityOfServiceReport{Latency: sdk.ZeroDec(), Availability: sdk.ZeroDec(), Sync: sdk.ZeroDec()}
+	sendRelay(3, secondReport)
+
+	oldWeight := sdk.NewDec(int64(cuSum)).Mul(decay)
+	totalWeight := oldWeight.Add(sdk.NewDec(int64(cuSum)))
+	expected := oldWeight.Quo(totalWeight)
+	providerQoS, found = ts.keepers.Pairing.GetProviderQoS(ctx, provider, ts.spec.Index)
+	require.True(t, found)
+	require.Equal(t, expected, providerQoS.Score.Latency)
+	require.Equal(t, expected, providerQoS.Score.Availability)
+	require.Equal(t, expected, providerQoS.Score.Sync)
+	require.Equal(t, totalWeight, providerQoS.Weight)
+	require.True(t, expected.LT(sdk.NewDecWithPrec(5, 1)))
+
+	// the query decays the weight up to the current block
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	res, err := ts.keepers.Pairing.ProviderQoS(ts.ctx, &types.QueryProviderQoSRequest{Provider: provider, ChainID: ts.spec.Index})
+	require.Nil(t, err)
+	require.Equal(t, totalWeight.Mul(decay), res.ProviderQoS.Weight)
+	score, err := providerQoS.Score.ComputeQoS()
+	require.Nil(t, err)
+	require.Equal(t, score, res.Score)
+
+	_, err = ts.keepers.Pairing.ProviderQoS(ts.ctx, &types.QueryProviderQoSRequest{Provider: provider, ChainID: "noSuchChain"})
+	require.NotNil(t, err)
+}
@@ -13,7 +13,7 @@ func (k Keeper) BurnClientStake(ctx sdk.Context, chainID string, clientAddressTo
 		return false, fmt.Errorf("burn coin isn't right denom: %s", burnAmount.Denom)
 	}
 	// find the user in the stake list
-	clientEntry, found, indexFound := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientAddressToBurn)
+	clientEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientAddressToBurn)
 	if found {
 		if clientEntry.Stake.IsLT(burnAmount) {
 			if failBurnOnLeftover {
@@ -24,9 +24,9 @@ func (k Keeper) BurnClientStake(ctx sdk.Context, chainID string, clientAddressTo
 		// reduce the requested burn from the entry
 		clientEntry.Stake = clientEntry.Stake.Sub(burnAmount)
 		// now we need to save the entry
-		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientEntry, indexFound)
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientEntry)
 
-		spec, found := k.specKeeper.GetSpec(ctx, clientEntry.Chain)
+		spec, found := k.specKeeper.GetLatestSpec(ctx, clientEntry.Chain)
 		if !found {
 			return true, fmt.Errorf("could not fetch spec %s in burn client stake", clientEntry.Chain)
 		}
@@ -42,7 +42,7 @@ func (k Keeper) BurnClientStake(ctx sdk.Context, chainID string, clientAddressTo
 	}
 
 	// didnt find user in staked users
-	clientEntry, found, indexFound = k.epochStorageKeeper.UnstakeEntryByAddress(ctx, epochstoragetypes.ClientKey, clientAddressToBurn)
+	clientEntry, found, indexFound := k.epochStorageKeeper.UnstakeEntryByAddress(ctx, epochstoragetypes.ClientKey, clientAddressToBurn)
 	if found {
 		userAddr, err := sdk.AccAddressFromBech32(clientEntry.Address)
 		if err != nil {
@@ -78,13 +78,13 @@ func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress
 	case false:
 		storageType = epochstoragetypes.ClientKey
 	}
-	entry, found, indexFound := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, storageType, chainID, lookUpAddress)
+	entry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, storageType, chainID, lookUpAddress)
 	if found {
 		// add the requested credit to the entry
 		entry.Stake = entry.Stake.Add(creditAmount)
 		// now we need to save the entry
 		k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(creditAmount))
-		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, storageType, chainID, entry, indexFound)
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, storageType, chainID, entry)
 		return true, nil
 	}
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
@@ -10,7 +11,7 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string) error {
+func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string, operator string) error {
 	logger := k.Logger(ctx)
 	var stake_type string
 	if provider {
@@ -21,7 +22,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 	// TODO: basic validation for chain ID
 	specChainID := chainID
 
-	spec, found := k.specKeeper.GetSpec(ctx, specChainID)
+	spec, found := k.specKeeper.GetLatestSpec(ctx, specChainID)
 	if !found || !spec.Enabled {
 		details := map[string]string{"spec": specChainID}
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_spec", details, "spec not found or not active")
@@ -43,35 +44,13 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 		details := map[string]string{stake_type: creator, "error": err.Error()}
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_addr", details, "invalid "+stake_type+" address")
 	}
-	// define the function here for later use
-	verifySufficientAmountAndSendToModule := func(ctx sdk.Context, k Keeper, addr sdk.AccAddress, neededAmount sdk.Coin) error {
-		if k.bankKeeper.GetBalance(ctx, addr, epochstoragetypes.TokenDenom).IsLT(neededAmount) {
-			return fmt.Errorf("insufficient balance for staking %s current balance: %s", neededAmount, k.bankKeeper.GetBalance(ctx, addr, epochstoragetypes.TokenDenom))
-		}
-		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, []sdk.Coin{neededAmount})
-		if err != nil {
-			return fmt.Errorf("invalid transfer coins to module, %s", err)
-		}
-		return nil
+	err = k.validateStakeEntryDetails(ctx, provider, creator, specChainID, endpoints, geolocation, vrfpk)
+	if err != nil {
+		return err
 	}
-	geolocations := k.specKeeper.GeolocationCount(ctx)
-	if geolocation == 0 || geolocation > (1<<geolocations) {
-		details := map[string]string{"geolocation": strconv.FormatUint(geolocation, 10)}
-		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_geolocation", details, "can't register for no geolocation or geolocation outside zones")
-	}
-	if provider {
-		err := k.validateGeoLocationAndApiInterfaces(ctx, endpoints, geolocation, specChainID)
-		if err != nil {
-			details := map[string]string{stake_type: creator, "error": err.Error(), "endpoints": fmt.Sprintf("%v", endpoints), "Chain": specChainID, "geolocation": strconv.FormatUint(geolocation, 10)}
-			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_endpoints", details, "invalid "+stake_type+" endpoints implementation for the given spec")
-		}
-	} else {
-		// clients need to provide their VRF PK before running to limit brute forcing the random functions
-		err := utils.VerifyVRF(vrfpk)
-		if err != nil {
-			details := map[string]string{stake_type: creator, "error": err.Error()}
-			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_vrfpk", details, "invalid "+stake_type+" stake: invalid vrf pk, must provide a valid verification key")
-		}
+	if provider && operator != "" && !k.IsOperatorProvider(ctx, operator, creator) {
+		details := map[string]string{stake_type: creator, "operator": operator}
+		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_operator", details, "the operator didn't approve the provider")
 	}
 
 	// new staking takes effect from the next block
@@ -81,7 +60,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 		moniker = moniker[:50]
 	}
 
-	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
+	existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
 	if entryExists {
 		// modify the entry
 		if existingEntry.Address != creator {
@@ -90,42 +69,47 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 		}
 		details := map[string]string{"spec": specChainID, stake_type: senderAddr.String(), "deadline": strconv.FormatUint(blockDeadline, 10), "stake": amount.String()}
 		details["moniker"] = moniker
+		details["operator"] = operator
 		if existingEntry.Stake.IsLT(amount) {
 			// increasing stake is allowed
-			err := verifySufficientAmountAndSendToModule(ctx, k, senderAddr, amount.Sub(existingEntry.Stake))
+			err := k.verifySufficientAmountAndSendToModule(ctx, senderAddr, amount.Sub(existingEntry.Stake))
 			if err != nil {
 				details["error"] = err.Error()
 				details["neededStake"] = amount.Sub(existingEntry.Stake).String()
 				return utils.LavaError(ctx, logger, "stake_"+stake_type+"_update_amount", details, "insufficient funds to pay for difference in stake")
 			}
 
-			// TODO: create a new entry entirely because then we can keep the copies of this list as pointers only
-			// then we need to change the Copy of StoreCurrentEpochStakeStorage to copy of the pointers only
-			// must also change the unstaking to create a new entry entirely
-
 			// paid the difference to module
 			existingEntry.Stake = amount
-			// we dont change vrfpk, deadlines and chain once they are set, if they need to change, unstake first
+			// we dont change vrfpk, deadlines and chain here, the vrfpk is rotated with MsgUpdateClientVrfpk
 			existingEntry.Geolocation = geolocation
 			existingEntry.Endpoints = endpoints
 			existingEntry.Moniker = moniker
-			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stake_type, chainID, existingEntry, indexInStakeStorage)
-			utils.LogLavaEvent(ctx, logger, types.StakeUpdateEventName(provider), details, "Changing Staked "+stake_type)
+			existingEntry.Operator = operator
+			k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stake_type, chainID, existingEntry)
+			utils.LogLavaTypedEvent(ctx, logger, types.StakeUpdateEvent(provider, existingEntry), types.StakeUpdateEventName(provider), details, "Changing Staked "+stake_type)
 			return nil
 		}
 		details["existingStake"] = existingEntry.Stake.String()
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_stake", details, "can't decrease stake for existing "+stake_type)
 	}
 
+	if provider {
+		// a jailed provider that unstaked stays out of the pairing until its jail ends
+		if jailedUntil, jailed := k.IsProviderJailed(ctx, creator, chainID); jailed && jailedUntil > blockDeadline {
+			blockDeadline = jailedUntil
+		}
+	}
+
 	// entry isn't staked so add him
 	details := map[string]string{"spec": specChainID, stake_type: senderAddr.String(), "deadline": strconv.FormatUint(blockDeadline, 10), "stake": amount.String(), "geolocation": strconv.FormatUint(geolocation, 10)}
-	err = verifySufficientAmountAndSendToModule(ctx, k, senderAddr, amount)
+	err = k.verifySufficientAmountAndSendToModule(ctx, senderAddr, amount)
 	if err != nil {
 		details["error"] = err.Error()
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_new_amount", details, "insufficient amount to pay for stake")
 	}
 
-	stakeEntry := epochstoragetypes.StakeEntry{Stake: amount, Address: creator, Deadline: blockDeadline, Endpoints: endpoints, Geolocation: geolocation, Chain: chainID, Vrfpk: vrfpk, Moniker: moniker}
+	stakeEntry := epochstoragetypes.StakeEntry{Stake: amount, Address: creator, Deadline: blockDeadline, Endpoints: endpoints, Geolocation: geolocation, Chain: chainID, Vrfpk: vrfpk, Moniker: moniker, Operator: operator}
 	k.epochStorageKeeper.AppendStakeEntryCurrent(ctx, stake_type, chainID, stakeEntry)
 	appended := false
 	if !provider {
@@ -139,10 +123,217 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 	}
 	details["effectiveImmediately"] = strconv.FormatBool(appended)
 	details["moniker"] = moniker
-	utils.LogLavaEvent(ctx, logger, types.StakeNewEventName(provider), details, "Adding Staked "+stake_type)
+	if provider {
+		details["operator"] = operator
+	}
+	utils.LogLavaTypedEvent(ctx, logger, types.StakeNewEvent(provider, stakeEntry, appended), types.StakeNewEventName(provider), details, "Adding Staked "+stake_type)
 	return err
 }
 
+// ModifyStakeEntry updates an existing stake entry in place, the changes take effect at the next epoch snapshot.
+// a stake increase is paid to the module, a stake decrease is withdrawn through the unstake hold
+func (k Keeper) ModifyStakeEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string, operator string) error {
+	logger := k.Logger(ctx)
+	var stake_type string
+	if provider {
+		stake_type = epochstoragetypes.ProviderKey
+	} else {
+		stake_type = epochstoragetypes.ClientKey
+	}
+
+	spec, found := k.specKeeper.GetLatestSpec(ctx, chainID)
+	if !found || !spec.Enabled {
+		details := map[string]string{"spec": chainID}
+		return utils.LavaError(ctx, logger, "modify_"+stake_type+"_spec", details, "spec not found or not active")
+	}
+	var minStake sdk.Coin
+	if provider {
+		minStake = spec.MinStakeProvider
+	} else {
+		minStake = spec.MinStakeClient
+	}
+	if amount.IsLT(minStake) { // we count on this to also check the denom
+		details := map[string]string{"spec": chainID, stake_type: creator, "stake": amount.String(), "minStake": minStake.String()}
+		return utils.LavaError(ctx, logger, "modify_"+stake_type+"_amount", details, "insufficient "+stake_type+" stake amount, unstake to withdraw the whole stake")
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		details := map[string]string{stake_type: creator, "error": err.Error()}
+		return utils.LavaError(ctx, logger, "modify_"+stake_type+"_addr", details, "invalid "+stake_type+" address")
+	}
+	existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
+	if !entryExists {
+		details := map[string]string{stake_type: creator, "spec": chainID}
+		return utils.LavaError(ctx, logger, "modify_"+stake_type+"_entry", details, "can't modify entry, stake entry not found for address")
+	}
+	err = k.validateStakeEntryDetails(ctx, provider, creator, chainID, endpoints, geolocation, vrfpk)
+	if err != nil {
+		return err
+	}
+	if provider && operator != "" && !k.IsOperatorProvider(ctx, operator, creator) {
+		details := map[string]string{stake_type: creator, "operator": operator}
+		return utils.LavaError(ctx, logger, "modify_"+stake_type+"_operator", details, "the operator didn't approve the provider")
+	}
+
+	if len(moniker) > 50 {
+		moniker = moniker[:50]
+	}
+
+	details := map[string]string{"spec": chainID, stake_type: creator, "stake": amount.String(), "existingStake": existingEntry.Stake.String(), "geolocation": strconv.FormatUint(geolocation, 10)}
+	existingStake := existingEntry.Stake
+	withdrawn := sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+	var withdrawnDeadline uint64
+	if existingEntry.Stake.IsLT(amount) {
+		err := k.verifySufficientAmountAndSendToModule(ctx, senderAddr, amount.Sub(existingEntry.Stake))
+		if err != nil {
+			details["error"] = err.Error()
+			details["neededStake"] = amount.Sub(existingEntry.Stake).String()
+			return utils.LavaError(ctx, logger, "modify_"+stake_type+"_amount", details, "insufficient funds to pay for difference in stake")
+		}
+	} else if amount.IsLT(existingEntry.Stake) {
+		// the withdrawn part of the stake is held like an unstaked entry until its deadline
+		unstakeHoldBlocks, err := k.unstakeHoldBlocks(ctx, existingEntry.Chain, provider)
+		if err != nil {
+			return err
+		}
+		withdrawnEntry := existingEntry
+		withdrawnEntry.Stake = existingEntry.Stake.Sub(amount)
+		err = k.epochStorageKeeper.AppendUnstakeEntry(ctx, stake_type, withdrawnEntry, unstakeHoldBlocks)
+		if err != nil {
+			details["error"] = err.Error()
+			return utils.LavaError(ctx, logger, "modify_"+stake_type+"_unstake", details, "could not hold the withdrawn stake")
+		}
+		withdrawn = withdrawnEntry.Stake
+		withdrawnDeadline = uint64(ctx.BlockHeight()) + unstakeHoldBlocks
+		details["withdrawn"] = withdrawn.String()
+		details["withdrawnDeadline"] = strconv.FormatUint(withdrawnDeadline, 10)
+	}
+
+	existingEntry.Stake = amount
+	existingEntry.Geolocation = geolocation
+	if provider {
+		existingEntry.Endpoints = endpoints
+		existingEntry.Moniker = moniker
+		existingEntry.Operator = operator
+		details["moniker"] = moniker
+		details["operator"] = operator
+	} else {
+		existingEntry.Vrfpk = vrfpk
+	}
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stake_type, chainID, existingEntry)
+	utils.LogLavaTypedEvent(ctx, logger, types.StakeModifyEvent(provider, existingEntry, existingStake, withdrawn, withdrawnDeadline), types.StakeModifyEventName(provider), details, "Modifying Staked "+stake_type)
+	return nil
+}
+
+// UpdateClientVrfpk sets the vrf public key of all the client stake entries and the active subscription of creator.
+// the stake entries are fixated at the next epoch snapshot and the subscription keeps the replaced key for the epochs before it,
+// so relays are always verified against the key that was valid at their epoch
+func (k Keeper) UpdateClientVrfpk(ctx sdk.Context, creator string, vrfpk string) (effectiveEpoch uint64, err error) {
+	logger := k.Logger(ctx)
+	details := map[string]string{"client": creator}
+	clientAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		details["error"] = err.Error()
+		return 0, utils.LavaError(ctx, logger, "update_client_vrfpk_addr", details, "invalid client address")
+	}
+	err = utils.VerifyVRF(vrfpk)
+	if err == nil {
+		_, err = (&utils.VrfPubKey{}).DecodeFromBech32(vrfpk)
+	}
+	if err != nil {
+		details["error"] = err.Error()
+		return 0, utils.LavaError(ctx, logger, "update_client_vrfpk_vrfpk", details, "invalid vrf pk, must provide a valid verification key")
+	}
+	effectiveEpoch, err = k.epochStorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		details["error"] = err.Error()
+		return 0, utils.LavaError(ctx, logger, "update_client_vrfpk_epoch", details, "could not get the next epoch")
+	}
+
+	updatedChains := []string{}
+	for _, chainID := range k.specKeeper.GetAllChainIDs(ctx) {
+		stakeEntry, found := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, chainID, clientAddr)
+		if !found {
+			continue
+		}
+		stakeEntry.Vrfpk = vrfpk
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ClientKey, chainID, stakeEntry)
+		updatedChains = append(updatedChains, chainID)
+	}
+
+	subscription, subscribed := k.GetSubscription(ctx, creator)
+	if subscribed {
+		// replaced keys are dropped once their epochs are too old to be paid for
+		earliestEpochStart := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+		replacedVrfpks := []types.ReplacedVrfpk{}
+		for _, replaced := range subscription.ReplacedVrfpks {
+			if replaced.ValidUntilEpoch > earliestEpochStart {
+				replacedVrfpks = append(replacedVrfpks, replaced)
+			}
+		}
+		// a key that was rotated again before it took effect never verified any relay
+		if len(replacedVrfpks) == 0 || replacedVrfpks[len(replacedVrfpks)-1].ValidUntilEpoch < effectiveEpoch {
+			replacedVrfpks = append(replacedVrfpks, types.ReplacedVrfpk{Vrfpk: subscription.Vrfpk, ValidUntilEpoch: effectiveEpoch})
+		}
+		subscription.ReplacedVrfpks = replacedVrfpks
+		subscription.Vrfpk = vrfpk
+		k.SetSubscription(ctx, subscription)
+	}
+
+	if len(updatedChains) == 0 && !subscribed {
+		return 0, utils.LavaError(ctx, logger, "update_client_vrfpk_entry", details, "can't update vrf pk, no client stake entry or active subscription for address")
+	}
+
+	details["vrfpk"] = vrfpk
+	details["chainIDs"] = strings.Join(updatedChains, ",")
+	details["subscription"] = strconv.FormatBool(subscribed)
+	details["effectiveEpoch"] = strconv.FormatUint(effectiveEpoch, 10)
+	event := &types.EventClientVrfpkUpdate{Client: creator, Vrfpk: vrfpk, ChainIDs: updatedChains, Subscription: subscribed, EffectiveEpoch: effectiveEpoch}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.ClientVrfpkUpdateEventName, details, "Updating Client Vrf Pk")
+	return effectiveEpoch, nil
+}
+
+func (k Keeper) verifySufficientAmountAndSendToModule(ctx sdk.Context, addr sdk.AccAddress, neededAmount sdk.Coin) error {
+	if k.bankKeeper.GetBalance(ctx, addr, epochstoragetypes.TokenDenom).IsLT(neededAmount) {
+		return fmt.Errorf("insufficient balance for staking %s current balance: %s", neededAmount, k.bankKeeper.GetBalance(ctx, addr, epochstoragetypes.TokenDenom))
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, []sdk.Coin{neededAmount})
+	if err != nil {
+		return fmt.Errorf("invalid transfer coins to module, %s", err)
+	}
+	return nil
+}
+
+func (k Keeper) validateStakeEntryDetails(ctx sdk.Context, provider bool, creator string, chainID string, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string) error {
+	logger := k.Logger(ctx)
+	var stake_type string
+	if provider {
+		stake_type = epochstoragetypes.ProviderKey
+	} else {
+		stake_type = epochstoragetypes.ClientKey
+	}
+	geolocations := k.specKeeper.GeolocationCount(ctx)
+	if geolocation == 0 || geolocation > (1<<geolocations) {
+		details := map[string]string{"geolocation": strconv.FormatUint(geolocation, 10)}
+		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_geolocation", details, "can't register for no geolocation or geolocation outside zones")
+	}
+	if provider {
+		err := k.validateGeoLocationAndApiInterfaces(ctx, endpoints, geolocation, chainID)
+		if err != nil {
+			details := map[string]string{stake_type: creator, "error": err.Error(), "endpoints": fmt.Sprintf("%v", endpoints), "Chain": chainID, "geolocation": strconv.FormatUint(geolocation, 10)}
+			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_endpoints", details, "invalid "+stake_type+" endpoints implementation for the given spec")
+		}
+	} else {
+		// clients need to provide their VRF PK before running to limit brute forcing the random functions
+		err := utils.VerifyVRF(vrfpk)
+		if err != nil {
+			details := map[string]string{stake_type: creator, "error": err.Error()}
+			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_vrfpk", details, "invalid "+stake_type+" stake: invalid vrf pk, must provide a valid verification key")
+		}
+	}
+	return nil
+}
+
 func (k Keeper) validateGeoLocationAndApiInterfaces(ctx sdk.Context, endpoints []epochstoragetypes.Endpoint, geolocation uint64, chainID string) (err error) {
 	expectedInterfaces := k.specKeeper.GetExpectedInterfacesForSpec(ctx, chainID)
 	geolocMap := map[string]bool{} // TODO: turn this into spectypes.ApiInterface
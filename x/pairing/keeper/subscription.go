@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetSubscription set a specific subscription in the store from its consumer
+func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionKeyPrefix))
+	b := k.cdc.MustMarshal(&subscription)
+	store.Set(types.SubscriptionKey(
+		subscription.Consumer,
+	), b)
+}
+
+// GetSubscription returns a subscription from its consumer
+func (k Keeper) GetSubscription(
+	ctx sdk.Context,
+	consumer string,
+) (val types.Subscription, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionKeyPrefix))
+
+	b := store.Get(types.SubscriptionKey(
+		consumer,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSubscription removes a subscription from the store
+func (k Keeper) RemoveSubscription(
+	ctx sdk.Context,
+	consumer string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionKeyPrefix))
+	store.Delete(types.SubscriptionKey(
+		consumer,
+	))
+}
+
+// GetAllSubscription returns all subscription
+func (k Keeper) GetAllSubscription(ctx sdk.Context) (list []types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Subscription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetExpiredSubscription set an expired subscription in the store from its consumer and expiry block
+func (k Keeper) SetExpiredSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExpiredSubscriptionKeyPrefix))
+	b := k.cdc.MustMarshal(&subscription)
+	store.Set(types.ExpiredSubscriptionKey(
+		subscription.Consumer,
+		subscription.ExpiryBlock,
+	), b)
+}
+
+// RemoveExpiredSubscription removes an expired subscription from the store
+func (k Keeper) RemoveExpiredSubscription(
+	ctx sdk.Context,
+	consumer string,
+	expiryBlock uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExpiredSubscriptionKeyPrefix))
+	store.Delete(types.ExpiredSubscriptionKey(
+		consumer,
+		expiryBlock,
+	))
+}
+
+// GetAllExpiredSubscription returns all expired subscription
+func (k Keeper) GetAllExpiredSubscription(ctx sdk.Context) (list []types.Subscription) {
+	return k.getExpiredSubscriptions(ctx, []byte{})
+}
+
+// getExpiredSubscriptions returns the expired subscriptions whose store key starts with keyPrefix
+func (k Keeper) getExpiredSubscriptions(ctx sdk.Context, keyPrefix []byte) (list []types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExpiredSubscriptionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Subscription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetSubscriptionForBlock returns the consumer subscription if it can be used on chainID at the given block,
+// blocks before the expiry of a previous subscription are served by the expired subscription
+func (k Keeper) GetSubscriptionForBlock(ctx sdk.Context, consumer sdk.AccAddress, chainID string, block uint64) (val types.Subscription, found bool) {
+	subscriptions := k.getExpiredSubscriptions(ctx, types.SubscriptionKey(consumer.String()))
+	if subscription, found := k.GetSubscription(ctx, consumer.String()); found {
+		subscriptions = append(subscriptions, subscription)
+	}
+	for _, subscription := range subscriptions {
+		if subscription.IsActiveForBlock(block) {
+			if !subscription.Plan.CoversChain(chainID) {
+				return val, false
+			}
+			return subscription, true
+		}
+	}
+	return val, false
+}
+
+// SubscriptionStakeEntry creates a stake entry representing the subscription consumer on chainID at the given epoch, so pairing can treat it like a staked client
+func SubscriptionStakeEntry(subscription types.Subscription, chainID string, epoch uint64) epochstoragetypes.StakeEntry {
+	return epochstoragetypes.StakeEntry{
+		Stake:       sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt()),
+		Address:     subscription.Consumer,
+		Deadline:    subscription.StartBlock,
+		Geolocation: subscription.Geolocation,
+		Chain:       chainID,
+		Vrfpk:       subscription.VrfpkForEpoch(epoch),
+	}
+}
+
+// nextMonthTime returns the unix time one calendar month after the given unix time
+func nextMonthTime(unixTime uint64) uint64 {
+	return uint64(time.Unix(int64(unixTime), 0).UTC().AddDate(0, 1, 0).Unix())
+}
+
+// ChargeSubscriptionCU reduces the CU used from the consumer monthly quota, the quota is shared across all chains
+func (k Keeper) ChargeSubscriptionCU(ctx sdk.Context, subscription types.Subscription, cuSum uint64) error {
+	if cuSum > subscription.MonthCuLeft {
+		return fmt.Errorf("subscription of %s has %d CU left this month, requested: %d", subscription.Consumer, subscription.MonthCuLeft, cuSum)
+	}
+	subscription.MonthCuLeft -= cuSum
+	if subscription.ExpiryBlock != 0 {
+		k.SetExpiredSubscription(ctx, subscription)
+	} else {
+		k.SetSubscription(ctx, subscription)
+	}
+	return nil
+}
+
+// UpdateSubscriptions is called on epoch start, it renews the monthly quota of subscriptions and snapshots the quota left for the epoch,
+// moves finished subscriptions to the expired subscriptions and removes expired subscriptions that can no longer be paid for
+func (k Keeper) UpdateSubscriptions(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	block := uint64(ctx.BlockHeight())
+	now := uint64(ctx.BlockTime().UTC().Unix())
+	earliestEpochStart := k.epochStorageKeeper.GetEarliestEpochStart(ctx)
+
+	// relay payments for blocks before the expiry are accepted until the epoch is no longer saved
+	for _, subscription := range k.GetAllExpiredSubscription(ctx) {
+		if subscription.ExpiryBlock < earliestEpochStart {
+			k.RemoveExpiredSubscription(ctx, subscription.Consumer, subscription.ExpiryBlock)
+		}
+	}
+
+	for _, subscription := range k.GetAllSubscription(ctx) {
+		details := map[string]string{"consumer": subscription.Consumer, "plan": subscription.Plan.Index}
+		if now >= subscription.MonthExpiryTime {
+			if subscription.MonthsLeft == 0 {
+				// the quota left is kept for the relays served before the expiry, the consumer can already buy a new subscription
+				subscription.ExpiryBlock = block
+				k.RemoveSubscription(ctx, subscription.Consumer)
+				k.SetExpiredSubscription(ctx, subscription)
+				details["expiryBlock"] = strconv.FormatUint(block, 10)
+				event := &types.EventSubscriptionExpired{Consumer: subscription.Consumer, Plan: subscription.Plan.Index, ExpiryBlock: block}
+				utils.LogLavaTypedEvent(ctx, logger, event, types.SubscriptionExpiredEventName, details, "Subscription Expired")
+				continue
+			}
+			subscription.MonthsLeft--
+			subscription.MonthCuLeft = subscription.Plan.MonthlyComputeUnits
+			subscription.MonthExpiryTime = nextMonthTime(subscription.MonthExpiryTime)
+			details["monthsLeft"] = strconv.FormatUint(subscription.MonthsLeft, 10)
+			details["monthCU"] = strconv.FormatUint(subscription.MonthCuLeft, 10)
+			event := &types.EventSubscriptionRenew{Consumer: subscription.Consumer, Plan: subscription.Plan.Index, MonthsLeft: subscription.MonthsLeft, MonthCU: subscription.MonthCuLeft}
+			utils.LogLavaTypedEvent(ctx, logger, event, types.SubscriptionRenewEventName, details, "Subscription Month Renewed")
+		}
+		// the per provider quota of the epoch is fixed now, so charging relays of one provider doesn't lower the quota of the others
+		subscription.EpochCuLeft = subscription.MonthCuLeft
+		k.SetSubscription(ctx, subscription)
+	}
+}
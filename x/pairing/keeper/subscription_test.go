@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/testutil/common"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+const planPrice int64 = 1000
+
+func createMockPlan(chainIDs []string) types.Plan {
+	return types.Plan{
+		Index:               "mockPlan",
+		Name:                "mock plan",
+		Price:               sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(planPrice)),
+		DurationMonths:      2,
+		MonthlyComputeUnits: 10000,
+		ChainIds:            chainIDs,
+		Enabled:             true,
+	}
+}
+
+// setupForSubscriptionTest creates a mock spec with a staked provider and a consumer that didn't stake
+func setupForSubscriptionTest(t *testing.T) (*testStruct, common.Account) {
+	ts := &testStruct{
+		providers: make([]*account, 0),
+		clients:   make([]*account, 0),
+	}
+	ts.servers, ts.keepers, ts.ctx = testkeeper.InitAllKeepers(t)
+	ts.ctx = sdk.WrapSDKContext(sdk.UnwrapSDKContext(ts.ctx).WithBlockTime(time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)))
+
+	ts.spec = common.CreateMockSpec()
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), ts.spec)
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+
+	consumer := common.CreateNewAccount(ts.ctx, *ts.keepers, balance)
+	return ts, consumer
+}
+
+func buySubscription(ts *testStruct, consumer common.Account, planIndex string) error {
+	_, pk, _ := utils.GeneratePrivateVRFKey()
+	vrfPk := &utils.VrfPubKey{}
+	vrfPk.Unmarshal(pk)
+	_, err := ts.servers.PairingServer.BuySubscription(ts.ctx, &types.MsgBuySubscription{Creator: consumer.Addr.String(), Index: planIndex, Geolocation: 1, Vrfpk: vrfPk.String()})
+	return err
+}
+
+func TestBuySubscription(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	plan := createMockPlan(nil)
+	disabledPlan := createMockPlan(nil)
+	disabledPlan.Index = "disabledPlan"
+	disabledPlan.Enabled = false
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(ctx, types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan, disabledPlan}))
+	require.Nil(t, err)
+
+	poorConsumer := common.CreateNewAccount(ts.ctx, *ts.keepers, planPrice-1)
+
+	err = buySubscription(ts, consumer, "noSuchPlan")
+	require.NotNil(t, err)
+	err = buySubscription(ts, consumer, disabledPlan.Index)
+	require.NotNil(t, err)
+	err = buySubscription(ts, poorConsumer, plan.Index)
+	require.NotNil(t, err)
+
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	require.Equal(t, balance-planPrice, ts.keepers.BankKeeper.GetBalance(ctx, consumer.Addr, epochstoragetypes.TokenDenom).Amount.Int64())
+
+	subscription, found := ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, plan.MonthlyComputeUnits, subscription.MonthCuLeft)
+	require.Equal(t, plan.DurationMonths-1, subscription.MonthsLeft)
+	require.Equal(t, uint64(0), subscription.ExpiryBlock)
+
+	// can't buy a second subscription while the first is active
+	err = buySubscription(ts, consumer, plan.Index)
+	require.NotNil(t, err)
+}
+
+func TestSubscriptionPairingAndPayment(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+
+	// the quota of each provider in an epoch is the monthly quota left split between the paired providers, two relays of cu
+	servicersToPairCount := ts.keepers.Pairing.ServicersToPairCountRaw(sdk.UnwrapSDKContext(ts.ctx))
+	cu := ts.spec.Apis[0].ComputeUnits * 10
+	plan := createMockPlan([]string{ts.spec.Index})
+	plan.MonthlyComputeUnits = cu * 2 * servicersToPairCount
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.Nil(t, err)
+
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	// the consumer gets a pairing without staking
+	providers, err := ts.keepers.Pairing.GetPairingForClient(ctx, ts.spec.Index, consumer.Addr)
+	require.Nil(t, err)
+	require.Len(t, providers, 1)
+
+	consumerBalance := ts.keepers.BankKeeper.GetBalance(ctx, consumer.Addr, epochstoragetypes.TokenDenom)
+	providerBalance := ts.keepers.BankKeeper.GetBalance(ctx, ts.providers[0].address, epochstoragetypes.TokenDenom)
+
+	sendRelay := func(sessionID uint64, cu uint64) error {
+		relayRequest := &types.RelayRequest{
+			Provider:        ts.providers[0].address.String(),
+			ApiUrl:          "",
+			Data:            []byte(ts.spec.Apis[0].Name),
+			SessionId:       sessionID,
+			ChainID:         ts.spec.Name,
+			CuSum:           cu,
+			BlockHeight:     ctx.BlockHeight(),
+			RelayNum:        0,
+			RequestBlock:    -1,
+			DataReliability: nil,
+		}
+		sig, err := sigs.SignRelay(sigs.NewLocalSigner(consumer.SK, nil), *relayRequest)
+		require.Nil(t, err)
+		relayRequest.Sig = sig
+		_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*types.RelayRequest{relayRequest}})
+		return err
+	}
+
+	err = sendRelay(1, cu)
+	require.Nil(t, err)
+
+	// the provider got paid and the quota was charged instead of the consumer balance
+	require.True(t, ts.keepers.BankKeeper.GetBalance(ctx, ts.providers[0].address, epochstoragetypes.TokenDenom).IsGTE(providerBalance))
+	require.Equal(t, consumerBalance, ts.keepers.BankKeeper.GetBalance(ctx, consumer.Addr, epochstoragetypes.TokenDenom))
+	subscription, found := ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, plan.MonthlyComputeUnits-cu, subscription.MonthCuLeft)
+
+	// the provider quota left in the epoch isn't enough for a relay of twice the size, even though the monthly quota is
+	err = sendRelay(2, cu*2)
+	require.NotNil(t, err)
+	subscription, found = ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, plan.MonthlyComputeUnits-cu, subscription.MonthCuLeft)
+
+	// relays paid to other providers don't lower the provider quota until the next epoch
+	consumerEntry := keeper.SubscriptionStakeEntry(subscription, ts.spec.Index, uint64(ctx.BlockHeight()))
+	err = ts.keepers.Pairing.ChargeSubscriptionCU(ctx, subscription, cu)
+	require.Nil(t, err)
+	maxCU, err := ts.keepers.Pairing.ClientMaxCUProviderForBlock(ctx, uint64(ctx.BlockHeight()), &consumerEntry)
+	require.Nil(t, err)
+	require.Equal(t, cu*2, maxCU)
+
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx = sdk.UnwrapSDKContext(ts.ctx)
+	maxCU, err = ts.keepers.Pairing.ClientMaxCUProviderForBlock(ctx, uint64(ctx.BlockHeight()), &consumerEntry)
+	require.Nil(t, err)
+	require.Equal(t, (plan.MonthlyComputeUnits-cu*2)/servicersToPairCount, maxCU)
+}
+
+func TestSubscriptionChargedWithoutReward(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+
+	plan := createMockPlan(nil)
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.Nil(t, err)
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+
+	params := ts.keepers.Pairing.GetParams(sdk.UnwrapSDKContext(ts.ctx))
+	params.MintCoinsPerCU = sdk.ZeroDec()
+	ts.keepers.Pairing.SetParams(sdk.UnwrapSDKContext(ts.ctx), params)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+
+	providerBalance := ts.keepers.BankKeeper.GetBalance(ctx, ts.providers[0].address, epochstoragetypes.TokenDenom)
+	cu := ts.spec.Apis[0].ComputeUnits * 10
+	relayRequest := &types.RelayRequest{
+		Provider:     ts.providers[0].address.String(),
+		Data:         []byte(ts.spec.Apis[0].Name),
+		SessionId:    1,
+		ChainID:      ts.spec.Name,
+		CuSum:        cu,
+		BlockHeight:  ctx.BlockHeight(),
+		RelayNum:     0,
+		RequestBlock: -1,
+	}
+	relayRequest.Sig, err = sigs.SignRelay(sigs.NewLocalSigner(consumer.SK, nil), *relayRequest)
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.RelayPayment(ts.ctx, &types.MsgRelayPayment{Creator: ts.providers[0].address.String(), Relays: []*types.RelayRequest{relayRequest}})
+	require.Nil(t, err)
+
+	// nothing is minted but the quota is still charged
+	require.Equal(t, providerBalance, ts.keepers.BankKeeper.GetBalance(ctx, ts.providers[0].address, epochstoragetypes.TokenDenom))
+	subscription, found := ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, plan.MonthlyComputeUnits-cu, subscription.MonthCuLeft)
+}
+
+func TestSubscriptionChainNotCovered(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+
+	otherSpec := common.CreateMockSpec()
+	otherSpec.Index = "otherSpec"
+	otherSpec.Name = otherSpec.Index
+	ts.keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ts.ctx), otherSpec)
+
+	plan := createMockPlan([]string{otherSpec.Index})
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.Nil(t, err)
+
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	_, err = ts.keepers.Pairing.GetPairingForClient(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Index, consumer.Addr)
+	require.NotNil(t, err)
+
+	// plans can't cover chains that don't exist
+	plan.ChainIds = []string{"noSuchSpec"}
+	err = pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.NotNil(t, err)
+}
+
+func TestSubscriptionMonthlyRenewAndExpiry(t *testing.T) {
+	ts, consumer := setupForSubscriptionTest(t)
+
+	plan := createMockPlan(nil)
+	err := pairing.NewPairingProposalsHandler(ts.keepers.Pairing)(sdk.UnwrapSDKContext(ts.ctx), types.NewPlansAddProposal("plans", "add plans", []types.Plan{plan}))
+	require.Nil(t, err)
+
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+
+	subscription, found := ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.True(t, found)
+	err = ts.keepers.Pairing.ChargeSubscriptionCU(sdk.UnwrapSDKContext(ts.ctx), subscription, 100)
+	require.Nil(t, err)
+
+	advanceMonth := func() {
+		ctx := sdk.UnwrapSDKContext(ts.ctx)
+		ts.ctx = sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().AddDate(0, 1, 0)))
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+
+	// the second month renews the quota
+	advanceMonth()
+	subscription, found = ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(0), subscription.MonthsLeft)
+	require.Equal(t, plan.MonthlyComputeUnits, subscription.MonthCuLeft)
+	require.Equal(t, uint64(0), subscription.ExpiryBlock)
+
+	// after the last month the subscription expires
+	blockBeforeExpiry := uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
+	advanceMonth()
+	ctx := sdk.UnwrapSDKContext(ts.ctx)
+	_, found = ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.False(t, found)
+	_, err = ts.keepers.Pairing.GetPairingForClient(ctx, ts.spec.Index, consumer.Addr)
+	require.NotNil(t, err)
+
+	// the expired subscription is kept apart, so the consumer can buy a new one right away
+	err = buySubscription(ts, consumer, plan.Index)
+	require.Nil(t, err)
+	newSubscription, found := ts.keepers.Pairing.GetSubscription(ctx, consumer.Addr.String())
+	require.True(t, found)
+	require.Equal(t, uint64(0), newSubscription.ExpiryBlock)
+
+	// relays from before the expiry are still paid against the expired subscription
+	expired, found := ts.keepers.Pairing.GetSubscriptionForBlock(ctx, consumer.Addr, ts.spec.Index, blockBeforeExpiry)
+	require.True(t, found)
+	require.Equal(t, uint64(ctx.BlockHeight()), expired.ExpiryBlock)
+	err = ts.keepers.Pairing.ChargeSubscriptionCU(ctx, expired, 100)
+	require.Nil(t, err)
+	expired, found = ts.keepers.Pairing.GetSubscriptionForBlock(ctx, consumer.Addr, ts.spec.Index, blockBeforeExpiry)
+	require.True(t, found)
+	require.Equal(t, plan.MonthlyComputeUnits-100, expired.MonthCuLeft)
+	current, found := ts.keepers.Pairing.GetSubscriptionForBlock(ctx, consumer.Addr, ts.spec.Index, uint64(ctx.BlockHeight()))
+	require.True(t, found)
+	require.Equal(t, newSubscription, current)
+
+	// once the expiry block is out of memory the expired subscription is removed
+	epochsToSave, err := ts.keepers.Epochstorage.EpochsToSave(ctx, uint64(ctx.BlockHeight()))
+	require.Nil(t, err)
+	for i := uint64(0); i <= epochsToSave+1; i++ {
+		ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	}
+	require.Empty(t, ts.keepers.Pairing.GetAllExpiredSubscription(sdk.UnwrapSDKContext(ts.ctx)))
+	_, found = ts.keepers.Pairing.GetSubscription(sdk.UnwrapSDKContext(ts.ctx), consumer.Addr.String())
+	require.True(t, found)
+}
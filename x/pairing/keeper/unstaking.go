@@ -22,7 +22,7 @@ func (k Keeper) UnstakeEntry(ctx sdk.Context, provider bool, chainID string, cre
 	// TODO: validate chainID basic validation
 
 	// we can unstake disabled specs, but not missing ones
-	_, found := k.specKeeper.GetSpec(ctx, chainID)
+	_, found := k.specKeeper.GetLatestSpec(ctx, chainID)
 	if !found {
 		return utils.LavaError(ctx, logger, "unstake_spec_missing", map[string]string{"spec": chainID}, "trying to unstake an entry on missing spec")
 	}
@@ -32,15 +32,15 @@ func (k Keeper) UnstakeEntry(ctx sdk.Context, provider bool, chainID string, cre
 		return utils.LavaError(ctx, logger, "unstake_"+stake_type+"_addr", details, "invalid "+stake_type+" address")
 	}
 
-	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
+	existingEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
 	if !entryExists {
 		details := map[string]string{stake_type: creator, "spec": chainID}
 		return utils.LavaError(ctx, logger, stake_type+"_unstake_entry", details, "can't unstake Entry, stake entry not found for address")
 	}
-	err = k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, stake_type, chainID, indexInStakeStorage)
+	err = k.epochStorageKeeper.RemoveStakeEntryCurrent(ctx, stake_type, chainID, senderAddr)
 	if err != nil {
-		details := map[string]string{stake_type: creator, "spec": chainID, "index": strconv.FormatUint(indexInStakeStorage, 10)}
-		return utils.LavaError(ctx, logger, stake_type+"_unstake_entry", details, "can't remove stake Entry, stake entry not found in index")
+		details := map[string]string{stake_type: creator, "spec": chainID}
+		return utils.LavaError(ctx, logger, stake_type+"_unstake_entry", details, "can't remove stake Entry, stake entry not found")
 	}
 
 	details := map[string]string{
@@ -50,7 +50,7 @@ func (k Keeper) UnstakeEntry(ctx sdk.Context, provider bool, chainID string, cre
 		"moniker":     existingEntry.GetMoniker(),
 		"stake":       existingEntry.GetStake().Amount.String(),
 	}
-	utils.LogLavaEvent(ctx, logger, types.UnstakeCommitNewEventName(provider), details, unstakeDescription)
+	utils.LogLavaTypedEvent(ctx, logger, types.UnstakeEvent(provider, existingEntry, unstakeDescription), types.UnstakeCommitNewEventName(provider), details, unstakeDescription)
 
 	unstakeHoldBlocks, err := k.unstakeHoldBlocks(ctx, existingEntry.Chain, provider)
 	if err != nil {
@@ -60,6 +60,84 @@ func (k Keeper) UnstakeEntry(ctx sdk.Context, provider bool, chainID string, cre
 	return k.epochStorageKeeper.AppendUnstakeEntry(ctx, stake_type, existingEntry, unstakeHoldBlocks)
 }
 
+// CancelUnstake returns the unstaking entries of an address to the current stake entries, it takes effect at the next epoch
+func (k Keeper) CancelUnstake(ctx sdk.Context, provider bool, chainID string, creator string) error {
+	logger := k.Logger(ctx)
+	var stake_type string
+	if provider {
+		stake_type = epochstoragetypes.ProviderKey
+	} else {
+		stake_type = epochstoragetypes.ClientKey
+	}
+
+	spec, found := k.specKeeper.GetLatestSpec(ctx, chainID)
+	if !found || !spec.Enabled {
+		details := map[string]string{"spec": chainID}
+		return utils.LavaError(ctx, logger, "cancel_unstake_"+stake_type+"_spec", details, "spec not found or not active")
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		details := map[string]string{stake_type: creator, "error": err.Error()}
+		return utils.LavaError(ctx, logger, "cancel_unstake_"+stake_type+"_addr", details, "invalid "+stake_type+" address")
+	}
+	if provider {
+		// a jailed provider can't use the cancel to get back to the pairing before its penalty ends
+		if jailedUntil, jailed := k.IsProviderJailed(ctx, creator, chainID); jailed {
+			details := map[string]string{stake_type: creator, "spec": chainID, "jailedUntil": strconv.FormatUint(jailedUntil, 10)}
+			return utils.LavaError(ctx, logger, "cancel_unstake_"+stake_type+"_jailed", details, "can't cancel unstake, provider is jailed")
+		}
+	}
+
+	unstakeEntries := k.epochStorageKeeper.PopUnstakeEntriesByAddress(ctx, stake_type, chainID, senderAddr)
+	if len(unstakeEntries) == 0 {
+		details := map[string]string{stake_type: creator, "spec": chainID}
+		return utils.LavaError(ctx, logger, "cancel_unstake_"+stake_type+"_entry", details, "can't cancel unstake, unstaking entry not found for address")
+	}
+
+	// an entry that is still staked only had part of its stake withdrawn, the withdrawn stake is added back to it
+	stakeEntry, entryExists := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
+	if !entryExists {
+		stakeEntry = unstakeEntries[0]
+		stakeEntry.Stake = sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.ZeroInt())
+		// new staking takes effect from the next block
+		stakeEntry.Deadline = uint64(ctx.BlockHeight()) + 1
+	}
+	for _, unstakeEntry := range unstakeEntries {
+		stakeEntry.Stake = stakeEntry.Stake.Add(unstakeEntry.Stake)
+	}
+
+	var minStake sdk.Coin
+	if provider {
+		minStake = spec.MinStakeProvider
+	} else {
+		minStake = spec.MinStakeClient
+	}
+	details := map[string]string{
+		"address":     stakeEntry.GetAddress(),
+		"chainID":     stakeEntry.GetChain(),
+		"geolocation": strconv.FormatUint(stakeEntry.GetGeolocation(), 10),
+		"moniker":     stakeEntry.GetMoniker(),
+		"stake":       stakeEntry.GetStake().Amount.String(),
+		"deadline":    strconv.FormatUint(stakeEntry.GetDeadline(), 10),
+	}
+	if stakeEntry.Stake.IsLT(minStake) {
+		details["minStake"] = minStake.String()
+		return utils.LavaError(ctx, logger, "cancel_unstake_"+stake_type+"_amount", details, "insufficient "+stake_type+" stake amount")
+	}
+	err = k.validateStakeEntryDetails(ctx, provider, creator, chainID, stakeEntry.Endpoints, stakeEntry.Geolocation, stakeEntry.Vrfpk)
+	if err != nil {
+		return err
+	}
+
+	if entryExists {
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stake_type, chainID, stakeEntry)
+	} else {
+		k.epochStorageKeeper.AppendStakeEntryCurrent(ctx, stake_type, chainID, stakeEntry)
+	}
+	utils.LogLavaTypedEvent(ctx, logger, types.UnstakeCancelEvent(provider, stakeEntry), types.UnstakeCancelEventName(provider), details, "Canceled Unstaking "+stake_type)
+	return nil
+}
+
 func (k Keeper) CheckUnstakingForCommit(ctx sdk.Context) error {
 	// this pops all the entries that had their deadline pass
 	unstakingEntriesToCredit := k.epochStorageKeeper.PopUnstakeEntries(ctx, epochstoragetypes.ProviderKey, uint64(ctx.BlockHeight()))
@@ -116,7 +194,7 @@ func (k Keeper) creditUnstakingEntries(ctx sdk.Context, provider bool, entriesTo
 					utils.LavaError(ctx, logger, stake_type+"_unstaking_credit", details, "verifySufficientAmountAndSendFromModuleToAddress Failed,")
 					panic(fmt.Sprintf("error unstaking : %s", err))
 				}
-				utils.LogLavaEvent(ctx, logger, types.UnstakeCommitNewEventName(provider), details, "Unstaking Providers Commit")
+				utils.LogLavaTypedEvent(ctx, logger, types.UnstakeCommitEvent(provider, unstakingEntry), types.UnstakeCommitNewEventName(provider), details, "Unstaking Providers Commit")
 			}
 		} else {
 			// found an entry that isn't handled now, but later because its deadline isnt current block
@@ -127,7 +205,7 @@ func (k Keeper) creditUnstakingEntries(ctx sdk.Context, provider bool, entriesTo
 }
 
 func (k Keeper) unstakeHoldBlocks(ctx sdk.Context, chainID string, isProvider bool) (uint64, error) {
-	spec, found := k.specKeeper.GetSpec(ctx, chainID)
+	spec, found := k.specKeeper.GetLatestSpec(ctx, chainID)
 	if !found {
 		return 0, fmt.Errorf("coult not find spec %s", chainID)
 	}
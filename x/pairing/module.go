@@ -99,9 +99,11 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        keeper.Keeper
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	keeper             keeper.Keeper
+	accountKeeper      types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	specKeeper         types.SpecKeeper
+	epochstorageKeeper types.EpochstorageKeeper
 }
 
 func NewAppModule(
@@ -109,12 +111,16 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	specKeeper types.SpecKeeper,
+	epochstorageKeeper types.EpochstorageKeeper,
 ) AppModule {
 	return AppModule{
-		AppModuleBasic: NewAppModuleBasic(cdc),
-		keeper:         keeper,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
+		AppModuleBasic:     NewAppModuleBasic(cdc),
+		keeper:             keeper,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		specKeeper:         specKeeper,
+		epochstorageKeeper: epochstorageKeeper,
 	}
 }
 
@@ -180,6 +186,10 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		// 1. remove old session payments
 		// 2. unstake any unstaking providers
 		// 3. unstake any unstaking users
+		// 4. renew and expire subscriptions
+		// 5. fixate the specs for this epoch
+		// 6. snapshot the provider QoS factors for this epoch and remove old ones
+		// 7. remove the fee-free transaction counts of the previous epochs
 
 		// 1.
 		err := am.keeper.RemoveOldEpochPayment(ctx)
@@ -188,6 +198,19 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		// 2+3.
 		err = am.keeper.CheckUnstakingForCommit(ctx)
 		logOnErr(err, "CheckUnstakingForCommit")
+
+		// 4.
+		am.keeper.UpdateSubscriptions(ctx)
+
+		// 5.
+		am.keeper.FixateSpecs(ctx)
+
+		// 6.
+		am.keeper.RemoveOldEpochQoSFactors(ctx)
+		am.keeper.StoreEpochQoSFactors(ctx)
+
+		// 7.
+		am.keeper.RemoveOldFreeTxQuotas(ctx)
 	}
 }
 
@@ -105,7 +105,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgStakeProvider,
-		pairingsimulation.SimulateMsgStakeProvider(am.accountKeeper, am.bankKeeper, am.keeper),
+		pairingsimulation.SimulateMsgStakeProvider(am.accountKeeper, am.bankKeeper, am.specKeeper, am.epochstorageKeeper, am.keeper),
 	))
 
 	var weightMsgStakeClient int
@@ -116,7 +116,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgStakeClient,
-		pairingsimulation.SimulateMsgStakeClient(am.accountKeeper, am.bankKeeper, am.keeper),
+		pairingsimulation.SimulateMsgStakeClient(am.accountKeeper, am.bankKeeper, am.specKeeper, am.epochstorageKeeper, am.keeper),
 	))
 
 	var weightMsgUnstakeProvider int
@@ -149,7 +149,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgRelayPayment,
-		pairingsimulation.SimulateMsgRelayPayment(am.accountKeeper, am.bankKeeper, am.keeper),
+		pairingsimulation.SimulateMsgRelayPayment(am.accountKeeper, am.bankKeeper, am.specKeeper, am.epochstorageKeeper, am.keeper),
 	))
 
 	// this line is used by starport scaffolding # simapp/module/operation
@@ -0,0 +1,56 @@
+package pairing
+
+import (
+	"log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// NewPairingProposalsHandler creates a new governance Handler for subscription plans
+func NewPairingProposalsHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PlansAddProposal:
+			return handlePlansProposal(ctx, k, c)
+
+		default:
+			log.Println("unrecognized pairing proposal content")
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pairing proposal content type: %T", c)
+		}
+	}
+}
+
+func handlePlansProposal(ctx sdk.Context, k keeper.Keeper, p *types.PlansAddProposal) error {
+	logger := k.Logger(ctx)
+	for _, plan := range p.Plans {
+		details := map[string]string{"plan": plan.Index, "name": plan.Name, "price": plan.Price.String()}
+		err := k.ValidatePlanChains(ctx, plan)
+		if err != nil {
+			details["error"] = err.Error()
+			return utils.LavaError(ctx, logger, "invalid_plan", details, err.Error())
+		}
+
+		_, found := k.GetPlan(ctx, plan.Index)
+
+		plan.BlockLastUpdated = uint64(ctx.BlockHeight())
+		k.SetPlan(ctx, plan)
+
+		var name string
+		var event proto.Message
+		if found {
+			name = types.PlanModifyEventName
+			event = &types.EventPlanModify{Plan: plan.Index, Name: plan.Name, Price: plan.Price}
+		} else {
+			name = types.PlanAddEventName
+			event = &types.EventPlanAdd{Plan: plan.Index, Name: plan.Name, Price: plan.Price}
+		}
+		utils.LogLavaTypedEvent(ctx, logger, event, name, details, "Gov Proposal Accepted Plan")
+	}
+	return nil
+}
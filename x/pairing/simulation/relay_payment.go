@@ -1,29 +1,301 @@
 package simulation
 
 import (
+	"encoding/json"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/keeper"
 	"github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
+// maxVrfNonces is how many provider replies a client tries until the vrf picks another provider for data reliability
+const maxVrfNonces = 20
+
+// relaySession is a simulated client and the providers it is paired with on the current epoch
+type relaySession struct {
+	spec        spectypes.Spec
+	epochStart  uint64
+	client      simtypes.Account
+	clientEntry epochstoragetypes.StakeEntry
+	pairing     []epochstoragetypes.StakeEntry
+	providers   []simtypes.Account
+}
+
 func SimulateMsgRelayPayment(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	sk types.SpecKeeper,
+	ek types.EpochstorageKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgRelayPayment{
-			Creator: simAccount.Address.String(),
+		session, found := randomRelaySession(r, ctx, accs, sk, ek, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "no staked client paired with simulated providers"), nil, nil
+		}
+		provider := session.providers[r.Intn(len(session.providers))]
+		remainingCU := session.remainingCU(ctx, k, provider.Address)
+
+		switch r.Intn(10) {
+		case 0:
+			return simulateDataReliabilityRelay(r, app, ctx, ak, bk, k, session, provider)
+		case 1:
+			relay, err := session.newRelay(r, ctx, provider, 1)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+			}
+			relay.Sig = relay.Sig[:len(relay.Sig)-1]
+			msg := types.NewMsgRelayPayment(provider.Address.String(), []*types.RelayRequest{relay}, "")
+			return GenAndDeliverInvalidTx(operationInput(r, app, ctx, provider, msg, msg.Type(), nil, ak, bk), "relay payment with a corrupt client signature")
+		case 2:
+			relay, err := session.newRelay(r, ctx, provider, remainingCU+1)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+			}
+			msg := types.NewMsgRelayPayment(provider.Address.String(), []*types.RelayRequest{relay}, "")
+			return GenAndDeliverInvalidTx(operationInput(r, app, ctx, provider, msg, msg.Type(), nil, ak, bk), "relay payment over the client CU limit")
+		case 3:
+			unpaired, found := session.randomUnpairedAccount(r, accs)
+			if !found {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "every account is paired with the client"), nil, nil
+			}
+			relay, err := session.newRelay(r, ctx, unpaired, 1)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+			}
+			msg := types.NewMsgRelayPayment(unpaired.Address.String(), []*types.RelayRequest{relay}, "")
+			return GenAndDeliverInvalidTx(operationInput(r, app, ctx, unpaired, msg, msg.Type(), nil, ak, bk), "relay payment to a provider outside the client pairing")
+		case 4:
+			relay := session.unsignedRelay(r, ctx, provider, 1)
+			relay.BlockHeight = ctx.BlockHeight() + 1
+			var err error
+			relay.Sig, err = sigs.SignRelay(RelaySigner(session.client), *relay)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+			}
+			msg := types.NewMsgRelayPayment(provider.Address.String(), []*types.RelayRequest{relay}, "")
+			return GenAndDeliverInvalidTx(operationInput(r, app, ctx, provider, msg, msg.Type(), nil, ak, bk), "relay payment for a future block")
+		}
+
+		if remainingCU == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "client used all of its CU with the provider"), nil, nil
+		}
+		relay := session.unsignedRelay(r, ctx, provider, session.randomCU(r, remainingCU))
+		// complain about another paired provider once in a while
+		if len(session.providers) > 1 && r.Intn(4) == 0 {
+			unresponsive := session.providers[r.Intn(len(session.providers))]
+			if !unresponsive.Equals(provider) {
+				relay.UnresponsiveProviders, _ = json.Marshal([]string{unresponsive.Address.String()})
+			}
+		}
+		var err error
+		relay.Sig, err = sigs.SignRelay(RelaySigner(session.client), *relay)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+		}
+		msg := types.NewMsgRelayPayment(provider.Address.String(), []*types.RelayRequest{relay}, simtypes.RandStringOfLength(r, 10))
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, provider, msg, msg.Type(), nil, ak, bk))
+		if err != nil || !opMsg.OK || r.Intn(3) != 0 {
+			return opMsg, nil, err
+		}
+		// the same relay is claimed again on the next block
+		doubleSpend := simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op: func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+				return GenAndDeliverInvalidTx(operationInput(r, app, ctx, provider, msg, msg.Type(), nil, ak, bk), "double spent relay payment")
+			},
+		}
+		return opMsg, []simtypes.FutureOperation{doubleSpend}, nil
+	}
+}
+
+// simulateDataReliabilityRelay has the client send a data reliability relay on the reply of the provider
+// to the provider the vrf picks, which claims it
+func simulateDataReliabilityRelay(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, session relaySession, provider simtypes.Account) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if !session.spec.DataReliabilityEnabled {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "data reliability is disabled for the spec"), nil, nil
+	}
+	servicersToPairCount, err := k.ServicersToPairCount(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed getting the servicers to pair count"), nil, err
+	}
+	clientSigner := RelaySigner(session.client)
+	relay, err := session.newRelay(r, ctx, provider, 0)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+	}
+
+	// a different reply gives a different vrf value, the client only sends data reliability to another paired provider
+	var reply *types.RelayReply
+	var claimer simtypes.Account
+	found := false
+	for nonce := uint32(0); nonce < maxVrfNonces && !found; nonce++ {
+		reply = &types.RelayReply{Data: []byte(simtypes.RandStringOfLength(r, 20)), Nonce: nonce, LatestBlock: relay.RequestBlock}
+		reply.Sig, err = sigs.SignRelayResponse(RelaySigner(provider), reply, relay)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the reply"), nil, err
+		}
+		vrfRes0, _, err := sigs.CalculateVrfOnRelay(clientSigner, relay, reply, session.epochStart)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed computing the vrf"), nil, err
+		}
+		index, err := utils.GetIndexForVrf(vrfRes0, uint32(servicersToPairCount), session.spec.ReliabilityThreshold)
+		if err != nil || index >= int64(len(session.pairing)) || session.pairing[index].Address == provider.Address.String() {
+			continue
+		}
+		claimer, found = FindAccount(session.providers, session.pairing[index].Address)
+	}
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "the vrf didn't pick another simulated provider"), nil, nil
+	}
+	remainingCU := session.remainingCU(ctx, k, claimer.Address)
+	if remainingCU == 0 {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "client used all of its CU with the provider"), nil, nil
+	}
+
+	vrfRes, proof, err := sigs.ProveVrfOnRelay(clientSigner, relay, reply, false, session.epochStart)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed proving the vrf"), nil, err
+	}
+	dataReliability := &types.VRFData{
+		Differentiator: false,
+		VrfValue:       vrfRes,
+		VrfProof:       proof,
+		ProviderSig:    reply.Sig,
+		AllDataHash:    sigs.AllDataHash(reply, relay),
+		QueryHash:      utils.CalculateQueryHash(*relay),
+	}
+	invalid := r.Intn(5) == 0
+	if invalid {
+		// a proof that doesn't match the vrf value
+		dataReliability.VrfProof = dataReliability.VrfProof[:len(dataReliability.VrfProof)-1]
+	}
+	dataReliability.Sig, err = sigs.SignVRFData(clientSigner, dataReliability)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the vrf data"), nil, err
+	}
+
+	reliabilityRelay := session.unsignedRelay(r, ctx, claimer, session.randomCU(r, remainingCU))
+	reliabilityRelay.DataReliability = dataReliability
+	reliabilityRelay.Sig, err = sigs.SignRelay(clientSigner, *reliabilityRelay)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRelayPayment, "failed signing the relay"), nil, err
+	}
+	msg := types.NewMsgRelayPayment(claimer.Address.String(), []*types.RelayRequest{reliabilityRelay}, "")
+	txCtx := operationInput(r, app, ctx, claimer, msg, msg.Type(), nil, ak, bk)
+	if invalid {
+		return GenAndDeliverInvalidTx(txCtx, "data reliability relay with a corrupt vrf proof")
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomRelaySession picks a simulated client staked on the current epoch, that is paired with simulated providers
+func randomRelaySession(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, sk types.SpecKeeper, ek types.EpochstorageKeeper, k keeper.Keeper) (relaySession, bool) {
+	spec, found := randomEnabledSpec(r, ctx, sk)
+	if !found {
+		return relaySession{}, false
+	}
+	epochStart := ek.GetEpochStart(ctx)
+	clientEntries, found, _ := ek.GetEpochStakeEntries(ctx, epochStart, epochstoragetypes.ClientKey, spec.Index)
+	if !found || len(clientEntries) == 0 {
+		return relaySession{}, false
+	}
+	clientEntry := clientEntries[r.Intn(len(clientEntries))]
+	client, found := FindAccount(accs, clientEntry.Address)
+	if !found {
+		return relaySession{}, false
+	}
+	pairing, err := k.GetPairingForClient(ctx, spec.Index, client.Address)
+	if err != nil {
+		return relaySession{}, false
+	}
+	providers := SimAccountsOfEntries(accs, pairing)
+	if len(providers) == 0 {
+		return relaySession{}, false
+	}
+	return relaySession{spec: spec, epochStart: epochStart, client: client, clientEntry: clientEntry, pairing: pairing, providers: providers}, true
+}
+
+// remainingCU is how many CU the client can still use with the provider on this epoch
+func (rs relaySession) remainingCU(ctx sdk.Context, k keeper.Keeper, provider sdk.AccAddress) uint64 {
+	allowedCU, err := k.ClientMaxCUProviderForBlock(ctx, rs.epochStart, &rs.clientEntry)
+	if err != nil {
+		return 0
+	}
+	usedCU := uint64(0)
+	paymentStorage, found := k.GetProviderPaymentStorage(ctx, k.GetProviderPaymentStorageKey(ctx, rs.spec.Index, rs.epochStart, provider))
+	if found {
+		usedCU, err = k.GetTotalUsedCUForConsumerPerEpoch(ctx, rs.client.Address.String(), paymentStorage.UniquePaymentStorageClientProviderKeys, provider.String())
+		if err != nil {
+			return 0
 		}
+	}
+	if usedCU >= allowedCU {
+		return 0
+	}
+	return allowedCU - usedCU
+}
 
-		// TODO: Handling the RelayPayment simulation
+// randomCU returns the CU of a few calls to the spec apis, within the remaining CU
+func (rs relaySession) randomCU(r *rand.Rand, remainingCU uint64) uint64 {
+	cu := uint64(1)
+	if len(rs.spec.Apis) > 0 {
+		cu = rs.spec.Apis[r.Intn(len(rs.spec.Apis))].ComputeUnits * uint64(r.Intn(10)+1)
+	}
+	if cu == 0 || cu > remainingCU {
+		cu = remainingCU
+	}
+	return cu
+}
+
+// randomUnpairedAccount returns a simulated account that isn't in the client pairing
+func (rs relaySession) randomUnpairedAccount(r *rand.Rand, accs []simtypes.Account) (simtypes.Account, bool) {
+	paired := map[string]bool{rs.client.Address.String(): true}
+	for _, entry := range rs.pairing {
+		paired[entry.Address] = true
+	}
+	unpaired := []simtypes.Account{}
+	for _, acc := range accs {
+		if !paired[acc.Address.String()] {
+			unpaired = append(unpaired, acc)
+		}
+	}
+	if len(unpaired) == 0 {
+		return simtypes.Account{}, false
+	}
+	return unpaired[r.Intn(len(unpaired))], true
+}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "RelayPayment simulation not implemented"), nil, nil
+func (rs relaySession) unsignedRelay(r *rand.Rand, ctx sdk.Context, provider simtypes.Account, cu uint64) *types.RelayRequest {
+	randomScore := func() sdk.Dec { return sdk.NewDecWithPrec(int64(r.Intn(101)), 2) }
+	api := "sim_api"
+	if len(rs.spec.Apis) > 0 {
+		api = rs.spec.Apis[r.Intn(len(rs.spec.Apis))].Name
 	}
+	return &types.RelayRequest{
+		ChainID:      rs.spec.Index,
+		SessionId:    r.Uint64(),
+		CuSum:        cu,
+		Data:         []byte(api),
+		Provider:     provider.Address.String(),
+		BlockHeight:  ctx.BlockHeight(),
+		RelayNum:     uint64(r.Intn(100) + 1),
+		RequestBlock: ctx.BlockHeight(),
+		QoSReport:    &types.QualityOfServiceReport{Latency: randomScore(), Availability: randomScore(), Sync: randomScore()},
+	}
+}
+
+func (rs relaySession) newRelay(r *rand.Rand, ctx sdk.Context, provider simtypes.Account, cu uint64) (relay *types.RelayRequest, err error) {
+	relay = rs.unsignedRelay(r, ctx, provider, cu)
+	relay.Sig, err = sigs.SignRelay(RelaySigner(rs.client), *relay)
+	return relay, err
 }
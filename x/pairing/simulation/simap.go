@@ -1,8 +1,23 @@
 package simulation
 
 import (
+	"bytes"
+	"fmt"
+	"math/rand"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // FindAccount find a specific address from an account list
@@ -13,3 +28,83 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// RelaySigner returns the relay signer of a simulated account, the vrf key is derived from the account key
+// so every operation of the account uses the vrf key it staked with
+func RelaySigner(acc simtypes.Account) *sigs.LocalSigner {
+	privKey, _ := btcSecp256k1.PrivKeyFromBytes(btcSecp256k1.S256(), acc.PrivKey.Bytes())
+	vrfSk, err := vrf.GenerateKey(bytes.NewReader(tmhash.Sum(acc.PrivKey.Bytes())))
+	if err != nil {
+		panic(err)
+	}
+	return sigs.NewLocalSigner(privKey, vrfSk)
+}
+
+// VrfPubKeyBech32 returns the vrf public key a simulated account stakes with as a client
+func VrfPubKeyBech32(acc simtypes.Account) (string, error) {
+	vrfPk := &utils.VrfPubKey{}
+	if err := vrfPk.Unmarshal(RelaySigner(acc).VRFPubKey()); err != nil {
+		return "", err
+	}
+	return vrfPk.EncodeBech32()
+}
+
+// SimAccountsOfEntries returns the simulated accounts of the stake entries, with the entries order
+func SimAccountsOfEntries(accs []simtypes.Account, entries []epochstoragetypes.StakeEntry) []simtypes.Account {
+	simAccounts := []simtypes.Account{}
+	for _, entry := range entries {
+		if acc, found := FindAccount(accs, entry.Address); found {
+			simAccounts = append(simAccounts, acc)
+		}
+	}
+	return simAccounts
+}
+
+// randomEnabledSpec returns a random enabled spec
+func randomEnabledSpec(r *rand.Rand, ctx sdk.Context, sk types.SpecKeeper) (spectypes.Spec, bool) {
+	chainIDs := sk.GetAllChainIDs(ctx)
+	if len(chainIDs) == 0 {
+		return spectypes.Spec{}, false
+	}
+	spec, found := sk.GetLatestSpec(ctx, chainIDs[r.Intn(len(chainIDs))])
+	return spec, found && spec.Enabled
+}
+
+// randomStake returns a stake between the min stake and ten times the min stake, within the spendable balance of the account
+func randomStake(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress, minStake sdk.Coin) (sdk.Coin, bool) {
+	spendable := bk.SpendableCoins(ctx, addr).AmountOf(minStake.Denom)
+	maxStake := minStake.Amount.MulRaw(10)
+	if spendable.LT(maxStake) {
+		maxStake = spendable
+	}
+	if maxStake.LT(minStake.Amount) {
+		return sdk.Coin{}, false
+	}
+	return sdk.NewCoin(minStake.Denom, minStake.Amount.Add(simtypes.RandomAmount(r, maxStake.Sub(minStake.Amount)))), true
+}
+
+func operationInput(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, simAccount simtypes.Account, msg sdk.Msg, msgType string, coinsSpent sdk.Coins, ak types.AccountKeeper, bk types.BankKeeper) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: coinsSpent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+}
+
+// GenAndDeliverInvalidTx delivers a message the chain must reject, the simulation fails if it is accepted
+func GenAndDeliverInvalidTx(txCtx simulation.OperationInput, reason string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	_, _, err := simulation.GenAndDeliverTx(txCtx, sdk.Coins{})
+	if err == nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, reason), nil, fmt.Errorf("%s was accepted", reason)
+	}
+	return simtypes.NewOperationMsg(txCtx.Msg, false, reason, txCtx.Cdc), nil, nil
+}
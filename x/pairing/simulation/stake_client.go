@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/keeper"
 	"github.com/lavanet/lava/x/pairing/types"
 )
@@ -13,17 +15,34 @@ import (
 func SimulateMsgStakeClient(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	sk types.SpecKeeper,
+	ek types.EpochstorageKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		spec, found := randomEnabledSpec(r, ctx, sk)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeClient, "no enabled spec"), nil, nil
+		}
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgStakeClient{
-			Creator: simAccount.Address.String(),
+		if _, staked := ek.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, spec.Index, simAccount.Address); staked {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeClient, "account is already a staked client"), nil, nil
 		}
+		if _, staked := ek.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, spec.Index, simAccount.Address); staked {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeClient, "account is a staked provider"), nil, nil
+		}
+		amount, ok := randomStake(r, ctx, bk, simAccount.Address, spec.MinStakeClient)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeClient, "insufficient funds for the min stake"), nil, nil
+		}
+		vrfPk, err := VrfPubKeyBech32(simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeClient, "failed encoding the vrf pk"), nil, err
+		}
+		msg := types.NewMsgStakeClient(simAccount.Address.String(), spec.Index, amount, 1, vrfPk)
 
-		// TODO: Handling the StakeClient simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "StakeClient simulation not implemented"), nil, nil
+		txCtx := operationInput(r, app, ctx, simAccount, msg, msg.Type(), sdk.NewCoins(amount), ak, bk)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -1,12 +1,13 @@
 package simulation
 
 import (
-	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/pairing/keeper"
 	"github.com/lavanet/lava/x/pairing/types"
 )
@@ -14,35 +15,37 @@ import (
 func SimulateMsgStakeProvider(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	sk types.SpecKeeper,
+	ek types.EpochstorageKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgStakeProvider{
-			Creator: simAccount.Address.String(),
+		spec, found := randomEnabledSpec(r, ctx, sk)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeProvider, "no enabled spec"), nil, nil
 		}
-
-		// TODO: Handling the StakeProvider simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "StakeProvider simulation not implemented"), nil, nil
-	}
-}
-
-func SimulateMsgStakeProvider_HappyFlow(
-	ak types.AccountKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgStakeProvider{
-			Creator: simAccount.Address.String(),
+		// an account is either a provider or a client of a chain, so it is never paired with itself
+		if _, staked := ek.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, spec.Index, simAccount.Address); staked {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeProvider, "account is already a staked provider"), nil, nil
+		}
+		if _, staked := ek.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ClientKey, spec.Index, simAccount.Address); staked {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeProvider, "account is a staked client"), nil, nil
+		}
+		amount, ok := randomStake(r, ctx, bk, simAccount.Address, spec.MinStakeProvider)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStakeProvider, "insufficient funds for the min stake"), nil, nil
 		}
 
-		fmt.Printf("yes we did it")
+		geolocation := uint64(1)
+		endpoints := []epochstoragetypes.Endpoint{}
+		for apiInterface := range sk.GetExpectedInterfacesForSpec(ctx, spec.Index) {
+			endpoints = append(endpoints, epochstoragetypes.Endpoint{IPPORT: "127.0.0.1:2221", UseType: apiInterface, Geolocation: geolocation})
+		}
+		msg := types.NewMsgStakeProvider(simAccount.Address.String(), spec.Index, amount, endpoints, geolocation, simtypes.RandStringOfLength(r, 10), "")
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "StakeProvider simulation not implemented"), nil, nil
+		txCtx := operationInput(r, app, ctx, simAccount, msg, msg.Type(), sdk.NewCoins(amount), ak, bk)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -13,6 +14,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnstakeProvider{}, "pairing/UnstakeProvider", nil)
 	cdc.RegisterConcrete(&MsgUnstakeClient{}, "pairing/UnstakeClient", nil)
 	cdc.RegisterConcrete(&MsgRelayPayment{}, "pairing/RelayPayment", nil)
+	cdc.RegisterConcrete(&MsgBuySubscription{}, "pairing/BuySubscription", nil)
+	cdc.RegisterConcrete(&MsgModifyProvider{}, "pairing/ModifyProvider", nil)
+	cdc.RegisterConcrete(&MsgModifyClient{}, "pairing/ModifyClient", nil)
+	cdc.RegisterConcrete(&MsgCancelUnstake{}, "pairing/CancelUnstake", nil)
+	cdc.RegisterConcrete(&MsgUpdateClientVrfpk{}, "pairing/UpdateClientVrfpk", nil)
+	cdc.RegisterConcrete(&MsgSetOperatorProviders{}, "pairing/SetOperatorProviders", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,9 +39,32 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRelayPayment{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBuySubscription{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgModifyProvider{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgModifyClient{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelUnstake{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateClientVrfpk{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetOperatorProviders{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&PlansAddProposal{},
+	)
 }
 
 var (
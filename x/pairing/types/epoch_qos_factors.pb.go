@@ -0,0 +1,640 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/epoch_qos_factors.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderQoSFactor is the factor a provider stake is multiplied by for its pairing weight
+type ProviderQoSFactor struct {
+	Provider string                                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Factor   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"factor"`
+}
+
+func (m *ProviderQoSFactor) Reset()         { *m = ProviderQoSFactor{} }
+func (m *ProviderQoSFactor) String() string { return proto.CompactTextString(m) }
+func (*ProviderQoSFactor) ProtoMessage()    {}
+func (*ProviderQoSFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad92992e10d1d0f4, []int{0}
+}
+func (m *ProviderQoSFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQoSFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQoSFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQoSFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQoSFactor.Merge(m, src)
+}
+func (m *ProviderQoSFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQoSFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQoSFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQoSFactor proto.InternalMessageInfo
+
+func (m *ProviderQoSFactor) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// EpochQoSFactors is the snapshot of the provider QoS factors of a chain taken at an epoch start, providers without a factor have full weight
+type EpochQoSFactors struct {
+	Epoch   uint64              `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChainID string              `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Factors []ProviderQoSFactor `protobuf:"bytes,3,rep,name=factors,proto3" json:"factors"`
+}
+
+func (m *EpochQoSFactors) Reset()         { *m = EpochQoSFactors{} }
+func (m *EpochQoSFactors) String() string { return proto.CompactTextString(m) }
+func (*EpochQoSFactors) ProtoMessage()    {}
+func (*EpochQoSFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad92992e10d1d0f4, []int{1}
+}
+func (m *EpochQoSFactors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochQoSFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochQoSFactors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochQoSFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochQoSFactors.Merge(m, src)
+}
+func (m *EpochQoSFactors) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochQoSFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochQoSFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochQoSFactors proto.InternalMessageInfo
+
+func (m *EpochQoSFactors) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochQoSFactors) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EpochQoSFactors) GetFactors() []ProviderQoSFactor {
+	if m != nil {
+		return m.Factors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProviderQoSFactor)(nil), "lavanet.lava.pairing.ProviderQoSFactor")
+	proto.RegisterType((*EpochQoSFactors)(nil), "lavanet.lava.pairing.EpochQoSFactors")
+}
+
+func init() { proto.RegisterFile("pairing/epoch_qos_factors.proto", fileDescriptor_ad92992e10d1d0f4) }
+
+var fileDescriptor_ad92992e10d1d0f4 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x48, 0xcc, 0x2c,
+	0xca, 0xcc, 0x4b, 0xd7, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x88, 0x2f, 0xcc, 0x2f, 0x8e, 0x4f, 0x4b,
+	0x4c, 0x2e, 0xc9, 0x2f, 0x2a, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc9, 0x49, 0x2c,
+	0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x50, 0xd5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9,
+	0x60, 0x05, 0xfa, 0x20, 0x16, 0x44, 0xad, 0x52, 0x39, 0x97, 0x60, 0x40, 0x51, 0x7e, 0x59, 0x66,
+	0x4a, 0x6a, 0x51, 0x60, 0x7e, 0xb0, 0x1b, 0xd8, 0x1c, 0x21, 0x29, 0x2e, 0x8e, 0x02, 0xa8, 0xa0,
+	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x9c, 0x2f, 0xe4, 0xc6, 0xc5, 0x06, 0xb1, 0x4d, 0x82,
+	0x09, 0x24, 0xe3, 0xa4, 0x77, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25,
+	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0x50, 0x4a,
+	0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0x25, 0x35, 0x39, 0x08, 0xaa,
+	0x5b, 0xa9, 0x8b, 0x91, 0x8b, 0xdf, 0x15, 0xe4, 0x01, 0xb8, 0xb5, 0xc5, 0x42, 0x22, 0x5c, 0xac,
+	0x60, 0x3f, 0x81, 0x2d, 0x65, 0x09, 0x82, 0x70, 0x84, 0x24, 0xb8, 0xd8, 0x93, 0x33, 0x12, 0x33,
+	0xf3, 0x3c, 0x5d, 0x20, 0x56, 0x06, 0xc1, 0xb8, 0x42, 0xee, 0x5c, 0xec, 0x50, 0x9f, 0x4b, 0x30,
+	0x2b, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xeb, 0x61, 0xf3, 0xba, 0x1e, 0x86, 0x0f, 0x9d, 0x58, 0x40,
+	0xae, 0x0e, 0x82, 0xe9, 0x76, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
+	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
+	0x28, 0x75, 0x24, 0x6f, 0x41, 0xcd, 0x06, 0xd3, 0xfa, 0x15, 0xfa, 0xb0, 0x68, 0x00, 0xfb, 0x2d,
+	0x89, 0x0d, 0x1c, 0x9e, 0xc6, 0x80, 0x01, 0x00, 0xfb, 0xa9, 0xb4, 0x9e, 0x9e, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderQoSFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQoSFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQoSFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpochQosFactors(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEpochQosFactors(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochQoSFactors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochQoSFactors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochQoSFactors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Factors) > 0 {
+		for iNdEx := len(m.Factors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Factors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEpochQosFactors(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEpochQosFactors(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEpochQosFactors(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpochQosFactors(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpochQosFactors(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderQoSFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEpochQosFactors(uint64(l))
+	}
+	l = m.Factor.Size()
+	n += 1 + l + sovEpochQosFactors(uint64(l))
+	return n
+}
+
+func (m *EpochQoSFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEpochQosFactors(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEpochQosFactors(uint64(l))
+	}
+	if len(m.Factors) > 0 {
+		for _, e := range m.Factors {
+			l = e.Size()
+			n += 1 + l + sovEpochQosFactors(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEpochQosFactors(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpochQosFactors(x uint64) (n int) {
+	return sovEpochQosFactors(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderQoSFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpochQosFactors
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQoSFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQoSFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpochQosFactors(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochQoSFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpochQosFactors
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochQoSFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochQoSFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factors = append(m.Factors, ProviderQoSFactor{})
+			if err := m.Factors[len(m.Factors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpochQosFactors(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpochQosFactors
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpochQosFactors(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpochQosFactors
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpochQosFactors
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpochQosFactors
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpochQosFactors
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpochQosFactors
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpochQosFactors        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpochQosFactors          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpochQosFactors = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,6 +9,9 @@ import (
 // x/pairing module sentinel errors
 var (
 	ErrSample                                          = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrEmptyPlans                                      = sdkerrors.Register(ModuleName, 2, "plans list is empty")
+	ErrBlankPlanIndex                                  = sdkerrors.Register(ModuleName, 3, "plan index is blank")
+	ErrInvalidPlan                                     = sdkerrors.Register(ModuleName, 4, "invalid plan")
 	NoPreviousEpochForAverageBlockTimeCalculationError = sdkerrors.New("NoPreviousEpochForAverageBlockTimeCalculationError Error", 685, "Can't get previous epoch for average block time calculation.")
 	PreviousEpochStartIsBlockZeroError                 = sdkerrors.New("PreviousEpochStartIsBlockZeroError Error", 686, "Previous epoch start is block 0, can't be used for average block time calculation (core.Block(0) panics).")
 	AverageBlockTimeIsLessOrEqualToZeroError           = sdkerrors.New("AverageBlockTimeIsLessOrEqualToZeroError Error", 687, "The calculated average block time is less or equal to zero")
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// the typed event constructors below match the legacy event names of types.go by the staked entry type
+
+func StakeNewEvent(isProvider bool, entry epochstoragetypes.StakeEntry, effectiveImmediately bool) proto.Message {
+	if isProvider {
+		return &EventStakeNewProvider{Entry: entry, EffectiveImmediately: effectiveImmediately}
+	} else {
+		return &EventStakeNewConsumer{Entry: entry, EffectiveImmediately: effectiveImmediately}
+	}
+}
+
+func StakeUpdateEvent(isProvider bool, entry epochstoragetypes.StakeEntry) proto.Message {
+	if isProvider {
+		return &EventStakeUpdateProvider{Entry: entry}
+	} else {
+		return &EventStakeUpdateConsumer{Entry: entry}
+	}
+}
+
+func StakeModifyEvent(isProvider bool, entry epochstoragetypes.StakeEntry, existingStake sdk.Coin, withdrawn sdk.Coin, withdrawnDeadline uint64) proto.Message {
+	if isProvider {
+		return &EventStakeModifyProvider{Entry: entry, ExistingStake: existingStake, Withdrawn: withdrawn, WithdrawnDeadline: withdrawnDeadline}
+	} else {
+		return &EventStakeModifyConsumer{Entry: entry, ExistingStake: existingStake, Withdrawn: withdrawn, WithdrawnDeadline: withdrawnDeadline}
+	}
+}
+
+func UnstakeEvent(isProvider bool, entry epochstoragetypes.StakeEntry, description string) proto.Message {
+	if isProvider {
+		return &EventUnstakeProvider{Entry: entry, Description: description}
+	} else {
+		return &EventUnstakeConsumer{Entry: entry, Description: description}
+	}
+}
+
+func UnstakeCommitEvent(isProvider bool, entry epochstoragetypes.StakeEntry) proto.Message {
+	if isProvider {
+		return &EventUnstakeCommitProvider{Entry: entry}
+	} else {
+		return &EventUnstakeCommitConsumer{Entry: entry}
+	}
+}
+
+func UnstakeCancelEvent(isProvider bool, entry epochstoragetypes.StakeEntry) proto.Message {
+	if isProvider {
+		return &EventUnstakeCancelProvider{Entry: entry}
+	} else {
+		return &EventUnstakeCancelConsumer{Entry: entry}
+	}
+}
@@ -0,0 +1,6447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/lavanet/lava/x/epochstorage/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventStakeNewProvider struct {
+	Entry                types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	EffectiveImmediately bool             `protobuf:"varint,2,opt,name=effectiveImmediately,proto3" json:"effectiveImmediately,omitempty"`
+}
+
+func (m *EventStakeNewProvider) Reset()         { *m = EventStakeNewProvider{} }
+func (m *EventStakeNewProvider) String() string { return proto.CompactTextString(m) }
+func (*EventStakeNewProvider) ProtoMessage()    {}
+func (*EventStakeNewProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{0}
+}
+func (m *EventStakeNewProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeNewProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeNewProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeNewProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeNewProvider.Merge(m, src)
+}
+func (m *EventStakeNewProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeNewProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeNewProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeNewProvider proto.InternalMessageInfo
+
+func (m *EventStakeNewProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventStakeNewProvider) GetEffectiveImmediately() bool {
+	if m != nil {
+		return m.EffectiveImmediately
+	}
+	return false
+}
+
+type EventStakeNewConsumer struct {
+	Entry                types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	EffectiveImmediately bool             `protobuf:"varint,2,opt,name=effectiveImmediately,proto3" json:"effectiveImmediately,omitempty"`
+}
+
+func (m *EventStakeNewConsumer) Reset()         { *m = EventStakeNewConsumer{} }
+func (m *EventStakeNewConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventStakeNewConsumer) ProtoMessage()    {}
+func (*EventStakeNewConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{1}
+}
+func (m *EventStakeNewConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeNewConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeNewConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeNewConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeNewConsumer.Merge(m, src)
+}
+func (m *EventStakeNewConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeNewConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeNewConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeNewConsumer proto.InternalMessageInfo
+
+func (m *EventStakeNewConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventStakeNewConsumer) GetEffectiveImmediately() bool {
+	if m != nil {
+		return m.EffectiveImmediately
+	}
+	return false
+}
+
+type EventStakeUpdateProvider struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventStakeUpdateProvider) Reset()         { *m = EventStakeUpdateProvider{} }
+func (m *EventStakeUpdateProvider) String() string { return proto.CompactTextString(m) }
+func (*EventStakeUpdateProvider) ProtoMessage()    {}
+func (*EventStakeUpdateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{2}
+}
+func (m *EventStakeUpdateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeUpdateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeUpdateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeUpdateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeUpdateProvider.Merge(m, src)
+}
+func (m *EventStakeUpdateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeUpdateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeUpdateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeUpdateProvider proto.InternalMessageInfo
+
+func (m *EventStakeUpdateProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventStakeUpdateConsumer struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventStakeUpdateConsumer) Reset()         { *m = EventStakeUpdateConsumer{} }
+func (m *EventStakeUpdateConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventStakeUpdateConsumer) ProtoMessage()    {}
+func (*EventStakeUpdateConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{3}
+}
+func (m *EventStakeUpdateConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeUpdateConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeUpdateConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeUpdateConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeUpdateConsumer.Merge(m, src)
+}
+func (m *EventStakeUpdateConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeUpdateConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeUpdateConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeUpdateConsumer proto.InternalMessageInfo
+
+func (m *EventStakeUpdateConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventStakeModifyProvider struct {
+	Entry             types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	ExistingStake     types1.Coin      `protobuf:"bytes,2,opt,name=existingStake,proto3" json:"existingStake"`
+	Withdrawn         types1.Coin      `protobuf:"bytes,3,opt,name=withdrawn,proto3" json:"withdrawn"`
+	WithdrawnDeadline uint64           `protobuf:"varint,4,opt,name=withdrawnDeadline,proto3" json:"withdrawnDeadline,omitempty"`
+}
+
+func (m *EventStakeModifyProvider) Reset()         { *m = EventStakeModifyProvider{} }
+func (m *EventStakeModifyProvider) String() string { return proto.CompactTextString(m) }
+func (*EventStakeModifyProvider) ProtoMessage()    {}
+func (*EventStakeModifyProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{4}
+}
+func (m *EventStakeModifyProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeModifyProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeModifyProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeModifyProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeModifyProvider.Merge(m, src)
+}
+func (m *EventStakeModifyProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeModifyProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeModifyProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeModifyProvider proto.InternalMessageInfo
+
+func (m *EventStakeModifyProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventStakeModifyProvider) GetExistingStake() types1.Coin {
+	if m != nil {
+		return m.ExistingStake
+	}
+	return types1.Coin{}
+}
+
+func (m *EventStakeModifyProvider) GetWithdrawn() types1.Coin {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return types1.Coin{}
+}
+
+func (m *EventStakeModifyProvider) GetWithdrawnDeadline() uint64 {
+	if m != nil {
+		return m.WithdrawnDeadline
+	}
+	return 0
+}
+
+type EventStakeModifyConsumer struct {
+	Entry             types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	ExistingStake     types1.Coin      `protobuf:"bytes,2,opt,name=existingStake,proto3" json:"existingStake"`
+	Withdrawn         types1.Coin      `protobuf:"bytes,3,opt,name=withdrawn,proto3" json:"withdrawn"`
+	WithdrawnDeadline uint64           `protobuf:"varint,4,opt,name=withdrawnDeadline,proto3" json:"withdrawnDeadline,omitempty"`
+}
+
+func (m *EventStakeModifyConsumer) Reset()         { *m = EventStakeModifyConsumer{} }
+func (m *EventStakeModifyConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventStakeModifyConsumer) ProtoMessage()    {}
+func (*EventStakeModifyConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{5}
+}
+func (m *EventStakeModifyConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakeModifyConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakeModifyConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStakeModifyConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeModifyConsumer.Merge(m, src)
+}
+func (m *EventStakeModifyConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakeModifyConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeModifyConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeModifyConsumer proto.InternalMessageInfo
+
+func (m *EventStakeModifyConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventStakeModifyConsumer) GetExistingStake() types1.Coin {
+	if m != nil {
+		return m.ExistingStake
+	}
+	return types1.Coin{}
+}
+
+func (m *EventStakeModifyConsumer) GetWithdrawn() types1.Coin {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return types1.Coin{}
+}
+
+func (m *EventStakeModifyConsumer) GetWithdrawnDeadline() uint64 {
+	if m != nil {
+		return m.WithdrawnDeadline
+	}
+	return 0
+}
+
+// emitted when an entry is moved to the unstake storage
+type EventUnstakeProvider struct {
+	Entry       types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *EventUnstakeProvider) Reset()         { *m = EventUnstakeProvider{} }
+func (m *EventUnstakeProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeProvider) ProtoMessage()    {}
+func (*EventUnstakeProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{6}
+}
+func (m *EventUnstakeProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeProvider.Merge(m, src)
+}
+func (m *EventUnstakeProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeProvider proto.InternalMessageInfo
+
+func (m *EventUnstakeProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventUnstakeProvider) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type EventUnstakeConsumer struct {
+	Entry       types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *EventUnstakeConsumer) Reset()         { *m = EventUnstakeConsumer{} }
+func (m *EventUnstakeConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeConsumer) ProtoMessage()    {}
+func (*EventUnstakeConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{7}
+}
+func (m *EventUnstakeConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeConsumer.Merge(m, src)
+}
+func (m *EventUnstakeConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeConsumer proto.InternalMessageInfo
+
+func (m *EventUnstakeConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+func (m *EventUnstakeConsumer) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// emitted when the stake of an unstaked entry is returned
+type EventUnstakeCommitProvider struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnstakeCommitProvider) Reset()         { *m = EventUnstakeCommitProvider{} }
+func (m *EventUnstakeCommitProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeCommitProvider) ProtoMessage()    {}
+func (*EventUnstakeCommitProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{8}
+}
+func (m *EventUnstakeCommitProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeCommitProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeCommitProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeCommitProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeCommitProvider.Merge(m, src)
+}
+func (m *EventUnstakeCommitProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeCommitProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeCommitProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeCommitProvider proto.InternalMessageInfo
+
+func (m *EventUnstakeCommitProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventUnstakeCommitConsumer struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnstakeCommitConsumer) Reset()         { *m = EventUnstakeCommitConsumer{} }
+func (m *EventUnstakeCommitConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeCommitConsumer) ProtoMessage()    {}
+func (*EventUnstakeCommitConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{9}
+}
+func (m *EventUnstakeCommitConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeCommitConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeCommitConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeCommitConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeCommitConsumer.Merge(m, src)
+}
+func (m *EventUnstakeCommitConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeCommitConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeCommitConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeCommitConsumer proto.InternalMessageInfo
+
+func (m *EventUnstakeCommitConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventUnstakeCancelProvider struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnstakeCancelProvider) Reset()         { *m = EventUnstakeCancelProvider{} }
+func (m *EventUnstakeCancelProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeCancelProvider) ProtoMessage()    {}
+func (*EventUnstakeCancelProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{10}
+}
+func (m *EventUnstakeCancelProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeCancelProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeCancelProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeCancelProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeCancelProvider.Merge(m, src)
+}
+func (m *EventUnstakeCancelProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeCancelProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeCancelProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeCancelProvider proto.InternalMessageInfo
+
+func (m *EventUnstakeCancelProvider) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventUnstakeCancelConsumer struct {
+	Entry types.StakeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnstakeCancelConsumer) Reset()         { *m = EventUnstakeCancelConsumer{} }
+func (m *EventUnstakeCancelConsumer) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakeCancelConsumer) ProtoMessage()    {}
+func (*EventUnstakeCancelConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{11}
+}
+func (m *EventUnstakeCancelConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakeCancelConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakeCancelConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnstakeCancelConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakeCancelConsumer.Merge(m, src)
+}
+func (m *EventUnstakeCancelConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakeCancelConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakeCancelConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakeCancelConsumer proto.InternalMessageInfo
+
+func (m *EventUnstakeCancelConsumer) GetEntry() types.StakeEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return types.StakeEntry{}
+}
+
+type EventRelayPayment struct {
+	ChainID           string                                 `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Client            string                                 `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Provider          string                                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	CU                uint64                                 `protobuf:"varint,4,opt,name=CU,proto3" json:"CU,omitempty"`
+	BasePay           types1.Coin                            `protobuf:"bytes,5,opt,name=basePay,proto3" json:"basePay"`
+	Mint              types1.Coin                            `protobuf:"bytes,6,opt,name=mint,proto3" json:"mint"`
+	ReliabilityPay    bool                                   `protobuf:"varint,7,opt,name=reliabilityPay,proto3" json:"reliabilityPay,omitempty"`
+	ClientFee         types1.Coin                            `protobuf:"bytes,8,opt,name=clientFee,proto3" json:"clientFee"`
+	TotalCUInEpoch    uint64                                 `protobuf:"varint,9,opt,name=totalCUInEpoch,proto3" json:"totalCUInEpoch,omitempty"`
+	UniqueIdentifier  uint64                                 `protobuf:"varint,10,opt,name=uniqueIdentifier,proto3" json:"uniqueIdentifier,omitempty"`
+	RelayNumber       uint64                                 `protobuf:"varint,11,opt,name=relayNumber,proto3" json:"relayNumber,omitempty"`
+	DescriptionString string                                 `protobuf:"bytes,12,opt,name=descriptionString,proto3" json:"descriptionString,omitempty"`
+	QoSScore          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSScore"`
+	Subscription      string                                 `protobuf:"bytes,14,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (m *EventRelayPayment) Reset()         { *m = EventRelayPayment{} }
+func (m *EventRelayPayment) String() string { return proto.CompactTextString(m) }
+func (*EventRelayPayment) ProtoMessage()    {}
+func (*EventRelayPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{12}
+}
+func (m *EventRelayPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelayPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelayPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRelayPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelayPayment.Merge(m, src)
+}
+func (m *EventRelayPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelayPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelayPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRelayPayment proto.InternalMessageInfo
+
+func (m *EventRelayPayment) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventRelayPayment) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *EventRelayPayment) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventRelayPayment) GetCU() uint64 {
+	if m != nil {
+		return m.CU
+	}
+	return 0
+}
+
+func (m *EventRelayPayment) GetBasePay() types1.Coin {
+	if m != nil {
+		return m.BasePay
+	}
+	return types1.Coin{}
+}
+
+func (m *EventRelayPayment) GetMint() types1.Coin {
+	if m != nil {
+		return m.Mint
+	}
+	return types1.Coin{}
+}
+
+func (m *EventRelayPayment) GetReliabilityPay() bool {
+	if m != nil {
+		return m.ReliabilityPay
+	}
+	return false
+}
+
+func (m *EventRelayPayment) GetClientFee() types1.Coin {
+	if m != nil {
+		return m.ClientFee
+	}
+	return types1.Coin{}
+}
+
+func (m *EventRelayPayment) GetTotalCUInEpoch() uint64 {
+	if m != nil {
+		return m.TotalCUInEpoch
+	}
+	return 0
+}
+
+func (m *EventRelayPayment) GetUniqueIdentifier() uint64 {
+	if m != nil {
+		return m.UniqueIdentifier
+	}
+	return 0
+}
+
+func (m *EventRelayPayment) GetRelayNumber() uint64 {
+	if m != nil {
+		return m.RelayNumber
+	}
+	return 0
+}
+
+func (m *EventRelayPayment) GetDescriptionString() string {
+	if m != nil {
+		return m.DescriptionString
+	}
+	return ""
+}
+
+func (m *EventRelayPayment) GetSubscription() string {
+	if m != nil {
+		return m.Subscription
+	}
+	return ""
+}
+
+type EventUnresponsiveProvider struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventUnresponsiveProvider) Reset()         { *m = EventUnresponsiveProvider{} }
+func (m *EventUnresponsiveProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnresponsiveProvider) ProtoMessage()    {}
+func (*EventUnresponsiveProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{13}
+}
+func (m *EventUnresponsiveProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnresponsiveProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnresponsiveProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnresponsiveProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnresponsiveProvider.Merge(m, src)
+}
+func (m *EventUnresponsiveProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnresponsiveProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnresponsiveProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnresponsiveProvider proto.InternalMessageInfo
+
+func (m *EventUnresponsiveProvider) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventProviderJailed struct {
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID     string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Offenses    uint64 `protobuf:"varint,3,opt,name=offenses,proto3" json:"offenses,omitempty"`
+	Complaints  uint64 `protobuf:"varint,4,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	JailedUntil uint64 `protobuf:"varint,5,opt,name=jailedUntil,proto3" json:"jailedUntil,omitempty"`
+}
+
+func (m *EventProviderJailed) Reset()         { *m = EventProviderJailed{} }
+func (m *EventProviderJailed) String() string { return proto.CompactTextString(m) }
+func (*EventProviderJailed) ProtoMessage()    {}
+func (*EventProviderJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{14}
+}
+func (m *EventProviderJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProviderJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderJailed.Merge(m, src)
+}
+func (m *EventProviderJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderJailed proto.InternalMessageInfo
+
+func (m *EventProviderJailed) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventProviderJailed) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventProviderJailed) GetOffenses() uint64 {
+	if m != nil {
+		return m.Offenses
+	}
+	return 0
+}
+
+func (m *EventProviderJailed) GetComplaints() uint64 {
+	if m != nil {
+		return m.Complaints
+	}
+	return 0
+}
+
+func (m *EventProviderJailed) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventProviderUnresponsiveUnstake struct {
+	Provider    string      `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID     string      `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Offenses    uint64      `protobuf:"varint,3,opt,name=offenses,proto3" json:"offenses,omitempty"`
+	Complaints  uint64      `protobuf:"varint,4,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Slashed     types1.Coin `protobuf:"bytes,5,opt,name=slashed,proto3" json:"slashed"`
+	JailedUntil uint64      `protobuf:"varint,6,opt,name=jailedUntil,proto3" json:"jailedUntil,omitempty"`
+}
+
+func (m *EventProviderUnresponsiveUnstake) Reset()         { *m = EventProviderUnresponsiveUnstake{} }
+func (m *EventProviderUnresponsiveUnstake) String() string { return proto.CompactTextString(m) }
+func (*EventProviderUnresponsiveUnstake) ProtoMessage()    {}
+func (*EventProviderUnresponsiveUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{15}
+}
+func (m *EventProviderUnresponsiveUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderUnresponsiveUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderUnresponsiveUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProviderUnresponsiveUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderUnresponsiveUnstake.Merge(m, src)
+}
+func (m *EventProviderUnresponsiveUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderUnresponsiveUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderUnresponsiveUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderUnresponsiveUnstake proto.InternalMessageInfo
+
+func (m *EventProviderUnresponsiveUnstake) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventProviderUnresponsiveUnstake) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventProviderUnresponsiveUnstake) GetOffenses() uint64 {
+	if m != nil {
+		return m.Offenses
+	}
+	return 0
+}
+
+func (m *EventProviderUnresponsiveUnstake) GetComplaints() uint64 {
+	if m != nil {
+		return m.Complaints
+	}
+	return 0
+}
+
+func (m *EventProviderUnresponsiveUnstake) GetSlashed() types1.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types1.Coin{}
+}
+
+func (m *EventProviderUnresponsiveUnstake) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventPlanAdd struct {
+	Plan  string      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Name  string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price types1.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *EventPlanAdd) Reset()         { *m = EventPlanAdd{} }
+func (m *EventPlanAdd) String() string { return proto.CompactTextString(m) }
+func (*EventPlanAdd) ProtoMessage()    {}
+func (*EventPlanAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{16}
+}
+func (m *EventPlanAdd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPlanAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPlanAdd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPlanAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPlanAdd.Merge(m, src)
+}
+func (m *EventPlanAdd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPlanAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPlanAdd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPlanAdd proto.InternalMessageInfo
+
+func (m *EventPlanAdd) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *EventPlanAdd) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventPlanAdd) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
+}
+
+type EventPlanModify struct {
+	Plan  string      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Name  string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price types1.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *EventPlanModify) Reset()         { *m = EventPlanModify{} }
+func (m *EventPlanModify) String() string { return proto.CompactTextString(m) }
+func (*EventPlanModify) ProtoMessage()    {}
+func (*EventPlanModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{17}
+}
+func (m *EventPlanModify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPlanModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPlanModify.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPlanModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPlanModify.Merge(m, src)
+}
+func (m *EventPlanModify) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPlanModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPlanModify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPlanModify proto.InternalMessageInfo
+
+func (m *EventPlanModify) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *EventPlanModify) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventPlanModify) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
+}
+
+type EventBuySubscription struct {
+	Consumer   string      `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Plan       string      `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	Price      types1.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	StartBlock uint64      `protobuf:"varint,4,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	MonthCU    uint64      `protobuf:"varint,5,opt,name=monthCU,proto3" json:"monthCU,omitempty"`
+}
+
+func (m *EventBuySubscription) Reset()         { *m = EventBuySubscription{} }
+func (m *EventBuySubscription) String() string { return proto.CompactTextString(m) }
+func (*EventBuySubscription) ProtoMessage()    {}
+func (*EventBuySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{18}
+}
+func (m *EventBuySubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBuySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBuySubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBuySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBuySubscription.Merge(m, src)
+}
+func (m *EventBuySubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBuySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBuySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBuySubscription proto.InternalMessageInfo
+
+func (m *EventBuySubscription) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *EventBuySubscription) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *EventBuySubscription) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
+}
+
+func (m *EventBuySubscription) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *EventBuySubscription) GetMonthCU() uint64 {
+	if m != nil {
+		return m.MonthCU
+	}
+	return 0
+}
+
+type EventSubscriptionRenew struct {
+	Consumer   string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Plan       string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	MonthsLeft uint64 `protobuf:"varint,3,opt,name=monthsLeft,proto3" json:"monthsLeft,omitempty"`
+	MonthCU    uint64 `protobuf:"varint,4,opt,name=monthCU,proto3" json:"monthCU,omitempty"`
+}
+
+func (m *EventSubscriptionRenew) Reset()         { *m = EventSubscriptionRenew{} }
+func (m *EventSubscriptionRenew) String() string { return proto.CompactTextString(m) }
+func (*EventSubscriptionRenew) ProtoMessage()    {}
+func (*EventSubscriptionRenew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{19}
+}
+func (m *EventSubscriptionRenew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubscriptionRenew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubscriptionRenew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubscriptionRenew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscriptionRenew.Merge(m, src)
+}
+func (m *EventSubscriptionRenew) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubscriptionRenew) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscriptionRenew.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubscriptionRenew proto.InternalMessageInfo
+
+func (m *EventSubscriptionRenew) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *EventSubscriptionRenew) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *EventSubscriptionRenew) GetMonthsLeft() uint64 {
+	if m != nil {
+		return m.MonthsLeft
+	}
+	return 0
+}
+
+func (m *EventSubscriptionRenew) GetMonthCU() uint64 {
+	if m != nil {
+		return m.MonthCU
+	}
+	return 0
+}
+
+type EventSubscriptionExpired struct {
+	Consumer    string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Plan        string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	ExpiryBlock uint64 `protobuf:"varint,3,opt,name=expiryBlock,proto3" json:"expiryBlock,omitempty"`
+}
+
+func (m *EventSubscriptionExpired) Reset()         { *m = EventSubscriptionExpired{} }
+func (m *EventSubscriptionExpired) String() string { return proto.CompactTextString(m) }
+func (*EventSubscriptionExpired) ProtoMessage()    {}
+func (*EventSubscriptionExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{20}
+}
+func (m *EventSubscriptionExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubscriptionExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubscriptionExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubscriptionExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscriptionExpired.Merge(m, src)
+}
+func (m *EventSubscriptionExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubscriptionExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscriptionExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubscriptionExpired proto.InternalMessageInfo
+
+func (m *EventSubscriptionExpired) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *EventSubscriptionExpired) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *EventSubscriptionExpired) GetExpiryBlock() uint64 {
+	if m != nil {
+		return m.ExpiryBlock
+	}
+	return 0
+}
+
+type EventClientVrfpkUpdate struct {
+	Client         string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Vrfpk          string   `protobuf:"bytes,2,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	ChainIDs       []string `protobuf:"bytes,3,rep,name=chainIDs,proto3" json:"chainIDs,omitempty"`
+	Subscription   bool     `protobuf:"varint,4,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	EffectiveEpoch uint64   `protobuf:"varint,5,opt,name=effectiveEpoch,proto3" json:"effectiveEpoch,omitempty"`
+}
+
+func (m *EventClientVrfpkUpdate) Reset()         { *m = EventClientVrfpkUpdate{} }
+func (m *EventClientVrfpkUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventClientVrfpkUpdate) ProtoMessage()    {}
+func (*EventClientVrfpkUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{21}
+}
+func (m *EventClientVrfpkUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClientVrfpkUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClientVrfpkUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClientVrfpkUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClientVrfpkUpdate.Merge(m, src)
+}
+func (m *EventClientVrfpkUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClientVrfpkUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClientVrfpkUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClientVrfpkUpdate proto.InternalMessageInfo
+
+func (m *EventClientVrfpkUpdate) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *EventClientVrfpkUpdate) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
+	}
+	return ""
+}
+
+func (m *EventClientVrfpkUpdate) GetChainIDs() []string {
+	if m != nil {
+		return m.ChainIDs
+	}
+	return nil
+}
+
+func (m *EventClientVrfpkUpdate) GetSubscription() bool {
+	if m != nil {
+		return m.Subscription
+	}
+	return false
+}
+
+func (m *EventClientVrfpkUpdate) GetEffectiveEpoch() uint64 {
+	if m != nil {
+		return m.EffectiveEpoch
+	}
+	return 0
+}
+
+type EventOperatorProvidersSet struct {
+	Operator         string   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Providers        []string `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	RemovedProviders []string `protobuf:"bytes,3,rep,name=removedProviders,proto3" json:"removedProviders,omitempty"`
+}
+
+func (m *EventOperatorProvidersSet) Reset()         { *m = EventOperatorProvidersSet{} }
+func (m *EventOperatorProvidersSet) String() string { return proto.CompactTextString(m) }
+func (*EventOperatorProvidersSet) ProtoMessage()    {}
+func (*EventOperatorProvidersSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{22}
+}
+func (m *EventOperatorProvidersSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOperatorProvidersSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOperatorProvidersSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOperatorProvidersSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOperatorProvidersSet.Merge(m, src)
+}
+func (m *EventOperatorProvidersSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOperatorProvidersSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOperatorProvidersSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOperatorProvidersSet proto.InternalMessageInfo
+
+func (m *EventOperatorProvidersSet) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventOperatorProvidersSet) GetProviders() []string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *EventOperatorProvidersSet) GetRemovedProviders() []string {
+	if m != nil {
+		return m.RemovedProviders
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventStakeNewProvider)(nil), "lavanet.lava.pairing.EventStakeNewProvider")
+	proto.RegisterType((*EventStakeNewConsumer)(nil), "lavanet.lava.pairing.EventStakeNewConsumer")
+	proto.RegisterType((*EventStakeUpdateProvider)(nil), "lavanet.lava.pairing.EventStakeUpdateProvider")
+	proto.RegisterType((*EventStakeUpdateConsumer)(nil), "lavanet.lava.pairing.EventStakeUpdateConsumer")
+	proto.RegisterType((*EventStakeModifyProvider)(nil), "lavanet.lava.pairing.EventStakeModifyProvider")
+	proto.RegisterType((*EventStakeModifyConsumer)(nil), "lavanet.lava.pairing.EventStakeModifyConsumer")
+	proto.RegisterType((*EventUnstakeProvider)(nil), "lavanet.lava.pairing.EventUnstakeProvider")
+	proto.RegisterType((*EventUnstakeConsumer)(nil), "lavanet.lava.pairing.EventUnstakeConsumer")
+	proto.RegisterType((*EventUnstakeCommitProvider)(nil), "lavanet.lava.pairing.EventUnstakeCommitProvider")
+	proto.RegisterType((*EventUnstakeCommitConsumer)(nil), "lavanet.lava.pairing.EventUnstakeCommitConsumer")
+	proto.RegisterType((*EventUnstakeCancelProvider)(nil), "lavanet.lava.pairing.EventUnstakeCancelProvider")
+	proto.RegisterType((*EventUnstakeCancelConsumer)(nil), "lavanet.lava.pairing.EventUnstakeCancelConsumer")
+	proto.RegisterType((*EventRelayPayment)(nil), "lavanet.lava.pairing.EventRelayPayment")
+	proto.RegisterType((*EventUnresponsiveProvider)(nil), "lavanet.lava.pairing.EventUnresponsiveProvider")
+	proto.RegisterType((*EventProviderJailed)(nil), "lavanet.lava.pairing.EventProviderJailed")
+	proto.RegisterType((*EventProviderUnresponsiveUnstake)(nil), "lavanet.lava.pairing.EventProviderUnresponsiveUnstake")
+	proto.RegisterType((*EventPlanAdd)(nil), "lavanet.lava.pairing.EventPlanAdd")
+	proto.RegisterType((*EventPlanModify)(nil), "lavanet.lava.pairing.EventPlanModify")
+	proto.RegisterType((*EventBuySubscription)(nil), "lavanet.lava.pairing.EventBuySubscription")
+	proto.RegisterType((*EventSubscriptionRenew)(nil), "lavanet.lava.pairing.EventSubscriptionRenew")
+	proto.RegisterType((*EventSubscriptionExpired)(nil), "lavanet.lava.pairing.EventSubscriptionExpired")
+	proto.RegisterType((*EventClientVrfpkUpdate)(nil), "lavanet.lava.pairing.EventClientVrfpkUpdate")
+	proto.RegisterType((*EventOperatorProvidersSet)(nil), "lavanet.lava.pairing.EventOperatorProvidersSet")
+}
+
+func init() { proto.RegisterFile("pairing/events.proto", fileDescriptor_44055f8e5acc30a7) }
+
+var fileDescriptor_44055f8e5acc30a7 = []byte{
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xba, 0xce, 0x1f, 0xbf, 0xb4, 0x85, 0x2e, 0xa6, 0xda, 0x46, 0xc8, 0xb5, 0x2c, 0x51,
+	0x22, 0x04, 0x6b, 0x25, 0x15, 0x87, 0x1e, 0x38, 0x24, 0x4e, 0x90, 0x52, 0x41, 0x09, 0x6b, 0x99,
+	0x03, 0x12, 0xaa, 0xc6, 0xbb, 0xcf, 0xf6, 0x90, 0xdd, 0x99, 0x65, 0x66, 0xec, 0xc4, 0xe2, 0x04,
+	0x1f, 0x00, 0xc1, 0xc7, 0xe0, 0xc2, 0x95, 0xaf, 0xd0, 0x63, 0x8f, 0x08, 0xa1, 0x0a, 0x25, 0x9f,
+	0x80, 0x6f, 0x80, 0x66, 0x76, 0xd6, 0x5e, 0xdb, 0x91, 0x70, 0x91, 0xab, 0x8a, 0x93, 0xfd, 0xde,
+	0xbc, 0x37, 0xef, 0xdf, 0xef, 0xbd, 0x37, 0x0b, 0xd5, 0x94, 0x50, 0x41, 0x59, 0xbf, 0x89, 0x23,
+	0x64, 0x4a, 0xfa, 0xa9, 0xe0, 0x8a, 0xbb, 0xd5, 0x98, 0x8c, 0x08, 0x43, 0xe5, 0xeb, 0x5f, 0xdf,
+	0x8a, 0xec, 0x54, 0xfb, 0xbc, 0xcf, 0x8d, 0x40, 0x53, 0xff, 0xcb, 0x64, 0x77, 0x6a, 0x21, 0x97,
+	0x09, 0x97, 0xcd, 0x2e, 0x91, 0xd8, 0x1c, 0xed, 0x75, 0x51, 0x91, 0xbd, 0x66, 0xc8, 0x29, 0xcb,
+	0xcf, 0x31, 0xe5, 0xe1, 0x40, 0x2a, 0x2e, 0x48, 0x1f, 0x9b, 0x52, 0x91, 0x33, 0x7c, 0x8a, 0x4c,
+	0x89, 0x71, 0x76, 0xde, 0xf8, 0xd1, 0x81, 0xb7, 0x8f, 0xb5, 0xf1, 0xb6, 0x3e, 0x7a, 0x82, 0xe7,
+	0xa7, 0x82, 0x8f, 0x68, 0x84, 0xc2, 0x3d, 0x80, 0x75, 0x23, 0xe8, 0x39, 0x75, 0x67, 0x77, 0x7b,
+	0xff, 0x5d, 0x7f, 0xc6, 0xab, 0xe2, 0xb5, 0xbe, 0xd1, 0x3d, 0xd6, 0xc2, 0x87, 0xe5, 0x67, 0x2f,
+	0xee, 0xaf, 0x05, 0x99, 0xa6, 0xbb, 0x0f, 0x55, 0xec, 0xf5, 0x30, 0x54, 0x74, 0x84, 0x27, 0x49,
+	0x82, 0x11, 0x25, 0x0a, 0xe3, 0xb1, 0x57, 0xaa, 0x3b, 0xbb, 0x5b, 0xc1, 0xb5, 0x67, 0x8b, 0x0e,
+	0xb5, 0x38, 0x93, 0xc3, 0xe4, 0xf5, 0x39, 0xf4, 0x35, 0x78, 0x53, 0x7f, 0x3a, 0x69, 0x44, 0x14,
+	0xae, 0x30, 0x47, 0xd7, 0x5d, 0xbf, 0xc2, 0x88, 0x1b, 0x3f, 0x97, 0x8a, 0xf7, 0x7f, 0xc6, 0x23,
+	0xda, 0x1b, 0xaf, 0xb2, 0xc4, 0xc7, 0x70, 0x0b, 0x2f, 0xa8, 0x54, 0x94, 0xf5, 0x8d, 0x88, 0x49,
+	0xe5, 0xf6, 0xfe, 0x3d, 0x3f, 0xc3, 0xa5, 0xaf, 0x71, 0xe9, 0x5b, 0x5c, 0xfa, 0x2d, 0x4e, 0x99,
+	0x55, 0x9f, 0xd5, 0x72, 0x3f, 0x86, 0xca, 0x39, 0x55, 0x83, 0x48, 0x90, 0x73, 0xe6, 0xdd, 0x58,
+	0xee, 0x8a, 0xa9, 0x86, 0xfb, 0x01, 0xdc, 0x99, 0x10, 0x47, 0x48, 0xa2, 0x98, 0x32, 0xf4, 0xca,
+	0x75, 0x67, 0xb7, 0x1c, 0x2c, 0x1e, 0x5c, 0x9b, 0x93, 0x55, 0xa2, 0xec, 0xff, 0x98, 0x93, 0xef,
+	0xa0, 0x6a, 0x52, 0xd2, 0x61, 0x66, 0x46, 0xac, 0x12, 0x22, 0x75, 0xd8, 0x8e, 0x50, 0x86, 0x82,
+	0xa6, 0x8a, 0x72, 0x66, 0x92, 0x51, 0x09, 0x8a, 0xac, 0x79, 0xe3, 0xab, 0xac, 0xc5, 0xbf, 0x1b,
+	0x7f, 0x0a, 0x3b, 0xb3, 0xc6, 0x93, 0x84, 0xaa, 0x55, 0x76, 0xf8, 0xb5, 0x06, 0x56, 0xd9, 0xe3,
+	0xf3, 0x06, 0x08, 0x0b, 0x31, 0x7e, 0x95, 0x11, 0x18, 0x03, 0xab, 0x8c, 0xe0, 0xcf, 0x32, 0xdc,
+	0x31, 0x16, 0x02, 0x8c, 0xc9, 0xf8, 0x94, 0x8c, 0x13, 0x64, 0xca, 0xf5, 0x60, 0x33, 0x1c, 0x10,
+	0xca, 0x4e, 0x8e, 0xcc, 0xd5, 0x95, 0x20, 0x27, 0xdd, 0xbb, 0xb0, 0x11, 0xc6, 0x14, 0x99, 0xb2,
+	0x05, 0xb5, 0x94, 0xbb, 0x03, 0x5b, 0xa9, 0x8d, 0xdb, 0x74, 0x4c, 0x25, 0x98, 0xd0, 0xee, 0x6d,
+	0x28, 0xb5, 0x3a, 0xb6, 0x01, 0x4a, 0xad, 0x8e, 0xfb, 0x08, 0x36, 0x75, 0x17, 0x9d, 0x92, 0xb1,
+	0xb7, 0xbe, 0x5c, 0x73, 0xe5, 0xf2, 0xee, 0x43, 0x28, 0x27, 0x94, 0x29, 0x6f, 0x63, 0x39, 0x3d,
+	0x23, 0xec, 0x3e, 0x80, 0xdb, 0x02, 0x63, 0x4a, 0xba, 0x34, 0xa6, 0x4a, 0xc7, 0xe8, 0x6d, 0x9a,
+	0xad, 0x33, 0xc7, 0xd5, 0x6d, 0x9f, 0x45, 0xf3, 0x09, 0xa2, 0xb7, 0xb5, 0x64, 0xdb, 0x4f, 0x34,
+	0xb4, 0x19, 0xc5, 0x15, 0x89, 0x5b, 0x9d, 0x13, 0x76, 0xac, 0x73, 0xef, 0x55, 0x4c, 0xc8, 0x73,
+	0x5c, 0xf7, 0x7d, 0x78, 0x73, 0xc8, 0xe8, 0xb7, 0x43, 0x3c, 0x89, 0x90, 0x29, 0xda, 0xa3, 0x28,
+	0x3c, 0x30, 0x92, 0x0b, 0x7c, 0xdd, 0x44, 0x42, 0x17, 0xe6, 0xc9, 0x30, 0xe9, 0xa2, 0xf0, 0xb6,
+	0x8d, 0x58, 0x91, 0xa5, 0x87, 0x4d, 0xa1, 0xa7, 0xda, 0x4a, 0xbf, 0x58, 0xbc, 0x9b, 0xa6, 0x02,
+	0x8b, 0x07, 0xee, 0x63, 0xd8, 0xfa, 0x82, 0xb7, 0xdb, 0x21, 0x17, 0xe8, 0xdd, 0xd2, 0x42, 0x87,
+	0xbe, 0x0e, 0xe3, 0x8f, 0x17, 0xf7, 0x1f, 0xf4, 0xa9, 0x1a, 0x0c, 0xbb, 0x7e, 0xc8, 0x93, 0xa6,
+	0x7d, 0xd9, 0x64, 0x3f, 0x1f, 0xca, 0xe8, 0xac, 0xa9, 0xc6, 0x29, 0x4a, 0xff, 0x08, 0xc3, 0x60,
+	0xa2, 0xef, 0x36, 0xe0, 0xa6, 0x1c, 0x76, 0xa7, 0x1d, 0x7e, 0xdb, 0x18, 0x9d, 0xe1, 0x35, 0xf6,
+	0xe0, 0x9e, 0xc5, 0xaf, 0x40, 0x99, 0x72, 0x26, 0xe9, 0x68, 0x3a, 0xe1, 0xaa, 0xb0, 0x8e, 0x42,
+	0x70, 0x61, 0x31, 0x96, 0x11, 0x8d, 0x5f, 0x1c, 0x78, 0xcb, 0xe8, 0xe4, 0x72, 0x8f, 0x09, 0x8d,
+	0x31, 0x9a, 0x41, 0x98, 0x33, 0x87, 0xb0, 0x02, 0x5e, 0x4b, 0xb3, 0x78, 0xdd, 0x81, 0x2d, 0xde,
+	0xeb, 0x21, 0x93, 0x28, 0x0d, 0x2e, 0xcb, 0xc1, 0x84, 0x76, 0x6b, 0x00, 0x21, 0x4f, 0xd2, 0x98,
+	0x50, 0xa6, 0xa4, 0xc5, 0x67, 0x81, 0xa3, 0x93, 0xff, 0x8d, 0xb1, 0xdd, 0x61, 0x8a, 0xc6, 0x06,
+	0xab, 0xe5, 0xa0, 0xc8, 0x6a, 0xfc, 0xed, 0x40, 0x7d, 0xc6, 0xd7, 0x62, 0x9c, 0xb6, 0x67, 0x5f,
+	0x83, 0xe3, 0x8f, 0x60, 0x53, 0xc6, 0x44, 0x0e, 0x30, 0x5a, 0xba, 0xc1, 0xac, 0xfc, 0x7c, 0xcc,
+	0x1b, 0x8b, 0x31, 0x27, 0x70, 0x33, 0x0b, 0x39, 0x26, 0xec, 0x20, 0x8a, 0x5c, 0x17, 0xca, 0x69,
+	0x4c, 0x98, 0x0d, 0xcd, 0xfc, 0xd7, 0x3c, 0x46, 0x12, 0xb4, 0x31, 0x99, 0xff, 0xee, 0x47, 0xb0,
+	0x9e, 0x0a, 0x1a, 0xe2, 0xb2, 0x0b, 0x35, 0x93, 0x6e, 0xa4, 0xf0, 0xc6, 0xc4, 0x5c, 0xf6, 0x60,
+	0x78, 0xd5, 0x16, 0x7f, 0x73, 0xec, 0x52, 0x3c, 0x1c, 0x8e, 0xdb, 0x05, 0x30, 0xeb, 0x92, 0x84,
+	0x76, 0xf4, 0xe6, 0x85, 0xcc, 0xe9, 0x89, 0x4f, 0xa5, 0x82, 0x4f, 0xff, 0xcd, 0xbe, 0xae, 0xae,
+	0x54, 0x44, 0xa8, 0xc3, 0x98, 0x87, 0x67, 0x79, 0x75, 0xa7, 0x1c, 0x8d, 0x99, 0x84, 0x33, 0x35,
+	0x68, 0x75, 0x2c, 0x24, 0x73, 0xb2, 0xf1, 0x83, 0x03, 0x77, 0xb3, 0xe7, 0x55, 0xc1, 0xed, 0x00,
+	0x19, 0x9e, 0xbf, 0xb4, 0xef, 0x35, 0x00, 0x73, 0xab, 0xfc, 0x14, 0x7b, 0xca, 0x02, 0xb0, 0xc0,
+	0x29, 0x3a, 0x51, 0x9e, 0x75, 0x22, 0x06, 0x6f, 0xc1, 0x87, 0xe3, 0x8b, 0x94, 0x8a, 0xac, 0x87,
+	0x5f, 0xca, 0x8b, 0x3a, 0x6c, 0xa3, 0x56, 0x1d, 0x67, 0xb9, 0xc8, 0xdc, 0x28, 0xb2, 0x1a, 0xbf,
+	0xe6, 0x21, 0xb7, 0xcc, 0x1c, 0xfe, 0x52, 0xf4, 0xd2, 0xb3, 0xec, 0x2d, 0x5f, 0x58, 0x55, 0xce,
+	0xcc, 0xaa, 0xaa, 0xc2, 0xfa, 0x48, 0x8b, 0x59, 0x4b, 0x19, 0x61, 0x5c, 0xcb, 0x5a, 0x4f, 0xf7,
+	0xdb, 0x0d, 0xe3, 0x9a, 0xa5, 0x17, 0x26, 0x5d, 0xd9, 0xac, 0x8f, 0x19, 0x9e, 0x9e, 0xfe, 0x93,
+	0x8f, 0x98, 0x6c, 0xfa, 0x67, 0xc5, 0x99, 0xe3, 0x36, 0xbe, 0x77, 0xec, 0x48, 0xfc, 0x3c, 0x45,
+	0x41, 0x14, 0x17, 0xf9, 0xe8, 0x90, 0x6d, 0x34, 0x6b, 0x94, 0x5b, 0x7e, 0x9e, 0xa0, 0x9c, 0x76,
+	0xdf, 0x81, 0x4a, 0x3e, 0x37, 0xa4, 0x57, 0x32, 0x2e, 0x4e, 0x19, 0x7a, 0xab, 0x08, 0x4c, 0xf8,
+	0x08, 0xa3, 0xc9, 0x85, 0x36, 0x8e, 0x05, 0xfe, 0xe1, 0xc1, 0xb3, 0xcb, 0x9a, 0xf3, 0xfc, 0xb2,
+	0xe6, 0xfc, 0x75, 0x59, 0x73, 0x7e, 0xba, 0xaa, 0xad, 0x3d, 0xbf, 0xaa, 0xad, 0xfd, 0x7e, 0x55,
+	0x5b, 0xfb, 0xea, 0xbd, 0xc2, 0x16, 0xb0, 0x8f, 0x09, 0xf3, 0xdb, 0xbc, 0x68, 0xe6, 0x1f, 0xcc,
+	0x66, 0x15, 0x74, 0x37, 0xcc, 0x47, 0xec, 0xc3, 0x7f, 0x06, 0x00, 0x93, 0x47, 0x53, 0xe6, 0x48,
+	0x0f, 0x00, 0x00,
+}
+
+func (m *EventStakeNewProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeNewProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeNewProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveImmediately {
+		i--
+		if m.EffectiveImmediately {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeNewConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeNewConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeNewConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveImmediately {
+		i--
+		if m.EffectiveImmediately {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeUpdateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeUpdateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeUpdateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeUpdateConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeUpdateConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeUpdateConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeModifyProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeModifyProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeModifyProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawnDeadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WithdrawnDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ExistingStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakeModifyConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakeModifyConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakeModifyConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawnDeadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WithdrawnDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ExistingStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeCommitProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeCommitProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeCommitProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeCommitConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeCommitConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeCommitConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeCancelProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeCancelProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeCancelProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakeCancelConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakeCancelConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakeCancelConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRelayPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelayPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelayPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscription) > 0 {
+		i -= len(m.Subscription)
+		copy(dAtA[i:], m.Subscription)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Subscription)))
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.QoSScore.Size()
+		i -= size
+		if _, err := m.QoSScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.DescriptionString) > 0 {
+		i -= len(m.DescriptionString)
+		copy(dAtA[i:], m.DescriptionString)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DescriptionString)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RelayNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RelayNumber))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UniqueIdentifier != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UniqueIdentifier))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TotalCUInEpoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalCUInEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.ClientFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ReliabilityPay {
+		i--
+		if m.ReliabilityPay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.BasePay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CU != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CU))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnresponsiveProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnresponsiveProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnresponsiveProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Complaints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Complaints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Offenses != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Offenses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderUnresponsiveUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderUnresponsiveUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderUnresponsiveUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Complaints != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Complaints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Offenses != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Offenses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPlanAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPlanAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPlanAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plan) > 0 {
+		i -= len(m.Plan)
+		copy(dAtA[i:], m.Plan)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Plan)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPlanModify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPlanModify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPlanModify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plan) > 0 {
+		i -= len(m.Plan)
+		copy(dAtA[i:], m.Plan)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Plan)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MonthCU != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MonthCU))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Plan) > 0 {
+		i -= len(m.Plan)
+		copy(dAtA[i:], m.Plan)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Plan)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubscriptionRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubscriptionRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubscriptionRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MonthCU != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MonthCU))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MonthsLeft != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MonthsLeft))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Plan) > 0 {
+		i -= len(m.Plan)
+		copy(dAtA[i:], m.Plan)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Plan)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubscriptionExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubscriptionExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubscriptionExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlock != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Plan) > 0 {
+		i -= len(m.Plan)
+		copy(dAtA[i:], m.Plan)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Plan)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClientVrfpkUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClientVrfpkUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClientVrfpkUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Subscription {
+		i--
+		if m.Subscription {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainIDs) > 0 {
+		for iNdEx := len(m.ChainIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIDs[iNdEx])
+			copy(dAtA[i:], m.ChainIDs[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOperatorProvidersSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOperatorProvidersSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOperatorProvidersSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedProviders) > 0 {
+		for iNdEx := len(m.RemovedProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedProviders[iNdEx])
+			copy(dAtA[i:], m.RemovedProviders[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventStakeNewProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.EffectiveImmediately {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventStakeNewConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.EffectiveImmediately {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventStakeUpdateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventStakeUpdateConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventStakeModifyProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ExistingStake.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.WithdrawnDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.WithdrawnDeadline))
+	}
+	return n
+}
+
+func (m *EventStakeModifyConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ExistingStake.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.WithdrawnDeadline != 0 {
+		n += 1 + sovEvents(uint64(m.WithdrawnDeadline))
+	}
+	return n
+}
+
+func (m *EventUnstakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnstakeConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnstakeCommitProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnstakeCommitConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnstakeCancelProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnstakeCancelConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRelayPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CU != 0 {
+		n += 1 + sovEvents(uint64(m.CU))
+	}
+	l = m.BasePay.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Mint.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ReliabilityPay {
+		n += 2
+	}
+	l = m.ClientFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.TotalCUInEpoch != 0 {
+		n += 1 + sovEvents(uint64(m.TotalCUInEpoch))
+	}
+	if m.UniqueIdentifier != 0 {
+		n += 1 + sovEvents(uint64(m.UniqueIdentifier))
+	}
+	if m.RelayNumber != 0 {
+		n += 1 + sovEvents(uint64(m.RelayNumber))
+	}
+	l = len(m.DescriptionString)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.QoSScore.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Subscription)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnresponsiveProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProviderJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Offenses != 0 {
+		n += 1 + sovEvents(uint64(m.Offenses))
+	}
+	if m.Complaints != 0 {
+		n += 1 + sovEvents(uint64(m.Complaints))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventProviderUnresponsiveUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Offenses != 0 {
+		n += 1 + sovEvents(uint64(m.Offenses))
+	}
+	if m.Complaints != 0 {
+		n += 1 + sovEvents(uint64(m.Complaints))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventPlanAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPlanModify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBuySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.StartBlock != 0 {
+		n += 1 + sovEvents(uint64(m.StartBlock))
+	}
+	if m.MonthCU != 0 {
+		n += 1 + sovEvents(uint64(m.MonthCU))
+	}
+	return n
+}
+
+func (m *EventSubscriptionRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MonthsLeft != 0 {
+		n += 1 + sovEvents(uint64(m.MonthsLeft))
+	}
+	if m.MonthCU != 0 {
+		n += 1 + sovEvents(uint64(m.MonthCU))
+	}
+	return n
+}
+
+func (m *EventSubscriptionExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiryBlock != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryBlock))
+	}
+	return n
+}
+
+func (m *EventClientVrfpkUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ChainIDs) > 0 {
+		for _, s := range m.ChainIDs {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Subscription {
+		n += 2
+	}
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveEpoch))
+	}
+	return n
+}
+
+func (m *EventOperatorProvidersSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedProviders) > 0 {
+		for _, s := range m.RemovedProviders {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventStakeNewProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeNewProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeNewProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveImmediately", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EffectiveImmediately = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStakeNewConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeNewConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeNewConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveImmediately", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EffectiveImmediately = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStakeUpdateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeUpdateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeUpdateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStakeUpdateConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeUpdateConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeUpdateConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStakeModifyProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeModifyProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeModifyProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExistingStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnDeadline", wireType)
+			}
+			m.WithdrawnDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawnDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStakeModifyConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStakeModifyConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStakeModifyConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExistingStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnDeadline", wireType)
+			}
+			m.WithdrawnDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawnDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeCommitProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeCommitProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeCommitProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeCommitConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeCommitConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeCommitConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeCancelProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeCancelProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeCancelProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnstakeCancelConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnstakeCancelConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnstakeCancelConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRelayPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRelayPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CU", wireType)
+			}
+			m.CU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityPay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReliabilityPay = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCUInEpoch", wireType)
+			}
+			m.TotalCUInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCUInEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueIdentifier", wireType)
+			}
+			m.UniqueIdentifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueIdentifier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayNumber", wireType)
+			}
+			m.RelayNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QoSScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QoSScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnresponsiveProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnresponsiveProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnresponsiveProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderJailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderJailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			m.Offenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			m.Complaints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complaints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderUnresponsiveUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderUnresponsiveUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderUnresponsiveUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			m.Offenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			m.Complaints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complaints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlanAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlanAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlanAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPlanModify) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPlanModify: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPlanModify: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCU", wireType)
+			}
+			m.MonthCU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubscriptionRenew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubscriptionRenew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubscriptionRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLeft", wireType)
+			}
+			m.MonthsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCU", wireType)
+			}
+			m.MonthCU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubscriptionExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubscriptionExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubscriptionExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlock", wireType)
+			}
+			m.ExpiryBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClientVrfpkUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClientVrfpkUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClientVrfpkUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIDs = append(m.ChainIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Subscription = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
+			}
+			m.EffectiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOperatorProvidersSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOperatorProvidersSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOperatorProvidersSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedProviders = append(m.RemovedProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
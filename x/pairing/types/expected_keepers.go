@@ -10,9 +10,12 @@ import (
 type SpecKeeper interface {
 	// Methods imported from spec should be defined here
 	IsSpecFoundAndActive(ctx sdk.Context, chainID string) (foundAndActive bool, found bool)
-	GetSpec(ctx sdk.Context, index string) (val spectypes.Spec, found bool)
+	GetSpec(ctx sdk.Context, index string, block uint64) (val spectypes.Spec, found bool)
+	GetLatestSpec(ctx sdk.Context, index string) (val spectypes.Spec, found bool)
+	FixateSpecs(ctx sdk.Context, block uint64, earliestEpochStart uint64)
 	GeolocationCount(ctx sdk.Context) uint64
 	GetExpectedInterfacesForSpec(ctx sdk.Context, chainID string) map[string]bool
+	GetAllChainIDs(ctx sdk.Context) (chainIDs []string)
 }
 
 type EpochstorageKeeper interface {
@@ -28,13 +31,14 @@ type EpochstorageKeeper interface {
 	GetEpochStartForBlock(ctx sdk.Context, block uint64) (epochStart uint64, blockInEpoch uint64, err error)
 	GetPreviousEpochStartForBlock(ctx sdk.Context, block uint64) (previousEpochStart uint64, erro error)
 	PopUnstakeEntries(ctx sdk.Context, storageType string, block uint64) (value []epochstoragetypes.StakeEntry)
+	PopUnstakeEntriesByAddress(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value []epochstoragetypes.StakeEntry)
 	AppendUnstakeEntry(ctx sdk.Context, storageType string, stakeEntry epochstoragetypes.StakeEntry, unstakeHoldBlocks uint64) error
 	ModifyUnstakeEntry(ctx sdk.Context, storageType string, stakeEntry epochstoragetypes.StakeEntry, removeIndex uint64)
 	GetStakeStorageUnstake(ctx sdk.Context, storageType string) (epochstoragetypes.StakeStorage, bool)
-	ModifyStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry epochstoragetypes.StakeEntry, removeIndex uint64)
+	ModifyStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry epochstoragetypes.StakeEntry)
 	AppendStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, stakeEntry epochstoragetypes.StakeEntry)
-	RemoveStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, idx uint64) error
-	GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value epochstoragetypes.StakeEntry, found bool, index uint64)
+	RemoveStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) error
+	GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType string, chainID string, address sdk.AccAddress) (value epochstoragetypes.StakeEntry, found bool)
 	UnstakeEntryByAddress(ctx sdk.Context, storageType string, address sdk.AccAddress) (value epochstoragetypes.StakeEntry, found bool, index uint64)
 	GetStakeStorageCurrent(ctx sdk.Context, storageType string, chainID string) (epochstoragetypes.StakeStorage, bool)
 	GetEpochStakeEntries(ctx sdk.Context, block uint64, storageType string, chainID string) (entries []epochstoragetypes.StakeEntry, found bool, epochHash []byte)
@@ -60,5 +64,6 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
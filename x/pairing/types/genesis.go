@@ -13,6 +13,15 @@ func DefaultGenesis() *GenesisState {
 		UniquePaymentStorageClientProviderList: []UniquePaymentStorageClientProvider{},
 		ProviderPaymentStorageList:             []ProviderPaymentStorage{},
 		EpochPaymentsList:                      []EpochPayments{},
+		PlansList:                              []Plan{},
+		SubscriptionList:                       []Subscription{},
+		ProviderQoSList:                        []ProviderQoS{},
+		EpochQoSFactorsList:                    []EpochQoSFactors{},
+		ProviderJailList:                       []ProviderJail{},
+		FreeTxQuotaList:                        []FreeTxQuota{},
+		ExpiredSubscriptionList:                []Subscription{},
+		OperatorProvidersList:                  []OperatorProviders{},
+		EpochProviderQoSList:                   []EpochProviderQoS{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -51,6 +60,105 @@ func (gs GenesisState) Validate() error {
 		}
 		epochPaymentsIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in plans
+	planIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PlansList {
+		index := string(PlanKey(elem.Index))
+		if _, ok := planIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for plan")
+		}
+		if err := elem.ValidatePlan(); err != nil {
+			return err
+		}
+		planIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated consumer in subscriptions
+	subscriptionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SubscriptionList {
+		index := string(SubscriptionKey(elem.Consumer))
+		if _, ok := subscriptionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated consumer for subscription")
+		}
+		if elem.ExpiryBlock != 0 {
+			return fmt.Errorf("expired subscription in the active subscriptions")
+		}
+		subscriptionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in provider reputations
+	providerQoSIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderQoSList {
+		index := string(ProviderQoSKey(elem.Provider, elem.ChainID))
+		if _, ok := providerQoSIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for providerQoS")
+		}
+		providerQoSIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in epoch QoS factors
+	epochQoSFactorsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EpochQoSFactorsList {
+		index := string(EpochQoSFactorsKey(elem.Epoch, elem.ChainID))
+		if _, ok := epochQoSFactorsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for epochQoSFactors")
+		}
+		epochQoSFactorsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in provider jails
+	providerJailIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderJailList {
+		index := string(ProviderJailKey(elem.Provider, elem.ChainID))
+		if _, ok := providerJailIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for providerJail")
+		}
+		providerJailIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated address in fee-free transaction counts
+	freeTxQuotaIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FreeTxQuotaList {
+		index := string(FreeTxQuotaKey(elem.Address))
+		if _, ok := freeTxQuotaIndexMap[index]; ok {
+			return fmt.Errorf("duplicated address for freeTxQuota")
+		}
+		freeTxQuotaIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in expired subscriptions
+	expiredSubscriptionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ExpiredSubscriptionList {
+		index := string(ExpiredSubscriptionKey(elem.Consumer, elem.ExpiryBlock))
+		if _, ok := expiredSubscriptionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for expired subscription")
+		}
+		if elem.ExpiryBlock == 0 {
+			return fmt.Errorf("active subscription in the expired subscriptions")
+		}
+		expiredSubscriptionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated operator in operator providers
+	operatorProvidersIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OperatorProvidersList {
+		index := string(OperatorProvidersKey(elem.Operator))
+		if _, ok := operatorProvidersIndexMap[index]; ok {
+			return fmt.Errorf("duplicated operator for operatorProviders")
+		}
+		operatorProvidersIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in epoch QoS reports
+	epochProviderQoSIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EpochProviderQoSList {
+		index := string(EpochProviderQoSKey(elem.Epoch, elem.ChainID, elem.Provider))
+		if _, ok := epochProviderQoSIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for epochProviderQoS")
+		}
+		epochProviderQoSIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -29,6 +29,15 @@ type GenesisState struct {
 	UniquePaymentStorageClientProviderList []UniquePaymentStorageClientProvider `protobuf:"bytes,2,rep,name=uniquePaymentStorageClientProviderList,proto3" json:"uniquePaymentStorageClientProviderList"`
 	ProviderPaymentStorageList             []ProviderPaymentStorage             `protobuf:"bytes,3,rep,name=providerPaymentStorageList,proto3" json:"providerPaymentStorageList"`
 	EpochPaymentsList                      []EpochPayments                      `protobuf:"bytes,4,rep,name=epochPaymentsList,proto3" json:"epochPaymentsList"`
+	PlansList                              []Plan                               `protobuf:"bytes,5,rep,name=plansList,proto3" json:"plansList"`
+	SubscriptionList                       []Subscription                       `protobuf:"bytes,6,rep,name=subscriptionList,proto3" json:"subscriptionList"`
+	ProviderQoSList                        []ProviderQoS                        `protobuf:"bytes,7,rep,name=providerQoSList,proto3" json:"providerQoSList"`
+	EpochQoSFactorsList                    []EpochQoSFactors                    `protobuf:"bytes,8,rep,name=epochQoSFactorsList,proto3" json:"epochQoSFactorsList"`
+	ProviderJailList                       []ProviderJail                       `protobuf:"bytes,9,rep,name=providerJailList,proto3" json:"providerJailList"`
+	FreeTxQuotaList                        []FreeTxQuota                        `protobuf:"bytes,10,rep,name=freeTxQuotaList,proto3" json:"freeTxQuotaList"`
+	ExpiredSubscriptionList                []Subscription                       `protobuf:"bytes,11,rep,name=expiredSubscriptionList,proto3" json:"expiredSubscriptionList"`
+	OperatorProvidersList                  []OperatorProviders                  `protobuf:"bytes,12,rep,name=operatorProvidersList,proto3" json:"operatorProvidersList"`
+	EpochProviderQoSList                   []EpochProviderQoS                   `protobuf:"bytes,13,rep,name=epochProviderQoSList,proto3" json:"epochProviderQoSList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +101,69 @@ func (m *GenesisState) GetEpochPaymentsList() []EpochPayments {
 	return nil
 }
 
+func (m *GenesisState) GetPlansList() []Plan {
+	if m != nil {
+		return m.PlansList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubscriptionList() []Subscription {
+	if m != nil {
+		return m.SubscriptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderQoSList() []ProviderQoS {
+	if m != nil {
+		return m.ProviderQoSList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochQoSFactorsList() []EpochQoSFactors {
+	if m != nil {
+		return m.EpochQoSFactorsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderJailList() []ProviderJail {
+	if m != nil {
+		return m.ProviderJailList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreeTxQuotaList() []FreeTxQuota {
+	if m != nil {
+		return m.FreeTxQuotaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiredSubscriptionList() []Subscription {
+	if m != nil {
+		return m.ExpiredSubscriptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorProvidersList() []OperatorProviders {
+	if m != nil {
+		return m.OperatorProvidersList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochProviderQoSList() []EpochProviderQoS {
+	if m != nil {
+		return m.EpochProviderQoSList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
 }
@@ -99,29 +171,45 @@ func init() {
 func init() { proto.RegisterFile("pairing/genesis.proto", fileDescriptor_9f33c5159def4248) }
 
 var fileDescriptor_9f33c5159def4248 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x48, 0xcc, 0x2c,
-	0xca, 0xcc, 0x4b, 0xd7, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0xc9, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x50, 0x35,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x05, 0xfa, 0x20, 0x16, 0x44, 0xad, 0x94, 0x08, 0xcc,
-	0x88, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x09, 0x52, 0x26, 0x30, 0xd1, 0xd2, 0xbc, 0xcc, 0xc2,
-	0xd2, 0xd4, 0xf8, 0x82, 0xc4, 0xca, 0xdc, 0xd4, 0xbc, 0x92, 0xf8, 0xe2, 0x92, 0xfc, 0xa2, 0xc4,
-	0xf4, 0xd4, 0xf8, 0xe4, 0x9c, 0x4c, 0x10, 0xb7, 0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x08,
-	0xaa, 0x4b, 0x0d, 0x6e, 0x16, 0x54, 0x1c, 0x5d, 0x1f, 0x54, 0x9d, 0x0c, 0x4c, 0x5d, 0x6a, 0x41,
-	0x7e, 0x72, 0x06, 0x4c, 0x11, 0xd4, 0x6e, 0xa5, 0x0d, 0xcc, 0x5c, 0x3c, 0xee, 0x10, 0xff, 0x04,
-	0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x71, 0xb1, 0x41, 0x1c, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x6d, 0x24, 0xa3, 0x87, 0xcd, 0x7f, 0x7a, 0x01, 0x60, 0x35, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0x75, 0x08, 0x4d, 0x63, 0xe4, 0x52, 0x83, 0xf8, 0x21, 0x00, 0x62, 0x4b, 0x30, 0xc4,
-	0x25, 0xce, 0x60, 0x0f, 0x04, 0x40, 0xdd, 0xe9, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0x64, 0x81, 0xdd, 0xf0, 0x50, 0x82, 0x66, 0x40, 0x2d, 0x26, 0xd2, 0x36, 0xa1, 0x22,
-	0x2e, 0x29, 0x58, 0x28, 0xa1, 0xaa, 0x05, 0xbb, 0x85, 0x19, 0xec, 0x16, 0x1d, 0x1c, 0x1e, 0xc5,
-	0xaa, 0x0f, 0x6a, 0x3f, 0x1e, 0x53, 0x85, 0xc2, 0xb9, 0x04, 0xc1, 0x21, 0x0e, 0x95, 0x2a, 0x06,
-	0x5b, 0xc5, 0x02, 0xb6, 0x4a, 0x19, 0xbb, 0x55, 0xae, 0xc8, 0xca, 0xa1, 0x36, 0x60, 0x9a, 0xe1,
-	0xe4, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1b, 0xc0, 0xb4, 0x7e, 0x85, 0x3e, 0x2c,
-	0x11, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x23, 0xdf, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x6d, 0x04, 0x57, 0x99, 0xd3, 0x02, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0x1b, 0x36, 0x0a, 0x75, 0x87, 0x00, 0xd3, 0x89, 0x29, 0x4c, 0x59, 0x19, 0xa2, 0xdb,
+	0x01, 0xa5, 0xd2, 0xe0, 0x80, 0x38, 0x20, 0x31, 0xc4, 0x90, 0x10, 0x12, 0x2d, 0x19, 0x42, 0x42,
+	0x42, 0xc1, 0xcd, 0xbc, 0xcc, 0x28, 0x8d, 0x5d, 0xc7, 0x9d, 0xba, 0xff, 0x82, 0x13, 0x7f, 0xd3,
+	0x0e, 0x1c, 0x76, 0xe4, 0x84, 0x50, 0xfb, 0x8f, 0xa0, 0x7c, 0x63, 0xb7, 0x5d, 0x9a, 0x06, 0x38,
+	0xa5, 0x8d, 0xdf, 0xfb, 0xbc, 0xf8, 0xf9, 0x07, 0x5a, 0x17, 0x84, 0x49, 0x16, 0x87, 0xed, 0x90,
+	0xc6, 0x34, 0x61, 0x89, 0x2b, 0x24, 0x57, 0x1c, 0x37, 0x22, 0x72, 0x4a, 0x62, 0xaa, 0xdc, 0xf4,
+	0xe9, 0x6a, 0x8d, 0xdd, 0x08, 0x79, 0xc8, 0x41, 0xd0, 0x4e, 0x7f, 0x65, 0x5a, 0xbb, 0x61, 0x10,
+	0x82, 0x48, 0xd2, 0xd7, 0x04, 0xfb, 0x89, 0x79, 0x3b, 0x8c, 0xd9, 0x60, 0x48, 0x7d, 0x41, 0xce,
+	0xfa, 0x34, 0x56, 0x7e, 0xa2, 0xb8, 0x24, 0x21, 0xf5, 0x83, 0x88, 0xa5, 0x7f, 0x85, 0xe4, 0xa7,
+	0xec, 0x88, 0x4a, 0xed, 0x6a, 0x4d, 0x59, 0xfa, 0x7d, 0xde, 0xa7, 0x75, 0x9b, 0x46, 0x47, 0x05,
+	0x0f, 0x4e, 0x8c, 0xc8, 0x64, 0xdb, 0x66, 0x34, 0x19, 0xf6, 0x92, 0x40, 0x32, 0xa1, 0x18, 0x8f,
+	0xf3, 0x63, 0xd3, 0x84, 0x01, 0x37, 0xbe, 0xad, 0xcb, 0xd4, 0x01, 0x4f, 0xfc, 0x63, 0x12, 0x28,
+	0x2e, 0x8d, 0xe0, 0xde, 0x82, 0xf9, 0x2b, 0x61, 0x51, 0x7e, 0xf0, 0x58, 0x52, 0xea, 0xab, 0x91,
+	0x3f, 0x18, 0x72, 0x45, 0xf4, 0x60, 0xd3, 0x0c, 0x72, 0x41, 0x25, 0x51, 0x5c, 0x4e, 0x67, 0xae,
+	0xd9, 0xdb, 0x3f, 0x6a, 0x68, 0xed, 0x75, 0xb6, 0x08, 0x9e, 0x22, 0x8a, 0xe2, 0x67, 0xa8, 0x9a,
+	0x35, 0xba, 0x61, 0x35, 0xad, 0xdd, 0xfa, 0xde, 0xa6, 0x5b, 0xb4, 0x28, 0x6e, 0x07, 0x34, 0xfb,
+	0xab, 0xe7, 0xbf, 0xb6, 0x2a, 0xef, 0xb5, 0x03, 0x7f, 0xb7, 0x50, 0x2b, 0x2b, 0xbe, 0x93, 0x55,
+	0xe3, 0x65, 0xf5, 0xbd, 0x84, 0xd6, 0x3b, 0x3a, 0xfa, 0x2d, 0x4b, 0xd4, 0xc6, 0x95, 0xe6, 0xca,
+	0x6e, 0x7d, 0xef, 0x69, 0x31, 0xfc, 0xc3, 0x5f, 0x19, 0x3a, 0xf8, 0x1f, 0xd3, 0xb0, 0x44, 0xb6,
+	0x99, 0xf8, 0x65, 0x2d, 0x7c, 0xcb, 0x0a, 0x7c, 0xcb, 0xa3, 0x25, 0x13, 0x2d, 0xf4, 0xe9, 0xfc,
+	0x12, 0x2a, 0xfe, 0x88, 0x6e, 0xc3, 0x82, 0xea, 0xa1, 0x04, 0xa2, 0x56, 0x21, 0xea, 0x41, 0x71,
+	0xd4, 0xab, 0x79, 0xb9, 0x4e, 0x58, 0x64, 0xe0, 0xe7, 0xa8, 0x26, 0x22, 0x12, 0x67, 0xc0, 0xab,
+	0x00, 0xb4, 0x97, 0x7c, 0x7b, 0x44, 0x62, 0xcd, 0x99, 0x59, 0xf0, 0x21, 0xba, 0x35, 0xbf, 0x43,
+	0x01, 0x53, 0x05, 0xcc, 0x76, 0x31, 0xc6, 0x9b, 0x53, 0x6b, 0xdc, 0x02, 0x01, 0x77, 0xd1, 0x4d,
+	0x53, 0x46, 0x97, 0x7b, 0x00, 0xbd, 0x06, 0xd0, 0xfb, 0xe5, 0xbd, 0x76, 0xb9, 0xa7, 0x99, 0x79,
+	0x3f, 0xfe, 0x8c, 0xee, 0xc0, 0xec, 0xbb, 0xdc, 0x3b, 0xc8, 0x0e, 0x04, 0x60, 0xaf, 0x03, 0xf6,
+	0x61, 0x49, 0x87, 0x33, 0x83, 0x46, 0x17, 0x71, 0xd2, 0x1e, 0x4c, 0xe2, 0x1b, 0xc2, 0x22, 0x60,
+	0xd7, 0xca, 0x7a, 0xe8, 0xcc, 0xa9, 0x4d, 0x0f, 0x79, 0x42, 0xda, 0x43, 0x7a, 0x12, 0x0f, 0x47,
+	0xdd, 0xf4, 0x1c, 0x02, 0x14, 0x95, 0xf5, 0x70, 0x30, 0x13, 0x9b, 0x1e, 0x72, 0x7e, 0xdc, 0x43,
+	0x77, 0xe9, 0x48, 0x30, 0x49, 0x8f, 0xbc, 0xfc, 0xba, 0xd5, 0xff, 0x73, 0xdd, 0x96, 0x81, 0x70,
+	0x80, 0xd6, 0xcd, 0x1d, 0x61, 0xa6, 0x99, 0xb5, 0xbd, 0x06, 0x09, 0x3b, 0xc5, 0x09, 0xef, 0xf2,
+	0x16, 0x1d, 0x53, 0xcc, 0xc2, 0x5f, 0x50, 0x23, 0xdb, 0xce, 0xb9, 0x8d, 0x72, 0x03, 0x32, 0x5a,
+	0x65, 0xa7, 0x62, 0x61, 0xb7, 0x14, 0x92, 0xf6, 0x5f, 0x9c, 0x8f, 0x1d, 0xeb, 0x62, 0xec, 0x58,
+	0xbf, 0xc7, 0x8e, 0xf5, 0x6d, 0xe2, 0x54, 0x2e, 0x26, 0x4e, 0xe5, 0xe7, 0xc4, 0xa9, 0x7c, 0xda,
+	0x09, 0x99, 0x3a, 0x19, 0xf6, 0xdc, 0x80, 0xf7, 0xdb, 0x3a, 0x07, 0x9e, 0xed, 0x51, 0xdb, 0x5c,
+	0x92, 0xea, 0x4c, 0xd0, 0xa4, 0x57, 0x85, 0x8b, 0xf1, 0xf1, 0x9f, 0x01, 0x00, 0xa2, 0xc5, 0x04,
+	0x06, 0xa4, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +232,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochProviderQoSList) > 0 {
+		for iNdEx := len(m.EpochProviderQoSList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochProviderQoSList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.OperatorProvidersList) > 0 {
+		for iNdEx := len(m.OperatorProvidersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorProvidersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExpiredSubscriptionList) > 0 {
+		for iNdEx := len(m.ExpiredSubscriptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiredSubscriptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FreeTxQuotaList) > 0 {
+		for iNdEx := len(m.FreeTxQuotaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreeTxQuotaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProviderJailList) > 0 {
+		for iNdEx := len(m.ProviderJailList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderJailList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EpochQoSFactorsList) > 0 {
+		for iNdEx := len(m.EpochQoSFactorsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochQoSFactorsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProviderQoSList) > 0 {
+		for iNdEx := len(m.ProviderQoSList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderQoSList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SubscriptionList) > 0 {
+		for iNdEx := len(m.SubscriptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PlansList) > 0 {
+		for iNdEx := len(m.PlansList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlansList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.EpochPaymentsList) > 0 {
 		for iNdEx := len(m.EpochPaymentsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -236,6 +450,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PlansList) > 0 {
+		for _, e := range m.PlansList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubscriptionList) > 0 {
+		for _, e := range m.SubscriptionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderQoSList) > 0 {
+		for _, e := range m.ProviderQoSList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochQoSFactorsList) > 0 {
+		for _, e := range m.EpochQoSFactorsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderJailList) > 0 {
+		for _, e := range m.ProviderJailList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FreeTxQuotaList) > 0 {
+		for _, e := range m.FreeTxQuotaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiredSubscriptionList) > 0 {
+		for _, e := range m.ExpiredSubscriptionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorProvidersList) > 0 {
+		for _, e := range m.OperatorProvidersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochProviderQoSList) > 0 {
+		for _, e := range m.EpochProviderQoSList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -409,6 +677,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlansList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlansList = append(m.PlansList, Plan{})
+			if err := m.PlansList[len(m.PlansList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionList = append(m.SubscriptionList, Subscription{})
+			if err := m.SubscriptionList[len(m.SubscriptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderQoSList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderQoSList = append(m.ProviderQoSList, ProviderQoS{})
+			if err := m.ProviderQoSList[len(m.ProviderQoSList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochQoSFactorsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochQoSFactorsList = append(m.EpochQoSFactorsList, EpochQoSFactors{})
+			if err := m.EpochQoSFactorsList[len(m.EpochQoSFactorsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderJailList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderJailList = append(m.ProviderJailList, ProviderJail{})
+			if err := m.ProviderJailList[len(m.ProviderJailList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTxQuotaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeTxQuotaList = append(m.FreeTxQuotaList, FreeTxQuota{})
+			if err := m.FreeTxQuotaList[len(m.FreeTxQuotaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredSubscriptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredSubscriptionList = append(m.ExpiredSubscriptionList, Subscription{})
+			if err := m.ExpiredSubscriptionList[len(m.ExpiredSubscriptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorProvidersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorProvidersList = append(m.OperatorProvidersList, OperatorProviders{})
+			if err := m.OperatorProvidersList[len(m.OperatorProvidersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProviderQoSList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochProviderQoSList = append(m.EpochProviderQoSList, EpochProviderQoS{})
+			if err := m.EpochProviderQoSList[len(m.EpochProviderQoSList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
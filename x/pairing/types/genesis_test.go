@@ -95,6 +95,168 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated plans",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PlansList: []types.Plan{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated subscriptions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SubscriptionList: []types.Subscription{
+					{
+						Consumer: "0",
+					},
+					{
+						Consumer: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerQoS",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderQoSList: []types.ProviderQoS{
+					{
+						Provider: "0",
+						ChainID:  "0",
+					},
+					{
+						Provider: "0",
+						ChainID:  "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated epochQoSFactors",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochQoSFactorsList: []types.EpochQoSFactors{
+					{
+						Epoch:   1,
+						ChainID: "0",
+					},
+					{
+						Epoch:   1,
+						ChainID: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerJail",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderJailList: []types.ProviderJail{
+					{
+						Provider: "0",
+						ChainID:  "0",
+					},
+					{
+						Provider: "0",
+						ChainID:  "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated freeTxQuota",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FreeTxQuotaList: []types.FreeTxQuota{
+					{
+						Address: "0",
+						Epoch:   0,
+					},
+					{
+						Address: "0",
+						Epoch:   1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "expired subscription in subscriptions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SubscriptionList: []types.Subscription{
+					{
+						Consumer:    "0",
+						ExpiryBlock: 10,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated expired subscriptions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ExpiredSubscriptionList: []types.Subscription{
+					{
+						Consumer:    "0",
+						ExpiryBlock: 10,
+					},
+					{
+						Consumer:    "0",
+						ExpiryBlock: 10,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated operatorProviders",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OperatorProvidersList: []types.OperatorProviders{
+					{
+						Operator: "0",
+					},
+					{
+						Operator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated epochProviderQoS",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochProviderQoSList: []types.EpochProviderQoS{
+					{
+						Epoch:    0,
+						ChainID:  "0",
+						Provider: "0",
+					},
+					{
+						Epoch:    0,
+						ChainID:  "0",
+						Provider: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
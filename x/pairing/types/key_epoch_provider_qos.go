@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// EpochProviderQoSKeyPrefix is the prefix to retrieve all EpochProviderQoS
+	EpochProviderQoSKeyPrefix = "EpochProviderQoS/value/"
+)
+
+// EpochProviderQoSEpochKey returns the store key prefix of all the EpochProviderQoS of an epoch
+func EpochProviderQoSEpochKey(
+	epoch uint64,
+) []byte {
+	var key []byte
+
+	epochBytes := []byte(strconv.FormatUint(epoch, 10))
+	key = append(key, epochBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EpochProviderQoSChainKey returns the store key prefix of all the EpochProviderQoS of a chain in an epoch
+func EpochProviderQoSChainKey(
+	epoch uint64,
+	chainID string,
+) []byte {
+	key := EpochProviderQoSEpochKey(epoch)
+
+	chainIDBytes := []byte(chainID)
+	key = append(key, chainIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EpochProviderQoSKey returns the store key to retrieve an EpochProviderQoS from the index fields
+func EpochProviderQoSKey(
+	epoch uint64,
+	chainID string,
+	provider string,
+) []byte {
+	key := EpochProviderQoSChainKey(epoch, chainID)
+
+	providerBytes := []byte(provider)
+	key = append(key, providerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
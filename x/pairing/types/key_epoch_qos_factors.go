@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// EpochQoSFactorsKeyPrefix is the prefix to retrieve all EpochQoSFactors
+	EpochQoSFactorsKeyPrefix = "EpochQoSFactors/value/"
+)
+
+// EpochQoSFactorsEpochKey returns the store key prefix of all the EpochQoSFactors of an epoch
+func EpochQoSFactorsEpochKey(
+	epoch uint64,
+) []byte {
+	var key []byte
+
+	epochBytes := []byte(strconv.FormatUint(epoch, 10))
+	key = append(key, epochBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EpochQoSFactorsKey returns the store key to retrieve an EpochQoSFactors from the index fields
+func EpochQoSFactorsKey(
+	epoch uint64,
+	chainID string,
+) []byte {
+	key := EpochQoSFactorsEpochKey(epoch)
+
+	chainIDBytes := []byte(chainID)
+	key = append(key, chainIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// FreeTxQuotaKeyPrefix is the prefix to retrieve all FreeTxQuota
+	FreeTxQuotaKeyPrefix = "FreeTxQuota/value/"
+)
+
+// FreeTxQuotaKey returns the store key to retrieve a FreeTxQuota from the index fields
+func FreeTxQuotaKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
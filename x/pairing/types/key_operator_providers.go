@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// OperatorProvidersKeyPrefix is the prefix to retrieve all OperatorProviders
+	OperatorProvidersKeyPrefix = "OperatorProviders/value/"
+)
+
+// OperatorProvidersKey returns the store key to retrieve an OperatorProviders from the index fields
+func OperatorProvidersKey(
+	operator string,
+) []byte {
+	var key []byte
+
+	operatorBytes := []byte(operator)
+	key = append(key, operatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PlanKeyPrefix is the prefix to retrieve all Plan
+	PlanKeyPrefix = "Plan/value/"
+)
+
+// PlanKey returns the store key to retrieve a Plan from the index fields
+func PlanKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,28 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ProviderJailKeyPrefix is the prefix to retrieve all ProviderJail
+	ProviderJailKeyPrefix = "ProviderJail/value/"
+)
+
+// ProviderJailKey returns the store key to retrieve a ProviderJail from the index fields
+func ProviderJailKey(
+	provider string,
+	chainID string,
+) []byte {
+	var key []byte
+
+	providerBytes := []byte(provider)
+	key = append(key, providerBytes...)
+	key = append(key, []byte("/")...)
+
+	chainIDBytes := []byte(chainID)
+	key = append(key, chainIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
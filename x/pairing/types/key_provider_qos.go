@@ -0,0 +1,28 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ProviderQoSKeyPrefix is the prefix to retrieve all ProviderQoS
+	ProviderQoSKeyPrefix = "ProviderQoS/value/"
+)
+
+// ProviderQoSKey returns the store key to retrieve a ProviderQoS from the index fields
+func ProviderQoSKey(
+	provider string,
+	chainID string,
+) []byte {
+	var key []byte
+
+	providerBytes := []byte(provider)
+	key = append(key, providerBytes...)
+	key = append(key, []byte("/")...)
+
+	chainIDBytes := []byte(chainID)
+	key = append(key, chainIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// SubscriptionKeyPrefix is the prefix to retrieve all Subscription
+	SubscriptionKeyPrefix = "Subscription/value/"
+	// ExpiredSubscriptionKeyPrefix is the prefix to retrieve all expired Subscription
+	ExpiredSubscriptionKeyPrefix = "ExpiredSubscription/value/"
+)
+
+// SubscriptionKey returns the store key to retrieve a Subscription from the index fields
+func SubscriptionKey(
+	consumer string,
+) []byte {
+	var key []byte
+
+	consumerBytes := []byte(consumer)
+	key = append(key, consumerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ExpiredSubscriptionKey returns the store key to retrieve an expired Subscription from the index fields
+func ExpiredSubscriptionKey(
+	consumer string,
+	expiryBlock uint64,
+) []byte {
+	key := SubscriptionKey(consumer)
+
+	expiryBlockBytes := []byte(strconv.FormatUint(expiryBlock, 10))
+	key = append(key, expiryBlockBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
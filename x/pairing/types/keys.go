@@ -15,6 +15,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_pairing"
+
+	// ProposalsRouterKey defines the module's governance proposals routing key
+	ProposalsRouterKey = "pairingproposals"
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBuySubscription = "buy_subscription"
+
+var _ sdk.Msg = &MsgBuySubscription{}
+
+func NewMsgBuySubscription(creator string, index string, geolocation uint64, vrfpk string) *MsgBuySubscription {
+	return &MsgBuySubscription{
+		Creator:     creator,
+		Index:       index,
+		Geolocation: geolocation,
+		Vrfpk:       vrfpk,
+	}
+}
+
+func (msg *MsgBuySubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBuySubscription) Type() string {
+	return TypeMsgBuySubscription
+}
+
+func (msg *MsgBuySubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBuySubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBuySubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(strings.TrimSpace(msg.Index)) == 0 {
+		return sdkerrors.Wrap(ErrBlankPlanIndex, "plan index cannot be blank")
+	}
+	return nil
+}
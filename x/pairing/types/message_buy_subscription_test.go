@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBuySubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBuySubscription
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBuySubscription{
+				Creator: "invalid_address",
+				Index:   "basic",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "blank plan index",
+			msg: MsgBuySubscription{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrBlankPlanIndex,
+		}, {
+			name: "valid address",
+			msg: MsgBuySubscription{
+				Creator: sample.AccAddress(),
+				Index:   "basic",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
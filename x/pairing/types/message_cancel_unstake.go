@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelUnstake = "cancel_unstake"
+
+var _ sdk.Msg = &MsgCancelUnstake{}
+
+func NewMsgCancelUnstake(creator string, chainID string, provider bool) *MsgCancelUnstake {
+	return &MsgCancelUnstake{
+		Creator:  creator,
+		ChainID:  chainID,
+		Provider: provider,
+	}
+}
+
+func (msg *MsgCancelUnstake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelUnstake) Type() string {
+	return TypeMsgCancelUnstake
+}
+
+func (msg *MsgCancelUnstake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelUnstake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgModifyClient = "modify_client"
+
+var _ sdk.Msg = &MsgModifyClient{}
+
+func NewMsgModifyClient(creator string, chainID string, amount sdk.Coin, geolocation uint64, vrfpk string) *MsgModifyClient {
+	return &MsgModifyClient{
+		Creator:     creator,
+		ChainID:     chainID,
+		Amount:      amount,
+		Geolocation: geolocation,
+		Vrfpk:       vrfpk,
+	}
+}
+
+func (msg *MsgModifyClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgModifyClient) Type() string {
+	return TypeMsgModifyClient
+}
+
+func (msg *MsgModifyClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgModifyClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgModifyClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
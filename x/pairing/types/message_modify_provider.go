@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+)
+
+const TypeMsgModifyProvider = "modify_provider"
+
+var _ sdk.Msg = &MsgModifyProvider{}
+
+func NewMsgModifyProvider(creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, moniker string, operator string) *MsgModifyProvider {
+	return &MsgModifyProvider{
+		Creator:     creator,
+		ChainID:     chainID,
+		Amount:      amount,
+		Endpoints:   endpoints,
+		Geolocation: geolocation,
+		Moniker:     moniker,
+		Operator:    operator,
+	}
+}
+
+func (msg *MsgModifyProvider) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgModifyProvider) Type() string {
+	return TypeMsgModifyProvider
+}
+
+func (msg *MsgModifyProvider) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgModifyProvider) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgModifyProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Operator != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Operator)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+		}
+	}
+	for _, endpoint := range msg.Endpoints {
+		if err := endpoint.ValidateTLSCertHash(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
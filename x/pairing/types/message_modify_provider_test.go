@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgModifyProvider_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgModifyProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgModifyProvider{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgModifyProvider{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "operator address",
+			msg: MsgModifyProvider{
+				Creator:  sample.AccAddress(),
+				Operator: sample.AccAddress(),
+			},
+		}, {
+			name: "invalid operator address",
+			msg: MsgModifyProvider{
+				Creator:  sample.AccAddress(),
+				Operator: "BigOperator",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "tls certificate hash",
+			msg: MsgModifyProvider{
+				Creator:   sample.AccAddress(),
+				Endpoints: []epochstoragetypes.Endpoint{{IPPORT: "127.0.0.1:2221", UseType: "jsonrpc", Geolocation: 1, TlsCertHash: strings.Repeat("ab", 32)}},
+			},
+		}, {
+			name: "invalid tls certificate hash",
+			msg: MsgModifyProvider{
+				Creator:   sample.AccAddress(),
+				Endpoints: []epochstoragetypes.Endpoint{{IPPORT: "127.0.0.1:2221", UseType: "jsonrpc", Geolocation: 1, TlsCertHash: strings.Repeat("ab", 20)}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetOperatorProviders = "set_operator_providers"
+
+var _ sdk.Msg = &MsgSetOperatorProviders{}
+
+func NewMsgSetOperatorProviders(creator string, providers []string) *MsgSetOperatorProviders {
+	return &MsgSetOperatorProviders{
+		Creator:   creator,
+		Providers: providers,
+	}
+}
+
+func (msg *MsgSetOperatorProviders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetOperatorProviders) Type() string {
+	return TypeMsgSetOperatorProviders
+}
+
+func (msg *MsgSetOperatorProviders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetOperatorProviders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetOperatorProviders) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Providers) > MaxOperatorProviders {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "an operator can approve up to %d providers", MaxOperatorProviders)
+	}
+	providers := map[string]bool{}
+	for _, provider := range msg.Providers {
+		_, err := sdk.AccAddressFromBech32(provider)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address %s (%s)", provider, err)
+		}
+		if providers[provider] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated provider %s", provider)
+		}
+		providers[provider] = true
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetOperatorProviders_ValidateBasic(t *testing.T) {
+	provider := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetOperatorProviders
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetOperatorProviders{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgSetOperatorProviders{
+				Creator:   sample.AccAddress(),
+				Providers: []string{provider},
+			},
+		}, {
+			name: "invalid provider address",
+			msg: MsgSetOperatorProviders{
+				Creator:   sample.AccAddress(),
+				Providers: []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicated provider",
+			msg: MsgSetOperatorProviders{
+				Creator:   sample.AccAddress(),
+				Providers: []string{provider, provider},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
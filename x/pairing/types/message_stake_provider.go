@@ -10,7 +10,7 @@ const TypeMsgStakeProvider = "stake_provider"
 
 var _ sdk.Msg = &MsgStakeProvider{}
 
-func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, moniker string) *MsgStakeProvider {
+func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, moniker string, operator string) *MsgStakeProvider {
 	return &MsgStakeProvider{
 		Creator:     creator,
 		ChainID:     chainID,
@@ -18,6 +18,7 @@ func NewMsgStakeProvider(creator string, chainID string, amount sdk.Coin, endpoi
 		Endpoints:   endpoints,
 		Geolocation: geolocation,
 		Moniker:     moniker,
+		Operator:    operator,
 	}
 }
 
@@ -47,5 +48,16 @@ func (msg *MsgStakeProvider) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.Operator != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Operator)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+		}
+	}
+	for _, endpoint := range msg.Endpoints {
+		if err := endpoint.ValidateTLSCertHash(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	return nil
 }
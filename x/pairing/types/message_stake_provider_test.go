@@ -1,10 +1,12 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/lavanet/lava/testutil/sample"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +27,32 @@ func TestMsgStakeProvider_ValidateBasic(t *testing.T) {
 			msg: MsgStakeProvider{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "operator address",
+			msg: MsgStakeProvider{
+				Creator:  sample.AccAddress(),
+				Operator: sample.AccAddress(),
+			},
+		}, {
+			name: "invalid operator address",
+			msg: MsgStakeProvider{
+				Creator:  sample.AccAddress(),
+				Operator: "BigOperator",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "tls certificate hash",
+			msg: MsgStakeProvider{
+				Creator:   sample.AccAddress(),
+				Endpoints: []epochstoragetypes.Endpoint{{IPPORT: "127.0.0.1:2221", UseType: "jsonrpc", Geolocation: 1, TlsCertHash: strings.Repeat("ab", 32)}},
+			},
+		}, {
+			name: "invalid tls certificate hash",
+			msg: MsgStakeProvider{
+				Creator:   sample.AccAddress(),
+				Endpoints: []epochstoragetypes.Endpoint{{IPPORT: "127.0.0.1:2221", UseType: "jsonrpc", Geolocation: 1, TlsCertHash: strings.Repeat("ab", 20)}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateClientVrfpk = "update_client_vrfpk"
+
+var _ sdk.Msg = &MsgUpdateClientVrfpk{}
+
+func NewMsgUpdateClientVrfpk(creator string, vrfpk string) *MsgUpdateClientVrfpk {
+	return &MsgUpdateClientVrfpk{
+		Creator: creator,
+		Vrfpk:   vrfpk,
+	}
+}
+
+func (msg *MsgUpdateClientVrfpk) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateClientVrfpk) Type() string {
+	return TypeMsgUpdateClientVrfpk
+}
+
+func (msg *MsgUpdateClientVrfpk) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateClientVrfpk) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateClientVrfpk) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Vrfpk == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty vrf pk")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateClientVrfpk_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateClientVrfpk
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateClientVrfpk{
+				Creator: "invalid_address",
+				Vrfpk:   "vrf1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty vrfpk",
+			msg: MsgUpdateClientVrfpk{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUpdateClientVrfpk{
+				Creator: sample.AccAddress(),
+				Vrfpk:   "vrf1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -78,6 +78,46 @@ var (
 	DefaultQoSWeight sdk.Dec = sdk.NewDecWithPrec(5, 1) // 0.5
 )
 
+var (
+	KeyReputationDecayFactor             = []byte("ReputationDecayFactor")
+	DefaultReputationDecayFactor sdk.Dec = sdk.NewDecWithPrec(9, 1) // 0.9
+)
+
+var (
+	KeyMaxProvidersPerOperator            = []byte("MaxProvidersPerOperator")
+	DefaultMaxProvidersPerOperator uint64 = 0 // 0 = no limit on the pairing slots of an operator
+)
+
+var (
+	KeyQoSPairingMinFactor             = []byte("QoSPairingMinFactor")
+	DefaultQoSPairingMinFactor sdk.Dec = sdk.NewDecWithPrec(5, 1) // 0.5
+)
+
+var (
+	KeyQoSPairingEpochs            = []byte("QoSPairingEpochs")
+	DefaultQoSPairingEpochs uint64 = 10
+)
+
+var (
+	KeyUnresponsiveJailEpochs            = []byte("UnresponsiveJailEpochs")
+	DefaultUnresponsiveJailEpochs uint64 = 1 // multiplied by the number of offenses
+)
+
+var (
+	KeyUnresponsiveOffensesToUnstake            = []byte("UnresponsiveOffensesToUnstake")
+	DefaultUnresponsiveOffensesToUnstake uint64 = 3
+)
+
+var (
+	KeyUnresponsiveSlashFactor             = []byte("UnresponsiveSlashFactor")
+	DefaultUnresponsiveSlashFactor sdk.Dec = sdk.ZeroDec()
+)
+
+var (
+	KeyFreeTxsPerEpoch            = []byte("FreeTxsPerEpoch")
+	DefaultFreeTxsPerEpoch uint64 = 10
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -96,19 +136,35 @@ func NewParams(
 	slashLimit sdk.Dec,
 	dataReliabilityReward sdk.Dec,
 	qoSWeight sdk.Dec,
+	reputationDecayFactor sdk.Dec,
+	maxProvidersPerOperator uint64,
+	qosPairingMinFactor sdk.Dec,
+	qosPairingEpochs uint64,
+	unresponsiveJailEpochs uint64,
+	unresponsiveOffensesToUnstake uint64,
+	unresponsiveSlashFactor sdk.Dec,
+	freeTxsPerEpoch uint64,
 ) Params {
 	return Params{
-		MintCoinsPerCU:           mintCoinsPerCU,
-		BurnCoinsPerCU:           burnCoinsPerCU,
-		FraudStakeSlashingFactor: fraudStakeSlashingFactor,
-		FraudSlashingAmount:      fraudSlashingAmount,
-		ServicersToPairCount:     servicersToPairCount,
-		EpochBlocksOverlap:       epochBlocksOverlap,
-		StakeToMaxCUList:         stakeToMaxCUList,
-		UnpayLimit:               unpayLimit,
-		SlashLimit:               slashLimit,
-		DataReliabilityReward:    dataReliabilityReward,
-		QoSWeight:                qoSWeight,
+		MintCoinsPerCU:                mintCoinsPerCU,
+		BurnCoinsPerCU:                burnCoinsPerCU,
+		FraudStakeSlashingFactor:      fraudStakeSlashingFactor,
+		FraudSlashingAmount:           fraudSlashingAmount,
+		ServicersToPairCount:          servicersToPairCount,
+		EpochBlocksOverlap:            epochBlocksOverlap,
+		StakeToMaxCUList:              stakeToMaxCUList,
+		UnpayLimit:                    unpayLimit,
+		SlashLimit:                    slashLimit,
+		DataReliabilityReward:         dataReliabilityReward,
+		QoSWeight:                     qoSWeight,
+		ReputationDecayFactor:         reputationDecayFactor,
+		MaxProvidersPerOperator:       maxProvidersPerOperator,
+		QosPairingMinFactor:           qosPairingMinFactor,
+		QosPairingEpochs:              qosPairingEpochs,
+		UnresponsiveJailEpochs:        unresponsiveJailEpochs,
+		UnresponsiveOffensesToUnstake: unresponsiveOffensesToUnstake,
+		UnresponsiveSlashFactor:       unresponsiveSlashFactor,
+		FreeTxsPerEpoch:               freeTxsPerEpoch,
 	}
 }
 
@@ -126,6 +182,14 @@ func DefaultParams() Params {
 		DefaultSlashLimit,
 		DefaultDataReliabilityReward,
 		DefaultQoSWeight,
+		DefaultReputationDecayFactor,
+		DefaultMaxProvidersPerOperator,
+		DefaultQoSPairingMinFactor,
+		DefaultQoSPairingEpochs,
+		DefaultUnresponsiveJailEpochs,
+		DefaultUnresponsiveOffensesToUnstake,
+		DefaultUnresponsiveSlashFactor,
+		DefaultFreeTxsPerEpoch,
 	)
 }
 
@@ -143,6 +207,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashLimit, &p.SlashLimit, validateSlashLimit),
 		paramtypes.NewParamSetPair(KeyDataReliabilityReward, &p.DataReliabilityReward, validateDataReliabilityReward),
 		paramtypes.NewParamSetPair(KeyQoSWeight, &p.QoSWeight, validateQoSWeight),
+		paramtypes.NewParamSetPair(KeyReputationDecayFactor, &p.ReputationDecayFactor, validateReputationDecayFactor),
+		paramtypes.NewParamSetPair(KeyMaxProvidersPerOperator, &p.MaxProvidersPerOperator, validateMaxProvidersPerOperator),
+		paramtypes.NewParamSetPair(KeyQoSPairingMinFactor, &p.QosPairingMinFactor, validateQoSPairingMinFactor),
+		paramtypes.NewParamSetPair(KeyQoSPairingEpochs, &p.QosPairingEpochs, validateQoSPairingEpochs),
+		paramtypes.NewParamSetPair(KeyUnresponsiveJailEpochs, &p.UnresponsiveJailEpochs, validateUnresponsiveJailEpochs),
+		paramtypes.NewParamSetPair(KeyUnresponsiveOffensesToUnstake, &p.UnresponsiveOffensesToUnstake, validateUnresponsiveOffensesToUnstake),
+		paramtypes.NewParamSetPair(KeyUnresponsiveSlashFactor, &p.UnresponsiveSlashFactor, validateUnresponsiveSlashFactor),
+		paramtypes.NewParamSetPair(KeyFreeTxsPerEpoch, &p.FreeTxsPerEpoch, validateFreeTxsPerEpoch),
 	}
 }
 
@@ -186,6 +258,30 @@ func (p Params) Validate() error {
 	if err := validateDataReliabilityReward(p.DataReliabilityReward); err != nil {
 		return err
 	}
+	if err := validateReputationDecayFactor(p.ReputationDecayFactor); err != nil {
+		return err
+	}
+	if err := validateMaxProvidersPerOperator(p.MaxProvidersPerOperator); err != nil {
+		return err
+	}
+	if err := validateQoSPairingMinFactor(p.QosPairingMinFactor); err != nil {
+		return err
+	}
+	if err := validateQoSPairingEpochs(p.QosPairingEpochs); err != nil {
+		return err
+	}
+	if err := validateUnresponsiveJailEpochs(p.UnresponsiveJailEpochs); err != nil {
+		return err
+	}
+	if err := validateUnresponsiveOffensesToUnstake(p.UnresponsiveOffensesToUnstake); err != nil {
+		return err
+	}
+	if err := validateUnresponsiveSlashFactor(p.UnresponsiveSlashFactor); err != nil {
+		return err
+	}
+	if err := validateFreeTxsPerEpoch(p.FreeTxsPerEpoch); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -354,3 +450,107 @@ func validateQoSWeight(v interface{}) error {
 
 	return nil
 }
+
+// validateReputationDecayFactor validates the param
+func validateReputationDecayFactor(v interface{}) error {
+	reputationDecayFactor, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if reputationDecayFactor.GT(sdk.OneDec()) || reputationDecayFactor.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter ReputationDecayFactor")
+	}
+
+	return nil
+}
+
+// validateMaxProvidersPerOperator validates the param
+func validateMaxProvidersPerOperator(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateQoSPairingMinFactor validates the param
+func validateQoSPairingMinFactor(v interface{}) error {
+	qosPairingMinFactor, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if qosPairingMinFactor.GT(sdk.OneDec()) || qosPairingMinFactor.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter QoSPairingMinFactor")
+	}
+
+	return nil
+}
+
+// validateQoSPairingEpochs validates the param
+func validateQoSPairingEpochs(v interface{}) error {
+	qosPairingEpochs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if qosPairingEpochs == 0 {
+		return fmt.Errorf("invalid parameter QoSPairingEpochs, can't be zero")
+	}
+
+	return nil
+}
+
+// validateUnresponsiveJailEpochs validates the param
+func validateUnresponsiveJailEpochs(v interface{}) error {
+	unresponsiveJailEpochs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if unresponsiveJailEpochs == 0 {
+		return fmt.Errorf("invalid parameter UnresponsiveJailEpochs, can't be zero")
+	}
+
+	return nil
+}
+
+// validateUnresponsiveOffensesToUnstake validates the param
+func validateUnresponsiveOffensesToUnstake(v interface{}) error {
+	unresponsiveOffensesToUnstake, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if unresponsiveOffensesToUnstake == 0 {
+		return fmt.Errorf("invalid parameter UnresponsiveOffensesToUnstake, can't be zero")
+	}
+
+	return nil
+}
+
+// validateUnresponsiveSlashFactor validates the param
+func validateUnresponsiveSlashFactor(v interface{}) error {
+	unresponsiveSlashFactor, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if unresponsiveSlashFactor.GT(sdk.OneDec()) || unresponsiveSlashFactor.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("invalid parameter UnresponsiveSlashFactor")
+	}
+
+	return nil
+}
+
+// validateFreeTxsPerEpoch validates the param, zero disables fee-free transactions
+func validateFreeTxsPerEpoch(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -26,17 +26,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	MintCoinsPerCU           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mintCoinsPerCU,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mintCoinsPerCU" yaml:"mint_coins_per_cu"`
-	BurnCoinsPerCU           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burnCoinsPerCU,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burnCoinsPerCU" yaml:"burn_coins_per_cu"`
-	FraudStakeSlashingFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraudStakeSlashingFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraudStakeSlashingFactor" yaml:"fraud_stake_slashing_factor"`
-	FraudSlashingAmount      uint64                                 `protobuf:"varint,6,opt,name=fraudSlashingAmount,proto3" json:"fraudSlashingAmount,omitempty" yaml:"fraud_slashing_amount"`
-	ServicersToPairCount     uint64                                 `protobuf:"varint,7,opt,name=servicersToPairCount,proto3" json:"servicersToPairCount,omitempty" yaml:"servicers_to_pair_count"`
-	EpochBlocksOverlap       uint64                                 `protobuf:"varint,8,opt,name=epochBlocksOverlap,proto3" json:"epochBlocksOverlap,omitempty" yaml:"epoch_blocks_overlap"`
-	StakeToMaxCUList         StakeToMaxCUList                       `protobuf:"bytes,9,opt,name=stakeToMaxCUList,proto3,customtype=StakeToMaxCUList" json:"stakeToMaxCUList" yaml:"stake_to_computeunits_list"`
-	UnpayLimit               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=unpayLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unpayLimit" yaml:"unpay_limit"`
-	SlashLimit               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=slashLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slashLimit" yaml:"slash_limit"`
-	DataReliabilityReward    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=dataReliabilityReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dataReliabilityReward" yaml:"data_reliability_reward"`
-	QoSWeight                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSWeight" yaml:"data_reliability_reward"`
+	MintCoinsPerCU                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mintCoinsPerCU,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mintCoinsPerCU" yaml:"mint_coins_per_cu"`
+	BurnCoinsPerCU                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burnCoinsPerCU,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burnCoinsPerCU" yaml:"burn_coins_per_cu"`
+	FraudStakeSlashingFactor      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraudStakeSlashingFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraudStakeSlashingFactor" yaml:"fraud_stake_slashing_factor"`
+	FraudSlashingAmount           uint64                                 `protobuf:"varint,6,opt,name=fraudSlashingAmount,proto3" json:"fraudSlashingAmount,omitempty" yaml:"fraud_slashing_amount"`
+	ServicersToPairCount          uint64                                 `protobuf:"varint,7,opt,name=servicersToPairCount,proto3" json:"servicersToPairCount,omitempty" yaml:"servicers_to_pair_count"`
+	EpochBlocksOverlap            uint64                                 `protobuf:"varint,8,opt,name=epochBlocksOverlap,proto3" json:"epochBlocksOverlap,omitempty" yaml:"epoch_blocks_overlap"`
+	StakeToMaxCUList              StakeToMaxCUList                       `protobuf:"bytes,9,opt,name=stakeToMaxCUList,proto3,customtype=StakeToMaxCUList" json:"stakeToMaxCUList" yaml:"stake_to_computeunits_list"`
+	UnpayLimit                    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=unpayLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unpayLimit" yaml:"unpay_limit"`
+	SlashLimit                    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=slashLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slashLimit" yaml:"slash_limit"`
+	DataReliabilityReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=dataReliabilityReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dataReliabilityReward" yaml:"data_reliability_reward"`
+	QoSWeight                     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=QoSWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"QoSWeight" yaml:"data_reliability_reward"`
+	ReputationDecayFactor         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=reputationDecayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reputationDecayFactor" yaml:"reputation_decay_factor"`
+	MaxProvidersPerOperator       uint64                                 `protobuf:"varint,15,opt,name=maxProvidersPerOperator,proto3" json:"maxProvidersPerOperator,omitempty" yaml:"max_providers_per_operator"`
+	QosPairingMinFactor           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=qosPairingMinFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"qosPairingMinFactor" yaml:"qos_pairing_min_factor"`
+	QosPairingEpochs              uint64                                 `protobuf:"varint,17,opt,name=qosPairingEpochs,proto3" json:"qosPairingEpochs,omitempty" yaml:"qos_pairing_epochs"`
+	UnresponsiveJailEpochs        uint64                                 `protobuf:"varint,18,opt,name=unresponsiveJailEpochs,proto3" json:"unresponsiveJailEpochs,omitempty" yaml:"unresponsive_jail_epochs"`
+	UnresponsiveOffensesToUnstake uint64                                 `protobuf:"varint,19,opt,name=unresponsiveOffensesToUnstake,proto3" json:"unresponsiveOffensesToUnstake,omitempty" yaml:"unresponsive_offenses_to_unstake"`
+	UnresponsiveSlashFactor       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=unresponsiveSlashFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unresponsiveSlashFactor" yaml:"unresponsive_slash_factor"`
+	FreeTxsPerEpoch               uint64                                 `protobuf:"varint,21,opt,name=freeTxsPerEpoch,proto3" json:"freeTxsPerEpoch,omitempty" yaml:"free_txs_per_epoch"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -92,6 +100,41 @@ func (m *Params) GetEpochBlocksOverlap() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxProvidersPerOperator() uint64 {
+	if m != nil {
+		return m.MaxProvidersPerOperator
+	}
+	return 0
+}
+
+func (m *Params) GetQosPairingEpochs() uint64 {
+	if m != nil {
+		return m.QosPairingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetUnresponsiveJailEpochs() uint64 {
+	if m != nil {
+		return m.UnresponsiveJailEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetUnresponsiveOffensesToUnstake() uint64 {
+	if m != nil {
+		return m.UnresponsiveOffensesToUnstake
+	}
+	return 0
+}
+
+func (m *Params) GetFreeTxsPerEpoch() uint64 {
+	if m != nil {
+		return m.FreeTxsPerEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lavanet.lava.pairing.Params")
 }
@@ -99,44 +142,61 @@ func init() {
 func init() { proto.RegisterFile("pairing/params.proto", fileDescriptor_72cc734580d3bc3a) }
 
 var fileDescriptor_72cc734580d3bc3a = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xe3, 0x36, 0xbf, 0xfe, 0x9a, 0xe3, 0x8f, 0xa2, 0x23, 0x48, 0x16, 0x20, 0xbb, 0x78,
-	0x80, 0x2e, 0xc4, 0x03, 0x5b, 0x25, 0x86, 0x26, 0x15, 0x43, 0x55, 0xd4, 0xe0, 0xb4, 0x20, 0xb1,
-	0x9c, 0x2e, 0xce, 0xd5, 0x39, 0xc5, 0xf6, 0x59, 0x77, 0xe7, 0xd0, 0xbc, 0x01, 0xe6, 0x8e, 0x8c,
-	0xbc, 0x16, 0xa6, 0x8e, 0x1d, 0x11, 0x83, 0x85, 0x92, 0x77, 0x90, 0x57, 0x80, 0xfc, 0xd8, 0x6d,
-	0xd2, 0x10, 0x86, 0xa8, 0x62, 0x3a, 0xcb, 0xf7, 0x7d, 0x3e, 0x9f, 0xd3, 0x3d, 0xa7, 0x07, 0x35,
-	0x12, 0xca, 0x25, 0x8f, 0x03, 0x37, 0xa1, 0x92, 0x46, 0xaa, 0x99, 0x48, 0xa1, 0x05, 0x6e, 0x84,
-	0x74, 0x44, 0x63, 0xa6, 0x9b, 0xf9, 0xda, 0x2c, 0x23, 0x4f, 0x1a, 0x81, 0x08, 0x04, 0x04, 0xdc,
-	0xfc, 0xab, 0xc8, 0x3a, 0xdf, 0x6b, 0x68, 0xab, 0x03, 0xc5, 0x58, 0xa2, 0x87, 0x11, 0x8f, 0x75,
-	0x5b, 0xf0, 0x58, 0x75, 0x98, 0x6c, 0x9f, 0x9a, 0x9b, 0x3b, 0xc6, 0x6e, 0xad, 0x75, 0x78, 0x99,
-	0xd9, 0x95, 0x9f, 0x99, 0xfd, 0x22, 0xe0, 0x7a, 0x90, 0xf6, 0x9a, 0xbe, 0x88, 0x5c, 0x5f, 0xa8,
-	0x48, 0xa8, 0x72, 0x79, 0xa5, 0xfa, 0x43, 0x57, 0x8f, 0x13, 0xa6, 0x9a, 0x07, 0xcc, 0x9f, 0x65,
-	0xb6, 0x39, 0xa6, 0x51, 0xb8, 0xe7, 0xe4, 0x34, 0xe2, 0xe7, 0x38, 0x92, 0x30, 0x49, 0xfc, 0xd4,
-	0xf1, 0x96, 0x0c, 0xb9, 0xb3, 0x97, 0xca, 0x78, 0xc1, 0x59, 0xbd, 0x9b, 0x33, 0xa7, 0x2d, 0x3b,
-	0x6f, 0x1b, 0xf0, 0x85, 0x81, 0xcc, 0x33, 0x49, 0xd3, 0x7e, 0x57, 0xd3, 0x21, 0xeb, 0x86, 0x54,
-	0x0d, 0x78, 0x1c, 0xbc, 0xa5, 0xbe, 0x16, 0xd2, 0xfc, 0x0f, 0xf4, 0x27, 0x6b, 0xeb, 0x9d, 0x42,
-	0x0f, 0x5c, 0xa2, 0x72, 0x30, 0x51, 0x25, 0x99, 0x9c, 0x01, 0xda, 0xf1, 0xfe, 0x6a, 0xc5, 0x1e,
-	0x7a, 0x54, 0xec, 0x95, 0xbf, 0xf7, 0x23, 0x91, 0xc6, 0xda, 0xdc, 0xda, 0x31, 0x76, 0xab, 0xad,
-	0x9d, 0x59, 0x66, 0x3f, 0xbb, 0x85, 0xbf, 0x06, 0x53, 0x88, 0x39, 0xde, 0xaa, 0x62, 0xfc, 0x01,
-	0x35, 0x14, 0x93, 0x23, 0xee, 0x33, 0xa9, 0x4e, 0x44, 0x87, 0x72, 0xd9, 0x06, 0xe8, 0xff, 0x00,
-	0x75, 0x66, 0x99, 0x6d, 0x15, 0xd0, 0x9b, 0x14, 0xd1, 0x82, 0xe4, 0xaf, 0x85, 0xf8, 0x05, 0x76,
-	0x65, 0x3d, 0x3e, 0x46, 0x98, 0x25, 0xc2, 0x1f, 0xb4, 0x42, 0xe1, 0x0f, 0xd5, 0xf1, 0x88, 0xc9,
-	0x90, 0x26, 0xe6, 0x36, 0x50, 0xed, 0x59, 0x66, 0x3f, 0x2d, 0xa8, 0x90, 0x21, 0x3d, 0x08, 0x11,
-	0x51, 0xa4, 0x1c, 0x6f, 0x45, 0x29, 0xe6, 0xa8, 0x0e, 0x17, 0x76, 0x22, 0xde, 0xd1, 0xf3, 0xf6,
-	0xe9, 0x11, 0x57, 0xda, 0xac, 0x41, 0x1b, 0xde, 0x94, 0x6d, 0xa8, 0x77, 0x97, 0xf6, 0x67, 0x99,
-	0xfd, 0xbc, 0x3c, 0x3c, 0x5c, 0xb5, 0x16, 0xc4, 0x17, 0x51, 0x92, 0x6a, 0x96, 0xc6, 0x5c, 0x2b,
-	0x12, 0x72, 0xa5, 0x1d, 0xef, 0x0f, 0x2c, 0xee, 0x23, 0x94, 0xc6, 0x09, 0x1d, 0x1f, 0xf1, 0x88,
-	0x6b, 0x13, 0x81, 0xe4, 0x60, 0xed, 0x5e, 0xe3, 0x42, 0x0d, 0x24, 0x12, 0xe6, 0x28, 0xc7, 0x5b,
-	0xe0, 0xe6, 0x16, 0x68, 0x51, 0x61, 0xb9, 0x77, 0x37, 0x0b, 0x90, 0x6e, 0x2c, 0x73, 0x2e, 0xfe,
-	0x62, 0xa0, 0xc7, 0x7d, 0xaa, 0xa9, 0xc7, 0x42, 0x4e, 0x7b, 0x3c, 0xe4, 0x7a, 0xec, 0xb1, 0xcf,
-	0x54, 0xf6, 0xcd, 0xfb, 0x60, 0xec, 0xac, 0x6d, 0x2c, 0xdf, 0x43, 0x0e, 0x25, 0x72, 0x4e, 0x25,
-	0x12, 0xb0, 0x8e, 0xb7, 0x5a, 0x87, 0x63, 0x54, 0x7b, 0x2f, 0xba, 0x1f, 0x19, 0x0f, 0x06, 0xda,
-	0x7c, 0xf0, 0x8f, 0xdc, 0x73, 0xc5, 0x5e, 0xf5, 0xeb, 0x37, 0xbb, 0x72, 0x58, 0xdd, 0x36, 0xea,
-	0x1b, 0x87, 0xd5, 0xed, 0x8d, 0xfa, 0x66, 0x6b, 0xff, 0x72, 0x62, 0x19, 0x57, 0x13, 0xcb, 0xf8,
-	0x35, 0xb1, 0x8c, 0x8b, 0xa9, 0x55, 0xb9, 0x9a, 0x5a, 0x95, 0x1f, 0x53, 0xab, 0xf2, 0xe9, 0xe5,
-	0xc2, 0x01, 0xca, 0xa9, 0x08, 0xab, 0x7b, 0xee, 0x5e, 0x8f, 0x4e, 0x38, 0x45, 0x6f, 0x0b, 0xc6,
-	0xe1, 0xeb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x32, 0x2d, 0x65, 0x2c, 0x52, 0x05, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0x23, 0x35,
+	0x18, 0xc7, 0x33, 0xbb, 0xa1, 0xb4, 0x06, 0x76, 0x83, 0x9b, 0x65, 0x87, 0x97, 0x66, 0xca, 0x20,
+	0xd8, 0x95, 0x10, 0xc9, 0x81, 0xdb, 0x4a, 0x1c, 0xb6, 0x2d, 0x20, 0xaa, 0x5d, 0x25, 0x4c, 0x53,
+	0x90, 0xe0, 0x60, 0x39, 0x13, 0x27, 0x35, 0x9d, 0xb1, 0xa7, 0xb6, 0x27, 0x24, 0x47, 0x2e, 0x9c,
+	0x38, 0xec, 0x91, 0x23, 0x12, 0x5f, 0x66, 0x8f, 0x7b, 0x44, 0x1c, 0x46, 0xa8, 0xfd, 0x06, 0xf9,
+	0x04, 0xc8, 0xcf, 0x4c, 0x5e, 0x9a, 0x4e, 0x90, 0xa2, 0x8a, 0xd3, 0x54, 0x99, 0xbf, 0x7f, 0x3f,
+	0x3f, 0x7e, 0x6c, 0x77, 0x50, 0x3d, 0xa1, 0x5c, 0x71, 0x31, 0x6c, 0x25, 0x54, 0xd1, 0x58, 0x37,
+	0x13, 0x25, 0x8d, 0xc4, 0xf5, 0x88, 0x8e, 0xa8, 0x60, 0xa6, 0x69, 0x9f, 0xcd, 0x22, 0xf2, 0x5e,
+	0x7d, 0x28, 0x87, 0x12, 0x02, 0x2d, 0xfb, 0x57, 0x9e, 0xf5, 0xff, 0xac, 0xa1, 0xad, 0x0e, 0x0c,
+	0xc6, 0x0a, 0xdd, 0x8b, 0xb9, 0x30, 0x87, 0x92, 0x0b, 0xdd, 0x61, 0xea, 0xf0, 0xd4, 0xbd, 0xbb,
+	0xef, 0x3c, 0xde, 0x39, 0x38, 0x7e, 0x99, 0x79, 0x95, 0xbf, 0x33, 0xef, 0x93, 0x21, 0x37, 0x67,
+	0x69, 0xaf, 0x19, 0xca, 0xb8, 0x15, 0x4a, 0x1d, 0x4b, 0x5d, 0x3c, 0x3e, 0xd3, 0xfd, 0xf3, 0x96,
+	0x99, 0x24, 0x4c, 0x37, 0x8f, 0x58, 0x38, 0xcd, 0x3c, 0x77, 0x42, 0xe3, 0xe8, 0x89, 0x6f, 0x69,
+	0x24, 0xb4, 0x38, 0x92, 0x30, 0x45, 0xc2, 0xd4, 0x0f, 0x56, 0x0c, 0xd6, 0xd9, 0x4b, 0x95, 0x58,
+	0x72, 0x56, 0x6f, 0xe7, 0xb4, 0xb4, 0x55, 0xe7, 0x75, 0x03, 0x7e, 0xe1, 0x20, 0x77, 0xa0, 0x68,
+	0xda, 0x3f, 0x31, 0xf4, 0x9c, 0x9d, 0x44, 0x54, 0x9f, 0x71, 0x31, 0xfc, 0x8a, 0x86, 0x46, 0x2a,
+	0xf7, 0x35, 0xd0, 0x77, 0x37, 0xd6, 0xfb, 0xb9, 0x1e, 0xb8, 0x44, 0x5b, 0x30, 0xd1, 0x05, 0x99,
+	0x0c, 0x00, 0xed, 0x07, 0x6b, 0xad, 0x38, 0x40, 0xbb, 0xf9, 0xbb, 0xe2, 0xe7, 0xa7, 0xb1, 0x4c,
+	0x85, 0x71, 0xb7, 0xf6, 0x9d, 0xc7, 0xd5, 0x83, 0xfd, 0x69, 0xe6, 0x7d, 0x70, 0x0d, 0x3f, 0x03,
+	0x53, 0x88, 0xf9, 0x41, 0xd9, 0x60, 0xfc, 0x1d, 0xaa, 0x6b, 0xa6, 0x46, 0x3c, 0x64, 0x4a, 0x77,
+	0x65, 0x87, 0x72, 0x75, 0x08, 0xd0, 0xd7, 0x01, 0xea, 0x4f, 0x33, 0xaf, 0x91, 0x43, 0xe7, 0x29,
+	0x62, 0x24, 0xb1, 0xbb, 0x85, 0x84, 0x39, 0xb6, 0x74, 0x3c, 0x6e, 0x23, 0xcc, 0x12, 0x19, 0x9e,
+	0x1d, 0x44, 0x32, 0x3c, 0xd7, 0xed, 0x11, 0x53, 0x11, 0x4d, 0xdc, 0x6d, 0xa0, 0x7a, 0xd3, 0xcc,
+	0x7b, 0x3f, 0xa7, 0x42, 0x86, 0xf4, 0x20, 0x44, 0x64, 0x9e, 0xf2, 0x83, 0x92, 0xa1, 0x98, 0xa3,
+	0x1a, 0x2c, 0x58, 0x57, 0x3e, 0xa7, 0xe3, 0xc3, 0xd3, 0x67, 0x5c, 0x1b, 0x77, 0x07, 0xda, 0xf0,
+	0x45, 0xd1, 0x86, 0xda, 0xc9, 0xca, 0xfb, 0x69, 0xe6, 0x7d, 0x58, 0x4c, 0x1e, 0x96, 0xda, 0x48,
+	0x12, 0xca, 0x38, 0x49, 0x0d, 0x4b, 0x05, 0x37, 0x9a, 0x44, 0x5c, 0x1b, 0x3f, 0xb8, 0x81, 0xc5,
+	0x7d, 0x84, 0x52, 0x91, 0xd0, 0xc9, 0x33, 0x1e, 0x73, 0xe3, 0x22, 0x90, 0x1c, 0x6d, 0xdc, 0x6b,
+	0x9c, 0xab, 0x81, 0x44, 0x22, 0x8b, 0xf2, 0x83, 0x25, 0xae, 0xb5, 0x40, 0x8b, 0x72, 0xcb, 0x1b,
+	0xb7, 0xb3, 0x00, 0x69, 0x6e, 0x59, 0x70, 0xf1, 0xaf, 0x0e, 0x7a, 0xd0, 0xa7, 0x86, 0x06, 0x2c,
+	0xe2, 0xb4, 0xc7, 0x23, 0x6e, 0x26, 0x01, 0xfb, 0x99, 0xaa, 0xbe, 0xfb, 0x26, 0x18, 0x3b, 0x1b,
+	0x1b, 0x8b, 0xfd, 0x60, 0xa1, 0x44, 0x2d, 0xa8, 0x44, 0x01, 0xd6, 0x0f, 0xca, 0x75, 0x58, 0xa0,
+	0x9d, 0x6f, 0xe5, 0xc9, 0xf7, 0x8c, 0x0f, 0xcf, 0x8c, 0xfb, 0xd6, 0xff, 0xe4, 0x5e, 0x28, 0xa0,
+	0x70, 0xc5, 0x92, 0xd4, 0x50, 0xc3, 0xa5, 0x38, 0x62, 0x21, 0x9d, 0x14, 0x87, 0xf7, 0xde, 0xed,
+	0xe4, 0x0b, 0x28, 0xe9, 0x5b, 0xea, 0xfc, 0xe0, 0x96, 0xeb, 0x30, 0x41, 0x0f, 0x63, 0x3a, 0xee,
+	0x28, 0x39, 0xe2, 0x7d, 0xa6, 0xec, 0xed, 0xd2, 0x4e, 0x98, 0xa2, 0x76, 0x26, 0xf7, 0xe1, 0x38,
+	0x7c, 0xbc, 0xd8, 0xa7, 0x31, 0x1d, 0x93, 0x64, 0x96, 0x84, 0xab, 0x49, 0x16, 0x59, 0x3f, 0x58,
+	0x47, 0xc1, 0xbf, 0x38, 0x68, 0xf7, 0x42, 0xea, 0x4e, 0x7e, 0x83, 0x3f, 0xe7, 0xa2, 0xa8, 0xb3,
+	0x06, 0x75, 0xb6, 0x37, 0xae, 0x73, 0x2f, 0x9f, 0xcb, 0x85, 0xd4, 0xa4, 0xf8, 0xaf, 0x40, 0x62,
+	0x2e, 0xe6, 0x65, 0x96, 0xb9, 0xf0, 0x37, 0xa8, 0xb6, 0xf8, 0xf9, 0x4b, 0x7b, 0x7a, 0xb5, 0xfb,
+	0x36, 0x54, 0xb7, 0x37, 0xcd, 0xbc, 0x77, 0x6f, 0x12, 0xe1, 0x84, 0x6b, 0x3f, 0xb8, 0x31, 0x0c,
+	0xff, 0x88, 0xde, 0x49, 0x85, 0x62, 0x3a, 0x91, 0x42, 0xf3, 0x11, 0x3b, 0xa6, 0x3c, 0x2a, 0x80,
+	0x18, 0x80, 0x1f, 0x4d, 0x33, 0xcf, 0x9b, 0x9d, 0xad, 0x45, 0x8e, 0xfc, 0x44, 0x79, 0x34, 0xc7,
+	0xae, 0x41, 0xe0, 0x0b, 0xb4, 0xb7, 0xfc, 0xa6, 0x3d, 0x18, 0x30, 0xa1, 0x99, 0xee, 0xca, 0x53,
+	0x01, 0xd7, 0x80, 0xbb, 0x0b, 0x8e, 0x4f, 0xa7, 0x99, 0xf7, 0xa8, 0xc4, 0x21, 0x8b, 0xbc, 0xbd,
+	0x4a, 0xd2, 0x7c, 0x84, 0x1f, 0xfc, 0x37, 0x11, 0xff, 0xe6, 0xa0, 0x87, 0xcb, 0x09, 0xb8, 0x80,
+	0x8b, 0x16, 0xd5, 0xa1, 0x45, 0xc1, 0xc6, 0x2d, 0xda, 0x2f, 0x99, 0x5b, 0x7e, 0x05, 0xcc, 0xba,
+	0xb4, 0x4e, 0x89, 0xbf, 0x46, 0xf7, 0x07, 0x8a, 0xb1, 0xee, 0xd8, 0xee, 0x21, 0x58, 0x15, 0xf7,
+	0xc1, 0x6a, 0xa3, 0x6c, 0x80, 0x98, 0x71, 0xbe, 0x03, 0x61, 0x49, 0xfd, 0x60, 0x75, 0xd4, 0x93,
+	0xea, 0xef, 0x7f, 0x78, 0x95, 0xe3, 0xea, 0xb6, 0x53, 0xbb, 0x73, 0x5c, 0xdd, 0xbe, 0x53, 0xbb,
+	0x7b, 0xf0, 0xf4, 0xe5, 0x65, 0xc3, 0x79, 0x75, 0xd9, 0x70, 0xfe, 0xb9, 0x6c, 0x38, 0x2f, 0xae,
+	0x1a, 0x95, 0x57, 0x57, 0x8d, 0xca, 0x5f, 0x57, 0x8d, 0xca, 0x0f, 0x8f, 0x96, 0x2a, 0x2b, 0x3e,
+	0x3b, 0xe0, 0xd9, 0x1a, 0xb7, 0x66, 0xdf, 0x26, 0x50, 0x5e, 0x6f, 0x0b, 0xbe, 0x37, 0x3e, 0xff,
+	0x77, 0x00, 0xc3, 0xfe, 0x35, 0x30, 0xb3, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -159,6 +219,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FreeTxsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FreeTxsPerEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.UnresponsiveSlashFactor.Size()
+		i -= size
+		if _, err := m.UnresponsiveSlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.UnresponsiveOffensesToUnstake != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnresponsiveOffensesToUnstake))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.UnresponsiveJailEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnresponsiveJailEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.QosPairingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QosPairingEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.QosPairingMinFactor.Size()
+		i -= size
+		if _, err := m.QosPairingMinFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.MaxProvidersPerOperator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProvidersPerOperator))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.ReputationDecayFactor.Size()
+		i -= size
+		if _, err := m.ReputationDecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	{
 		size := m.QoSWeight.Size()
 		i -= size
@@ -299,6 +426,27 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.QoSWeight.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ReputationDecayFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxProvidersPerOperator != 0 {
+		n += 1 + sovParams(uint64(m.MaxProvidersPerOperator))
+	}
+	l = m.QosPairingMinFactor.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.QosPairingEpochs != 0 {
+		n += 2 + sovParams(uint64(m.QosPairingEpochs))
+	}
+	if m.UnresponsiveJailEpochs != 0 {
+		n += 2 + sovParams(uint64(m.UnresponsiveJailEpochs))
+	}
+	if m.UnresponsiveOffensesToUnstake != 0 {
+		n += 2 + sovParams(uint64(m.UnresponsiveOffensesToUnstake))
+	}
+	l = m.UnresponsiveSlashFactor.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.FreeTxsPerEpoch != 0 {
+		n += 2 + sovParams(uint64(m.FreeTxsPerEpoch))
+	}
 	return n
 }
 
@@ -666,6 +814,203 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationDecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputationDecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProvidersPerOperator", wireType)
+			}
+			m.MaxProvidersPerOperator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProvidersPerOperator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QosPairingMinFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QosPairingMinFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QosPairingEpochs", wireType)
+			}
+			m.QosPairingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QosPairingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveJailEpochs", wireType)
+			}
+			m.UnresponsiveJailEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnresponsiveJailEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveOffensesToUnstake", wireType)
+			}
+			m.UnresponsiveOffensesToUnstake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnresponsiveOffensesToUnstake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveSlashFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnresponsiveSlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTxsPerEpoch", wireType)
+			}
+			m.FreeTxsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeTxsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"golang.org/x/exp/slices"
+)
+
+// ValidatePlan checks the plan fields are valid for governance to set
+func (plan Plan) ValidatePlan() error {
+	if len(strings.TrimSpace(plan.Index)) == 0 {
+		return sdkerrors.Wrap(ErrBlankPlanIndex, "plan index cannot be blank")
+	}
+	if len(strings.TrimSpace(plan.Name)) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s name cannot be blank", plan.Index)
+	}
+	if plan.Price.Denom != epochstoragetypes.TokenDenom {
+		return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s price denom must be %s, got: %s", plan.Index, epochstoragetypes.TokenDenom, plan.Price.Denom)
+	}
+	if !plan.Price.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s price is invalid: %s", plan.Index, plan.Price)
+	}
+	if plan.DurationMonths == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s duration must be at least one month", plan.Index)
+	}
+	if plan.MonthlyComputeUnits == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s monthly compute units can't be zero", plan.Index)
+	}
+	checkUnique := map[string]bool{}
+	for _, chainID := range plan.ChainIds {
+		if len(strings.TrimSpace(chainID)) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s has a blank chain ID", plan.Index)
+		}
+		if checkUnique[chainID] {
+			return sdkerrors.Wrapf(ErrInvalidPlan, "plan %s has a duplicate chain ID: %s", plan.Index, chainID)
+		}
+		checkUnique[chainID] = true
+	}
+	return nil
+}
+
+// CoversChain returns true if the plan can be used on the given chain, an empty chain list covers all chains
+func (plan Plan) CoversChain(chainID string) bool {
+	return len(plan.ChainIds) == 0 || slices.Contains(plan.ChainIds, chainID)
+}
+
+// IsActiveForBlock returns true if the subscription can be used for relays on the given block
+func (sub Subscription) IsActiveForBlock(block uint64) bool {
+	if block < sub.StartBlock {
+		return false
+	}
+	return sub.ExpiryBlock == 0 || block < sub.ExpiryBlock
+}
+
+// VrfpkForEpoch returns the vrf public key that verifies the subscription relays of the given epoch
+func (sub Subscription) VrfpkForEpoch(epoch uint64) string {
+	for _, replaced := range sub.ReplacedVrfpks {
+		if epoch < replaced.ValidUntilEpoch {
+			return replaced.Vrfpk
+		}
+	}
+	return sub.Vrfpk
+}
+
+func stringPlan(plan Plan, b strings.Builder) strings.Builder {
+	b.WriteString(fmt.Sprintf(`    Plan:
+	Index: %s, Name: %s, Enabled: %t, Price: %s, Months: %d, Monthly CU: %d, Chains: %v
+`, plan.Index, plan.Name, plan.Enabled, plan.Price, plan.DurationMonths, plan.MonthlyComputeUnits, plan.ChainIds))
+
+	return b
+}
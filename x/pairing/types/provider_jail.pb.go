@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/provider_jail.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderJail keeps the unresponsiveness offenses of a provider on a chain and the penalties they got
+type ProviderJail struct {
+	Provider         string               `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID          string               `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Offenses         uint64               `protobuf:"varint,3,opt,name=offenses,proto3" json:"offenses,omitempty"`
+	JailedUntil      uint64               `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	LastOffenseBlock uint64               `protobuf:"varint,5,opt,name=last_offense_block,json=lastOffenseBlock,proto3" json:"last_offense_block,omitempty"`
+	History          []ProviderJailRecord `protobuf:"bytes,6,rep,name=history,proto3" json:"history"`
+}
+
+func (m *ProviderJail) Reset()         { *m = ProviderJail{} }
+func (m *ProviderJail) String() string { return proto.CompactTextString(m) }
+func (*ProviderJail) ProtoMessage()    {}
+func (*ProviderJail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7126fa477c237191, []int{0}
+}
+func (m *ProviderJail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderJail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderJail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderJail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderJail.Merge(m, src)
+}
+func (m *ProviderJail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderJail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderJail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderJail proto.InternalMessageInfo
+
+func (m *ProviderJail) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderJail) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderJail) GetOffenses() uint64 {
+	if m != nil {
+		return m.Offenses
+	}
+	return 0
+}
+
+func (m *ProviderJail) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *ProviderJail) GetLastOffenseBlock() uint64 {
+	if m != nil {
+		return m.LastOffenseBlock
+	}
+	return 0
+}
+
+func (m *ProviderJail) GetHistory() []ProviderJailRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+// ProviderJailRecord is a penalty given to a provider for unresponsiveness
+type ProviderJailRecord struct {
+	Block       uint64     `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Epoch       uint64     `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Complaints  uint64     `protobuf:"varint,3,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Payments    uint64     `protobuf:"varint,4,opt,name=payments,proto3" json:"payments,omitempty"`
+	Unstaked    bool       `protobuf:"varint,5,opt,name=unstaked,proto3" json:"unstaked,omitempty"`
+	JailedUntil uint64     `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	Slashed     types.Coin `protobuf:"bytes,7,opt,name=slashed,proto3" json:"slashed"`
+}
+
+func (m *ProviderJailRecord) Reset()         { *m = ProviderJailRecord{} }
+func (m *ProviderJailRecord) String() string { return proto.CompactTextString(m) }
+func (*ProviderJailRecord) ProtoMessage()    {}
+func (*ProviderJailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7126fa477c237191, []int{1}
+}
+func (m *ProviderJailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderJailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderJailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderJailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderJailRecord.Merge(m, src)
+}
+func (m *ProviderJailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderJailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderJailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderJailRecord proto.InternalMessageInfo
+
+func (m *ProviderJailRecord) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ProviderJailRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProviderJailRecord) GetComplaints() uint64 {
+	if m != nil {
+		return m.Complaints
+	}
+	return 0
+}
+
+func (m *ProviderJailRecord) GetPayments() uint64 {
+	if m != nil {
+		return m.Payments
+	}
+	return 0
+}
+
+func (m *ProviderJailRecord) GetUnstaked() bool {
+	if m != nil {
+		return m.Unstaked
+	}
+	return false
+}
+
+func (m *ProviderJailRecord) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *ProviderJailRecord) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*ProviderJail)(nil), "lavanet.lava.pairing.ProviderJail")
+	proto.RegisterType((*ProviderJailRecord)(nil), "lavanet.lava.pairing.ProviderJailRecord")
+}
+
+func init() { proto.RegisterFile("pairing/provider_jail.proto", fileDescriptor_7126fa477c237191) }
+
+var fileDescriptor_7126fa477c237191 = []byte{
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0x8e, 0x69, 0x9a, 0x14, 0xa7, 0x03, 0xb2, 0x32, 0x1c, 0x41, 0x3a, 0x42, 0x17, 0x6e, 0x40,
+	0xb6, 0x5a, 0x26, 0x46, 0x02, 0x03, 0xb0, 0x80, 0x4e, 0x62, 0x61, 0x89, 0x7c, 0x3e, 0x37, 0x67,
+	0xea, 0xf8, 0x9d, 0xce, 0x4e, 0x44, 0xfe, 0x05, 0x3f, 0xab, 0x63, 0x47, 0x26, 0x84, 0x92, 0xff,
+	0xc0, 0xc2, 0x82, 0x6c, 0xdf, 0x9d, 0x2a, 0xa5, 0x93, 0xfd, 0xbd, 0xef, 0x7b, 0xf2, 0xfb, 0xbe,
+	0x67, 0xfc, 0xac, 0xe6, 0xaa, 0x51, 0x66, 0xc5, 0xea, 0x06, 0xb6, 0xaa, 0x94, 0xcd, 0xf2, 0x3b,
+	0x57, 0x9a, 0xd6, 0x0d, 0x38, 0x20, 0x53, 0xcd, 0xb7, 0xdc, 0x48, 0x47, 0xfd, 0x49, 0x5b, 0xe5,
+	0x6c, 0xba, 0x82, 0x15, 0x04, 0x01, 0xf3, 0xb7, 0xa8, 0x9d, 0xa5, 0x02, 0xec, 0x1a, 0x2c, 0x2b,
+	0xb8, 0x95, 0x6c, 0x7b, 0x59, 0x48, 0xc7, 0x2f, 0x99, 0x00, 0x65, 0x22, 0x7f, 0xf1, 0x0f, 0xe1,
+	0xf3, 0x2f, 0xed, 0x1b, 0x9f, 0xb8, 0xd2, 0x64, 0x86, 0xcf, 0xba, 0x37, 0x13, 0x34, 0x47, 0xd9,
+	0xe3, 0xbc, 0xc7, 0x24, 0xc1, 0x63, 0x51, 0x71, 0x65, 0x3e, 0xbe, 0x4f, 0x1e, 0x05, 0xaa, 0x83,
+	0xbe, 0x0b, 0xae, 0xaf, 0xa5, 0xb1, 0xd2, 0x26, 0x27, 0x73, 0x94, 0x0d, 0xf3, 0x1e, 0x93, 0x17,
+	0xf8, 0xdc, 0x0f, 0x2f, 0xcb, 0xe5, 0xc6, 0x38, 0xa5, 0x93, 0x61, 0xe0, 0x27, 0xb1, 0xf6, 0xd5,
+	0x97, 0xc8, 0x2b, 0x4c, 0x34, 0xb7, 0x6e, 0xd9, 0xf6, 0x2c, 0x0b, 0x0d, 0xe2, 0x26, 0x39, 0x0d,
+	0xc2, 0x27, 0x9e, 0xf9, 0x1c, 0x89, 0x85, 0xaf, 0x93, 0x0f, 0x78, 0x5c, 0x29, 0xeb, 0xa0, 0xd9,
+	0x25, 0xa3, 0xf9, 0x49, 0x36, 0xb9, 0xca, 0xe8, 0x43, 0x89, 0xd0, 0xfb, 0xbe, 0x72, 0x29, 0xa0,
+	0x29, 0x17, 0xc3, 0xdb, 0xdf, 0xcf, 0x07, 0x79, 0xd7, 0x7e, 0xf1, 0x17, 0x61, 0x72, 0xac, 0x22,
+	0x53, 0x7c, 0x1a, 0x27, 0x40, 0x61, 0x82, 0x08, 0x7c, 0x55, 0xd6, 0x20, 0xaa, 0xe0, 0x7d, 0x98,
+	0x47, 0x40, 0x52, 0x8c, 0x05, 0xac, 0x6b, 0xcd, 0x95, 0x71, 0x9d, 0xf7, 0x7b, 0x95, 0x90, 0x27,
+	0xdf, 0xad, 0xa5, 0x67, 0xa3, 0xf3, 0x1e, 0x7b, 0x6e, 0x63, 0xac, 0xe3, 0x37, 0xb2, 0x0c, 0x66,
+	0xcf, 0xf2, 0x1e, 0x1f, 0xa5, 0x36, 0x3a, 0x4e, 0xed, 0x0d, 0x1e, 0x5b, 0xcd, 0x6d, 0x25, 0xcb,
+	0x64, 0x3c, 0x47, 0xd9, 0xe4, 0xea, 0x29, 0x8d, 0xdb, 0xa6, 0x7e, 0xdb, 0xb4, 0xdd, 0x36, 0x7d,
+	0x07, 0xca, 0x74, 0xc6, 0x5b, 0xfd, 0xe2, 0xed, 0xed, 0x3e, 0x45, 0x77, 0xfb, 0x14, 0xfd, 0xd9,
+	0xa7, 0xe8, 0xe7, 0x21, 0x1d, 0xdc, 0x1d, 0xd2, 0xc1, 0xaf, 0x43, 0x3a, 0xf8, 0xf6, 0x72, 0xa5,
+	0x5c, 0xb5, 0x29, 0xa8, 0x80, 0x35, 0x6b, 0x53, 0x0d, 0x27, 0xfb, 0xc1, 0xba, 0x3f, 0xe9, 0x76,
+	0xb5, 0xb4, 0xc5, 0x28, 0x7c, 0xa0, 0xd7, 0xff, 0x07, 0x00, 0xb3, 0x99, 0x09, 0xea, 0xab, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ProviderJail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderJail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderJail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProviderJail(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastOffenseBlock != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.LastOffenseBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Offenses != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.Offenses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderJail(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderJail(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderJailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderJailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderJailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderJail(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.JailedUntil != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Unstaked {
+		i--
+		if m.Unstaked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Payments != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.Payments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Complaints != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.Complaints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != 0 {
+		i = encodeVarintProviderJail(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderJail(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderJail(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderJail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderJail(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderJail(uint64(l))
+	}
+	if m.Offenses != 0 {
+		n += 1 + sovProviderJail(uint64(m.Offenses))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovProviderJail(uint64(m.JailedUntil))
+	}
+	if m.LastOffenseBlock != 0 {
+		n += 1 + sovProviderJail(uint64(m.LastOffenseBlock))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovProviderJail(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderJailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovProviderJail(uint64(m.Block))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProviderJail(uint64(m.Epoch))
+	}
+	if m.Complaints != 0 {
+		n += 1 + sovProviderJail(uint64(m.Complaints))
+	}
+	if m.Payments != 0 {
+		n += 1 + sovProviderJail(uint64(m.Payments))
+	}
+	if m.Unstaked {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovProviderJail(uint64(m.JailedUntil))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovProviderJail(uint64(l))
+	return n
+}
+
+func sovProviderJail(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderJail(x uint64) (n int) {
+	return sovProviderJail(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderJail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderJail
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderJail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderJail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			m.Offenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffenseBlock", wireType)
+			}
+			m.LastOffenseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOffenseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ProviderJailRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderJail(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderJailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderJail
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderJailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderJailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			m.Complaints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complaints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+			}
+			m.Payments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Payments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstaked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unstaked = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderJail(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderJail
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderJail(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderJail
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderJail
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderJail
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderJail
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderJail
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderJail        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderJail          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderJail = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,10 +25,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ProviderPaymentStorage struct {
-	Index                                  string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Epoch                                  uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	UnresponsivenessComplaints             []string `protobuf:"bytes,4,rep,name=unresponsiveness_complaints,json=unresponsivenessComplaints,proto3" json:"unresponsiveness_complaints,omitempty"`
-	UniquePaymentStorageClientProviderKeys []string `protobuf:"bytes,5,rep,name=uniquePaymentStorageClientProviderKeys,proto3" json:"uniquePaymentStorageClientProviderKeys,omitempty"`
+	Index                                  string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Epoch                                  uint64     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	UnresponsivenessComplaints             []string   `protobuf:"bytes,4,rep,name=unresponsiveness_complaints,json=unresponsivenessComplaints,proto3" json:"unresponsiveness_complaints,omitempty"`
+	UniquePaymentStorageClientProviderKeys []string   `protobuf:"bytes,5,rep,name=uniquePaymentStorageClientProviderKeys,proto3" json:"uniquePaymentStorageClientProviderKeys,omitempty"`
+	Rewards                                types.Coin `protobuf:"bytes,6,opt,name=rewards,proto3" json:"rewards"`
+	ReliabilityRewards                     types.Coin `protobuf:"bytes,7,opt,name=reliabilityRewards,proto3" json:"reliabilityRewards"`
 }
 
 func (m *ProviderPaymentStorage) Reset()         { *m = ProviderPaymentStorage{} }
@@ -90,6 +94,20 @@ func (m *ProviderPaymentStorage) GetUniquePaymentStorageClientProviderKeys() []s
 	return nil
 }
 
+func (m *ProviderPaymentStorage) GetRewards() types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderPaymentStorage) GetReliabilityRewards() types.Coin {
+	if m != nil {
+		return m.ReliabilityRewards
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*ProviderPaymentStorage)(nil), "lavanet.lava.pairing.ProviderPaymentStorage")
 }
@@ -99,25 +117,31 @@ func init() {
 }
 
 var fileDescriptor_4f1d2e8d774659ae = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4a, 0xf4, 0x40,
-	0x14, 0x85, 0x33, 0x7f, 0xb2, 0x3f, 0x6e, 0x2a, 0x09, 0x8b, 0x84, 0x15, 0x86, 0x60, 0xb1, 0xa6,
-	0x4a, 0x0a, 0xed, 0x45, 0xb7, 0xd3, 0x66, 0x89, 0x60, 0x61, 0x13, 0x66, 0xb3, 0x97, 0xec, 0x40,
-	0x32, 0x33, 0xce, 0x4c, 0xc2, 0xe6, 0x2d, 0x7c, 0x2c, 0xcb, 0x2d, 0x2d, 0x25, 0x79, 0x11, 0x49,
-	0x26, 0x11, 0xdc, 0xca, 0xea, 0x72, 0x2e, 0xe7, 0x3b, 0x1c, 0x8e, 0xbb, 0x12, 0x84, 0x4a, 0xca,
-	0xf2, 0x58, 0x48, 0x5e, 0xd3, 0x1d, 0xc8, 0x54, 0x90, 0xa6, 0x04, 0xa6, 0x53, 0xa5, 0xb9, 0x24,
-	0x39, 0x44, 0x42, 0x72, 0xcd, 0xbd, 0x45, 0x41, 0x6a, 0xc2, 0x40, 0x47, 0xfd, 0x8d, 0x46, 0x68,
-	0x79, 0x3b, 0xd1, 0x15, 0xa3, 0x6f, 0x15, 0x9c, 0xb2, 0x69, 0x56, 0xd0, 0x5e, 0x4e, 0xd9, 0x26,
-	0xeb, 0xaa, 0x43, 0xee, 0xc5, 0x66, 0x7c, 0x6d, 0x0c, 0xf1, 0x6c, 0x00, 0x6f, 0xe1, 0xce, 0x28,
-	0xdb, 0xc1, 0xc1, 0x47, 0x01, 0x0a, 0xe7, 0x89, 0x11, 0xfd, 0x17, 0x04, 0xcf, 0xf6, 0xbe, 0x1d,
-	0xa0, 0xd0, 0x49, 0x8c, 0xf0, 0xee, 0xdc, 0xcb, 0x8a, 0x49, 0x50, 0x82, 0x33, 0x45, 0x6b, 0x60,
-	0xa0, 0x54, 0x9a, 0xf1, 0x52, 0x14, 0x84, 0x32, 0xad, 0x7c, 0x27, 0xb0, 0xc3, 0x79, 0xb2, 0x3c,
-	0xb5, 0xac, 0x7f, 0x1c, 0xde, 0x8b, 0xbb, 0x32, 0xbd, 0x7f, 0x97, 0x58, 0x0f, 0xa5, 0xa7, 0x82,
-	0x4f, 0xd0, 0x28, 0x7f, 0x36, 0x64, 0xfd, 0xd1, 0xfd, 0xe8, 0x9c, 0xfd, 0x3b, 0xb7, 0x1f, 0xee,
-	0x3f, 0x5a, 0x8c, 0x8e, 0x2d, 0x46, 0x5f, 0x2d, 0x46, 0xef, 0x1d, 0xb6, 0x8e, 0x1d, 0xb6, 0x3e,
-	0x3b, 0x6c, 0xbd, 0x5e, 0xe7, 0x54, 0xef, 0xab, 0x6d, 0x94, 0xf1, 0x32, 0x1e, 0x67, 0x1d, 0x6e,
-	0x7c, 0x88, 0xa7, 0x3d, 0x75, 0x23, 0x40, 0x6d, 0xff, 0x0f, 0x7b, 0xdd, 0x7c, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0xb5, 0x8b, 0x82, 0xb1, 0xa5, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbb, 0x0e, 0xd3, 0x30,
+	0x14, 0x4d, 0xda, 0xb4, 0xa5, 0x61, 0x41, 0x51, 0x85, 0x42, 0x91, 0x42, 0xc4, 0x50, 0x32, 0xd9,
+	0x2a, 0xb0, 0x30, 0x21, 0xda, 0x0d, 0x06, 0xaa, 0x20, 0x31, 0xb0, 0x44, 0x4e, 0x7a, 0x95, 0x5a,
+	0x4a, 0x6c, 0x63, 0x3b, 0xa1, 0xf9, 0x0b, 0x3e, 0xab, 0x63, 0x47, 0x26, 0x84, 0xda, 0x0f, 0xe0,
+	0x17, 0x50, 0xe2, 0x04, 0x89, 0x8a, 0xa1, 0xd3, 0x7d, 0xf8, 0x9c, 0x73, 0x1f, 0xbe, 0xee, 0x4a,
+	0x10, 0x2a, 0x29, 0xcb, 0xb1, 0x90, 0xbc, 0xa6, 0x7b, 0x90, 0x89, 0x20, 0x4d, 0x09, 0x4c, 0x27,
+	0x4a, 0x73, 0x49, 0x72, 0x40, 0x42, 0x72, 0xcd, 0xbd, 0x45, 0x41, 0x6a, 0xc2, 0x40, 0xa3, 0xd6,
+	0xa2, 0x9e, 0xb4, 0x7c, 0x3d, 0xb0, 0x2b, 0x46, 0xbf, 0x56, 0x70, 0xcb, 0x4d, 0xb2, 0x82, 0xb6,
+	0xe1, 0xa0, 0x6d, 0xb4, 0x96, 0x8b, 0x9c, 0xe7, 0xbc, 0x73, 0x71, 0xeb, 0xf5, 0xd9, 0x20, 0xe3,
+	0xaa, 0xe4, 0x0a, 0xa7, 0x44, 0x01, 0xae, 0xd7, 0x29, 0x68, 0xb2, 0xc6, 0x19, 0xa7, 0xcc, 0xbc,
+	0x3f, 0xff, 0x3d, 0x72, 0x1f, 0xef, 0x7a, 0xa1, 0x9d, 0xa9, 0xf3, 0xc9, 0x94, 0xf1, 0x16, 0xee,
+	0x84, 0xb2, 0x3d, 0x1c, 0x7d, 0x3b, 0xb4, 0xa3, 0x79, 0x6c, 0x82, 0x36, 0x0b, 0x82, 0x67, 0x07,
+	0x7f, 0x1c, 0xda, 0x91, 0x13, 0x9b, 0xc0, 0x7b, 0xeb, 0x3e, 0xad, 0x98, 0x04, 0x25, 0x38, 0x53,
+	0xb4, 0x06, 0x06, 0x4a, 0x25, 0x19, 0x2f, 0x45, 0x41, 0x28, 0xd3, 0xca, 0x77, 0xc2, 0x71, 0x34,
+	0x8f, 0x97, 0xb7, 0x90, 0xed, 0x5f, 0x84, 0xf7, 0xd9, 0x5d, 0x99, 0x69, 0xff, 0x6d, 0x62, 0xdb,
+	0x8d, 0x3a, 0x34, 0xf8, 0x01, 0x1a, 0xe5, 0x4f, 0x3a, 0xad, 0x3b, 0xd1, 0xde, 0x1b, 0x77, 0x26,
+	0xe1, 0x1b, 0x91, 0x7b, 0xe5, 0x4f, 0x43, 0x3b, 0x7a, 0xf8, 0xf2, 0x09, 0x32, 0x1b, 0x41, 0xed,
+	0x46, 0x50, 0xbf, 0x11, 0xb4, 0xe5, 0x94, 0x6d, 0x9c, 0xd3, 0xcf, 0x67, 0x56, 0x3c, 0xe0, 0xbd,
+	0x8f, 0xae, 0x27, 0xa1, 0xa0, 0x24, 0xa5, 0x05, 0xd5, 0x4d, 0xdc, 0xab, 0xcc, 0xee, 0x53, 0xf9,
+	0x0f, 0xf5, 0xbd, 0xf3, 0x60, 0xf4, 0x68, 0xbc, 0x79, 0x77, 0xba, 0x04, 0xf6, 0xf9, 0x12, 0xd8,
+	0xbf, 0x2e, 0x81, 0xfd, 0xfd, 0x1a, 0x58, 0xe7, 0x6b, 0x60, 0xfd, 0xb8, 0x06, 0xd6, 0x97, 0x17,
+	0x39, 0xd5, 0x87, 0x2a, 0x45, 0x19, 0x2f, 0x71, 0x7f, 0x18, 0x9d, 0xc5, 0x47, 0x3c, 0x5c, 0x84,
+	0x6e, 0x04, 0xa8, 0x74, 0xda, 0xfd, 0xdd, 0xab, 0x3f, 0x03, 0x00, 0xa1, 0x53, 0xd6, 0x84, 0x67,
+	0x02, 0x00, 0x00,
 }
 
 func (m *ProviderPaymentStorage) Marshal() (dAtA []byte, err error) {
@@ -140,6 +164,26 @@ func (m *ProviderPaymentStorage) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReliabilityRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderPaymentStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderPaymentStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.UniquePaymentStorageClientProviderKeys) > 0 {
 		for iNdEx := len(m.UniquePaymentStorageClientProviderKeys) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.UniquePaymentStorageClientProviderKeys[iNdEx])
@@ -209,6 +253,10 @@ func (m *ProviderPaymentStorage) Size() (n int) {
 			n += 1 + l + sovProviderPaymentStorage(uint64(l))
 		}
 	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovProviderPaymentStorage(uint64(l))
+	l = m.ReliabilityRewards.Size()
+	n += 1 + l + sovProviderPaymentStorage(uint64(l))
 	return n
 }
 
@@ -362,6 +410,72 @@ func (m *ProviderPaymentStorage) Unmarshal(dAtA []byte) error {
 			}
 			m.UniquePaymentStorageClientProviderKeys = append(m.UniquePaymentStorageClientProviderKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderPaymentStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderPaymentStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderPaymentStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderPaymentStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderPaymentStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderPaymentStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReliabilityRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProviderPaymentStorage(dAtA[iNdEx:])
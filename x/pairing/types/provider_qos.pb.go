@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/provider_qos.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderQoS is the reputation of a provider on a chain, built from the QoS reports of the relays it was paid for
+type ProviderQoS struct {
+	Provider         string                                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID          string                                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Score            QualityOfServiceReport                 `protobuf:"bytes,3,opt,name=score,proto3" json:"score"`
+	Weight           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	BlockLastUpdated uint64                                 `protobuf:"varint,5,opt,name=block_last_updated,json=blockLastUpdated,proto3" json:"block_last_updated,omitempty"`
+}
+
+func (m *ProviderQoS) Reset()         { *m = ProviderQoS{} }
+func (m *ProviderQoS) String() string { return proto.CompactTextString(m) }
+func (*ProviderQoS) ProtoMessage()    {}
+func (*ProviderQoS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82e057664e4ac1fd, []int{0}
+}
+func (m *ProviderQoS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQoS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQoS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQoS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQoS.Merge(m, src)
+}
+func (m *ProviderQoS) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQoS) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQoS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQoS proto.InternalMessageInfo
+
+func (m *ProviderQoS) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderQoS) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *ProviderQoS) GetScore() QualityOfServiceReport {
+	if m != nil {
+		return m.Score
+	}
+	return QualityOfServiceReport{}
+}
+
+func (m *ProviderQoS) GetBlockLastUpdated() uint64 {
+	if m != nil {
+		return m.BlockLastUpdated
+	}
+	return 0
+}
+
+// EpochProviderQoS is the aggregate of the QoS reports a provider was paid for on a chain for the relays of an epoch,
+// the pairing factors are built from the reports of the last epochs
+type EpochProviderQoS struct {
+	Epoch    uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChainID  string                 `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Score    QualityOfServiceReport `protobuf:"bytes,4,opt,name=score,proto3" json:"score"`
+	Cu       uint64                 `protobuf:"varint,5,opt,name=cu,proto3" json:"cu,omitempty"`
+}
+
+func (m *EpochProviderQoS) Reset()         { *m = EpochProviderQoS{} }
+func (m *EpochProviderQoS) String() string { return proto.CompactTextString(m) }
+func (*EpochProviderQoS) ProtoMessage()    {}
+func (*EpochProviderQoS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82e057664e4ac1fd, []int{1}
+}
+func (m *EpochProviderQoS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochProviderQoS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochProviderQoS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochProviderQoS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProviderQoS.Merge(m, src)
+}
+func (m *EpochProviderQoS) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochProviderQoS) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProviderQoS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProviderQoS proto.InternalMessageInfo
+
+func (m *EpochProviderQoS) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochProviderQoS) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EpochProviderQoS) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EpochProviderQoS) GetScore() QualityOfServiceReport {
+	if m != nil {
+		return m.Score
+	}
+	return QualityOfServiceReport{}
+}
+
+func (m *EpochProviderQoS) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ProviderQoS)(nil), "lavanet.lava.pairing.ProviderQoS")
+	proto.RegisterType((*EpochProviderQoS)(nil), "lavanet.lava.pairing.EpochProviderQoS")
+}
+
+func init() { proto.RegisterFile("pairing/provider_qos.proto", fileDescriptor_82e057664e4ac1fd) }
+
+var fileDescriptor_82e057664e4ac1fd = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4d, 0x6e, 0xe2, 0x30,
+	0x14, 0xc7, 0xe3, 0x10, 0x98, 0x19, 0x23, 0x8d, 0x50, 0x86, 0x45, 0x94, 0x45, 0x40, 0x2c, 0x66,
+	0x58, 0x30, 0x8e, 0x34, 0x73, 0x82, 0x22, 0x5a, 0xb5, 0x52, 0xa5, 0x96, 0xa0, 0x6e, 0xba, 0x41,
+	0xc6, 0x71, 0x13, 0x8b, 0x80, 0x53, 0xdb, 0xa1, 0x65, 0xdd, 0x0b, 0xf4, 0x38, 0x3d, 0x02, 0x4b,
+	0x96, 0x55, 0x17, 0xa8, 0x82, 0x8b, 0x54, 0xf9, 0x42, 0xa8, 0xaa, 0xba, 0xe9, 0xea, 0xf9, 0x7d,
+	0xf8, 0xff, 0xb7, 0x7f, 0x7a, 0xd0, 0x8e, 0x31, 0x13, 0x6c, 0x1e, 0xb8, 0xb1, 0xe0, 0x0b, 0xe6,
+	0x53, 0x31, 0xbe, 0xe5, 0x12, 0xc5, 0x82, 0x2b, 0x6e, 0x36, 0x23, 0xbc, 0xc0, 0x73, 0xaa, 0x50,
+	0x1a, 0x51, 0x31, 0x68, 0x37, 0x03, 0x1e, 0xf0, 0x6c, 0xc0, 0x4d, 0x4f, 0xf9, 0xac, 0xfd, 0xab,
+	0xd4, 0x11, 0x34, 0xc2, 0xcb, 0xbc, 0xd8, 0x79, 0xd0, 0x61, 0xfd, 0xb2, 0xd0, 0x1d, 0xf2, 0x91,
+	0x69, 0xc3, 0xef, 0xa5, 0x8d, 0x05, 0xda, 0xa0, 0xfb, 0xc3, 0xdb, 0xe7, 0xa6, 0x05, 0xbf, 0x91,
+	0x10, 0xb3, 0xf9, 0xd9, 0xc0, 0xd2, 0xb3, 0x56, 0x99, 0x9a, 0xa7, 0xb0, 0x2a, 0x09, 0x17, 0xd4,
+	0xaa, 0xb4, 0x41, 0xb7, 0xfe, 0xaf, 0x87, 0x3e, 0x7a, 0x16, 0x1a, 0x26, 0x38, 0x62, 0x6a, 0x79,
+	0x71, 0x33, 0xa2, 0x62, 0xc1, 0x08, 0xf5, 0x68, 0xcc, 0x85, 0xea, 0x1b, 0xab, 0x4d, 0x4b, 0xf3,
+	0x72, 0x01, 0xf3, 0x04, 0xd6, 0xee, 0x28, 0x0b, 0x42, 0x65, 0x19, 0xa9, 0x45, 0x1f, 0xa5, 0xcd,
+	0x97, 0x4d, 0xeb, 0x77, 0xc0, 0x54, 0x98, 0x4c, 0x10, 0xe1, 0x33, 0x97, 0x70, 0x39, 0xe3, 0xb2,
+	0x08, 0x7f, 0xa5, 0x3f, 0x75, 0xd5, 0x32, 0xa6, 0x12, 0x0d, 0x28, 0xf1, 0x8a, 0xdb, 0x66, 0x0f,
+	0x9a, 0x93, 0x88, 0x93, 0xe9, 0x38, 0xc2, 0x52, 0x8d, 0x93, 0xd8, 0xc7, 0x8a, 0xfa, 0x56, 0xb5,
+	0x0d, 0xba, 0x86, 0xd7, 0xc8, 0x3a, 0xe7, 0x58, 0xaa, 0xab, 0xbc, 0xde, 0x79, 0x02, 0xb0, 0x71,
+	0x1c, 0x73, 0x12, 0x1e, 0xa2, 0x68, 0xc2, 0x2a, 0x4d, 0x6b, 0x19, 0x07, 0xc3, 0xcb, 0x93, 0x4f,
+	0x20, 0x1c, 0xa2, 0xab, 0xbc, 0x43, 0xb7, 0x07, 0x64, 0x7c, 0x15, 0xd0, 0x4f, 0xa8, 0x93, 0xa4,
+	0xf8, 0x88, 0x4e, 0x92, 0xfe, 0xd1, 0x6a, 0xeb, 0x80, 0xf5, 0xd6, 0x01, 0xaf, 0x5b, 0x07, 0x3c,
+	0xee, 0x1c, 0x6d, 0xbd, 0x73, 0xb4, 0xe7, 0x9d, 0xa3, 0x5d, 0xff, 0x39, 0x40, 0x56, 0xd8, 0x65,
+	0xd1, 0xbd, 0x77, 0xcb, 0x4d, 0xc8, 0xb8, 0x4d, 0x6a, 0xd9, 0x2a, 0xfc, 0x7f, 0x1b, 0x00, 0x75,
+	0xee, 0x4f, 0x5d, 0x69, 0x02, 0x00, 0x00,
+}
+
+func (m *ProviderQoS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQoS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQoS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockLastUpdated != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.BlockLastUpdated))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochProviderQoS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochProviderQoS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochProviderQoS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cu != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProviderQos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProviderQos(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProviderQos(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderQos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderQos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderQoS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	if m.BlockLastUpdated != 0 {
+		n += 1 + sovProviderQos(uint64(m.BlockLastUpdated))
+	}
+	return n
+}
+
+func (m *EpochProviderQoS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProviderQos(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderQos(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovProviderQos(uint64(l))
+	if m.Cu != 0 {
+		n += 1 + sovProviderQos(uint64(m.Cu))
+	}
+	return n
+}
+
+func sovProviderQos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderQos(x uint64) (n int) {
+	return sovProviderQos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderQoS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQoS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQoS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLastUpdated", wireType)
+			}
+			m.BlockLastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockLastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderQos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochProviderQoS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochProviderQoS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochProviderQoS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderQos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderQos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderQos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderQos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderQos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderQos
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderQos
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderQos
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderQos        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderQos          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderQos = fmt.Errorf("proto: unexpected end of group")
+)
@@ -44,6 +44,7 @@ type RelayRequest struct {
 	DataReliability       *VRFData                `protobuf:"bytes,12,opt,name=DataReliability,proto3" json:"DataReliability,omitempty"`
 	QoSReport             *QualityOfServiceReport `protobuf:"bytes,13,opt,name=QoSReport,proto3" json:"QoSReport,omitempty"`
 	UnresponsiveProviders []byte                  `protobuf:"bytes,14,opt,name=unresponsive_providers,json=unresponsiveProviders,proto3" json:"unresponsive_providers,omitempty"`
+	ProofSig              []byte                  `protobuf:"bytes,15,opt,name=proof_sig,json=proofSig,proto3" json:"proof_sig,omitempty"`
 }
 
 func (m *RelayRequest) Reset()         { *m = RelayRequest{} }
@@ -177,6 +178,122 @@ func (m *RelayRequest) GetUnresponsiveProviders() []byte {
 	return nil
 }
 
+func (m *RelayRequest) GetProofSig() []byte {
+	if m != nil {
+		return m.ProofSig
+	}
+	return nil
+}
+
+// RelayProof is the compact payment proof of a relay session, signed by the consumer without the relay payload
+type RelayProof struct {
+	ChainID               string                  `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	SessionId             uint64                  `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	BlockHeight           int64                   `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Provider              string                  `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	CuSum                 uint64                  `protobuf:"varint,5,opt,name=cu_sum,json=cuSum,proto3" json:"cu_sum,omitempty"`
+	RelayNum              uint64                  `protobuf:"varint,6,opt,name=relay_num,json=relayNum,proto3" json:"relay_num,omitempty"`
+	QoSReport             *QualityOfServiceReport `protobuf:"bytes,7,opt,name=QoSReport,proto3" json:"QoSReport,omitempty"`
+	UnresponsiveProviders []byte                  `protobuf:"bytes,8,opt,name=unresponsive_providers,json=unresponsiveProviders,proto3" json:"unresponsive_providers,omitempty"`
+	Sig                   []byte                  `protobuf:"bytes,9,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *RelayProof) Reset()         { *m = RelayProof{} }
+func (m *RelayProof) String() string { return proto.CompactTextString(m) }
+func (*RelayProof) ProtoMessage()    {}
+func (*RelayProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10cd1bfeb9978acf, []int{1}
+}
+func (m *RelayProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayProof.Merge(m, src)
+}
+func (m *RelayProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayProof proto.InternalMessageInfo
+
+func (m *RelayProof) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *RelayProof) GetSessionId() uint64 {
+	if m != nil {
+		return m.SessionId
+	}
+	return 0
+}
+
+func (m *RelayProof) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RelayProof) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *RelayProof) GetCuSum() uint64 {
+	if m != nil {
+		return m.CuSum
+	}
+	return 0
+}
+
+func (m *RelayProof) GetRelayNum() uint64 {
+	if m != nil {
+		return m.RelayNum
+	}
+	return 0
+}
+
+func (m *RelayProof) GetQoSReport() *QualityOfServiceReport {
+	if m != nil {
+		return m.QoSReport
+	}
+	return nil
+}
+
+func (m *RelayProof) GetUnresponsiveProviders() []byte {
+	if m != nil {
+		return m.UnresponsiveProviders
+	}
+	return nil
+}
+
+func (m *RelayProof) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
 type RelayReply struct {
 	Data                  []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Sig                   []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
@@ -190,7 +307,7 @@ func (m *RelayReply) Reset()         { *m = RelayReply{} }
 func (m *RelayReply) String() string { return proto.CompactTextString(m) }
 func (*RelayReply) ProtoMessage()    {}
 func (*RelayReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{1}
+	return fileDescriptor_10cd1bfeb9978acf, []int{2}
 }
 func (m *RelayReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +392,7 @@ func (m *VRFData) Reset()         { *m = VRFData{} }
 func (m *VRFData) String() string { return proto.CompactTextString(m) }
 func (*VRFData) ProtoMessage()    {}
 func (*VRFData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{2}
+	return fileDescriptor_10cd1bfeb9978acf, []int{3}
 }
 func (m *VRFData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +480,7 @@ func (m *QualityOfServiceReport) Reset()         { *m = QualityOfServiceReport{}
 func (m *QualityOfServiceReport) String() string { return proto.CompactTextString(m) }
 func (*QualityOfServiceReport) ProtoMessage()    {}
 func (*QualityOfServiceReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{3}
+	return fileDescriptor_10cd1bfeb9978acf, []int{4}
 }
 func (m *QualityOfServiceReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +511,7 @@ var xxx_messageInfo_QualityOfServiceReport proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*RelayRequest)(nil), "lavanet.lava.pairing.RelayRequest")
+	proto.RegisterType((*RelayProof)(nil), "lavanet.lava.pairing.RelayProof")
 	proto.RegisterType((*RelayReply)(nil), "lavanet.lava.pairing.RelayReply")
 	proto.RegisterType((*VRFData)(nil), "lavanet.lava.pairing.VRFData")
 	proto.RegisterType((*QualityOfServiceReport)(nil), "lavanet.lava.pairing.QualityOfServiceReport")
@@ -402,57 +520,61 @@ func init() {
 func init() { proto.RegisterFile("pairing/relay.proto", fileDescriptor_10cd1bfeb9978acf) }
 
 var fileDescriptor_10cd1bfeb9978acf = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x8e, 0x1b, 0x45,
-	0x10, 0xf6, 0xec, 0x7a, 0xfd, 0x53, 0xf6, 0x3a, 0xa8, 0xb3, 0x9b, 0x8c, 0x16, 0xe2, 0x35, 0x83,
-	0x94, 0xf8, 0x00, 0x36, 0x0a, 0x82, 0x03, 0x12, 0x12, 0x58, 0x0b, 0x24, 0x08, 0x91, 0x6c, 0x1b,
-	0x72, 0xd8, 0xcb, 0xa8, 0x3d, 0x6e, 0x8f, 0x5b, 0x69, 0x4f, 0x4f, 0xba, 0x67, 0x46, 0x0c, 0x4f,
-	0x81, 0xc4, 0x9b, 0x70, 0xe0, 0x01, 0x38, 0xe5, 0x98, 0x23, 0xe2, 0xb0, 0x8a, 0x76, 0xdf, 0x80,
-	0x27, 0x40, 0x5d, 0x33, 0x63, 0x9b, 0xc4, 0x42, 0x8a, 0x94, 0xd3, 0x74, 0x7d, 0x55, 0xf5, 0x95,
-	0xeb, 0xab, 0xea, 0x36, 0xdc, 0x8c, 0x99, 0xd0, 0x22, 0x0a, 0xc7, 0x9a, 0x4b, 0x96, 0x8f, 0x62,
-	0xad, 0x12, 0x45, 0x8e, 0x24, 0xcb, 0x58, 0xc4, 0x93, 0x91, 0xfd, 0x8e, 0xca, 0x88, 0x93, 0xa3,
-	0x50, 0x85, 0x0a, 0x03, 0xc6, 0xf6, 0x54, 0xc4, 0x7a, 0xbf, 0xd5, 0xa1, 0x4b, 0x6d, 0x2e, 0xe5,
-	0xcf, 0x52, 0x6e, 0x12, 0xe2, 0x42, 0x33, 0x58, 0x32, 0x11, 0x3d, 0x3c, 0x73, 0x9d, 0x81, 0x33,
-	0x6c, 0xd3, 0xca, 0x24, 0xf7, 0xe0, 0x46, 0xa0, 0xa2, 0x88, 0x07, 0x89, 0x50, 0x91, 0x9f, 0xe4,
-	0x31, 0x77, 0xf7, 0x30, 0xa2, 0xb7, 0x81, 0x7f, 0xcc, 0x63, 0x4e, 0x6e, 0x43, 0x93, 0xc5, 0xc2,
-	0x4f, 0xb5, 0x74, 0xf7, 0x31, 0xa0, 0xc1, 0x62, 0xf1, 0x93, 0x96, 0xe4, 0x0e, 0x80, 0xe1, 0xc6,
-	0xd8, 0x74, 0x31, 0x77, 0xeb, 0x03, 0x67, 0x58, 0xa7, 0xed, 0x12, 0x79, 0x38, 0x27, 0xc7, 0xd0,
-	0x08, 0x52, 0xdf, 0xa4, 0x2b, 0xf7, 0x00, 0x5d, 0x07, 0x41, 0x3a, 0x4d, 0x57, 0x84, 0x40, 0x7d,
-	0xce, 0x12, 0xe6, 0x36, 0x06, 0xce, 0xb0, 0x4b, 0xf1, 0x4c, 0xde, 0x81, 0x7d, 0x23, 0x42, 0xb7,
-	0x89, 0x90, 0x3d, 0x92, 0x13, 0x68, 0xc5, 0x5a, 0x65, 0x62, 0xce, 0xb5, 0xdb, 0xc2, 0xaa, 0x6b,
-	0x9b, 0xbc, 0x0f, 0xdd, 0x99, 0x54, 0xc1, 0x53, 0x7f, 0xc9, 0x45, 0xb8, 0x4c, 0xdc, 0xf6, 0xc0,
-	0x19, 0xee, 0xd3, 0x0e, 0x62, 0x0f, 0x10, 0x22, 0xef, 0x42, 0x1b, 0x25, 0xf4, 0xa3, 0x74, 0xe5,
-	0x02, 0x96, 0x6f, 0x21, 0xf0, 0x43, 0xba, 0x22, 0x1f, 0xc0, 0xa1, 0x2e, 0xe4, 0xf1, 0x31, 0xc7,
-	0xed, 0x20, 0x41, 0xb7, 0x04, 0x27, 0x16, 0x23, 0xdf, 0xc2, 0x8d, 0x33, 0x96, 0x30, 0xca, 0xa5,
-	0x60, 0x33, 0x21, 0x45, 0x92, 0xbb, 0xdd, 0x81, 0x33, 0xec, 0xdc, 0xbf, 0x33, 0xda, 0x35, 0x8f,
-	0xd1, 0x13, 0xfa, 0x0d, 0xc6, 0xbf, 0x9a, 0x45, 0xbe, 0x83, 0xf6, 0xb9, 0x9a, 0x52, 0x1e, 0x2b,
-	0x9d, 0xb8, 0x87, 0x48, 0xf1, 0xe1, 0x6e, 0x8a, 0xf3, 0x94, 0xd9, 0x8c, 0x47, 0x8b, 0x29, 0xd7,
-	0x99, 0x08, 0x78, 0x91, 0x43, 0x37, 0xe9, 0xe4, 0x53, 0xb8, 0x95, 0x46, 0x9a, 0x9b, 0x58, 0x45,
-	0x46, 0x64, 0xdc, 0xaf, 0x24, 0x31, 0x6e, 0x0f, 0xa5, 0x3b, 0xde, 0xf6, 0x3e, 0xae, 0x9c, 0xde,
-	0x9f, 0x0e, 0x40, 0xb9, 0x15, 0xb1, 0xcc, 0xd7, 0x13, 0x70, 0x5e, 0x9f, 0xc0, 0xde, 0x66, 0x02,
-	0x47, 0x70, 0x10, 0xa9, 0x28, 0xe0, 0x38, 0xf4, 0x43, 0x5a, 0x18, 0x56, 0x7b, 0xc9, 0x92, 0x8d,
-	0x74, 0xf5, 0x42, 0xfb, 0x02, 0x2b, 0x94, 0xfb, 0x0c, 0x6e, 0x2f, 0x44, 0xc4, 0xa4, 0xf8, 0x85,
-	0xcf, 0x8b, 0x28, 0xe3, 0x2f, 0x99, 0x59, 0x72, 0x83, 0x8b, 0xd0, 0xa5, 0xc7, 0x6b, 0x37, 0x26,
-	0x98, 0x07, 0xe8, 0xc4, 0x75, 0x12, 0x61, 0x99, 0x51, 0xae, 0x47, 0xdb, 0x88, 0xb0, 0x08, 0xf2,
-	0x5e, 0x3a, 0xd0, 0x2c, 0x45, 0x26, 0x77, 0xa1, 0x37, 0x17, 0x8b, 0x05, 0xd7, 0x3c, 0x4a, 0x04,
-	0x4b, 0x94, 0xc6, 0x5e, 0x5a, 0xf4, 0x15, 0xd4, 0xae, 0x41, 0xa6, 0x17, 0x7e, 0xc6, 0x64, 0xca,
-	0xcb, 0xde, 0x5a, 0x99, 0x5e, 0x3c, 0xb1, 0x76, 0xe5, 0x8c, 0xb5, 0x52, 0x0b, 0x6c, 0xb2, 0x70,
-	0x3e, 0xb6, 0xb6, 0xed, 0xb3, 0x12, 0xd7, 0xb7, 0xc2, 0xd4, 0xd1, 0xdf, 0xa9, 0xb0, 0xa9, 0x08,
-	0xc9, 0x00, 0x3a, 0x4c, 0x4a, 0xfb, 0x7b, 0x6c, 0x03, 0x65, 0x6f, 0xdb, 0x10, 0x79, 0x0f, 0xda,
-	0xcf, 0x52, 0xae, 0x73, 0xf4, 0x97, 0x0d, 0xad, 0x81, 0xd7, 0x97, 0xde, 0xfb, 0x7d, 0x0f, 0x6e,
-	0xed, 0x5e, 0x02, 0x72, 0x01, 0x4d, 0xab, 0x71, 0x14, 0xe4, 0xc5, 0x3d, 0x9e, 0x7c, 0xf9, 0xfc,
-	0xf2, 0xb4, 0xf6, 0xf7, 0xe5, 0xe9, 0xdd, 0x50, 0x24, 0xcb, 0x74, 0x36, 0x0a, 0xd4, 0x6a, 0x1c,
-	0x28, 0xb3, 0x52, 0xa6, 0xfc, 0x7c, 0x64, 0xe6, 0x4f, 0xc7, 0xf6, 0x5a, 0x9b, 0xd1, 0x19, 0x0f,
-	0xfe, 0xb9, 0x3c, 0xed, 0xe5, 0x6c, 0x25, 0x3f, 0xf7, 0xbe, 0x2f, 0x68, 0x3c, 0x5a, 0x11, 0x12,
-	0x01, 0x5d, 0x96, 0x31, 0x21, 0xab, 0x3d, 0xc7, 0x67, 0x60, 0xf2, 0xf5, 0x1b, 0x17, 0xb8, 0x59,
-	0x14, 0xd8, 0xe6, 0xf2, 0xe8, 0x7f, 0xa8, 0xc9, 0x39, 0xd4, 0x4d, 0x1e, 0x05, 0xc5, 0x43, 0x32,
-	0xf9, 0xe2, 0x8d, 0x4b, 0x74, 0x8a, 0x12, 0x96, 0xc3, 0xa3, 0x48, 0x75, 0xff, 0x0f, 0x07, 0x9a,
-	0xb8, 0xdc, 0x5c, 0x93, 0x47, 0x70, 0x80, 0x47, 0xe2, 0xed, 0xbe, 0x61, 0xdb, 0x4f, 0xe3, 0xc9,
-	0xe0, 0x7f, 0x63, 0x62, 0x99, 0x7b, 0x35, 0x72, 0x01, 0x3d, 0xb4, 0xa7, 0xe9, 0xcc, 0x04, 0x5a,
-	0xcc, 0xf8, 0xdb, 0x62, 0xfe, 0xd8, 0x99, 0x7c, 0xf5, 0xfc, 0xaa, 0xef, 0xbc, 0xb8, 0xea, 0x3b,
-	0x2f, 0xaf, 0xfa, 0xce, 0xaf, 0xd7, 0xfd, 0xda, 0x8b, 0xeb, 0x7e, 0xed, 0xaf, 0xeb, 0x7e, 0xed,
-	0xe2, 0xde, 0x96, 0x1e, 0x25, 0x13, 0x7e, 0xc7, 0x3f, 0x8f, 0xab, 0x3f, 0x08, 0x14, 0x65, 0xd6,
-	0xc0, 0x57, 0xff, 0x93, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x30, 0x0a, 0xad, 0xe0, 0x38, 0x06,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x8e, 0xdb, 0x44,
+	0x1c, 0x8e, 0x93, 0x6c, 0xfe, 0xfc, 0x92, 0xcd, 0xa2, 0xe9, 0x6e, 0x6b, 0x2d, 0x34, 0x9b, 0x1a,
+	0xa9, 0xcd, 0x01, 0x12, 0x54, 0x04, 0x07, 0x24, 0x24, 0x88, 0x16, 0x68, 0x11, 0xa2, 0xdd, 0x09,
+	0xf4, 0xb0, 0x17, 0x6b, 0xe2, 0x4c, 0x9c, 0x51, 0x1d, 0x8f, 0x3b, 0x63, 0x47, 0x98, 0xa7, 0xe0,
+	0x51, 0x10, 0x07, 0x1e, 0x80, 0x53, 0x2f, 0x48, 0x3d, 0x22, 0x0e, 0xab, 0x6a, 0xf7, 0x0d, 0x78,
+	0x02, 0x34, 0x3f, 0xdb, 0xd9, 0x6c, 0x36, 0xac, 0x54, 0x89, 0x9e, 0x3c, 0xf3, 0xfd, 0xfe, 0x79,
+	0xbe, 0xef, 0xf3, 0x18, 0x6e, 0x45, 0x4c, 0x28, 0x11, 0xfa, 0x43, 0xc5, 0x03, 0x96, 0x0e, 0x22,
+	0x25, 0x63, 0x49, 0xf6, 0x03, 0xb6, 0x64, 0x21, 0x8f, 0x07, 0xe6, 0x39, 0xc8, 0x33, 0x0e, 0xf7,
+	0x7d, 0xe9, 0x4b, 0x4c, 0x18, 0x9a, 0x55, 0x96, 0xeb, 0xfc, 0x5a, 0x85, 0x36, 0x35, 0xb5, 0x94,
+	0xbf, 0x48, 0xb8, 0x8e, 0x89, 0x0d, 0x75, 0x6f, 0xce, 0x44, 0xf8, 0xf8, 0xd8, 0xb6, 0x7a, 0x56,
+	0xbf, 0x49, 0x8b, 0x2d, 0x79, 0x00, 0x7b, 0x9e, 0x0c, 0x43, 0xee, 0xc5, 0x42, 0x86, 0x6e, 0x9c,
+	0x46, 0xdc, 0x2e, 0x63, 0x46, 0xe7, 0x12, 0xfe, 0x21, 0x8d, 0x38, 0xb9, 0x03, 0x75, 0x16, 0x09,
+	0x37, 0x51, 0x81, 0x5d, 0xc1, 0x84, 0x1a, 0x8b, 0xc4, 0x8f, 0x2a, 0x20, 0x77, 0x01, 0x34, 0xd7,
+	0xda, 0x94, 0x8b, 0xa9, 0x5d, 0xed, 0x59, 0xfd, 0x2a, 0x6d, 0xe6, 0xc8, 0xe3, 0x29, 0x39, 0x80,
+	0x9a, 0x97, 0xb8, 0x3a, 0x59, 0xd8, 0x3b, 0x18, 0xda, 0xf1, 0x92, 0x71, 0xb2, 0x20, 0x04, 0xaa,
+	0x53, 0x16, 0x33, 0xbb, 0xd6, 0xb3, 0xfa, 0x6d, 0x8a, 0x6b, 0xf2, 0x0e, 0x54, 0xb4, 0xf0, 0xed,
+	0x3a, 0x42, 0x66, 0x49, 0x0e, 0xa1, 0x11, 0x29, 0xb9, 0x14, 0x53, 0xae, 0xec, 0x06, 0x4e, 0x5d,
+	0xed, 0xc9, 0x3d, 0x68, 0x4f, 0x02, 0xe9, 0x3d, 0x77, 0xe7, 0x5c, 0xf8, 0xf3, 0xd8, 0x6e, 0xf6,
+	0xac, 0x7e, 0x85, 0xb6, 0x10, 0x7b, 0x84, 0x10, 0x79, 0x17, 0x9a, 0x48, 0xa1, 0x1b, 0x26, 0x0b,
+	0x1b, 0x70, 0x7c, 0x03, 0x81, 0xef, 0x93, 0x05, 0x79, 0x1f, 0x76, 0x55, 0x46, 0x8f, 0x8b, 0x35,
+	0x76, 0x0b, 0x1b, 0xb4, 0x73, 0x70, 0x64, 0x30, 0xf2, 0x0d, 0xec, 0x1d, 0xb3, 0x98, 0x51, 0x1e,
+	0x08, 0x36, 0x11, 0x81, 0x88, 0x53, 0xbb, 0xdd, 0xb3, 0xfa, 0xad, 0x87, 0x77, 0x07, 0xdb, 0xf4,
+	0x18, 0x3c, 0xa3, 0x5f, 0x63, 0xfe, 0x66, 0x15, 0xf9, 0x16, 0x9a, 0x27, 0x72, 0x4c, 0x79, 0x24,
+	0x55, 0x6c, 0xef, 0x62, 0x8b, 0x0f, 0xb6, 0xb7, 0x38, 0x49, 0x98, 0xa9, 0x78, 0x32, 0x1b, 0x73,
+	0xb5, 0x14, 0x1e, 0xcf, 0x6a, 0xe8, 0x65, 0x39, 0xf9, 0x04, 0x6e, 0x27, 0xa1, 0xe2, 0x3a, 0x92,
+	0xa1, 0x16, 0x4b, 0xee, 0x16, 0x94, 0x68, 0xbb, 0x83, 0xd4, 0x1d, 0xac, 0x47, 0x9f, 0x16, 0x41,
+	0xc3, 0x46, 0xa4, 0xa4, 0x9c, 0xb9, 0x86, 0xe4, 0x3d, 0xcc, 0x6c, 0x20, 0x30, 0x16, 0xbe, 0xf3,
+	0x67, 0x19, 0x00, 0x2d, 0xf3, 0xd4, 0x20, 0x37, 0x18, 0xe6, 0xaa, 0xdc, 0xe5, 0x4d, 0xb9, 0x37,
+	0x55, 0xa9, 0x5c, 0x57, 0x65, 0x5d, 0xd4, 0xea, 0x86, 0xa8, 0xff, 0xe1, 0x96, 0x2b, 0x42, 0xd6,
+	0x36, 0x84, 0xbc, 0x42, 0x6d, 0xfd, 0x6d, 0x51, 0xdb, 0xb8, 0x89, 0xda, 0xdc, 0xb9, 0xcd, 0x95,
+	0x73, 0x9d, 0x3f, 0xac, 0x9c, 0x4f, 0xca, 0xa3, 0x20, 0x5d, 0xd9, 0xdd, 0xba, 0x6e, 0xf7, 0xf2,
+	0xa5, 0xdd, 0xf7, 0x61, 0x27, 0x94, 0xa1, 0xc7, 0x91, 0xb5, 0x5d, 0x9a, 0x6d, 0x0c, 0xa5, 0x01,
+	0x8b, 0x2f, 0x7d, 0x5a, 0xcd, 0x28, 0xcd, 0xb0, 0xcc, 0xa6, 0x9f, 0xc2, 0x9d, 0x99, 0x08, 0x59,
+	0x20, 0x7e, 0xe6, 0xd3, 0x2c, 0x4b, 0xbb, 0x73, 0xa6, 0xe7, 0x5c, 0x23, 0x8f, 0x6d, 0x7a, 0xb0,
+	0x0a, 0x63, 0x81, 0x7e, 0x84, 0x41, 0x14, 0x53, 0xf8, 0x79, 0x45, 0xfe, 0x2d, 0x36, 0xb5, 0xf0,
+	0xb3, 0x24, 0xe7, 0xb5, 0x05, 0xf5, 0xdc, 0xd1, 0xe4, 0x3e, 0x74, 0xa6, 0x62, 0x36, 0xe3, 0x8a,
+	0x87, 0xb1, 0x60, 0xb1, 0x54, 0x78, 0x96, 0x06, 0xdd, 0x40, 0x8d, 0x54, 0x4b, 0x35, 0x73, 0x97,
+	0x2c, 0x48, 0x78, 0x7e, 0xb6, 0xc6, 0x52, 0xcd, 0x9e, 0x99, 0x7d, 0x11, 0x44, 0xd7, 0xd9, 0x95,
+	0x55, 0x30, 0xf3, 0xdc, 0x3d, 0x68, 0x17, 0x74, 0xa3, 0x45, 0xab, 0x18, 0x6f, 0x15, 0xd8, 0x58,
+	0xf8, 0xa4, 0x07, 0x2d, 0x16, 0x04, 0xe6, 0x7d, 0xcc, 0x01, 0xf2, 0xb3, 0xad, 0x43, 0xe4, 0x3d,
+	0x68, 0xbe, 0x48, 0xb8, 0x4a, 0x31, 0x9e, 0x1f, 0x68, 0x05, 0x5c, 0xbf, 0x61, 0x9c, 0xdf, 0xca,
+	0x70, 0x7b, 0xbb, 0x2d, 0xc8, 0x29, 0xd4, 0x0d, 0xc7, 0xa1, 0x97, 0x66, 0xdf, 0xc0, 0xe8, 0x8b,
+	0x97, 0x67, 0x47, 0xa5, 0xbf, 0xcf, 0x8e, 0xee, 0xfb, 0x22, 0x9e, 0x27, 0x93, 0x81, 0x27, 0x17,
+	0x43, 0x4f, 0xea, 0x85, 0xd4, 0xf9, 0xe3, 0x43, 0x3d, 0x7d, 0x3e, 0x34, 0x77, 0xa8, 0x1e, 0x1c,
+	0x73, 0xef, 0x9f, 0xb3, 0xa3, 0x4e, 0xca, 0x16, 0xc1, 0x67, 0xce, 0x77, 0x59, 0x1b, 0x87, 0x16,
+	0x0d, 0x89, 0x80, 0x36, 0x5b, 0x32, 0x11, 0x14, 0x97, 0x0a, 0xde, 0xb9, 0xa3, 0xaf, 0xde, 0x78,
+	0xc0, 0xad, 0x6c, 0xc0, 0x7a, 0x2f, 0x87, 0x5e, 0x69, 0x4d, 0x4e, 0xa0, 0xaa, 0xd3, 0xd0, 0xcb,
+	0x6e, 0xed, 0xd1, 0xe7, 0x6f, 0x3c, 0xa2, 0x95, 0x8d, 0x30, 0x3d, 0x1c, 0x8a, 0xad, 0x1e, 0xfe,
+	0x6e, 0x41, 0x1d, 0xcd, 0xcd, 0x15, 0x79, 0x02, 0x3b, 0xb8, 0x24, 0xce, 0xf6, 0x6f, 0x6e, 0xfd,
+	0x3f, 0x74, 0xd8, 0xbb, 0x31, 0x27, 0x0a, 0x52, 0xa7, 0x44, 0x4e, 0xa1, 0x83, 0xfb, 0x71, 0x32,
+	0xd1, 0x9e, 0x12, 0x13, 0xfe, 0x7f, 0x75, 0xfe, 0xc8, 0x1a, 0x7d, 0xf9, 0xf2, 0xbc, 0x6b, 0xbd,
+	0x3a, 0xef, 0x5a, 0xaf, 0xcf, 0xbb, 0xd6, 0x2f, 0x17, 0xdd, 0xd2, 0xab, 0x8b, 0x6e, 0xe9, 0xaf,
+	0x8b, 0x6e, 0xe9, 0xf4, 0xc1, 0x1a, 0x1f, 0x79, 0x27, 0x7c, 0x0e, 0x7f, 0x1a, 0x16, 0x7f, 0x63,
+	0x24, 0x65, 0x52, 0xc3, 0x5f, 0xec, 0xc7, 0xff, 0x0e, 0x00, 0xdf, 0x6b, 0x53, 0x52, 0xa5, 0x07,
 	0x00, 0x00,
 }
 
@@ -620,6 +742,13 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofSig) > 0 {
+		i -= len(m.ProofSig)
+		copy(dAtA[i:], m.ProofSig)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.ProofSig)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.UnresponsiveProviders) > 0 {
 		i -= len(m.UnresponsiveProviders)
 		copy(dAtA[i:], m.UnresponsiveProviders)
@@ -721,6 +850,89 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.UnresponsiveProviders) > 0 {
+		i -= len(m.UnresponsiveProviders)
+		copy(dAtA[i:], m.UnresponsiveProviders)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.UnresponsiveProviders)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.QoSReport != nil {
+		{
+			size, err := m.QoSReport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RelayNum != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.RelayNum))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CuSum != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.CuSum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SessionId != 0 {
+		i = encodeVarintRelay(dAtA, i, uint64(m.SessionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RelayReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -978,6 +1190,51 @@ func (m *RelayRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	l = len(m.ProofSig)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.SessionId != 0 {
+		n += 1 + sovRelay(uint64(m.SessionId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRelay(uint64(m.BlockHeight))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	if m.CuSum != 0 {
+		n += 1 + sovRelay(uint64(m.CuSum))
+	}
+	if m.RelayNum != 0 {
+		n += 1 + sovRelay(uint64(m.RelayNum))
+	}
+	if m.QoSReport != nil {
+		l = m.QoSReport.Size()
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.UnresponsiveProviders)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
 	return n
 }
 
@@ -1495,6 +1752,334 @@ func (m *RelayRequest) Unmarshal(dAtA []byte) error {
 				m.UnresponsiveProviders = []byte{}
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofSig = append(m.ProofSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofSig == nil {
+				m.ProofSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			m.SessionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuSum", wireType)
+			}
+			m.CuSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CuSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayNum", wireType)
+			}
+			m.RelayNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QoSReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QoSReport == nil {
+				m.QoSReport = &QualityOfServiceReport{}
+			}
+			if err := m.QoSReport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresponsiveProviders", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnresponsiveProviders = append(m.UnresponsiveProviders[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnresponsiveProviders == nil {
+				m.UnresponsiveProviders = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
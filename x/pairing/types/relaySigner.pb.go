@@ -0,0 +1,2190 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/relaySigner.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SignerPubKeys struct {
+	PubKey    []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	VrfPubKey []byte `protobuf:"bytes,2,opt,name=vrfPubKey,proto3" json:"vrfPubKey,omitempty"`
+}
+
+func (m *SignerPubKeys) Reset()         { *m = SignerPubKeys{} }
+func (m *SignerPubKeys) String() string { return proto.CompactTextString(m) }
+func (*SignerPubKeys) ProtoMessage()    {}
+func (*SignerPubKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{0}
+}
+func (m *SignerPubKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerPubKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerPubKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerPubKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerPubKeys.Merge(m, src)
+}
+func (m *SignerPubKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerPubKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerPubKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerPubKeys proto.InternalMessageInfo
+
+func (m *SignerPubKeys) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SignerPubKeys) GetVrfPubKey() []byte {
+	if m != nil {
+		return m.VrfPubKey
+	}
+	return nil
+}
+
+type SignReply struct {
+	Sig []byte `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *SignReply) Reset()         { *m = SignReply{} }
+func (m *SignReply) String() string { return proto.CompactTextString(m) }
+func (*SignReply) ProtoMessage()    {}
+func (*SignReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{1}
+}
+func (m *SignReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignReply.Merge(m, src)
+}
+func (m *SignReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignReply proto.InternalMessageInfo
+
+func (m *SignReply) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+type SignRelayResponseRequest struct {
+	Reply   *RelayReply   `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Request *RelayRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *SignRelayResponseRequest) Reset()         { *m = SignRelayResponseRequest{} }
+func (m *SignRelayResponseRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRelayResponseRequest) ProtoMessage()    {}
+func (*SignRelayResponseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{2}
+}
+func (m *SignRelayResponseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRelayResponseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRelayResponseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignRelayResponseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRelayResponseRequest.Merge(m, src)
+}
+func (m *SignRelayResponseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRelayResponseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRelayResponseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRelayResponseRequest proto.InternalMessageInfo
+
+func (m *SignRelayResponseRequest) GetReply() *RelayReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *SignRelayResponseRequest) GetRequest() *RelayRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type SignFinalizationDataRequest struct {
+	Reply         *RelayReply   `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Request       *RelayRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	ClientAddress []byte        `protobuf:"bytes,3,opt,name=clientAddress,proto3" json:"clientAddress,omitempty"`
+}
+
+func (m *SignFinalizationDataRequest) Reset()         { *m = SignFinalizationDataRequest{} }
+func (m *SignFinalizationDataRequest) String() string { return proto.CompactTextString(m) }
+func (*SignFinalizationDataRequest) ProtoMessage()    {}
+func (*SignFinalizationDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{3}
+}
+func (m *SignFinalizationDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignFinalizationDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignFinalizationDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignFinalizationDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignFinalizationDataRequest.Merge(m, src)
+}
+func (m *SignFinalizationDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignFinalizationDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignFinalizationDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignFinalizationDataRequest proto.InternalMessageInfo
+
+func (m *SignFinalizationDataRequest) GetReply() *RelayReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *SignFinalizationDataRequest) GetRequest() *RelayRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SignFinalizationDataRequest) GetClientAddress() []byte {
+	if m != nil {
+		return m.ClientAddress
+	}
+	return nil
+}
+
+type SignTxRequest struct {
+	SignDoc []byte `protobuf:"bytes,1,opt,name=signDoc,proto3" json:"signDoc,omitempty"`
+}
+
+func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
+func (m *SignTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTxRequest) ProtoMessage()    {}
+func (*SignTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{4}
+}
+func (m *SignTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTxRequest.Merge(m, src)
+}
+func (m *SignTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTxRequest proto.InternalMessageInfo
+
+func (m *SignTxRequest) GetSignDoc() []byte {
+	if m != nil {
+		return m.SignDoc
+	}
+	return nil
+}
+
+type VRFRequest struct {
+	Request        *RelayRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Reply          *RelayReply   `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	Differentiator bool          `protobuf:"varint,3,opt,name=differentiator,proto3" json:"differentiator,omitempty"`
+	Epoch          uint64        `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Prove          bool          `protobuf:"varint,5,opt,name=prove,proto3" json:"prove,omitempty"`
+}
+
+func (m *VRFRequest) Reset()         { *m = VRFRequest{} }
+func (m *VRFRequest) String() string { return proto.CompactTextString(m) }
+func (*VRFRequest) ProtoMessage()    {}
+func (*VRFRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{5}
+}
+func (m *VRFRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VRFRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VRFRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VRFRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VRFRequest.Merge(m, src)
+}
+func (m *VRFRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VRFRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VRFRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VRFRequest proto.InternalMessageInfo
+
+func (m *VRFRequest) GetRequest() *RelayRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *VRFRequest) GetReply() *RelayReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *VRFRequest) GetDifferentiator() bool {
+	if m != nil {
+		return m.Differentiator
+	}
+	return false
+}
+
+func (m *VRFRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *VRFRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
+type VRFReply struct {
+	VrfRes []byte `protobuf:"bytes,1,opt,name=vrfRes,proto3" json:"vrfRes,omitempty"`
+	Proof  []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *VRFReply) Reset()         { *m = VRFReply{} }
+func (m *VRFReply) String() string { return proto.CompactTextString(m) }
+func (*VRFReply) ProtoMessage()    {}
+func (*VRFReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8231abe7112cbf36, []int{6}
+}
+func (m *VRFReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VRFReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VRFReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VRFReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VRFReply.Merge(m, src)
+}
+func (m *VRFReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *VRFReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VRFReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VRFReply proto.InternalMessageInfo
+
+func (m *VRFReply) GetVrfRes() []byte {
+	if m != nil {
+		return m.VrfRes
+	}
+	return nil
+}
+
+func (m *VRFReply) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignerPubKeys)(nil), "lavanet.lava.pairing.SignerPubKeys")
+	proto.RegisterType((*SignReply)(nil), "lavanet.lava.pairing.SignReply")
+	proto.RegisterType((*SignRelayResponseRequest)(nil), "lavanet.lava.pairing.SignRelayResponseRequest")
+	proto.RegisterType((*SignFinalizationDataRequest)(nil), "lavanet.lava.pairing.SignFinalizationDataRequest")
+	proto.RegisterType((*SignTxRequest)(nil), "lavanet.lava.pairing.SignTxRequest")
+	proto.RegisterType((*VRFRequest)(nil), "lavanet.lava.pairing.VRFRequest")
+	proto.RegisterType((*VRFReply)(nil), "lavanet.lava.pairing.VRFReply")
+}
+
+func init() { proto.RegisterFile("pairing/relaySigner.proto", fileDescriptor_8231abe7112cbf36) }
+
+var fileDescriptor_8231abe7112cbf36 = []byte{
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0xdb, 0x26, 0x6d, 0x27, 0x7f, 0xab, 0x9f, 0xa5, 0xaa, 0x4c, 0xda, 0x9a, 0xc8, 0x20,
+	0x28, 0x17, 0x5b, 0x04, 0x09, 0x71, 0xe0, 0x52, 0xd4, 0x06, 0x21, 0x0e, 0x44, 0x4b, 0xc9, 0x81,
+	0xdb, 0x26, 0x59, 0xbb, 0x2b, 0xb9, 0x5e, 0xb3, 0xde, 0x44, 0x31, 0x4f, 0xd1, 0xd7, 0xe1, 0x0d,
+	0xb8, 0x20, 0xf5, 0x84, 0x38, 0xa2, 0xe4, 0x45, 0xd0, 0xee, 0xda, 0x0d, 0x69, 0x93, 0x28, 0x39,
+	0x71, 0xb2, 0x67, 0xf6, 0xfb, 0x3e, 0xcf, 0xcc, 0xce, 0x97, 0xc0, 0x83, 0x84, 0x30, 0xc1, 0xe2,
+	0xd0, 0x17, 0x34, 0x22, 0xd9, 0x47, 0x16, 0xc6, 0x54, 0x78, 0x89, 0xe0, 0x92, 0xa3, 0xbd, 0x88,
+	0x0c, 0x48, 0x4c, 0xa5, 0xa7, 0x9e, 0x5e, 0x8e, 0xab, 0x1d, 0x84, 0x9c, 0x87, 0x11, 0xf5, 0x35,
+	0xa6, 0xd3, 0x0f, 0x7c, 0x7a, 0x99, 0xc8, 0xcc, 0x50, 0x6a, 0xf7, 0xa7, 0xd4, 0x4c, 0xd2, 0x3d,
+	0x83, 0x1d, 0xa3, 0xdb, 0xea, 0x77, 0xde, 0xd3, 0x2c, 0x45, 0xfb, 0x50, 0x49, 0xf4, 0xab, 0x6d,
+	0xd5, 0xad, 0xe3, 0xff, 0x70, 0x1e, 0xa1, 0x43, 0xd8, 0x1e, 0x88, 0xc0, 0xa0, 0xec, 0x35, 0x7d,
+	0x34, 0x49, 0xb8, 0x47, 0xb0, 0xad, 0x64, 0x30, 0x4d, 0xa2, 0x0c, 0xfd, 0x0f, 0xeb, 0x29, 0x0b,
+	0x73, 0xbe, 0x7a, 0x75, 0xaf, 0x2c, 0xb0, 0xcd, 0x79, 0x44, 0x32, 0x4c, 0xd3, 0x84, 0xc7, 0x29,
+	0xc5, 0xf4, 0x4b, 0x9f, 0xa6, 0x12, 0xbd, 0x84, 0xb2, 0x50, 0x3c, 0x4d, 0xa8, 0x36, 0xea, 0xde,
+	0xac, 0xd6, 0xbc, 0x9c, 0x9a, 0x44, 0x19, 0x36, 0x70, 0xf4, 0x1a, 0x36, 0x85, 0x91, 0xd0, 0xf5,
+	0x54, 0x1b, 0xee, 0x42, 0xa6, 0x46, 0xe2, 0x82, 0xe2, 0x7e, 0xb3, 0xe0, 0x40, 0x95, 0xd4, 0x64,
+	0x31, 0x89, 0xd8, 0x57, 0x22, 0x19, 0x8f, 0x4f, 0x89, 0x24, 0xff, 0xb4, 0x2a, 0xf4, 0x18, 0x76,
+	0xba, 0x11, 0xa3, 0xb1, 0x3c, 0xe9, 0xf5, 0x04, 0x4d, 0x53, 0x7b, 0x5d, 0x0f, 0x71, 0x3a, 0xe9,
+	0x3e, 0x33, 0x97, 0x76, 0x3e, 0x2c, 0x8a, 0xb5, 0x61, 0x33, 0x65, 0x61, 0x7c, 0xca, 0xbb, 0xf9,
+	0xd4, 0x8b, 0xd0, 0xfd, 0x69, 0x01, 0xb4, 0x71, 0xb3, 0x00, 0xfe, 0x55, 0x9d, 0xb5, 0x7a, 0x75,
+	0x37, 0x33, 0x59, 0x5b, 0x6d, 0x26, 0x4f, 0x60, 0xb7, 0xc7, 0x82, 0x80, 0x0a, 0x1a, 0x4b, 0x46,
+	0x24, 0x17, 0xba, 0xad, 0x2d, 0x7c, 0x2b, 0x8b, 0xf6, 0xa0, 0x4c, 0x13, 0xde, 0xbd, 0xb0, 0x37,
+	0xea, 0xd6, 0xf1, 0x06, 0x36, 0x81, 0xca, 0x26, 0x82, 0x0f, 0xa8, 0x5d, 0xd6, 0x24, 0x13, 0xb8,
+	0xaf, 0x60, 0x4b, 0xf7, 0xa5, 0xf4, 0xf7, 0xa1, 0x32, 0x10, 0x01, 0xa6, 0x69, 0xb1, 0xb3, 0x26,
+	0xca, 0x99, 0x3c, 0xc8, 0xf7, 0xd5, 0x04, 0x8d, 0x1f, 0x65, 0xa8, 0xe2, 0x89, 0xa1, 0xd0, 0x5b,
+	0xa8, 0x98, 0x2d, 0x46, 0xfb, 0x9e, 0xf1, 0x8f, 0x57, 0xf8, 0xc7, 0x3b, 0x53, 0xfe, 0xa9, 0x3d,
+	0x9a, 0xdd, 0xe8, 0x94, 0x71, 0xdc, 0x12, 0xc2, 0x85, 0x09, 0x22, 0x92, 0xa1, 0x25, 0x06, 0x5b,
+	0x7b, 0x38, 0x5f, 0x57, 0x37, 0xe6, 0x96, 0xd0, 0x27, 0xd8, 0xbd, 0xd1, 0x6c, 0xa9, 0xf2, 0xd1,
+	0xa2, 0xa9, 0x6b, 0xc4, 0x32, 0xb2, 0x1f, 0xa0, 0xaa, 0xc2, 0x36, 0x6e, 0xaa, 0x9d, 0x47, 0x47,
+	0xb3, 0x19, 0xf9, 0xf1, 0x32, 0x82, 0x01, 0xdc, 0xbb, 0x63, 0x70, 0xe4, 0x2d, 0xe2, 0xdd, 0xfd,
+	0x25, 0x58, 0xe6, 0x3b, 0x12, 0x0e, 0x4d, 0x68, 0x98, 0xb7, 0xdd, 0x8b, 0x9e, 0xcf, 0x97, 0x98,
+	0xe3, 0xf4, 0x65, 0xbe, 0xda, 0x82, 0x8a, 0x31, 0x1c, 0x5a, 0xb0, 0x0a, 0xe7, 0xc3, 0x15, 0x14,
+	0xdf, 0xc1, 0x7a, 0x1b, 0x37, 0xe7, 0x5d, 0xe6, 0xc4, 0xb1, 0x35, 0x67, 0x01, 0x42, 0x4b, 0xbd,
+	0x39, 0xf9, 0x3e, 0x72, 0xac, 0xeb, 0x91, 0x63, 0xfd, 0x1e, 0x39, 0xd6, 0xd5, 0xd8, 0x29, 0x5d,
+	0x8f, 0x9d, 0xd2, 0xaf, 0xb1, 0x53, 0xfa, 0xfc, 0x34, 0x64, 0xf2, 0xa2, 0xdf, 0xf1, 0xba, 0xfc,
+	0xd2, 0xcf, 0x55, 0xf4, 0xd3, 0x1f, 0xfa, 0xc5, 0x7f, 0x81, 0xcc, 0x12, 0x9a, 0x76, 0x2a, 0x7a,
+	0xe1, 0x5f, 0xfc, 0x19, 0x00, 0x20, 0x04, 0xad, 0x7b, 0x71, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RelaySignerClient is the client API for RelaySigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelaySignerClient interface {
+	PubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SignerPubKeys, error)
+	SignRelay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*SignReply, error)
+	SignRelayProof(ctx context.Context, in *RelayProof, opts ...grpc.CallOption) (*SignReply, error)
+	SignVRFData(ctx context.Context, in *VRFData, opts ...grpc.CallOption) (*SignReply, error)
+	SignRelayResponse(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*SignReply, error)
+	SignResponseFinalizationData(ctx context.Context, in *SignFinalizationDataRequest, opts ...grpc.CallOption) (*SignReply, error)
+	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignReply, error)
+	VRF(ctx context.Context, in *VRFRequest, opts ...grpc.CallOption) (*VRFReply, error)
+}
+
+type relaySignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelaySignerClient(cc grpc1.ClientConn) RelaySignerClient {
+	return &relaySignerClient{cc}
+}
+
+func (c *relaySignerClient) PubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SignerPubKeys, error) {
+	out := new(SignerPubKeys)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignRelay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignRelayProof(ctx context.Context, in *RelayProof, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignRelayProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignVRFData(ctx context.Context, in *VRFData, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignVRFData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignRelayResponse(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignRelayResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignResponseFinalizationData(ctx context.Context, in *SignFinalizationDataRequest, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignResponseFinalizationData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignReply, error) {
+	out := new(SignReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) VRF(ctx context.Context, in *VRFRequest, opts ...grpc.CallOption) (*VRFReply, error) {
+	out := new(VRFReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/VRF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelaySignerServer is the server API for RelaySigner service.
+type RelaySignerServer interface {
+	PubKey(context.Context, *emptypb.Empty) (*SignerPubKeys, error)
+	SignRelay(context.Context, *RelayRequest) (*SignReply, error)
+	SignRelayProof(context.Context, *RelayProof) (*SignReply, error)
+	SignVRFData(context.Context, *VRFData) (*SignReply, error)
+	SignRelayResponse(context.Context, *SignRelayResponseRequest) (*SignReply, error)
+	SignResponseFinalizationData(context.Context, *SignFinalizationDataRequest) (*SignReply, error)
+	SignTx(context.Context, *SignTxRequest) (*SignReply, error)
+	VRF(context.Context, *VRFRequest) (*VRFReply, error)
+}
+
+// UnimplementedRelaySignerServer can be embedded to have forward compatible implementations.
+type UnimplementedRelaySignerServer struct {
+}
+
+func (*UnimplementedRelaySignerServer) PubKey(ctx context.Context, req *emptypb.Empty) (*SignerPubKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignRelay(ctx context.Context, req *RelayRequest) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignRelay not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignRelayProof(ctx context.Context, req *RelayProof) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignRelayProof not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignVRFData(ctx context.Context, req *VRFData) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVRFData not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignRelayResponse(ctx context.Context, req *SignRelayResponseRequest) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignRelayResponse not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignResponseFinalizationData(ctx context.Context, req *SignFinalizationDataRequest) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignResponseFinalizationData not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignTx(ctx context.Context, req *SignTxRequest) (*SignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTx not implemented")
+}
+func (*UnimplementedRelaySignerServer) VRF(ctx context.Context, req *VRFRequest) (*VRFReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VRF not implemented")
+}
+
+func RegisterRelaySignerServer(s grpc1.Server, srv RelaySignerServer) {
+	s.RegisterService(&_RelaySigner_serviceDesc, srv)
+}
+
+func _RelaySigner_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).PubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/PubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).PubKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignRelay(ctx, req.(*RelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignRelayProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignRelayProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignRelayProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignRelayProof(ctx, req.(*RelayProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignVRFData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VRFData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignVRFData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignVRFData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignVRFData(ctx, req.(*VRFData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignRelayResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRelayResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignRelayResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignRelayResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignRelayResponse(ctx, req.(*SignRelayResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignResponseFinalizationData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignFinalizationDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignResponseFinalizationData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignResponseFinalizationData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignResponseFinalizationData(ctx, req.(*SignFinalizationDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignTx(ctx, req.(*SignTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_VRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VRFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).VRF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/VRF",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).VRF(ctx, req.(*VRFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelaySigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelaySigner",
+	HandlerType: (*RelaySignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PubKey",
+			Handler:    _RelaySigner_PubKey_Handler,
+		},
+		{
+			MethodName: "SignRelay",
+			Handler:    _RelaySigner_SignRelay_Handler,
+		},
+		{
+			MethodName: "SignRelayProof",
+			Handler:    _RelaySigner_SignRelayProof_Handler,
+		},
+		{
+			MethodName: "SignVRFData",
+			Handler:    _RelaySigner_SignVRFData_Handler,
+		},
+		{
+			MethodName: "SignRelayResponse",
+			Handler:    _RelaySigner_SignRelayResponse_Handler,
+		},
+		{
+			MethodName: "SignResponseFinalizationData",
+			Handler:    _RelaySigner_SignResponseFinalizationData_Handler,
+		},
+		{
+			MethodName: "SignTx",
+			Handler:    _RelaySigner_SignTx_Handler,
+		},
+		{
+			MethodName: "VRF",
+			Handler:    _RelaySigner_VRF_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/relaySigner.proto",
+}
+
+func (m *SignerPubKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerPubKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerPubKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VrfPubKey) > 0 {
+		i -= len(m.VrfPubKey)
+		copy(dAtA[i:], m.VrfPubKey)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.VrfPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRelayResponseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRelayResponseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRelayResponseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignFinalizationDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignFinalizationDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignFinalizationDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientAddress) > 0 {
+		i -= len(m.ClientAddress)
+		copy(dAtA[i:], m.ClientAddress)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.ClientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignDoc) > 0 {
+		i -= len(m.SignDoc)
+		copy(dAtA[i:], m.SignDoc)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.SignDoc)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VRFRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VRFRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VRFRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRelaySigner(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Differentiator {
+		i--
+		if m.Differentiator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRelaySigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VRFReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VRFReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VRFReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VrfRes) > 0 {
+		i -= len(m.VrfRes)
+		copy(dAtA[i:], m.VrfRes)
+		i = encodeVarintRelaySigner(dAtA, i, uint64(len(m.VrfRes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelaySigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelaySigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignerPubKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	l = len(m.VrfPubKey)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignRelayResponseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignFinalizationDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	l = len(m.ClientAddress)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignDoc)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func (m *VRFRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	if m.Differentiator {
+		n += 2
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRelaySigner(uint64(m.Epoch))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *VRFReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VrfRes)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovRelaySigner(uint64(l))
+	}
+	return n
+}
+
+func sovRelaySigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelaySigner(x uint64) (n int) {
+	return sovRelaySigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignerPubKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerPubKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerPubKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfPubKey = append(m.VrfPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfPubKey == nil {
+				m.VrfPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRelayResponseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRelayResponseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRelayResponseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignFinalizationDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignFinalizationDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignFinalizationDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAddress = append(m.ClientAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientAddress == nil {
+				m.ClientAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDoc", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignDoc = append(m.SignDoc[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignDoc == nil {
+				m.SignDoc = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VRFRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VRFRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VRFRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Differentiator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Differentiator = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VRFReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VRFReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VRFReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfRes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfRes = append(m.VrfRes[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfRes == nil {
+				m.VrfRes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelaySigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelaySigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelaySigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelaySigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelaySigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelaySigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelaySigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelaySigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelaySigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelaySigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelaySigner = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,3 +11,33 @@ func (m *RelayRequest) ShallowCopy() *RelayRequest {
 	requestCopy := *m
 	return &requestCopy
 }
+
+// RelayProof returns the compact payment proof of the relay request, signed with its proof signature
+func (m *RelayRequest) RelayProof() *RelayProof {
+	return &RelayProof{
+		ChainID:               m.ChainID,
+		SessionId:             m.SessionId,
+		BlockHeight:           m.BlockHeight,
+		Provider:              m.Provider,
+		CuSum:                 m.CuSum,
+		RelayNum:              m.RelayNum,
+		QoSReport:             m.QoSReport,
+		UnresponsiveProviders: m.UnresponsiveProviders,
+		Sig:                   m.ProofSig,
+	}
+}
+
+// RelayRequest returns a relay request without a payload holding the payment fields of the proof
+func (m *RelayProof) RelayRequest() *RelayRequest {
+	return &RelayRequest{
+		ChainID:               m.ChainID,
+		SessionId:             m.SessionId,
+		BlockHeight:           m.BlockHeight,
+		Provider:              m.Provider,
+		CuSum:                 m.CuSum,
+		RelayNum:              m.RelayNum,
+		QoSReport:             m.QoSReport,
+		UnresponsiveProviders: m.UnresponsiveProviders,
+		Sig:                   m.Sig,
+	}
+}
@@ -0,0 +1,1629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/subscription.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Plan is a governance defined subscription package that consumers can buy instead of staking per chain
+type Plan struct {
+	Index               string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name                string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price               types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	DurationMonths      uint64     `protobuf:"varint,5,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	MonthlyComputeUnits uint64     `protobuf:"varint,6,opt,name=monthly_compute_units,json=monthlyComputeUnits,proto3" json:"monthly_compute_units,omitempty"`
+	ChainIds            []string   `protobuf:"bytes,7,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	Enabled             bool       `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BlockLastUpdated    uint64     `protobuf:"varint,9,opt,name=block_last_updated,json=blockLastUpdated,proto3" json:"block_last_updated,omitempty"`
+}
+
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cac93f0db7b02100, []int{0}
+}
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(m, src)
+}
+func (m *Plan) XXX_Size() int {
+	return m.Size()
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Plan proto.InternalMessageInfo
+
+func (m *Plan) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Plan) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Plan) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Plan) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Plan) GetDurationMonths() uint64 {
+	if m != nil {
+		return m.DurationMonths
+	}
+	return 0
+}
+
+func (m *Plan) GetMonthlyComputeUnits() uint64 {
+	if m != nil {
+		return m.MonthlyComputeUnits
+	}
+	return 0
+}
+
+func (m *Plan) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *Plan) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Plan) GetBlockLastUpdated() uint64 {
+	if m != nil {
+		return m.BlockLastUpdated
+	}
+	return 0
+}
+
+// Subscription is a plan bought by a consumer
+type Subscription struct {
+	Consumer        string          `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Plan            Plan            `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan"`
+	Geolocation     uint64          `protobuf:"varint,3,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Vrfpk           string          `protobuf:"bytes,4,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	StartBlock      uint64          `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	ExpiryBlock     uint64          `protobuf:"varint,6,opt,name=expiry_block,json=expiryBlock,proto3" json:"expiry_block,omitempty"`
+	MonthsLeft      uint64          `protobuf:"varint,7,opt,name=months_left,json=monthsLeft,proto3" json:"months_left,omitempty"`
+	MonthExpiryTime uint64          `protobuf:"varint,8,opt,name=month_expiry_time,json=monthExpiryTime,proto3" json:"month_expiry_time,omitempty"`
+	MonthCuLeft     uint64          `protobuf:"varint,9,opt,name=month_cu_left,json=monthCuLeft,proto3" json:"month_cu_left,omitempty"`
+	ReplacedVrfpks  []ReplacedVrfpk `protobuf:"bytes,10,rep,name=replaced_vrfpks,json=replacedVrfpks,proto3" json:"replaced_vrfpks"`
+	EpochCuLeft     uint64          `protobuf:"varint,11,opt,name=epoch_cu_left,json=epochCuLeft,proto3" json:"epoch_cu_left,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cac93f0db7b02100, []int{1}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *Subscription) GetPlan() Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return Plan{}
+}
+
+func (m *Subscription) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *Subscription) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
+	}
+	return ""
+}
+
+func (m *Subscription) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *Subscription) GetExpiryBlock() uint64 {
+	if m != nil {
+		return m.ExpiryBlock
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthsLeft() uint64 {
+	if m != nil {
+		return m.MonthsLeft
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthExpiryTime() uint64 {
+	if m != nil {
+		return m.MonthExpiryTime
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthCuLeft() uint64 {
+	if m != nil {
+		return m.MonthCuLeft
+	}
+	return 0
+}
+
+func (m *Subscription) GetReplacedVrfpks() []ReplacedVrfpk {
+	if m != nil {
+		return m.ReplacedVrfpks
+	}
+	return nil
+}
+
+func (m *Subscription) GetEpochCuLeft() uint64 {
+	if m != nil {
+		return m.EpochCuLeft
+	}
+	return 0
+}
+
+// ReplacedVrfpk is a vrf public key replaced by a rotation, kept while relays signed with it can still be paid
+type ReplacedVrfpk struct {
+	Vrfpk           string `protobuf:"bytes,1,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+	ValidUntilEpoch uint64 `protobuf:"varint,2,opt,name=valid_until_epoch,json=validUntilEpoch,proto3" json:"valid_until_epoch,omitempty"`
+}
+
+func (m *ReplacedVrfpk) Reset()         { *m = ReplacedVrfpk{} }
+func (m *ReplacedVrfpk) String() string { return proto.CompactTextString(m) }
+func (*ReplacedVrfpk) ProtoMessage()    {}
+func (*ReplacedVrfpk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cac93f0db7b02100, []int{2}
+}
+func (m *ReplacedVrfpk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplacedVrfpk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplacedVrfpk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplacedVrfpk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplacedVrfpk.Merge(m, src)
+}
+func (m *ReplacedVrfpk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplacedVrfpk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplacedVrfpk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplacedVrfpk proto.InternalMessageInfo
+
+func (m *ReplacedVrfpk) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
+	}
+	return ""
+}
+
+func (m *ReplacedVrfpk) GetValidUntilEpoch() uint64 {
+	if m != nil {
+		return m.ValidUntilEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Plan)(nil), "lavanet.lava.pairing.Plan")
+	proto.RegisterType((*Subscription)(nil), "lavanet.lava.pairing.Subscription")
+	proto.RegisterType((*ReplacedVrfpk)(nil), "lavanet.lava.pairing.ReplacedVrfpk")
+}
+
+func init() { proto.RegisterFile("pairing/subscription.proto", fileDescriptor_cac93f0db7b02100) }
+
+var fileDescriptor_cac93f0db7b02100 = []byte{
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xc1, 0x4e, 0xdc, 0x30,
+	0x10, 0x86, 0x37, 0x6c, 0x80, 0x5d, 0x07, 0xd8, 0xe2, 0x52, 0x29, 0xdd, 0x4a, 0x61, 0xbb, 0x3d,
+	0xb0, 0x42, 0x55, 0x22, 0xb6, 0xed, 0x0b, 0x80, 0x38, 0x54, 0xa2, 0x52, 0x9b, 0x96, 0x1e, 0x7a,
+	0x89, 0x1c, 0xc7, 0x2c, 0x16, 0x89, 0x1d, 0xc5, 0xce, 0x0a, 0xde, 0xa2, 0x8f, 0xd1, 0x17, 0xa9,
+	0x84, 0xd4, 0x0b, 0xc7, 0x9e, 0xaa, 0x6a, 0x79, 0x91, 0xca, 0x63, 0x03, 0x5b, 0x89, 0x93, 0x3d,
+	0xdf, 0xfc, 0x9a, 0x19, 0xff, 0xa3, 0x04, 0x0d, 0x6b, 0xc2, 0x1b, 0x2e, 0x66, 0x89, 0x6a, 0x73,
+	0x45, 0x1b, 0x5e, 0x6b, 0x2e, 0x45, 0x5c, 0x37, 0x52, 0x4b, 0xbc, 0x53, 0x92, 0x39, 0x11, 0x4c,
+	0xc7, 0xe6, 0x8c, 0x9d, 0x70, 0xb8, 0x33, 0x93, 0x33, 0x09, 0x82, 0xc4, 0xdc, 0xac, 0x76, 0x18,
+	0x51, 0xa9, 0x2a, 0xa9, 0x92, 0x9c, 0x28, 0x96, 0xcc, 0x0f, 0x72, 0xa6, 0xc9, 0x41, 0x42, 0x25,
+	0x77, 0xb5, 0xc6, 0xbf, 0x56, 0x90, 0xff, 0xb1, 0x24, 0x02, 0xef, 0xa0, 0x55, 0x2e, 0x0a, 0x76,
+	0x19, 0x7a, 0x23, 0x6f, 0xd2, 0x4f, 0x6d, 0x80, 0x31, 0xf2, 0x05, 0xa9, 0x58, 0xb8, 0x02, 0x10,
+	0xee, 0x78, 0x84, 0x82, 0x82, 0xdd, 0xcf, 0x14, 0x76, 0x21, 0xb5, 0x8c, 0xf0, 0x3b, 0xb4, 0x5a,
+	0x37, 0x9c, 0xb2, 0xd0, 0x1f, 0x79, 0x93, 0x60, 0xfa, 0x3c, 0xb6, 0x43, 0xc4, 0x66, 0x88, 0xd8,
+	0x0d, 0x11, 0x1f, 0x49, 0x2e, 0x0e, 0xfd, 0xeb, 0x3f, 0xbb, 0x9d, 0xd4, 0xaa, 0xf1, 0x1e, 0x1a,
+	0x14, 0x6d, 0x43, 0x4c, 0x89, 0xac, 0x92, 0x42, 0x9f, 0xab, 0x70, 0x75, 0xe4, 0x4d, 0xfc, 0x74,
+	0xeb, 0x0e, 0x7f, 0x00, 0x8a, 0xa7, 0xe8, 0x19, 0xe4, 0xcb, 0xab, 0x8c, 0xca, 0xaa, 0x6e, 0x35,
+	0xcb, 0x5a, 0xc1, 0xb5, 0x0a, 0xd7, 0x40, 0xfe, 0xd4, 0x25, 0x8f, 0x6c, 0xee, 0xd4, 0xa4, 0xf0,
+	0x0b, 0xd4, 0xa7, 0xe7, 0x84, 0x8b, 0x8c, 0x17, 0x2a, 0x5c, 0x1f, 0x75, 0x27, 0xfd, 0xb4, 0x07,
+	0xe0, 0x7d, 0xa1, 0x70, 0x88, 0xd6, 0x99, 0x20, 0x79, 0xc9, 0x8a, 0xb0, 0x37, 0xf2, 0x26, 0xbd,
+	0xf4, 0x2e, 0xc4, 0xaf, 0x11, 0xce, 0x4b, 0x49, 0x2f, 0xb2, 0x92, 0x28, 0x9d, 0xb5, 0x75, 0x41,
+	0x34, 0x2b, 0xc2, 0x3e, 0xf4, 0x79, 0x02, 0x99, 0x13, 0xa2, 0xf4, 0xa9, 0xe5, 0xe3, 0x9f, 0x5d,
+	0xb4, 0xf1, 0x79, 0x69, 0x61, 0x78, 0x88, 0x7a, 0x54, 0x0a, 0xd5, 0x56, 0xac, 0x71, 0xc6, 0xde,
+	0xc7, 0xf8, 0x2d, 0xf2, 0xeb, 0x92, 0x08, 0xf0, 0x36, 0x98, 0x0e, 0xe3, 0xc7, 0xb6, 0x1a, 0x9b,
+	0xdd, 0x38, 0x97, 0x40, 0x6d, 0xdc, 0x9f, 0x31, 0x59, 0x4a, 0x4a, 0xee, 0xdd, 0xf7, 0xd3, 0x65,
+	0x64, 0x36, 0x39, 0x6f, 0xce, 0xea, 0x0b, 0x70, 0xbf, 0x9f, 0xda, 0x00, 0xef, 0xa2, 0x40, 0x69,
+	0xd2, 0xe8, 0x0c, 0x86, 0x76, 0xc6, 0x22, 0x40, 0x87, 0x86, 0xe0, 0x97, 0x68, 0x83, 0x5d, 0xd6,
+	0xbc, 0xb9, 0x72, 0x0a, 0xeb, 0x65, 0x60, 0x99, 0x95, 0xec, 0xa2, 0xc0, 0xee, 0x25, 0x2b, 0xd9,
+	0x99, 0x0e, 0xd7, 0x6d, 0x0d, 0x8b, 0x4e, 0xd8, 0x99, 0xc6, 0xfb, 0x68, 0x1b, 0xa2, 0xcc, 0x55,
+	0xd2, 0xbc, 0x62, 0xe0, 0xa8, 0x9f, 0x0e, 0x20, 0x71, 0x0c, 0xfc, 0x0b, 0xaf, 0x18, 0x1e, 0xa3,
+	0x4d, 0xab, 0xa5, 0xad, 0x2d, 0x67, 0x4d, 0xb5, 0x1d, 0x8e, 0x5a, 0xa8, 0x97, 0xa2, 0x41, 0xc3,
+	0xea, 0x92, 0x50, 0x56, 0x64, 0xf0, 0x0c, 0x15, 0xa2, 0x51, 0x77, 0x12, 0x4c, 0x5f, 0x3d, 0xee,
+	0x56, 0xea, 0xc4, 0x5f, 0x8d, 0xd6, 0xd9, 0xb6, 0xd5, 0x2c, 0x43, 0x65, 0xfa, 0xb2, 0x5a, 0xd2,
+	0x87, 0xbe, 0x81, 0x7b, 0xa8, 0x81, 0xb6, 0xef, 0xf8, 0x13, 0xda, 0xfc, 0xaf, 0xd4, 0x83, 0xa7,
+	0xde, 0xb2, 0xa7, 0xfb, 0x68, 0x7b, 0x4e, 0x4a, 0x5e, 0x64, 0xad, 0xd0, 0xbc, 0xcc, 0xa0, 0x02,
+	0xac, 0xd3, 0x4f, 0x07, 0x90, 0x38, 0x35, 0xfc, 0xd8, 0xe0, 0xc3, 0xe3, 0x1f, 0x8b, 0xc8, 0xbb,
+	0x5e, 0x44, 0xde, 0xcd, 0x22, 0xf2, 0xfe, 0x2e, 0x22, 0xef, 0xfb, 0x6d, 0xd4, 0xb9, 0xb9, 0x8d,
+	0x3a, 0xbf, 0x6f, 0xa3, 0xce, 0xb7, 0xbd, 0x19, 0xd7, 0xe7, 0x6d, 0x1e, 0x53, 0x59, 0x25, 0xee,
+	0x65, 0x70, 0x26, 0x97, 0xc9, 0xdd, 0x8f, 0x40, 0x5f, 0xd5, 0x4c, 0xe5, 0x6b, 0xf0, 0xd9, 0xbe,
+	0xf9, 0x37, 0x00, 0x66, 0x11, 0x6e, 0x76, 0x20, 0x04, 0x00, 0x00,
+}
+
+func (this *Plan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Plan)
+	if !ok {
+		that2, ok := that.(Plan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if this.DurationMonths != that1.DurationMonths {
+		return false
+	}
+	if this.MonthlyComputeUnits != that1.MonthlyComputeUnits {
+		return false
+	}
+	if len(this.ChainIds) != len(that1.ChainIds) {
+		return false
+	}
+	for i := range this.ChainIds {
+		if this.ChainIds[i] != that1.ChainIds[i] {
+			return false
+		}
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.BlockLastUpdated != that1.BlockLastUpdated {
+		return false
+	}
+	return true
+}
+func (this *Subscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Subscription)
+	if !ok {
+		that2, ok := that.(Subscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Consumer != that1.Consumer {
+		return false
+	}
+	if !this.Plan.Equal(&that1.Plan) {
+		return false
+	}
+	if this.Geolocation != that1.Geolocation {
+		return false
+	}
+	if this.Vrfpk != that1.Vrfpk {
+		return false
+	}
+	if this.StartBlock != that1.StartBlock {
+		return false
+	}
+	if this.ExpiryBlock != that1.ExpiryBlock {
+		return false
+	}
+	if this.MonthsLeft != that1.MonthsLeft {
+		return false
+	}
+	if this.MonthExpiryTime != that1.MonthExpiryTime {
+		return false
+	}
+	if this.MonthCuLeft != that1.MonthCuLeft {
+		return false
+	}
+	if len(this.ReplacedVrfpks) != len(that1.ReplacedVrfpks) {
+		return false
+	}
+	for i := range this.ReplacedVrfpks {
+		if !this.ReplacedVrfpks[i].Equal(&that1.ReplacedVrfpks[i]) {
+			return false
+		}
+	}
+	if this.EpochCuLeft != that1.EpochCuLeft {
+		return false
+	}
+	return true
+}
+func (this *ReplacedVrfpk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplacedVrfpk)
+	if !ok {
+		that2, ok := that.(ReplacedVrfpk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Vrfpk != that1.Vrfpk {
+		return false
+	}
+	if this.ValidUntilEpoch != that1.ValidUntilEpoch {
+		return false
+	}
+	return true
+}
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockLastUpdated != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.BlockLastUpdated))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintSubscription(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MonthlyComputeUnits != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthlyComputeUnits))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DurationMonths != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.DurationMonths))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochCuLeft != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.EpochCuLeft))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ReplacedVrfpks) > 0 {
+		for iNdEx := len(m.ReplacedVrfpks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplacedVrfpks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubscription(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MonthCuLeft != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthCuLeft))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MonthExpiryTime != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthExpiryTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MonthsLeft != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthsLeft))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiryBlock != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.ExpiryBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplacedVrfpk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplacedVrfpk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplacedVrfpk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidUntilEpoch != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.ValidUntilEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubscription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Plan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	if m.DurationMonths != 0 {
+		n += 1 + sovSubscription(uint64(m.DurationMonths))
+	}
+	if m.MonthlyComputeUnits != 0 {
+		n += 1 + sovSubscription(uint64(m.MonthlyComputeUnits))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.BlockLastUpdated != 0 {
+		n += 1 + sovSubscription(uint64(m.BlockLastUpdated))
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovSubscription(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovSubscription(uint64(m.StartBlock))
+	}
+	if m.ExpiryBlock != 0 {
+		n += 1 + sovSubscription(uint64(m.ExpiryBlock))
+	}
+	if m.MonthsLeft != 0 {
+		n += 1 + sovSubscription(uint64(m.MonthsLeft))
+	}
+	if m.MonthExpiryTime != 0 {
+		n += 1 + sovSubscription(uint64(m.MonthExpiryTime))
+	}
+	if m.MonthCuLeft != 0 {
+		n += 1 + sovSubscription(uint64(m.MonthCuLeft))
+	}
+	if len(m.ReplacedVrfpks) > 0 {
+		for _, e := range m.ReplacedVrfpks {
+			l = e.Size()
+			n += 1 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.EpochCuLeft != 0 {
+		n += 1 + sovSubscription(uint64(m.EpochCuLeft))
+	}
+	return n
+}
+
+func (m *ReplacedVrfpk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.ValidUntilEpoch != 0 {
+		n += 1 + sovSubscription(uint64(m.ValidUntilEpoch))
+	}
+	return n
+}
+
+func sovSubscription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubscription(x uint64) (n int) {
+	return sovSubscription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMonths", wireType)
+			}
+			m.DurationMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyComputeUnits", wireType)
+			}
+			m.MonthlyComputeUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthlyComputeUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLastUpdated", wireType)
+			}
+			m.BlockLastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockLastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlock", wireType)
+			}
+			m.ExpiryBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLeft", wireType)
+			}
+			m.MonthsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthExpiryTime", wireType)
+			}
+			m.MonthExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuLeft", wireType)
+			}
+			m.MonthCuLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedVrfpks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedVrfpks = append(m.ReplacedVrfpks, ReplacedVrfpk{})
+			if err := m.ReplacedVrfpks[len(m.ReplacedVrfpks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCuLeft", wireType)
+			}
+			m.EpochCuLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCuLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplacedVrfpk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplacedVrfpk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplacedVrfpk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntilEpoch", wireType)
+			}
+			m.ValidUntilEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntilEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubscription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubscription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubscription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubscription = fmt.Errorf("proto: unexpected end of group")
+)
@@ -37,6 +37,7 @@ type MsgStakeProvider struct {
 	Endpoints   []types1.Endpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
 	Geolocation uint64            `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
 	Moniker     string            `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Operator    string            `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
 func (m *MsgStakeProvider) Reset()         { *m = MsgStakeProvider{} }
@@ -114,6 +115,13 @@ func (m *MsgStakeProvider) GetMoniker() string {
 	return ""
 }
 
+func (m *MsgStakeProvider) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
 type MsgStakeProviderResponse struct {
 }
 
@@ -442,6 +450,7 @@ type MsgRelayPayment struct {
 	Creator           string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Relays            []*RelayRequest `protobuf:"bytes,2,rep,name=relays,proto3" json:"relays,omitempty"`
 	DescriptionString string          `protobuf:"bytes,3,opt,name=descriptionString,proto3" json:"descriptionString,omitempty"`
+	Proofs            []*RelayProof   `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
 }
 
 func (m *MsgRelayPayment) Reset()         { *m = MsgRelayPayment{} }
@@ -498,6 +507,13 @@ func (m *MsgRelayPayment) GetDescriptionString() string {
 	return ""
 }
 
+func (m *MsgRelayPayment) GetProofs() []*RelayProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type MsgRelayPaymentResponse struct {
 }
 
@@ -534,836 +550,3731 @@ func (m *MsgRelayPaymentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRelayPaymentResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
-	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
-	proto.RegisterType((*MsgStakeClient)(nil), "lavanet.lava.pairing.MsgStakeClient")
-	proto.RegisterType((*MsgStakeClientResponse)(nil), "lavanet.lava.pairing.MsgStakeClientResponse")
-	proto.RegisterType((*MsgUnstakeProvider)(nil), "lavanet.lava.pairing.MsgUnstakeProvider")
-	proto.RegisterType((*MsgUnstakeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnstakeProviderResponse")
-	proto.RegisterType((*MsgUnstakeClient)(nil), "lavanet.lava.pairing.MsgUnstakeClient")
-	proto.RegisterType((*MsgUnstakeClientResponse)(nil), "lavanet.lava.pairing.MsgUnstakeClientResponse")
-	proto.RegisterType((*MsgRelayPayment)(nil), "lavanet.lava.pairing.MsgRelayPayment")
-	proto.RegisterType((*MsgRelayPaymentResponse)(nil), "lavanet.lava.pairing.MsgRelayPaymentResponse")
+type MsgBuySubscription struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index       string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Geolocation uint64 `protobuf:"varint,3,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Vrfpk       string `protobuf:"bytes,4,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
 }
 
-func init() { proto.RegisterFile("pairing/tx.proto", fileDescriptor_b2db224a5e52fa36) }
-
-var fileDescriptor_b2db224a5e52fa36 = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xb6, 0xae, 0xa8, 0x2e, 0x63, 0x23, 0x54, 0x90, 0x05, 0x14, 0xaa, 0x00, 0xa3, 0x87,
-	0xe1, 0xb0, 0x72, 0x40, 0xe2, 0xc6, 0xc6, 0xcf, 0x43, 0xa5, 0x29, 0x13, 0x17, 0x6e, 0x6e, 0x6a,
-	0x5c, 0xab, 0x8d, 0x1d, 0x6c, 0xb7, 0x5a, 0xef, 0xdc, 0xe1, 0xc2, 0x9f, 0xc2, 0xff, 0xb0, 0xe3,
-	0x8e, 0x9c, 0x10, 0x6a, 0xff, 0x11, 0x94, 0xc4, 0x09, 0x4d, 0xba, 0x8d, 0x0a, 0x24, 0x4e, 0xc9,
-	0xf3, 0xfb, 0xde, 0xfb, 0xde, 0xf7, 0xfc, 0xa5, 0x05, 0xdb, 0x11, 0xa2, 0x82, 0x32, 0xe2, 0xa9,
-	0x13, 0x18, 0x09, 0xae, 0xb8, 0xd9, 0x1c, 0xa1, 0x09, 0x62, 0x58, 0xc1, 0xf8, 0x09, 0x75, 0xda,
-	0x76, 0x02, 0x2e, 0x43, 0x2e, 0xbd, 0x1e, 0x92, 0xd8, 0x9b, 0xec, 0xf7, 0xb0, 0x42, 0xfb, 0x5e,
-	0xc0, 0x29, 0x4b, 0xab, 0xec, 0x26, 0xe1, 0x84, 0x27, 0xaf, 0x5e, 0xfc, 0xa6, 0x4f, 0x6f, 0xe3,
-	0x88, 0x07, 0x03, 0xa9, 0xb8, 0x40, 0x04, 0x7b, 0x98, 0xf5, 0x23, 0x4e, 0x99, 0xd2, 0xc9, 0x1b,
-	0x19, 0xb5, 0xc0, 0x23, 0x34, 0x4d, 0x0f, 0xdd, 0x4f, 0x6b, 0x60, 0xbb, 0x2b, 0xc9, 0xb1, 0x42,
-	0x43, 0x7c, 0x24, 0xf8, 0x84, 0xf6, 0xb1, 0x30, 0x2d, 0x70, 0x25, 0x10, 0x18, 0x29, 0x2e, 0x2c,
-	0xa3, 0x65, 0xb4, 0xeb, 0x7e, 0x16, 0x26, 0x99, 0x01, 0xa2, 0xec, 0xed, 0x0b, 0x6b, 0x4d, 0x67,
-	0xd2, 0xd0, 0x7c, 0x0a, 0x6a, 0x28, 0xe4, 0x63, 0xa6, 0xac, 0xf5, 0x96, 0xd1, 0x6e, 0x74, 0x76,
-	0x60, 0xaa, 0x00, 0xc6, 0x0a, 0xa0, 0x56, 0x00, 0x0f, 0x39, 0x65, 0x07, 0xd5, 0xd3, 0x1f, 0x77,
-	0x2b, 0xbe, 0x86, 0x9b, 0xaf, 0x41, 0x3d, 0x1b, 0x54, 0x5a, 0xd5, 0xd6, 0x7a, 0xbb, 0xd1, 0xb9,
-	0x07, 0x0b, 0x3b, 0x59, 0x14, 0x05, 0x5f, 0x6a, 0xac, 0xee, 0xf2, 0xbb, 0xd6, 0x6c, 0x81, 0x06,
-	0xc1, 0x7c, 0xc4, 0x03, 0xa4, 0x28, 0x67, 0xd6, 0x46, 0xcb, 0x68, 0x57, 0xfd, 0xc5, 0xa3, 0x78,
-	0xfa, 0x90, 0x33, 0x3a, 0xc4, 0xc2, 0xaa, 0xa5, 0xd3, 0xeb, 0xd0, 0xb5, 0x81, 0x55, 0xde, 0x82,
-	0x8f, 0x65, 0xc4, 0x99, 0xc4, 0xee, 0x37, 0x03, 0x5c, 0xcb, 0x92, 0x87, 0x23, 0x8a, 0x99, 0xfa,
-	0xbf, 0x0b, 0x2a, 0xe9, 0xaa, 0x2e, 0xeb, 0x6a, 0x82, 0x8d, 0x89, 0xf8, 0x10, 0x0d, 0x13, 0xcd,
-	0x75, 0x3f, 0x0d, 0x5c, 0x0b, 0xdc, 0x2c, 0x8e, 0x9d, 0x2b, 0x7a, 0x03, 0xcc, 0xae, 0x24, 0xef,
-	0x98, 0xfc, 0xd7, 0x5b, 0x77, 0xef, 0x00, 0x7b, 0xb9, 0x53, 0xce, 0xf3, 0x2a, 0xf1, 0x96, 0xce,
-	0xfe, 0xfd, 0xea, 0xf4, 0xed, 0x14, 0xfa, 0xe4, 0x1c, 0x5f, 0x0d, 0xb0, 0xd5, 0x95, 0xc4, 0x8f,
-	0x3d, 0x7d, 0x84, 0xa6, 0xe1, 0xe5, 0x1c, 0xcf, 0x40, 0x2d, 0x71, 0xbf, 0xb4, 0xd6, 0x12, 0xa7,
-	0xb9, 0xf0, 0xbc, 0xaf, 0x0f, 0x26, 0xdd, 0x7c, 0xfc, 0x71, 0x8c, 0xa5, 0xf2, 0x75, 0x85, 0xb9,
-	0x07, 0xae, 0xf7, 0xb1, 0x0c, 0x04, 0x8d, 0xe2, 0xa5, 0x1f, 0xab, 0x18, 0x99, 0xdc, 0x65, 0xdd,
-	0x5f, 0x4e, 0xb8, 0x3b, 0xe0, 0x56, 0x69, 0xac, 0x6c, 0xe4, 0xce, 0xe7, 0x2a, 0x58, 0xef, 0x4a,
-	0x62, 0x12, 0xb0, 0x59, 0xfc, 0xee, 0x76, 0xcf, 0x9f, 0xa6, 0xec, 0x4c, 0x1b, 0xae, 0x86, 0xcb,
-	0x08, 0x4d, 0x04, 0x1a, 0x8b, 0xee, 0xbd, 0x7f, 0x79, 0x79, 0x8a, 0xb2, 0xf7, 0x56, 0x41, 0xe5,
-	0x14, 0x21, 0xd8, 0x2a, 0xfb, 0xa9, 0x7d, 0x61, 0x83, 0x12, 0xd2, 0x7e, 0xbc, 0x2a, 0x32, 0xa7,
-	0x23, 0x60, 0xb3, 0x68, 0xab, 0xdd, 0x3f, 0xb5, 0xd0, 0xaa, 0xe0, 0x6a, 0xb8, 0x9c, 0xa8, 0x0f,
-	0xae, 0x16, 0xac, 0xf5, 0xe0, 0xc2, 0xfa, 0x45, 0x98, 0xfd, 0x68, 0x25, 0x58, 0xc6, 0x72, 0xf0,
-	0xfc, 0x74, 0xe6, 0x18, 0x67, 0x33, 0xc7, 0xf8, 0x39, 0x73, 0x8c, 0x2f, 0x73, 0xa7, 0x72, 0x36,
-	0x77, 0x2a, 0xdf, 0xe7, 0x4e, 0xe5, 0xfd, 0x43, 0x42, 0xd5, 0x60, 0xdc, 0x83, 0x01, 0x0f, 0x3d,
-	0xdd, 0x32, 0x79, 0x7a, 0x27, 0x5e, 0xfe, 0x4f, 0x32, 0x8d, 0xb0, 0xec, 0xd5, 0x92, 0xdf, 0xf3,
-	0x27, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x92, 0x41, 0xe8, 0x61, 0x06, 0x00, 0x00,
+func (m *MsgBuySubscription) Reset()         { *m = MsgBuySubscription{} }
+func (m *MsgBuySubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgBuySubscription) ProtoMessage()    {}
+func (*MsgBuySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error)
-	StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error)
-	UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error)
-	UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error)
-	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
+func (m *MsgBuySubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgBuySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuySubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgBuySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuySubscription.Merge(m, src)
 }
-
-func (c *msgClient) StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error) {
-	out := new(MsgStakeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgBuySubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuySubscription.DiscardUnknown(m)
 }
 
-func (c *msgClient) StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error) {
-	out := new(MsgStakeClientResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBuySubscription proto.InternalMessageInfo
+
+func (m *MsgBuySubscription) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error) {
-	out := new(MsgUnstakeProviderResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBuySubscription) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error) {
-	out := new(MsgUnstakeClientResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBuySubscription) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error) {
-	out := new(MsgRelayPaymentResponse)
-	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/RelayPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBuySubscription) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
-	StakeClient(context.Context, *MsgStakeClient) (*MsgStakeClientResponse, error)
-	UnstakeProvider(context.Context, *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error)
-	UnstakeClient(context.Context, *MsgUnstakeClient) (*MsgUnstakeClientResponse, error)
-	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
+type MsgBuySubscriptionResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBuySubscriptionResponse) Reset()         { *m = MsgBuySubscriptionResponse{} }
+func (m *MsgBuySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuySubscriptionResponse) ProtoMessage()    {}
+func (*MsgBuySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{11}
 }
-
-func (*UnimplementedMsgServer) StakeProvider(ctx context.Context, req *MsgStakeProvider) (*MsgStakeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeProvider not implemented")
+func (m *MsgBuySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) StakeClient(ctx context.Context, req *MsgStakeClient) (*MsgStakeClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeClient not implemented")
+func (m *MsgBuySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuySubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UnstakeProvider(ctx context.Context, req *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnstakeProvider not implemented")
+func (m *MsgBuySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuySubscriptionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UnstakeClient(ctx context.Context, req *MsgUnstakeClient) (*MsgUnstakeClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnstakeClient not implemented")
+func (m *MsgBuySubscriptionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RelayPayment(ctx context.Context, req *MsgRelayPayment) (*MsgRelayPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RelayPayment not implemented")
+func (m *MsgBuySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuySubscriptionResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgBuySubscriptionResponse proto.InternalMessageInfo
+
+type MsgModifyProvider struct {
+	Creator     string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID     string            `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount      types.Coin        `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Endpoints   []types1.Endpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
+	Geolocation uint64            `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Moniker     string            `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Operator    string            `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
-func _Msg_StakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStakeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StakeProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/StakeProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StakeProvider(ctx, req.(*MsgStakeProvider))
+func (m *MsgModifyProvider) Reset()         { *m = MsgModifyProvider{} }
+func (m *MsgModifyProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyProvider) ProtoMessage()    {}
+func (*MsgModifyProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{12}
+}
+func (m *MsgModifyProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgModifyProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyProvider.Merge(m, src)
+}
+func (m *MsgModifyProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyProvider.DiscardUnknown(m)
 }
 
-func _Msg_StakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStakeClient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StakeClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/StakeClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StakeClient(ctx, req.(*MsgStakeClient))
+var xxx_messageInfo_MsgModifyProvider proto.InternalMessageInfo
+
+func (m *MsgModifyProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnstakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstakeProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnstakeProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnstakeProvider(ctx, req.(*MsgUnstakeProvider))
+func (m *MsgModifyProvider) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnstakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstakeClient)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnstakeClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnstakeClient(ctx, req.(*MsgUnstakeClient))
+func (m *MsgModifyProvider) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_RelayPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRelayPayment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RelayPayment(ctx, in)
+func (m *MsgModifyProvider) GetEndpoints() []types1.Endpoint {
+	if m != nil {
+		return m.Endpoints
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lavanet.lava.pairing.Msg/RelayPayment",
+	return nil
+}
+
+func (m *MsgModifyProvider) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RelayPayment(ctx, req.(*MsgRelayPayment))
+	return 0
+}
+
+func (m *MsgModifyProvider) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StakeProvider",
-			Handler:    _Msg_StakeProvider_Handler,
-		},
-		{
-			MethodName: "StakeClient",
-			Handler:    _Msg_StakeClient_Handler,
-		},
-		{
-			MethodName: "UnstakeProvider",
-			Handler:    _Msg_UnstakeProvider_Handler,
-		},
-		{
-			MethodName: "UnstakeClient",
-			Handler:    _Msg_UnstakeClient_Handler,
-		},
-		{
-			MethodName: "RelayPayment",
-			Handler:    _Msg_RelayPayment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pairing/tx.proto",
-}
-
-func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModifyProvider) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStakeProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgModifyProviderResponse struct {
 }
 
-func (m *MsgStakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Geolocation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Endpoints) > 0 {
-		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgModifyProviderResponse) Reset()         { *m = MsgModifyProviderResponse{} }
+func (m *MsgModifyProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyProviderResponse) ProtoMessage()    {}
+func (*MsgModifyProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{13}
+}
+func (m *MsgModifyProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgModifyProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyProviderResponse.Merge(m, src)
+}
+func (m *MsgModifyProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyProviderResponse proto.InternalMessageInfo
+
+type MsgModifyClient struct {
+	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID     string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount      types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Geolocation uint64     `protobuf:"varint,4,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Vrfpk       string     `protobuf:"bytes,5,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+}
+
+func (m *MsgModifyClient) Reset()         { *m = MsgModifyClient{} }
+func (m *MsgModifyClient) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyClient) ProtoMessage()    {}
+func (*MsgModifyClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{14}
+}
+func (m *MsgModifyClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgModifyClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyClient.Merge(m, src)
+}
+func (m *MsgModifyClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyClient proto.InternalMessageInfo
+
+func (m *MsgModifyClient) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgStakeProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModifyClient) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgModifyClient) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgStakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgModifyClient) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
 }
 
-func (m *MsgStakeClient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModifyClient) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStakeClient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgModifyClientResponse struct {
 }
 
-func (m *MsgStakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vrfpk) > 0 {
-		i -= len(m.Vrfpk)
-		copy(dAtA[i:], m.Vrfpk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Geolocation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgModifyClientResponse) Reset()         { *m = MsgModifyClientResponse{} }
+func (m *MsgModifyClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyClientResponse) ProtoMessage()    {}
+func (*MsgModifyClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{15}
+}
+func (m *MsgModifyClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgStakeClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgModifyClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyClientResponse.Merge(m, src)
 }
-
-func (m *MsgStakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgModifyClientResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgStakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgModifyClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyClientResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnstakeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgModifyClientResponse proto.InternalMessageInfo
 
-func (m *MsgUnstakeProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelUnstake struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID  string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider bool   `protobuf:"varint,3,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (m *MsgUnstakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgCancelUnstake) Reset()         { *m = MsgCancelUnstake{} }
+func (m *MsgCancelUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnstake) ProtoMessage()    {}
+func (*MsgCancelUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{16}
+}
+func (m *MsgCancelUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnstake.Merge(m, src)
+}
+func (m *MsgCancelUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnstake proto.InternalMessageInfo
+
+func (m *MsgCancelUnstake) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUnstakeProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnstake) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnstakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelUnstake) GetProvider() bool {
+	if m != nil {
+		return m.Provider
+	}
+	return false
 }
 
-func (m *MsgUnstakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCancelUnstakeResponse struct {
 }
 
-func (m *MsgUnstakeClient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnstakeResponse) Reset()         { *m = MsgCancelUnstakeResponse{} }
+func (m *MsgCancelUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnstakeResponse) ProtoMessage()    {}
+func (*MsgCancelUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{17}
+}
+func (m *MsgCancelUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnstakeResponse.Merge(m, src)
+}
+func (m *MsgCancelUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnstakeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnstakeClient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelUnstakeResponse proto.InternalMessageInfo
+
+// MsgUpdateClientVrfpk rotates the vrf public key of all the client stake entries and the subscription of the creator
+type MsgUpdateClientVrfpk struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Vrfpk   string `protobuf:"bytes,2,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
 }
 
-func (m *MsgUnstakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUpdateClientVrfpk) Reset()         { *m = MsgUpdateClientVrfpk{} }
+func (m *MsgUpdateClientVrfpk) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClientVrfpk) ProtoMessage()    {}
+func (*MsgUpdateClientVrfpk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{18}
+}
+func (m *MsgUpdateClientVrfpk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClientVrfpk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClientVrfpk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateClientVrfpk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClientVrfpk.Merge(m, src)
+}
+func (m *MsgUpdateClientVrfpk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClientVrfpk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClientVrfpk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateClientVrfpk proto.InternalMessageInfo
+
+func (m *MsgUpdateClientVrfpk) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUnstakeClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateClientVrfpk) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnstakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateClientVrfpkResponse struct {
+	EffectiveEpoch uint64 `protobuf:"varint,1,opt,name=effectiveEpoch,proto3" json:"effectiveEpoch,omitempty"`
 }
 
-func (m *MsgUnstakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateClientVrfpkResponse) Reset()         { *m = MsgUpdateClientVrfpkResponse{} }
+func (m *MsgUpdateClientVrfpkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClientVrfpkResponse) ProtoMessage()    {}
+func (*MsgUpdateClientVrfpkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{19}
+}
+func (m *MsgUpdateClientVrfpkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClientVrfpkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClientVrfpkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClientVrfpkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClientVrfpkResponse.Merge(m, src)
+}
+func (m *MsgUpdateClientVrfpkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClientVrfpkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClientVrfpkResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRelayPayment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateClientVrfpkResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateClientVrfpkResponse) GetEffectiveEpoch() uint64 {
+	if m != nil {
+		return m.EffectiveEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRelayPayment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetOperatorProviders replaces the list of providers the creator approves as its operator
+type MsgSetOperatorProviders struct {
+	Creator   string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Providers []string `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func (m *MsgRelayPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DescriptionString) > 0 {
-		i -= len(m.DescriptionString)
-		copy(dAtA[i:], m.DescriptionString)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionString)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Relays) > 0 {
-		for iNdEx := len(m.Relays) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetOperatorProviders) Reset()         { *m = MsgSetOperatorProviders{} }
+func (m *MsgSetOperatorProviders) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorProviders) ProtoMessage()    {}
+func (*MsgSetOperatorProviders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{20}
+}
+func (m *MsgSetOperatorProviders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorProviders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorProviders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetOperatorProviders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorProviders.Merge(m, src)
+}
+func (m *MsgSetOperatorProviders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorProviders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorProviders.DiscardUnknown(m)
 }
 
-func (m *MsgRelayPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetOperatorProviders proto.InternalMessageInfo
+
+func (m *MsgSetOperatorProviders) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRelayPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetOperatorProviders) GetProviders() []string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-func (m *MsgRelayPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetOperatorProvidersResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetOperatorProvidersResponse) Reset()         { *m = MsgSetOperatorProvidersResponse{} }
+func (m *MsgSetOperatorProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorProvidersResponse) ProtoMessage()    {}
+func (*MsgSetOperatorProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{21}
 }
-func (m *MsgStakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Endpoints) > 0 {
-		for _, e := range m.Endpoints {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetOperatorProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgSetOperatorProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorProvidersResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetOperatorProvidersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
+	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
+	proto.RegisterType((*MsgStakeClient)(nil), "lavanet.lava.pairing.MsgStakeClient")
+	proto.RegisterType((*MsgStakeClientResponse)(nil), "lavanet.lava.pairing.MsgStakeClientResponse")
+	proto.RegisterType((*MsgUnstakeProvider)(nil), "lavanet.lava.pairing.MsgUnstakeProvider")
+	proto.RegisterType((*MsgUnstakeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnstakeProviderResponse")
+	proto.RegisterType((*MsgUnstakeClient)(nil), "lavanet.lava.pairing.MsgUnstakeClient")
+	proto.RegisterType((*MsgUnstakeClientResponse)(nil), "lavanet.lava.pairing.MsgUnstakeClientResponse")
+	proto.RegisterType((*MsgRelayPayment)(nil), "lavanet.lava.pairing.MsgRelayPayment")
+	proto.RegisterType((*MsgRelayPaymentResponse)(nil), "lavanet.lava.pairing.MsgRelayPaymentResponse")
+	proto.RegisterType((*MsgBuySubscription)(nil), "lavanet.lava.pairing.MsgBuySubscription")
+	proto.RegisterType((*MsgBuySubscriptionResponse)(nil), "lavanet.lava.pairing.MsgBuySubscriptionResponse")
+	proto.RegisterType((*MsgModifyProvider)(nil), "lavanet.lava.pairing.MsgModifyProvider")
+	proto.RegisterType((*MsgModifyProviderResponse)(nil), "lavanet.lava.pairing.MsgModifyProviderResponse")
+	proto.RegisterType((*MsgModifyClient)(nil), "lavanet.lava.pairing.MsgModifyClient")
+	proto.RegisterType((*MsgModifyClientResponse)(nil), "lavanet.lava.pairing.MsgModifyClientResponse")
+	proto.RegisterType((*MsgCancelUnstake)(nil), "lavanet.lava.pairing.MsgCancelUnstake")
+	proto.RegisterType((*MsgCancelUnstakeResponse)(nil), "lavanet.lava.pairing.MsgCancelUnstakeResponse")
+	proto.RegisterType((*MsgUpdateClientVrfpk)(nil), "lavanet.lava.pairing.MsgUpdateClientVrfpk")
+	proto.RegisterType((*MsgUpdateClientVrfpkResponse)(nil), "lavanet.lava.pairing.MsgUpdateClientVrfpkResponse")
+	proto.RegisterType((*MsgSetOperatorProviders)(nil), "lavanet.lava.pairing.MsgSetOperatorProviders")
+	proto.RegisterType((*MsgSetOperatorProvidersResponse)(nil), "lavanet.lava.pairing.MsgSetOperatorProvidersResponse")
+}
+
+func init() { proto.RegisterFile("pairing/tx.proto", fileDescriptor_b2db224a5e52fa36) }
+
+var fileDescriptor_b2db224a5e52fa36 = []byte{
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x34, 0xdb, 0xbc, 0xb0, 0xdd, 0xd6, 0x44, 0xe0, 0x7a, 0xab, 0x6c, 0x30, 0x6c,
+	0x37, 0x42, 0xbb, 0xf6, 0x6e, 0x10, 0x02, 0x71, 0xa3, 0x65, 0x17, 0x38, 0x44, 0x14, 0x47, 0x70,
+	0xe0, 0x36, 0x71, 0x26, 0xae, 0x69, 0xe2, 0x31, 0x9e, 0x49, 0xd4, 0x48, 0xfb, 0x23, 0xf8, 0x09,
+	0x70, 0xe7, 0x0a, 0xbf, 0x61, 0x6f, 0xf4, 0xc8, 0x09, 0xa1, 0xf6, 0x8f, 0x20, 0x8f, 0xc7, 0x53,
+	0xdb, 0x89, 0x53, 0x53, 0x24, 0x24, 0xa4, 0x3d, 0x25, 0xe3, 0xf7, 0xcd, 0xfb, 0xde, 0xf7, 0xcd,
+	0xf3, 0x9b, 0x04, 0x76, 0x03, 0xe4, 0x85, 0x9e, 0xef, 0x5a, 0xec, 0xdc, 0x0c, 0x42, 0xc2, 0x88,
+	0xda, 0x9e, 0xa2, 0x05, 0xf2, 0x31, 0x33, 0xa3, 0x4f, 0x53, 0x84, 0xf5, 0x8e, 0x43, 0xe8, 0x8c,
+	0x50, 0x6b, 0x84, 0x28, 0xb6, 0x16, 0xcf, 0x46, 0x98, 0xa1, 0x67, 0x96, 0x43, 0x3c, 0x3f, 0xde,
+	0xa5, 0xb7, 0x5d, 0xe2, 0x12, 0xfe, 0xd5, 0x8a, 0xbe, 0x89, 0xa7, 0xf7, 0x71, 0x40, 0x9c, 0x53,
+	0xca, 0x48, 0x88, 0x5c, 0x6c, 0x61, 0x7f, 0x1c, 0x10, 0xcf, 0x67, 0x22, 0xf8, 0x66, 0x42, 0x1d,
+	0xe2, 0x29, 0x5a, 0xc6, 0x0f, 0x8d, 0x9f, 0xaa, 0xb0, 0x3b, 0xa0, 0xee, 0x90, 0xa1, 0x33, 0x7c,
+	0x12, 0x92, 0x85, 0x37, 0xc6, 0xa1, 0xaa, 0xc1, 0x1d, 0x27, 0xc4, 0x88, 0x91, 0x50, 0x53, 0xba,
+	0x4a, 0xaf, 0x69, 0x27, 0x4b, 0x1e, 0x39, 0x45, 0x9e, 0xff, 0xe5, 0x67, 0x5a, 0x55, 0x44, 0xe2,
+	0xa5, 0xfa, 0x11, 0x34, 0xd0, 0x8c, 0xcc, 0x7d, 0xa6, 0xd5, 0xba, 0x4a, 0xaf, 0xd5, 0xdf, 0x37,
+	0x63, 0x05, 0x66, 0xa4, 0xc0, 0x14, 0x0a, 0xcc, 0x63, 0xe2, 0xf9, 0x47, 0xf5, 0x57, 0x7f, 0x3e,
+	0xa8, 0xd8, 0x02, 0xae, 0x7e, 0x0e, 0xcd, 0xa4, 0x50, 0xaa, 0xd5, 0xbb, 0xb5, 0x5e, 0xab, 0xff,
+	0xae, 0x99, 0xf1, 0x24, 0x2d, 0xca, 0x7c, 0x2e, 0xb0, 0x22, 0xcb, 0xf5, 0x5e, 0xb5, 0x0b, 0x2d,
+	0x17, 0x93, 0x29, 0x71, 0x10, 0xf3, 0x88, 0xaf, 0x6d, 0x75, 0x95, 0x5e, 0xdd, 0x4e, 0x3f, 0x8a,
+	0xaa, 0x9f, 0x11, 0xdf, 0x3b, 0xc3, 0xa1, 0xd6, 0x88, 0xab, 0x17, 0x4b, 0x55, 0x87, 0x6d, 0x12,
+	0xe0, 0x90, 0x4b, 0xbe, 0xc3, 0x43, 0x72, 0x6d, 0xe8, 0xa0, 0xe5, 0x1d, 0xb2, 0x31, 0x0d, 0x88,
+	0x4f, 0xb1, 0xf1, 0xab, 0x02, 0x3b, 0x49, 0xf0, 0x78, 0xea, 0x61, 0x9f, 0xfd, 0xb7, 0xe6, 0xe5,
+	0x34, 0xd7, 0x57, 0x35, 0xb7, 0x61, 0x6b, 0x11, 0x4e, 0x82, 0x33, 0xee, 0x47, 0xd3, 0x8e, 0x17,
+	0x86, 0x06, 0x6f, 0x65, 0xcb, 0x96, 0x8a, 0xbe, 0x00, 0x75, 0x40, 0xdd, 0x6f, 0x7c, 0xfa, 0x6f,
+	0x3b, 0xc2, 0x38, 0x00, 0x7d, 0x35, 0x93, 0xe4, 0x79, 0x01, 0xbb, 0xd7, 0xd1, 0xdb, 0x5b, 0x27,
+	0x4e, 0x27, 0x93, 0x47, 0x72, 0xfc, 0xae, 0xc0, 0xbd, 0x01, 0x75, 0xed, 0xa8, 0xdf, 0x4f, 0xd0,
+	0x72, 0xb6, 0x99, 0xe3, 0x13, 0x68, 0xf0, 0x37, 0x83, 0x6a, 0x55, 0xde, 0x85, 0x86, 0xb9, 0xee,
+	0xcd, 0x34, 0x79, 0x36, 0x1b, 0xff, 0x30, 0xc7, 0x94, 0xd9, 0x62, 0x87, 0xfa, 0x18, 0xf6, 0xc6,
+	0x98, 0x3a, 0xa1, 0x17, 0x44, 0xa6, 0x0f, 0x59, 0x84, 0xe4, 0x67, 0xd9, 0xb4, 0x57, 0x03, 0xea,
+	0xc7, 0xd0, 0x08, 0x42, 0x42, 0x26, 0x49, 0xbf, 0x77, 0x37, 0x30, 0x9d, 0x44, 0x40, 0x5b, 0xe0,
+	0x8d, 0x7d, 0x78, 0x3b, 0x27, 0x48, 0x8a, 0x7d, 0xc9, 0x0f, 0xee, 0x68, 0xbe, 0x1c, 0xce, 0x47,
+	0x92, 0x70, 0x83, 0xdc, 0x36, 0x6c, 0x79, 0xfe, 0x18, 0x9f, 0x0b, 0x43, 0xe3, 0x45, 0xbe, 0xa1,
+	0x6a, 0x1b, 0x1a, 0xaa, 0x9e, 0x6e, 0xa8, 0xf8, 0xb0, 0x73, 0xec, 0xb2, 0xb6, 0x9f, 0xab, 0xb0,
+	0x37, 0xa0, 0xee, 0x80, 0x8c, 0xbd, 0xc9, 0xf2, 0xf5, 0x98, 0x59, 0x3b, 0x66, 0xee, 0xc3, 0xfe,
+	0x8a, 0x45, 0xd2, 0xc0, 0xdf, 0xe2, 0x4e, 0x8e, 0xa3, 0xff, 0xa7, 0x41, 0x13, 0x37, 0x6c, 0xba,
+	0x6e, 0xa9, 0x69, 0xc4, 0x27, 0xc0, 0x31, 0xf2, 0x1d, 0x3c, 0x15, 0xef, 0xef, 0xad, 0x34, 0xe9,
+	0xb0, 0x1d, 0x08, 0xbf, 0xb8, 0xaa, 0x6d, 0x5b, 0xae, 0xc5, 0x74, 0xc8, 0x70, 0xa4, 0x26, 0x50,
+	0x3b, 0x9a, 0x1c, 0xc1, 0x18, 0x31, 0x31, 0x38, 0xbe, 0x8d, 0x4a, 0xde, 0xfc, 0xca, 0xc4, 0x12,
+	0xab, 0x69, 0x89, 0x2f, 0xe0, 0x60, 0x5d, 0x9e, 0x84, 0x47, 0x3d, 0x84, 0x1d, 0x3c, 0x99, 0x60,
+	0x87, 0x79, 0x0b, 0xfc, 0x3c, 0xea, 0x31, 0x9e, 0xb6, 0x6e, 0xe7, 0x9e, 0x1a, 0x5f, 0x73, 0xab,
+	0x86, 0x98, 0x7d, 0x25, 0x5a, 0x22, 0xe9, 0x02, 0xba, 0xa1, 0xa4, 0x03, 0x68, 0x26, 0x62, 0xe3,
+	0xb9, 0xd5, 0xb4, 0xaf, 0x1f, 0x18, 0xef, 0xc0, 0x83, 0x82, 0x94, 0x49, 0x75, 0xfd, 0x5f, 0x9a,
+	0x50, 0x1b, 0x50, 0x57, 0x75, 0xe1, 0x6e, 0xf6, 0x47, 0xc0, 0xe1, 0xfa, 0xa1, 0x94, 0xbf, 0x0a,
+	0x75, 0xb3, 0x1c, 0x4e, 0xda, 0x81, 0xa0, 0x95, 0xbe, 0x2e, 0xdf, 0xdb, 0xbc, 0x3d, 0x46, 0xe9,
+	0x8f, 0xcb, 0xa0, 0x24, 0xc5, 0x0c, 0xee, 0xe5, 0x2f, 0xb0, 0x5e, 0x61, 0x82, 0x1c, 0x52, 0x7f,
+	0x5a, 0x16, 0x29, 0xe9, 0x5c, 0xb8, 0x9b, 0xbd, 0xc7, 0x0e, 0x6f, 0x4a, 0x21, 0x54, 0x99, 0xe5,
+	0x70, 0x92, 0x68, 0x0c, 0x6f, 0x64, 0xee, 0xb2, 0x87, 0x85, 0xfb, 0xd3, 0x30, 0xfd, 0x49, 0x29,
+	0x58, 0xda, 0xbd, 0xfc, 0x2d, 0x52, 0xec, 0x5e, 0x0e, 0xa9, 0x3f, 0x2d, 0x8b, 0x94, 0x74, 0xdf,
+	0xc3, 0x4e, 0xee, 0x5e, 0x78, 0x54, 0x98, 0x23, 0x0b, 0xd4, 0xad, 0x92, 0xc0, 0xb4, 0x81, 0x99,
+	0x11, 0xfa, 0xf0, 0x86, 0x04, 0xe2, 0x9c, 0x9e, 0x94, 0x82, 0xa5, 0xfb, 0x21, 0x3b, 0xd5, 0x8a,
+	0xfb, 0x21, 0x83, 0xd3, 0xcd, 0x72, 0x38, 0x49, 0x44, 0x61, 0x6f, 0x75, 0x7c, 0xbd, 0x5f, 0xdc,
+	0x54, 0x79, 0xac, 0xde, 0x2f, 0x8f, 0x95, 0xa4, 0x2f, 0xa1, 0xbd, 0x76, 0x46, 0x15, 0x9b, 0xb4,
+	0x0e, 0xae, 0x7f, 0xf8, 0x8f, 0xe0, 0x09, 0xfb, 0xd1, 0xa7, 0xaf, 0x2e, 0x3b, 0xca, 0xc5, 0x65,
+	0x47, 0xf9, 0xeb, 0xb2, 0xa3, 0xfc, 0x78, 0xd5, 0xa9, 0x5c, 0x5c, 0x75, 0x2a, 0x7f, 0x5c, 0x75,
+	0x2a, 0xdf, 0x3d, 0x72, 0x3d, 0x76, 0x3a, 0x1f, 0x99, 0x0e, 0x99, 0x59, 0x22, 0x35, 0xff, 0xb4,
+	0xce, 0x2d, 0xf9, 0x9f, 0x6b, 0x19, 0x60, 0x3a, 0x6a, 0xf0, 0x7f, 0x3e, 0x1f, 0xfc, 0x3d, 0x00,
+	0x1c, 0x69, 0xed, 0x11, 0x8b, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error)
+	StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error)
+	UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error)
+	UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error)
+	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
+	BuySubscription(ctx context.Context, in *MsgBuySubscription, opts ...grpc.CallOption) (*MsgBuySubscriptionResponse, error)
+	ModifyProvider(ctx context.Context, in *MsgModifyProvider, opts ...grpc.CallOption) (*MsgModifyProviderResponse, error)
+	ModifyClient(ctx context.Context, in *MsgModifyClient, opts ...grpc.CallOption) (*MsgModifyClientResponse, error)
+	CancelUnstake(ctx context.Context, in *MsgCancelUnstake, opts ...grpc.CallOption) (*MsgCancelUnstakeResponse, error)
+	UpdateClientVrfpk(ctx context.Context, in *MsgUpdateClientVrfpk, opts ...grpc.CallOption) (*MsgUpdateClientVrfpkResponse, error)
+	SetOperatorProviders(ctx context.Context, in *MsgSetOperatorProviders, opts ...grpc.CallOption) (*MsgSetOperatorProvidersResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StakeProvider(ctx context.Context, in *MsgStakeProvider, opts ...grpc.CallOption) (*MsgStakeProviderResponse, error) {
+	out := new(MsgStakeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgStakeClient) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) StakeClient(ctx context.Context, in *MsgStakeClient, opts ...grpc.CallOption) (*MsgStakeClientResponse, error) {
+	out := new(MsgStakeClientResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/StakeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error) {
+	out := new(MsgUnstakeProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error) {
+	out := new(MsgUnstakeClientResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UnstakeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
+	return out, nil
+}
+
+func (c *msgClient) RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error) {
+	out := new(MsgRelayPaymentResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/RelayPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Vrfpk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BuySubscription(ctx context.Context, in *MsgBuySubscription, opts ...grpc.CallOption) (*MsgBuySubscriptionResponse, error) {
+	out := new(MsgBuySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/BuySubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ModifyProvider(ctx context.Context, in *MsgModifyProvider, opts ...grpc.CallOption) (*MsgModifyProviderResponse, error) {
+	out := new(MsgModifyProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/ModifyProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUnstakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ModifyClient(ctx context.Context, in *MsgModifyClient, opts ...grpc.CallOption) (*MsgModifyClientResponse, error) {
+	out := new(MsgModifyClientResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/ModifyClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelUnstake(ctx context.Context, in *MsgCancelUnstake, opts ...grpc.CallOption) (*MsgCancelUnstakeResponse, error) {
+	out := new(MsgCancelUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/CancelUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateClientVrfpk(ctx context.Context, in *MsgUpdateClientVrfpk, opts ...grpc.CallOption) (*MsgUpdateClientVrfpkResponse, error) {
+	out := new(MsgUpdateClientVrfpkResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/UpdateClientVrfpk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUnstakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetOperatorProviders(ctx context.Context, in *MsgSetOperatorProviders, opts ...grpc.CallOption) (*MsgSetOperatorProvidersResponse, error) {
+	out := new(MsgSetOperatorProvidersResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/SetOperatorProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUnstakeClient) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
+	StakeClient(context.Context, *MsgStakeClient) (*MsgStakeClientResponse, error)
+	UnstakeProvider(context.Context, *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error)
+	UnstakeClient(context.Context, *MsgUnstakeClient) (*MsgUnstakeClientResponse, error)
+	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
+	BuySubscription(context.Context, *MsgBuySubscription) (*MsgBuySubscriptionResponse, error)
+	ModifyProvider(context.Context, *MsgModifyProvider) (*MsgModifyProviderResponse, error)
+	ModifyClient(context.Context, *MsgModifyClient) (*MsgModifyClientResponse, error)
+	CancelUnstake(context.Context, *MsgCancelUnstake) (*MsgCancelUnstakeResponse, error)
+	UpdateClientVrfpk(context.Context, *MsgUpdateClientVrfpk) (*MsgUpdateClientVrfpkResponse, error)
+	SetOperatorProviders(context.Context, *MsgSetOperatorProviders) (*MsgSetOperatorProvidersResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) StakeProvider(ctx context.Context, req *MsgStakeProvider) (*MsgStakeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeProvider not implemented")
+}
+func (*UnimplementedMsgServer) StakeClient(ctx context.Context, req *MsgStakeClient) (*MsgStakeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeClient not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeProvider(ctx context.Context, req *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeProvider not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeClient(ctx context.Context, req *MsgUnstakeClient) (*MsgUnstakeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeClient not implemented")
+}
+func (*UnimplementedMsgServer) RelayPayment(ctx context.Context, req *MsgRelayPayment) (*MsgRelayPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayPayment not implemented")
+}
+func (*UnimplementedMsgServer) BuySubscription(ctx context.Context, req *MsgBuySubscription) (*MsgBuySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuySubscription not implemented")
+}
+func (*UnimplementedMsgServer) ModifyProvider(ctx context.Context, req *MsgModifyProvider) (*MsgModifyProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyProvider not implemented")
+}
+func (*UnimplementedMsgServer) ModifyClient(ctx context.Context, req *MsgModifyClient) (*MsgModifyClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyClient not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnstake(ctx context.Context, req *MsgCancelUnstake) (*MsgCancelUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnstake not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClientVrfpk(ctx context.Context, req *MsgUpdateClientVrfpk) (*MsgUpdateClientVrfpkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientVrfpk not implemented")
+}
+func (*UnimplementedMsgServer) SetOperatorProviders(ctx context.Context, req *MsgSetOperatorProviders) (*MsgSetOperatorProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorProviders not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).StakeProvider(ctx, in)
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/StakeProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeProvider(ctx, req.(*MsgStakeProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/StakeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeClient(ctx, req.(*MsgStakeClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnstakeProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeProvider(ctx, req.(*MsgUnstakeProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnstakeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UnstakeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeClient(ctx, req.(*MsgUnstakeClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RelayPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRelayPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RelayPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/RelayPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RelayPayment(ctx, req.(*MsgRelayPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/BuySubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuySubscription(ctx, req.(*MsgBuySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/ModifyProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyProvider(ctx, req.(*MsgModifyProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/ModifyClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyClient(ctx, req.(*MsgModifyClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/CancelUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnstake(ctx, req.(*MsgCancelUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClientVrfpk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClientVrfpk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClientVrfpk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/UpdateClientVrfpk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClientVrfpk(ctx, req.(*MsgUpdateClientVrfpk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOperatorProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorProviders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/SetOperatorProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorProviders(ctx, req.(*MsgSetOperatorProviders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakeProvider",
+			Handler:    _Msg_StakeProvider_Handler,
+		},
+		{
+			MethodName: "StakeClient",
+			Handler:    _Msg_StakeClient_Handler,
+		},
+		{
+			MethodName: "UnstakeProvider",
+			Handler:    _Msg_UnstakeProvider_Handler,
+		},
+		{
+			MethodName: "UnstakeClient",
+			Handler:    _Msg_UnstakeClient_Handler,
+		},
+		{
+			MethodName: "RelayPayment",
+			Handler:    _Msg_RelayPayment_Handler,
+		},
+		{
+			MethodName: "BuySubscription",
+			Handler:    _Msg_BuySubscription_Handler,
+		},
+		{
+			MethodName: "ModifyProvider",
+			Handler:    _Msg_ModifyProvider_Handler,
+		},
+		{
+			MethodName: "ModifyClient",
+			Handler:    _Msg_ModifyClient_Handler,
+		},
+		{
+			MethodName: "CancelUnstake",
+			Handler:    _Msg_CancelUnstake_Handler,
+		},
+		{
+			MethodName: "UpdateClientVrfpk",
+			Handler:    _Msg_UpdateClientVrfpk_Handler,
+		},
+		{
+			MethodName: "SetOperatorProviders",
+			Handler:    _Msg_SetOperatorProviders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/tx.proto",
+}
+
+func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRelayPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRelayPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRelayPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DescriptionString) > 0 {
+		i -= len(m.DescriptionString)
+		copy(dAtA[i:], m.DescriptionString)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionString)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relays) > 0 {
+		for iNdEx := len(m.Relays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRelayPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRelayPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRelayPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Provider {
+		i--
+		if m.Provider {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClientVrfpk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClientVrfpk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClientVrfpk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClientVrfpkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClientVrfpkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClientVrfpkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorProviders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorProviders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOperatorProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRelayPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relays) > 0 {
+		for _, e := range m.Relays {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DescriptionString)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRelayPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBuySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgModifyProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgModifyClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Provider {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateClientVrfpk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateClientVrfpkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveEpoch))
+	}
+	return n
+}
+
+func (m *MsgSetOperatorProviders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetOperatorProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relays = append(m.Relays, &RelayRequest{})
+			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &RelayProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUnstakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRelayPayment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Relays) > 0 {
-		for _, e := range m.Relays {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgBuySubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuySubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.DescriptionString)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgRelayPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,10 +4297,10 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1574,6 +4485,38 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1595,7 +4538,7 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,10 +4561,10 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1645,7 +4588,7 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1668,10 +4611,10 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1843,7 +4786,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1866,10 +4809,10 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1893,7 +4836,7 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnstake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,10 +4859,10 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnstake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1986,6 +4929,26 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Provider = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2007,7 +4970,7 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnstakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,10 +4993,10 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnstakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2057,7 +5020,7 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClientVrfpk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2080,10 +5043,10 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClientVrfpk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClientVrfpk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2120,7 +5083,7 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2148,7 +5111,7 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2171,7 +5134,7 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClientVrfpkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,12 +5157,31 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClientVrfpkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClientVrfpkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
+			}
+			m.EffectiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2221,7 +5203,7 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorProviders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,10 +5226,10 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorProviders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorProviders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2284,41 +5266,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relays = append(m.Relays, &RelayRequest{})
-			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,7 +5294,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DescriptionString = string(dAtA[iNdEx:postIndex])
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2369,7 +5317,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorProvidersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,10 +5340,10 @@ func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorProvidersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
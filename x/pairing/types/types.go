@@ -1,17 +1,30 @@
 package types
 
 const (
-	ProviderStakeEventName       = "stake_new_provider"
-	ConsumerStakeEventName       = "stake_new_consumer"
-	ProviderStakeUpdateEventName = "stake_update_provider"
-	ConsumerStakeUpdateEventName = "stake_update_consumer"
-	ProviderUnstakeEventName     = "provider_unstake_commit"
-	ConsumerUnstakeEventName     = "consumer_unstake_commit"
+	ProviderStakeEventName         = "stake_new_provider"
+	ConsumerStakeEventName         = "stake_new_consumer"
+	ProviderStakeUpdateEventName   = "stake_update_provider"
+	ConsumerStakeUpdateEventName   = "stake_update_consumer"
+	ProviderStakeModifyEventName   = "stake_modify_provider"
+	ConsumerStakeModifyEventName   = "stake_modify_consumer"
+	ClientVrfpkUpdateEventName     = "client_vrfpk_update"
+	ProviderUnstakeEventName       = "provider_unstake_commit"
+	ConsumerUnstakeEventName       = "consumer_unstake_commit"
+	ProviderUnstakeCancelEventName = "provider_unstake_cancel"
+	ConsumerUnstakeCancelEventName = "consumer_unstake_cancel"
 
 	ConsumerInsufficientFundsToStayStakedEventName = "consumer_insufficient_funds_to_stay_staked"
 	RelayPaymentEventName                          = "relay_payment"
 	UnresponsiveProviderUnstakeFailedEventName     = "unresponsive_provider"
 	ProviderJailedEventName                        = "provider_jailed"
+	ProviderUnresponsiveUnstakeEventName           = "provider_unresponsive_unstake"
+	OperatorProvidersSetEventName                  = "operator_providers_set"
+
+	PlanAddEventName             = "plan_add"
+	PlanModifyEventName          = "plan_modify"
+	BuySubscriptionEventName     = "buy_subscription"
+	SubscriptionRenewEventName   = "subscription_month_renew"
+	SubscriptionExpiredEventName = "subscription_expired"
 )
 
 // unstake description strings
@@ -21,6 +34,12 @@ const (
 	UnstakeDescriptionInsufficientFunds = "client stake is below the minimum stake required"
 )
 
+// MaxOperatorProviders is the largest number of providers an operator can approve
+const MaxOperatorProviders = 100
+
+// MaxProviderJailHistory is the number of latest penalties kept in a provider jail history
+const MaxProviderJailHistory = 10
+
 func StakeNewEventName(isProvider bool) string {
 	if isProvider {
 		return ProviderStakeEventName
@@ -37,6 +56,14 @@ func StakeUpdateEventName(isProvider bool) string {
 	}
 }
 
+func StakeModifyEventName(isProvider bool) string {
+	if isProvider {
+		return ProviderStakeModifyEventName
+	} else {
+		return ConsumerStakeModifyEventName
+	}
+}
+
 func UnstakeCommitNewEventName(isProvider bool) string {
 	if isProvider {
 		return ProviderUnstakeEventName
@@ -45,6 +72,14 @@ func UnstakeCommitNewEventName(isProvider bool) string {
 	}
 }
 
+func UnstakeCancelEventName(isProvider bool) string {
+	if isProvider {
+		return ProviderUnstakeCancelEventName
+	} else {
+		return ConsumerUnstakeCancelEventName
+	}
+}
+
 type ClientUsedCU struct {
 	TotalUsed uint64
 	Providers map[string]uint64
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lavanet/lava/x/spec/client/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewSubmitSpecDisableProposalTxCmd returns a CLI command handler for creating
+// a spec disable proposal governance transaction.
+func NewSubmitSpecDisableProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "spec-disable [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a spec disable proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a spec disable proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Disabled specs stop
+getting new stakes and pairings from the next epoch, existing stakes can still
+unstake.
+
+Example:
+$ %s tx gov submit-proposal spec-disable <path/to/proposal.json> --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseSpecDisableProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitSpecProposal(cmd, clientCtx, &proposal.Proposal, proposal.Deposit)
+		},
+	}
+}
+
+// NewSubmitSpecApisModifyProposalTxCmd returns a CLI command handler for creating
+// a spec apis modify proposal governance transaction.
+func NewSubmitSpecApisModifyProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "spec-apis-modify [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a spec apis modify proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a spec apis modify proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Apis in the proposal
+replace the spec apis with the same name, apis with a new name are added to
+the spec. The rest of the spec is left unchanged.
+
+Example:
+$ %s tx gov submit-proposal spec-apis-modify <path/to/proposal.json> --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseSpecApisModifyProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitSpecProposal(cmd, clientCtx, &proposal.Proposal, proposal.Deposit)
+		},
+	}
+}
+
+// NewSubmitSpecRemoveProposalTxCmd returns a CLI command handler for creating
+// a spec remove proposal governance transaction.
+func NewSubmitSpecRemoveProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "spec-remove [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a spec remove proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a spec remove proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. A spec can be removed
+only when it has no stake or unstaking entries and no other spec imports it.
+
+Example:
+$ %s tx gov submit-proposal spec-remove <path/to/proposal.json> --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseSpecRemoveProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitSpecProposal(cmd, clientCtx, &proposal.Proposal, proposal.Deposit)
+		},
+	}
+}
+
+func submitSpecProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content, depositStr string) error {
+	from := clientCtx.GetFromAddress()
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
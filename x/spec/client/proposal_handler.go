@@ -8,3 +8,12 @@ import (
 
 // SpecAddProposalHandler is the param change proposal handler.
 var SpecAddProposalHandler = govclient.NewProposalHandler(cli.NewSubmitSpecAddProposalTxCmd, rest.ProposalRESTHandler)
+
+// SpecDisableProposalHandler is the spec disable proposal handler.
+var SpecDisableProposalHandler = govclient.NewProposalHandler(cli.NewSubmitSpecDisableProposalTxCmd, rest.DisableProposalRESTHandler)
+
+// SpecApisModifyProposalHandler is the spec apis modify proposal handler.
+var SpecApisModifyProposalHandler = govclient.NewProposalHandler(cli.NewSubmitSpecApisModifyProposalTxCmd, rest.ApisModifyProposalRESTHandler)
+
+// SpecRemoveProposalHandler is the spec remove proposal handler.
+var SpecRemoveProposalHandler = govclient.NewProposalHandler(cli.NewSubmitSpecRemoveProposalTxCmd, rest.RemoveProposalRESTHandler)
@@ -17,6 +17,27 @@ func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	}
 }
 
+func DisableProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "spec_disable",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func ApisModifyProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "spec_apis_modify",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func RemoveProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "spec_remove",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
 func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Println("postProposalHandlerFn")
@@ -48,3 +48,53 @@ func ParseSpecAddProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ret
 	}
 	return ret, nil
 }
+
+type (
+	SpecDisableProposalJSON struct {
+		Proposal types.SpecDisableProposal `json:"proposal"`
+		Deposit  string                    `json:"deposit" yaml:"deposit"`
+	}
+
+	SpecApisModifyProposalJSON struct {
+		Proposal types.SpecApisModifyProposal `json:"proposal"`
+		Deposit  string                       `json:"deposit" yaml:"deposit"`
+	}
+
+	SpecRemoveProposalJSON struct {
+		Proposal types.SpecRemoveProposal `json:"proposal"`
+		Deposit  string                   `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// Parse spec disable proposal JSON form file
+func ParseSpecDisableProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ret SpecDisableProposalJSON, err error) {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return ret, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &ret)
+	return ret, err
+}
+
+// Parse spec apis modify proposal JSON form file
+func ParseSpecApisModifyProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ret SpecApisModifyProposalJSON, err error) {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return ret, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &ret)
+	return ret, err
+}
+
+// Parse spec remove proposal JSON form file
+func ParseSpecRemoveProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ret SpecRemoveProposalJSON, err error) {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return ret, err
+	}
+
+	err = cdc.UnmarshalJSON(contents, &ret)
+	return ret, err
+}
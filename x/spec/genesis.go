@@ -14,6 +14,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetSpec(ctx, elem)
 	}
 
+	// Set all the fixatedSpec
+	for _, elem := range genState.FixatedSpecList {
+		k.SetFixatedSpec(ctx, elem)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -25,6 +30,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.SpecList = k.GetAllSpec(ctx)
 	genesis.SpecCount = uint64(len(genesis.SpecList))
+	genesis.FixatedSpecList = k.GetAllFixatedSpec(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -23,6 +23,16 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		SpecCount: 2,
+		FixatedSpecList: []types.FixatedSpec{
+			{
+				Spec:          types.Spec{Index: "0"},
+				FixationBlock: 0,
+			},
+			{
+				Spec:          types.Spec{Index: "1"},
+				FixationBlock: 20,
+			},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -37,5 +47,6 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.SpecList, got.SpecList)
 	require.Equal(t, genesisState.SpecCount, got.SpecCount)
+	require.ElementsMatch(t, genesisState.FixatedSpecList, got.FixatedSpecList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+func (k Keeper) fixatedSpecStore(ctx sdk.Context, index string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.FixatedSpecKeyPrefix), types.SpecKey(index)...))
+}
+
+// SetFixatedSpec set a specific fixatedSpec in the store from its index and fixation block
+func (k Keeper) SetFixatedSpec(ctx sdk.Context, fixatedSpec types.FixatedSpec) {
+	store := k.fixatedSpecStore(ctx, fixatedSpec.Spec.Index)
+	b := k.cdc.MustMarshal(&fixatedSpec)
+	store.Set(types.FixatedSpecKey(fixatedSpec.FixationBlock), b)
+}
+
+// GetFixatedSpec returns the fixatedSpec that was active on the given block, which is the latest fixation at or before the block
+func (k Keeper) GetFixatedSpec(
+	ctx sdk.Context,
+	index string,
+	block uint64,
+) (val types.FixatedSpec, found bool) {
+	store := k.fixatedSpecStore(ctx, index)
+	var end []byte
+	if block < ^uint64(0) {
+		end = types.FixatedSpecKey(block + 1)
+	}
+	iterator := store.ReverseIterator(nil, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// RemoveFixatedSpec removes a fixatedSpec from the store
+func (k Keeper) RemoveFixatedSpec(
+	ctx sdk.Context,
+	index string,
+	fixationBlock uint64,
+) {
+	store := k.fixatedSpecStore(ctx, index)
+	store.Delete(types.FixatedSpecKey(fixationBlock))
+}
+
+// GetAllFixatedSpec returns all fixatedSpec
+func (k Keeper) GetAllFixatedSpec(ctx sdk.Context) (list []types.FixatedSpec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FixatedSpecKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FixatedSpec
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// FixateSpecs saves a snapshot of every spec that changed since its last fixation, it is called on epoch start
+// so every epoch has a single spec version. fixations that are older than the earliest epoch in memory are removed,
+// except for the one that is still active on it
+func (k Keeper) FixateSpecs(ctx sdk.Context, block uint64, earliestEpochStart uint64) {
+	rawSpecs := k.GetAllSpec(ctx)
+	handledSpecs := map[string]bool{}
+	for _, rawSpec := range rawSpecs {
+		handledSpecs[rawSpec.Index] = true
+	}
+
+	// removed specs are fixated as removed, once that is the only fixation left in memory the spec is forgotten
+	for _, fixatedSpec := range k.GetAllFixatedSpec(ctx) {
+		index := fixatedSpec.Spec.Index
+		if handledSpecs[index] {
+			continue
+		}
+		handledSpecs[index] = true
+		latestFixation, found := k.GetFixatedSpec(ctx, index, block)
+		if found && !latestFixation.Removed {
+			k.SetFixatedSpec(ctx, types.FixatedSpec{Spec: types.Spec{Index: index}, FixationBlock: block, Removed: true})
+			utils.LogLavaTypedEvent(ctx, k.Logger(ctx), &types.EventSpecFixated{ChainID: index, Block: block, Removed: true}, types.SpecFixatedEventName, map[string]string{"chainID": index, "block": strconv.FormatUint(block, 10), "removed": "true"}, "spec fixated after removal")
+		}
+		k.CleanOlderFixatedSpecs(ctx, index, earliestEpochStart)
+		if latestFixation, found = k.GetFixatedSpec(ctx, index, earliestEpochStart); found && latestFixation.Removed {
+			k.RemoveFixatedSpec(ctx, index, latestFixation.FixationBlock)
+		}
+	}
+
+	for _, rawSpec := range rawSpecs {
+		spec, err := k.ExpandSpec(ctx, rawSpec)
+		if err != nil {
+			utils.LavaError(ctx, k.Logger(ctx), "fixate_spec_imports", map[string]string{"chainID": rawSpec.Index, "error": err.Error()}, "failed expanding spec imports for fixation")
+			continue
+		}
+
+		latestFixation, found := k.GetFixatedSpec(ctx, spec.Index, block)
+		if !found || latestFixation.Removed || !latestFixation.Spec.Equal(spec) {
+			k.SetFixatedSpec(ctx, types.FixatedSpec{Spec: spec, FixationBlock: block})
+			utils.LogLavaTypedEvent(ctx, k.Logger(ctx), &types.EventSpecFixated{ChainID: spec.Index, Block: block}, types.SpecFixatedEventName, map[string]string{"chainID": spec.Index, "block": strconv.FormatUint(block, 10)}, "spec fixated after a change")
+		}
+
+		k.CleanOlderFixatedSpecs(ctx, spec.Index, earliestEpochStart)
+	}
+}
+
+// CleanOlderFixatedSpecs removes the fixations of a spec that are no longer needed for blocks from earliestEpochStart onwards
+func (k Keeper) CleanOlderFixatedSpecs(ctx sdk.Context, index string, earliestEpochStart uint64) {
+	store := k.fixatedSpecStore(ctx, index)
+	iterator := store.ReverseIterator(nil, types.FixatedSpecKey(earliestEpochStart+1))
+
+	keysToRemove := [][]byte{}
+	// the first fixation is the one active on earliestEpochStart, so it must be kept
+	if iterator.Valid() {
+		iterator.Next()
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToRemove = append(keysToRemove, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToRemove {
+		store.Delete(key)
+	}
+}
+
+// GetSpec returns the spec that was active on the given block, with the apis of its imports expanded.
+// specs are fixated on epoch start so changes take effect from the next epoch, a spec that wasn't fixated
+// yet on the block (e.g. it was added in the current epoch) returns its latest version
+func (k Keeper) GetSpec(ctx sdk.Context, index string, block uint64) (val types.Spec, found bool) {
+	if fixatedSpec, found := k.GetFixatedSpec(ctx, index, block); found {
+		if fixatedSpec.Removed {
+			return val, false
+		}
+		return fixatedSpec.Spec, true
+	}
+	return k.GetLatestSpec(ctx, index)
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/testutil/nullify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixateSpecs(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+
+	spec := createImportSpec("spec", nil, createImportApi("a", 1))
+	keeper.SetSpec(ctx, spec)
+
+	// a spec that wasn't fixated yet returns its latest version
+	val, found := keeper.GetSpec(ctx, spec.Index, 0)
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&spec), nullify.Fill(&val))
+
+	keeper.FixateSpecs(ctx, 20, 0)
+
+	// a change in the middle of the epoch is applied only on the next epoch start
+	changedSpec := spec
+	changedSpec.Apis = append(changedSpec.Apis, createImportApi("b", 1))
+	keeper.SetSpec(ctx, changedSpec)
+	val, found = keeper.GetSpec(ctx, spec.Index, 25)
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&spec), nullify.Fill(&val))
+
+	keeper.FixateSpecs(ctx, 40, 0)
+	val, found = keeper.GetSpec(ctx, spec.Index, 30)
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&spec), nullify.Fill(&val))
+	val, found = keeper.GetSpec(ctx, spec.Index, 40)
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&changedSpec), nullify.Fill(&val))
+
+	// an unchanged spec isn't fixated again
+	keeper.FixateSpecs(ctx, 60, 0)
+	require.Len(t, keeper.GetAllFixatedSpec(ctx), 2)
+
+	// fixations older than the earliest epoch are removed, except the one that is active on it
+	keeper.FixateSpecs(ctx, 80, 60)
+	require.Len(t, keeper.GetAllFixatedSpec(ctx), 1)
+	val, found = keeper.GetSpec(ctx, spec.Index, 60)
+	require.True(t, found)
+	require.Equal(t, nullify.Fill(&changedSpec), nullify.Fill(&val))
+}
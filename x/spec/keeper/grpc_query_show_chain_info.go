@@ -31,8 +31,12 @@ func (k Keeper) ShowChainInfo(goCtx context.Context, req *types.QueryShowChainIn
 			// get chain ID
 			chainID = spec.GetIndex()
 
-			// get API methods (includes their interfaces)
-			apis := spec.GetApis()
+			// get API methods (includes their interfaces), with the imported apis expanded
+			expandedSpec, err := k.ExpandSpec(ctx, spec)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			apis := expandedSpec.GetApis()
 			for _, api := range apis {
 				apiInterfaces := api.GetApiInterfaces()
 
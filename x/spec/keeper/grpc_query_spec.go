@@ -27,6 +27,10 @@ func (k Keeper) SpecAll(c context.Context, req *types.QueryAllSpecRequest) (*typ
 		if err := k.cdc.Unmarshal(value, &Spec); err != nil {
 			return err
 		}
+		Spec, err := k.ExpandSpec(ctx, Spec)
+		if err != nil {
+			return err
+		}
 
 		Specs = append(Specs, Spec)
 		return nil
@@ -44,7 +48,7 @@ func (k Keeper) Spec(c context.Context, req *types.QueryGetSpecRequest) (*types.
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetSpec(
+	val, found := k.GetLatestSpec(
 		ctx,
 		req.ChainID,
 	)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,8 +18,27 @@ func (k Keeper) SetSpec(ctx sdk.Context, spec types.Spec) {
 	), b)
 }
 
-// GetSpec returns a Spec from its index
-func (k Keeper) GetSpec(
+// GetLatestSpec returns the current version of a Spec from its index, with the apis of its imports expanded
+func (k Keeper) GetLatestSpec(
+	ctx sdk.Context,
+	index string,
+) (val types.Spec, found bool) {
+	val, found = k.GetRawSpec(ctx, index)
+	if !found {
+		return val, false
+	}
+
+	val, err := k.ExpandSpec(ctx, val)
+	if err != nil {
+		// imports are validated when specs are set, so this can only happen on a corrupted state
+		k.Logger(ctx).Error("failed expanding spec imports", "spec", index, "error", err.Error())
+		return val, false
+	}
+	return val, true
+}
+
+// GetRawSpec returns a Spec from its index as it was set, without expanding its imports
+func (k Keeper) GetRawSpec(
 	ctx sdk.Context,
 	index string,
 ) (val types.Spec, found bool) {
@@ -46,7 +66,7 @@ func (k Keeper) RemoveSpec(
 	))
 }
 
-// GetAllSpec returns all Spec
+// GetAllSpec returns all Spec as they were set, without expanding their imports
 func (k Keeper) GetAllSpec(ctx sdk.Context) (list []types.Spec) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SpecKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -62,10 +82,99 @@ func (k Keeper) GetAllSpec(ctx sdk.Context) (list []types.Spec) {
 	return
 }
 
+// ExpandSpec returns the spec with the apis of all of its imports merged into its apis.
+// imports are merged by order, so a later import overrides apis with the same name of an earlier one,
+// and the apis defined in the spec itself override all of the imported apis
+func (k Keeper) ExpandSpec(ctx sdk.Context, spec types.Spec) (types.Spec, error) {
+	if len(spec.Imports) == 0 {
+		return spec, nil
+	}
+
+	apis, err := k.expandSpecApis(ctx, spec, map[string]bool{spec.Index: true})
+	if err != nil {
+		return spec, err
+	}
+	spec.Apis = apis
+	return spec, nil
+}
+
+// expandSpecApis recursively merges the apis of the spec imports, visiting holds the import path to detect cycles
+func (k Keeper) expandSpecApis(ctx sdk.Context, spec types.Spec, visiting map[string]bool) ([]types.ServiceApi, error) {
+	apis := []types.ServiceApi{}
+	apiIndexByName := map[string]int{}
+	addApi := func(api types.ServiceApi) {
+		if idx, ok := apiIndexByName[api.Name]; ok {
+			apis[idx] = api
+			return
+		}
+		apiIndexByName[api.Name] = len(apis)
+		apis = append(apis, api)
+	}
+
+	for _, importIndex := range spec.Imports {
+		if visiting[importIndex] {
+			return nil, fmt.Errorf("spec %s has an import cycle through %s", spec.Index, importIndex)
+		}
+		importedSpec, found := k.GetRawSpec(ctx, importIndex)
+		if !found {
+			return nil, fmt.Errorf("spec %s imports a spec that doesn't exist: %s", spec.Index, importIndex)
+		}
+
+		visiting[importIndex] = true
+		importedApis, err := k.expandSpecApis(ctx, importedSpec, visiting)
+		delete(visiting, importIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, api := range importedApis {
+			addApi(api)
+		}
+	}
+
+	for _, api := range spec.Apis {
+		addApi(api)
+	}
+	return apis, nil
+}
+
+// GetSpecDependents returns the indexes of all the specs that import the given spec, directly or through other imports
+func (k Keeper) GetSpecDependents(ctx sdk.Context, index string) (dependents []string) {
+	specList := k.GetAllSpec(ctx)
+	allSpecs := map[string]types.Spec{}
+	for _, spec := range specList {
+		allSpecs[spec.Index] = spec
+	}
+
+	// memo holds whether a spec depends on index, specs on the current path are marked false to stop cycles
+	memo := map[string]bool{}
+	var dependsOn func(specIndex string) bool
+	dependsOn = func(specIndex string) bool {
+		if result, ok := memo[specIndex]; ok {
+			return result
+		}
+		memo[specIndex] = false
+		for _, importIndex := range allSpecs[specIndex].Imports {
+			if importIndex == index || dependsOn(importIndex) {
+				memo[specIndex] = true
+				break
+			}
+		}
+		return memo[specIndex]
+	}
+
+	for _, spec := range specList {
+		if spec.Index != index && dependsOn(spec.Index) {
+			dependents = append(dependents, spec.Index)
+		}
+	}
+	return dependents
+}
+
 // returns whether a spec name is a valid spec in the consensus
 // first return value is found and active, second argument is found only
 func (k Keeper) IsSpecFoundAndActive(ctx sdk.Context, chainID string) (foundAndActive bool, found bool) {
-	spec, found := k.GetSpec(ctx, chainID)
+	spec, found := k.GetLatestSpec(ctx, chainID)
 	foundAndActive = false
 	if found {
 		foundAndActive = spec.Enabled
@@ -96,7 +205,7 @@ func (k Keeper) GetAllChainIDs(ctx sdk.Context) (chainIDs []string) {
 
 func (k Keeper) GetExpectedInterfacesForSpec(ctx sdk.Context, chainID string) (expectedInterfaces map[string]bool) {
 	expectedInterfaces = make(map[string]bool)
-	spec, found := k.GetSpec(ctx, chainID)
+	spec, found := k.GetLatestSpec(ctx, chainID)
 	if found && spec.Enabled {
 		for _, api := range spec.Apis {
 			if api.Enabled {
@@ -109,8 +218,9 @@ func (k Keeper) GetExpectedInterfacesForSpec(ctx sdk.Context, chainID string) (e
 	return
 }
 
-func (k Keeper) IsFinalizedBlock(ctx sdk.Context, chainID string, requestedBlock int64, latestBlock int64) bool {
-	spec, found := k.GetSpec(ctx, chainID)
+// IsFinalizedBlock checks the finalization of requestedBlock using the spec that was active on block
+func (k Keeper) IsFinalizedBlock(ctx sdk.Context, chainID string, block uint64, requestedBlock int64, latestBlock int64) bool {
+	spec, found := k.GetSpec(ctx, chainID, block)
 	if !found {
 		return false
 	}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/x/spec"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createImportSpec(index string, imports []string, apis ...types.ServiceApi) types.Spec {
+	return types.Spec{Index: index, Name: index, Enabled: true, Imports: imports, Apis: apis}
+}
+
+func createImportApi(name string, cu uint64) types.ServiceApi {
+	return types.ServiceApi{Name: name, ComputeUnits: cu, Enabled: true}
+}
+
+func apisByName(spec types.Spec) map[string]uint64 {
+	apis := map[string]uint64{}
+	for _, api := range spec.Apis {
+		apis[api.Name] = api.ComputeUnits
+	}
+	return apis
+}
+
+func TestSpecImportExpand(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+
+	base := createImportSpec("base", nil, createImportApi("a", 1), createImportApi("b", 1))
+	other := createImportSpec("other", nil, createImportApi("b", 2), createImportApi("c", 2))
+	child := createImportSpec("child", []string{"base", "other"}, createImportApi("c", 3), createImportApi("d", 3))
+	grandchild := createImportSpec("grandchild", []string{"child"})
+	for _, s := range []types.Spec{base, other, child, grandchild} {
+		keeper.SetSpec(ctx, s)
+	}
+
+	// later imports override earlier ones, and the spec's own apis override all imports
+	expected := map[string]uint64{"a": 1, "b": 2, "c": 3, "d": 3}
+	expanded, found := keeper.GetLatestSpec(ctx, child.Index)
+	require.True(t, found)
+	require.Equal(t, expected, apisByName(expanded))
+	require.Len(t, expanded.Apis, len(expected))
+
+	expanded, found = keeper.GetLatestSpec(ctx, grandchild.Index)
+	require.True(t, found)
+	require.Equal(t, expected, apisByName(expanded))
+
+	// the raw spec is kept as it was set
+	raw, found := keeper.GetRawSpec(ctx, child.Index)
+	require.True(t, found)
+	require.Len(t, raw.Apis, 2)
+
+	require.ElementsMatch(t, []string{child.Index, grandchild.Index}, keeper.GetSpecDependents(ctx, base.Index))
+	require.Empty(t, keeper.GetSpecDependents(ctx, grandchild.Index))
+}
+
+func TestSpecImportErrors(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+
+	missing := createImportSpec("missing", []string{"noSuchSpec"})
+	_, err := keeper.ExpandSpec(ctx, missing)
+	require.NotNil(t, err)
+
+	// a cycle between specs can't be expanded
+	first := createImportSpec("first", []string{"second"}, createImportApi("a", 1))
+	second := createImportSpec("second", []string{"first"}, createImportApi("b", 1))
+	keeper.SetSpec(ctx, first)
+	keeper.SetSpec(ctx, second)
+	_, err = keeper.ExpandSpec(ctx, first)
+	require.NotNil(t, err)
+	_, found := keeper.GetLatestSpec(ctx, first.Index)
+	require.False(t, found)
+}
+
+func TestSpecImportProposal(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+	handler := spec.NewSpecProposalsHandler(*keeper, nil)
+
+	base := createImportSpec("base", nil, createImportApi("a", 1))
+	child := createImportSpec("child", []string{base.Index}, createImportApi("b", 1))
+
+	// the import must exist when the spec is proposed
+	err := handler(ctx, types.NewSpecAddProposal("spec", "add spec", []types.Spec{child}))
+	require.NotNil(t, err)
+
+	err = handler(ctx, types.NewSpecAddProposal("spec", "add spec", []types.Spec{base, child}))
+	require.Nil(t, err)
+
+	// a cycle is rejected
+	cyclicBase := base
+	cyclicBase.Imports = []string{child.Index}
+	err = handler(ctx, types.NewSpecAddProposal("spec", "modify spec", []types.Spec{cyclicBase}))
+	require.NotNil(t, err)
+
+	// modifying an imported spec updates its dependents
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	base.Apis = append(base.Apis, createImportApi("c", 1))
+	err = handler(ctx, types.NewSpecAddProposal("spec", "modify spec", []types.Spec{base}))
+	require.Nil(t, err)
+	expanded, found := keeper.GetLatestSpec(ctx, child.Index)
+	require.True(t, found)
+	require.Equal(t, map[string]uint64{"a": 1, "b": 1, "c": 1}, apisByName(expanded))
+	require.Equal(t, uint64(ctx.BlockHeight()), expanded.BlockLastUpdated)
+
+	// a change that makes a dependent invalid is rejected
+	child.DataReliabilityEnabled = true
+	child.Apis = []types.ServiceApi{createImportApi("b", 1)}
+	child.Apis[0].Parsing.FunctionTag = types.GET_BLOCKNUM
+	base.Apis = []types.ServiceApi{createImportApi("a", 1)}
+	base.Apis[0].Parsing.FunctionTag = types.GET_BLOCK_BY_NUM
+	err = handler(ctx, types.NewSpecAddProposal("spec", "modify spec", []types.Spec{base, child}))
+	require.Nil(t, err)
+	base.Apis[0].Parsing.FunctionTag = ""
+	err = handler(ctx, types.NewSpecAddProposal("spec", "modify spec", []types.Spec{base}))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/lavanet/lava/x/spec"
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecDisableAndRemoveProposals(t *testing.T) {
+	servers, keepers, ctx := keepertest.InitAllKeepers(t)
+	handler := spec.NewSpecProposalsHandler(keepers.Spec, keepers.Epochstorage)
+
+	mockSpec := common.CreateMockSpec()
+	keepers.Spec.SetSpec(sdk.UnwrapSDKContext(ctx), mockSpec)
+	provider := common.CreateNewAccount(ctx, *keepers, 10000)
+	common.StakeAccount(t, ctx, *keepers, *servers, provider, mockSpec, 1000, true)
+	ctx = keepertest.AdvanceEpoch(ctx, keepers)
+
+	// a spec with stake entries can't be removed
+	err := handler(sdk.UnwrapSDKContext(ctx), types.NewSpecRemoveProposal("remove", "remove spec", []string{mockSpec.Index}))
+	require.NotNil(t, err)
+
+	err = handler(sdk.UnwrapSDKContext(ctx), types.NewSpecDisableProposal("disable", "disable spec", []string{"noSuchSpec"}))
+	require.NotNil(t, err)
+	err = handler(sdk.UnwrapSDKContext(ctx), types.NewSpecDisableProposal("disable", "disable spec", []string{mockSpec.Index}))
+	require.Nil(t, err)
+	err = handler(sdk.UnwrapSDKContext(ctx), types.NewSpecDisableProposal("disable", "disable spec", []string{mockSpec.Index}))
+	require.NotNil(t, err)
+
+	// new stakes are rejected but the existing stake can unstake
+	newProvider := common.CreateNewAccount(ctx, *keepers, 10000)
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: mockSpec.Apis[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	_, err = servers.PairingServer.StakeProvider(ctx, &pairingtypes.MsgStakeProvider{Creator: newProvider.Addr.String(), ChainID: mockSpec.Index, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000)), Geolocation: 1, Endpoints: endpoints})
+	require.NotNil(t, err)
+	_, err = servers.PairingServer.UnstakeProvider(ctx, &pairingtypes.MsgUnstakeProvider{Creator: provider.Addr.String(), ChainID: mockSpec.Index})
+	require.Nil(t, err)
+
+	// the spec can't be removed while the provider is unstaking
+	err = handler(sdk.UnwrapSDKContext(ctx), types.NewSpecRemoveProposal("remove", "remove spec", []string{mockSpec.Index}))
+	require.NotNil(t, err)
+
+	unstakeHoldBlocks := keepers.Epochstorage.UnstakeHoldBlocks(sdk.UnwrapSDKContext(ctx), uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	unstakeDeadline := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + unstakeHoldBlocks
+	for uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) <= unstakeDeadline {
+		ctx = keepertest.AdvanceEpoch(ctx, keepers)
+	}
+	removalBlock := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	err = handler(sdk.UnwrapSDKContext(ctx), types.NewSpecRemoveProposal("remove", "remove spec", []string{mockSpec.Index}))
+	require.Nil(t, err)
+	_, found := keepers.Spec.GetLatestSpec(sdk.UnwrapSDKContext(ctx), mockSpec.Index)
+	require.False(t, found)
+
+	// the spec is still available for the epoch it was removed in, and removed from the next one
+	_, found = keepers.Spec.GetSpec(sdk.UnwrapSDKContext(ctx), mockSpec.Index, removalBlock)
+	require.True(t, found)
+	ctx = keepertest.AdvanceEpoch(ctx, keepers)
+	_, found = keepers.Spec.GetSpec(sdk.UnwrapSDKContext(ctx), mockSpec.Index, uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	require.False(t, found)
+	_, found = keepers.Spec.GetSpec(sdk.UnwrapSDKContext(ctx), mockSpec.Index, removalBlock)
+	require.True(t, found)
+}
+
+func TestSpecApisModifyProposal(t *testing.T) {
+	keeper, ctx := keepertest.SpecKeeper(t)
+	handler := spec.NewSpecProposalsHandler(*keeper, nil)
+
+	// the mock spec needs valid apis to pass the spec validation
+	mockSpec := common.CreateMockSpec()
+	mockSpec.DataReliabilityEnabled = false
+	mockSpec.Apis[0].ApiInterfaces = []types.ApiInterface{{Interface: types.APIInterfaceRest, Type: "GET"}}
+	keeper.SetSpec(ctx, mockSpec)
+
+	modifiedApi := mockSpec.Apis[0]
+	modifiedApi.ComputeUnits = 200
+	newApi := mockSpec.Apis[0]
+	newApi.Name = "newApi"
+
+	err := handler(ctx, types.NewSpecApisModifyProposal("modify", "modify apis", "noSuchSpec", []types.ServiceApi{modifiedApi}))
+	require.NotNil(t, err)
+
+	// invalid apis are rejected
+	invalidApi := newApi
+	invalidApi.ComputeUnits = keeper.MaxCU(ctx) + 1
+	err = handler(ctx, types.NewSpecApisModifyProposal("modify", "modify apis", mockSpec.Index, []types.ServiceApi{invalidApi}))
+	require.NotNil(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = handler(ctx, types.NewSpecApisModifyProposal("modify", "modify apis", mockSpec.Index, []types.ServiceApi{modifiedApi, newApi}))
+	require.Nil(t, err)
+
+	modifiedSpec, found := keeper.GetLatestSpec(ctx, mockSpec.Index)
+	require.True(t, found)
+	require.Equal(t, map[string]uint64{modifiedApi.Name: 200, newApi.Name: newApi.ComputeUnits}, apisByName(modifiedSpec))
+	require.Equal(t, uint64(ctx.BlockHeight()), modifiedSpec.BlockLastUpdated)
+	require.Equal(t, mockSpec.DataReliabilityEnabled, modifiedSpec.DataReliabilityEnabled)
+}
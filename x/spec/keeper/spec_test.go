@@ -29,7 +29,7 @@ func TestSpecGet(t *testing.T) {
 	keeper, ctx := keepertest.SpecKeeper(t)
 	items := createNSpec(keeper, ctx, 10)
 	for _, item := range items {
-		rst, found := keeper.GetSpec(ctx,
+		rst, found := keeper.GetLatestSpec(ctx,
 			item.Index,
 		)
 		require.True(t, found)
@@ -46,7 +46,7 @@ func TestSpecRemove(t *testing.T) {
 		keeper.RemoveSpec(ctx,
 			item.Index,
 		)
-		_, found := keeper.GetSpec(ctx,
+		_, found := keeper.GetLatestSpec(ctx,
 			item.Index,
 		)
 		require.False(t, found)
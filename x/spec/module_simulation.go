@@ -33,10 +33,11 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
-	specGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&specGenesis)
+	specGenesis := types.DefaultGenesis()
+	specGenesis.SpecList = []types.Spec{specsimulation.SimulationSpec()}
+	specGenesis.SpecCount = uint64(len(specGenesis.SpecList))
+	// this line is used by starport scaffolding # simapp/module/genesisState
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(specGenesis)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -3,12 +3,14 @@ package spec
 import (
 	"log"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramkeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/gogo/protobuf/proto"
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	"github.com/lavanet/lava/x/spec/keeper"
@@ -48,7 +50,8 @@ func HandleParameterChangeProposal(ctx sdk.Context, k paramkeeper.Keeper, p *par
 		}
 
 		details[epochstoragetypes.ModuleName] = strconv.FormatInt(ctx.BlockHeight(), 10)
-		utils.LogLavaEvent(ctx, logger, types.ParamChangeEventName, details, "Gov Proposal Accepted Param Changed")
+		event := &types.EventParamChange{Subspace: c.Subspace, Param: c.Key, Value: c.Value, Block: uint64(ctx.BlockHeight())}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.ParamChangeEventName, details, "Gov Proposal Accepted Param Changed")
 	}
 
 	ss, ok := k.GetSubspace(epochstoragetypes.ModuleName)
@@ -61,12 +64,21 @@ func HandleParameterChangeProposal(ctx sdk.Context, k paramkeeper.Keeper, p *par
 }
 
 // NewSpecProposalsHandler creates a new governance Handler for a Spec
-func NewSpecProposalsHandler(k keeper.Keeper) govtypes.Handler {
+func NewSpecProposalsHandler(k keeper.Keeper, epochstorageKeeper types.EpochstorageKeeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.SpecAddProposal:
 			return handleSpecProposal(ctx, k, c)
 
+		case *types.SpecDisableProposal:
+			return handleSpecDisableProposal(ctx, k, c)
+
+		case *types.SpecApisModifyProposal:
+			return handleSpecApisModifyProposal(ctx, k, c)
+
+		case *types.SpecRemoveProposal:
+			return handleSpecRemoveProposal(ctx, k, epochstorageKeeper, c)
+
 		default:
 			log.Println("unrecognized spec proposal content")
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized spec proposal content type: %T", c)
@@ -76,11 +88,17 @@ func NewSpecProposalsHandler(k keeper.Keeper) govtypes.Handler {
 
 func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddProposal) error {
 	for _, spec := range p.Specs {
-		_, found := k.GetSpec(ctx, spec.Index)
+		_, found := k.GetRawSpec(ctx, spec.Index)
 
 		logger := k.Logger(ctx)
 
-		details, err := spec.ValidateSpec(k.MaxCU(ctx))
+		// validate the spec with its imports expanded, this also detects import cycles
+		expandedSpec, err := k.ExpandSpec(ctx, spec)
+		if err != nil {
+			details := map[string]string{"spec": spec.Name, "chainID": spec.Index, "error": err.Error()}
+			return utils.LavaError(ctx, logger, "invalid_spec_imports", details, err.Error())
+		}
+		details, err := expandedSpec.ValidateSpec(k.MaxCU(ctx))
 		if err != nil {
 			return utils.LavaError(ctx, logger, "invalid_spec", details, err.Error())
 		}
@@ -88,17 +106,164 @@ func handleSpecProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecAddPropos
 		spec.BlockLastUpdated = uint64(ctx.BlockHeight())
 
 		k.SetSpec(ctx, spec)
+
+		// specs importing this spec changed too, make sure they are still valid
+		err = revalidateDependentSpecs(ctx, k, spec.Index)
+		if err != nil {
+			return err
+		}
 		// TODO: add api types once its implemented to the event
 
 		var name string
+		var event proto.Message
 		if found {
 			name = types.SpecModifyEventName
+			event = &types.EventSpecModify{ChainID: spec.Index, Name: spec.Name, Block: spec.BlockLastUpdated}
 		} else {
 			name = types.SpecAddEventName
+			event = &types.EventSpecAdd{ChainID: spec.Index, Name: spec.Name, Block: spec.BlockLastUpdated}
+		}
+		spec.BlockLastUpdated = uint64(ctx.BlockHeight())
+
+		utils.LogLavaTypedEvent(ctx, logger, event, name, details, "Gov Proposal Accepted Spec")
+	}
+	return nil
+}
+
+func revalidateDependentSpecs(ctx sdk.Context, k keeper.Keeper, index string) error {
+	logger := k.Logger(ctx)
+	for _, dependentIndex := range k.GetSpecDependents(ctx, index) {
+		dependent, found := k.GetRawSpec(ctx, dependentIndex)
+		if !found {
+			continue
 		}
+		expandedDependent, err := k.ExpandSpec(ctx, dependent)
+		if err != nil {
+			details := map[string]string{"spec": dependent.Name, "chainID": dependent.Index, "import": index, "error": err.Error()}
+			return utils.LavaError(ctx, logger, "invalid_dependent_spec", details, err.Error())
+		}
+		details, err := expandedDependent.ValidateSpec(k.MaxCU(ctx))
+		if err != nil {
+			details["import"] = index
+			details["error"] = err.Error()
+			return utils.LavaError(ctx, logger, "invalid_dependent_spec", details, err.Error())
+		}
+
+		dependent.BlockLastUpdated = uint64(ctx.BlockHeight())
+		k.SetSpec(ctx, dependent)
+
+		details["import"] = index
+		event := &types.EventSpecModify{ChainID: dependent.Index, Name: dependent.Name, Block: dependent.BlockLastUpdated, Import: index}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.SpecModifyEventName, details, "Spec Modified By Import Change")
+	}
+	return nil
+}
+
+// handleSpecDisableProposal disables specs, new stakes and pairings stop from the next epoch while existing stakes can still unstake
+func handleSpecDisableProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecDisableProposal) error {
+	logger := k.Logger(ctx)
+	for _, chainID := range p.ChainIds {
+		spec, found := k.GetRawSpec(ctx, chainID)
+		if !found {
+			return utils.LavaError(ctx, logger, "spec_disable_not_found", map[string]string{"chainID": chainID}, "spec to disable not found")
+		}
+		if !spec.Enabled {
+			return utils.LavaError(ctx, logger, "spec_disable_already_disabled", map[string]string{"chainID": chainID}, "spec is already disabled")
+		}
+
+		spec.Enabled = false
 		spec.BlockLastUpdated = uint64(ctx.BlockHeight())
+		k.SetSpec(ctx, spec)
+
+		details := map[string]string{"spec": spec.Name, "status": strconv.FormatBool(spec.Enabled), "chainID": spec.Index}
+		event := &types.EventSpecDisable{ChainID: spec.Index, Name: spec.Name}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.SpecDisableEventName, details, "Gov Proposal Accepted Spec Disabled")
+	}
+	return nil
+}
+
+// handleSpecApisModifyProposal replaces the spec apis with the same name as the proposal apis and adds the new ones
+func handleSpecApisModifyProposal(ctx sdk.Context, k keeper.Keeper, p *types.SpecApisModifyProposal) error {
+	logger := k.Logger(ctx)
+	spec, found := k.GetRawSpec(ctx, p.ChainId)
+	if !found {
+		return utils.LavaError(ctx, logger, "spec_apis_modify_not_found", map[string]string{"chainID": p.ChainId}, "spec to modify not found")
+	}
+
+	apiIndexByName := map[string]int{}
+	for idx, api := range spec.Apis {
+		apiIndexByName[api.Name] = idx
+	}
+	apiNames := []string{}
+	for _, api := range p.Apis {
+		if idx, ok := apiIndexByName[api.Name]; ok {
+			spec.Apis[idx] = api
+		} else {
+			spec.Apis = append(spec.Apis, api)
+		}
+		apiNames = append(apiNames, api.Name)
+	}
+
+	expandedSpec, err := k.ExpandSpec(ctx, spec)
+	if err != nil {
+		details := map[string]string{"spec": spec.Name, "chainID": spec.Index, "error": err.Error()}
+		return utils.LavaError(ctx, logger, "invalid_spec_imports", details, err.Error())
+	}
+	details, err := expandedSpec.ValidateSpec(k.MaxCU(ctx))
+	if err != nil {
+		return utils.LavaError(ctx, logger, "invalid_spec", details, err.Error())
+	}
+
+	spec.BlockLastUpdated = uint64(ctx.BlockHeight())
+	k.SetSpec(ctx, spec)
+
+	err = revalidateDependentSpecs(ctx, k, spec.Index)
+	if err != nil {
+		return err
+	}
+
+	details["apis"] = strings.Join(apiNames, ",")
+	event := &types.EventSpecApisModify{ChainID: spec.Index, Name: spec.Name, Apis: apiNames}
+	utils.LogLavaTypedEvent(ctx, logger, event, types.SpecApisModifyEventName, details, "Gov Proposal Accepted Spec Apis Modified")
+	return nil
+}
+
+// handleSpecRemoveProposal removes specs that no other spec imports and that have no stake entries left
+func handleSpecRemoveProposal(ctx sdk.Context, k keeper.Keeper, epochstorageKeeper types.EpochstorageKeeper, p *types.SpecRemoveProposal) error {
+	logger := k.Logger(ctx)
+	for _, chainID := range p.ChainIds {
+		spec, found := k.GetRawSpec(ctx, chainID)
+		if !found {
+			return utils.LavaError(ctx, logger, "spec_remove_not_found", map[string]string{"chainID": chainID}, "spec to remove not found")
+		}
+		details := map[string]string{"spec": spec.Name, "chainID": spec.Index}
+
+		if dependents := k.GetSpecDependents(ctx, chainID); len(dependents) > 0 {
+			details["dependents"] = strings.Join(dependents, ",")
+			return utils.LavaError(ctx, logger, "spec_remove_imported", details, "spec is imported by other specs")
+		}
+
+		for _, storageType := range []string{epochstoragetypes.ProviderKey, epochstoragetypes.ClientKey} {
+			if stakeStorage, found := epochstorageKeeper.GetStakeStorageCurrent(ctx, storageType, chainID); found && len(stakeStorage.StakeEntries) > 0 {
+				details["stakeEntries"] = strconv.Itoa(len(stakeStorage.StakeEntries))
+				details["storageType"] = storageType
+				return utils.LavaError(ctx, logger, "spec_remove_staked", details, "spec still has stake entries")
+			}
+			if unstakeStorage, found := epochstorageKeeper.GetStakeStorageUnstake(ctx, storageType); found {
+				for _, entry := range unstakeStorage.StakeEntries {
+					if entry.Chain == chainID {
+						details["storageType"] = storageType
+						return utils.LavaError(ctx, logger, "spec_remove_unstaking", details, "spec still has unstaking entries")
+					}
+				}
+			}
+		}
+
+		// the fixated versions of the spec are kept until they are out of memory, so relays served before the removal can still be paid
+		k.RemoveSpec(ctx, chainID)
 
-		utils.LogLavaEvent(ctx, logger, name, details, "Gov Proposal Accepted Spec")
+		event := &types.EventSpecRemove{ChainID: spec.Index, Name: spec.Name}
+		utils.LogLavaTypedEvent(ctx, logger, event, types.SpecRemoveEventName, details, "Gov Proposal Accepted Spec Removed")
 	}
 	return nil
 }
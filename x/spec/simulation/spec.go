@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/spec/types"
+)
+
+const SimulationSpecIndex = "SIM1"
+
+// SimulationSpec is the spec simulated providers and clients stake on,
+// data reliability is always required so every relay can be followed by a data reliability relay
+func SimulationSpec() types.Spec {
+	apiInterface := types.ApiInterface{Interface: "jsonrpc", Type: "POST"}
+	spec := types.Spec{
+		Index:                         SimulationSpecIndex,
+		Name:                          "simulation spec",
+		Enabled:                       true,
+		ReliabilityThreshold:          4294967295,
+		DataReliabilityEnabled:        true,
+		BlockDistanceForFinalizedData: 1,
+		BlocksInFinalizationProof:     1,
+		AverageBlockTime:              6500,
+		AllowedBlockLagForQosSync:     2,
+		MinStakeProvider:              sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(1000)),
+		MinStakeClient:                sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(100)),
+	}
+	for _, name := range []string{"sim_blockNumber", "sim_getBlock", "sim_call"} {
+		spec.Apis = append(spec.Apis, types.ServiceApi{Name: name, ComputeUnits: 10, Enabled: true, ApiInterfaces: []types.ApiInterface{apiInterface}})
+	}
+	return spec
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalSpecApisModify = "SpecApisModify"
+)
+
+var _ govtypes.Content = &SpecApisModifyProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalSpecApisModify)
+}
+
+func NewSpecApisModifyProposal(title, description string, chainID string, apis []ServiceApi) *SpecApisModifyProposal {
+	return &SpecApisModifyProposal{title, description, chainID, apis}
+}
+
+// GetTitle returns the title of a proposal.
+func (pcp *SpecApisModifyProposal) GetTitle() string { return pcp.Title }
+
+// GetDescription returns the description of a proposal.
+func (pcp *SpecApisModifyProposal) GetDescription() string { return pcp.Description }
+
+// ProposalRoute returns the routing key of a proposal.
+func (pcp *SpecApisModifyProposal) ProposalRoute() string { return ProposalsRouterKey }
+
+// ProposalType returns the type of a proposal.
+func (pcp *SpecApisModifyProposal) ProposalType() string { return ProposalSpecApisModify }
+
+// ValidateBasic validates the proposal
+func (pcp *SpecApisModifyProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(pcp)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(pcp.ChainId)) == 0 {
+		return sdkerrors.Wrap(ErrBlankSpecName, "spec index cannot be blank")
+	}
+	if len(pcp.Apis) == 0 {
+		return sdkerrors.Wrap(ErrEmptyApis, "proposal apis cannot be empty")
+	}
+
+	return checkServiceApis(pcp.ChainId, pcp.Apis)
+}
+
+// String implements the Stringer interface.
+func (pcp SpecApisModifyProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Spec Apis Modify Proposal:
+	  Title:       %s
+	  Description: %s
+	  Chain ID:    %s
+	  Changes:
+	`, pcp.Title, pcp.Description, pcp.ChainId))
+
+	for _, api := range pcp.Apis {
+		b.WriteString(fmt.Sprintf(`        Api:
+		      Name: %s, Enabled: %t, ComputeUntis: %d
+		`, api.Name, api.Enabled, api.ComputeUnits))
+	}
+
+	return b.String()
+}
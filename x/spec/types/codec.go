@@ -21,6 +21,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&SpecAddProposal{},
+		&SpecDisableProposal{},
+		&SpecApisModifyProposal{},
+		&SpecRemoveProposal{},
 	)
 }
 
@@ -0,0 +1,57 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalSpecDisable = "SpecDisable"
+)
+
+var _ govtypes.Content = &SpecDisableProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalSpecDisable)
+}
+
+func NewSpecDisableProposal(title, description string, chainIDs []string) *SpecDisableProposal {
+	return &SpecDisableProposal{title, description, chainIDs}
+}
+
+// GetTitle returns the title of a proposal.
+func (pcp *SpecDisableProposal) GetTitle() string { return pcp.Title }
+
+// GetDescription returns the description of a proposal.
+func (pcp *SpecDisableProposal) GetDescription() string { return pcp.Description }
+
+// ProposalRoute returns the routing key of a proposal.
+func (pcp *SpecDisableProposal) ProposalRoute() string { return ProposalsRouterKey }
+
+// ProposalType returns the type of a proposal.
+func (pcp *SpecDisableProposal) ProposalType() string { return ProposalSpecDisable }
+
+// ValidateBasic validates the proposal
+func (pcp *SpecDisableProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(pcp)
+	if err != nil {
+		return err
+	}
+
+	return checkChainIDs(pcp.ChainIds)
+}
+
+// String implements the Stringer interface.
+func (pcp SpecDisableProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Spec Disable Proposal:
+	  Title:       %s
+	  Description: %s
+	  Chain IDs:   %s
+	`, pcp.Title, pcp.Description, strings.Join(pcp.ChainIds, ", ")))
+
+	return b.String()
+}
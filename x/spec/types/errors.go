@@ -20,4 +20,6 @@ var (
 	ErrSpecNotFound      = sdkerrors.Register(ModuleName, 7, "spec not found")
 	ErrDuplicateSpecName = sdkerrors.Register(ModuleName, 8, "spec name is not unique")
 	ErrChainNameNotFound = sdkerrors.Register(ModuleName, 9, "chain name not found")
+	ErrInvalidImport     = sdkerrors.Register(ModuleName, 10, "invalid spec import")
+	ErrEmptyChainIDs     = sdkerrors.Register(ModuleName, 11, "chain ID list is empty")
 )
@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: spec/fixated_spec.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FixatedSpec is a snapshot of a spec (with its imports expanded) taken on the epoch start it became active
+type FixatedSpec struct {
+	Spec          Spec   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec"`
+	FixationBlock uint64 `protobuf:"varint,2,opt,name=fixation_block,json=fixationBlock,proto3" json:"fixation_block,omitempty"`
+	// removed marks that the spec was removed on the fixation block
+	Removed bool `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *FixatedSpec) Reset()         { *m = FixatedSpec{} }
+func (m *FixatedSpec) String() string { return proto.CompactTextString(m) }
+func (*FixatedSpec) ProtoMessage()    {}
+func (*FixatedSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1fd011bb14ffff9, []int{0}
+}
+func (m *FixatedSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixatedSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixatedSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixatedSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixatedSpec.Merge(m, src)
+}
+func (m *FixatedSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixatedSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixatedSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixatedSpec proto.InternalMessageInfo
+
+func (m *FixatedSpec) GetSpec() Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return Spec{}
+}
+
+func (m *FixatedSpec) GetFixationBlock() uint64 {
+	if m != nil {
+		return m.FixationBlock
+	}
+	return 0
+}
+
+func (m *FixatedSpec) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*FixatedSpec)(nil), "lavanet.lava.spec.FixatedSpec")
+}
+
+func init() { proto.RegisterFile("spec/fixated_spec.proto", fileDescriptor_d1fd011bb14ffff9) }
+
+var fileDescriptor_d1fd011bb14ffff9 = []byte{
+	// 230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0x2e, 0x48, 0x4d,
+	0xd6, 0x4f, 0xcb, 0xac, 0x48, 0x2c, 0x49, 0x4d, 0x89, 0x07, 0x71, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
+	0xf2, 0x85, 0x04, 0x73, 0x12, 0xcb, 0x12, 0xf3, 0x52, 0x4b, 0xf4, 0x40, 0xb4, 0x1e, 0x48, 0x42,
+	0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xab, 0x0f, 0x62, 0x41, 0x14, 0x4a, 0xf1, 0x83, 0x4d,
+	0x40, 0xe8, 0x54, 0x6a, 0x64, 0xe4, 0xe2, 0x76, 0x83, 0x18, 0x18, 0x5c, 0x90, 0x9a, 0x2c, 0x64,
+	0xc8, 0xc5, 0x02, 0x92, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd7, 0xc3, 0x30, 0x58,
+	0x0f, 0xa4, 0xcc, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xb0, 0x52, 0x21, 0x55, 0x2e, 0x3e,
+	0xb0, 0x93, 0x32, 0xf3, 0xf3, 0xe2, 0x93, 0x72, 0xf2, 0x93, 0xb3, 0x25, 0x98, 0x14, 0x18, 0x35,
+	0x58, 0x82, 0x78, 0x61, 0xa2, 0x4e, 0x20, 0x41, 0x21, 0x09, 0x2e, 0xf6, 0xa2, 0xd4, 0xdc, 0xfc,
+	0xb2, 0xd4, 0x14, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x18, 0xd7, 0xc9, 0x69, 0xc5, 0x23,
+	0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
+	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x49, 0xcf, 0x2c,
+	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xba, 0x06, 0x4c, 0xeb, 0x57, 0x80, 0xfd,
+	0xa1, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x8e, 0x31, 0x60, 0x00, 0xf0, 0x94,
+	0x75, 0x05, 0x23, 0x01, 0x00, 0x00,
+}
+
+func (this *FixatedSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FixatedSpec)
+	if !ok {
+		that2, ok := that.(FixatedSpec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Spec.Equal(&that1.Spec) {
+		return false
+	}
+	if this.FixationBlock != that1.FixationBlock {
+		return false
+	}
+	if this.Removed != that1.Removed {
+		return false
+	}
+	return true
+}
+func (m *FixatedSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixatedSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixatedSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FixationBlock != 0 {
+		i = encodeVarintFixatedSpec(dAtA, i, uint64(m.FixationBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFixatedSpec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFixatedSpec(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFixatedSpec(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FixatedSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovFixatedSpec(uint64(l))
+	if m.FixationBlock != 0 {
+		n += 1 + sovFixatedSpec(uint64(m.FixationBlock))
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func sovFixatedSpec(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFixatedSpec(x uint64) (n int) {
+	return sovFixatedSpec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FixatedSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFixatedSpec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixatedSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixatedSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixatedSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFixatedSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixatedSpec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixationBlock", wireType)
+			}
+			m.FixationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixatedSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixatedSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFixatedSpec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFixatedSpec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFixatedSpec(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFixatedSpec
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFixatedSpec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFixatedSpec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFixatedSpec
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFixatedSpec
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFixatedSpec
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFixatedSpec        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFixatedSpec          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFixatedSpec = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,7 +10,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		SpecList: []Spec{},
+		SpecList:        []Spec{},
+		FixatedSpecList: []FixatedSpec{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -33,6 +34,16 @@ func (gs GenesisState) Validate() error {
 	if gs.SpecCount != uint64(len(gs.SpecList)) {
 		return fmt.Errorf("Spec count mismatch spec list")
 	}
+	// Check for duplicated index in fixatedSpec
+	fixatedSpecIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FixatedSpecList {
+		index := string(append(SpecKey(elem.Spec.Index), FixatedSpecKey(elem.FixationBlock)...))
+		if _, ok := fixatedSpecIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for fixatedSpec")
+		}
+		fixatedSpecIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
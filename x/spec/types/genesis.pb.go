@@ -25,9 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the spec module's genesis state.
 type GenesisState struct {
-	Params    Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SpecList  []Spec `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
-	SpecCount uint64 `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	Params          Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SpecList        []Spec        `protobuf:"bytes,2,rep,name=specList,proto3" json:"specList"`
+	SpecCount       uint64        `protobuf:"varint,3,opt,name=specCount,proto3" json:"specCount,omitempty"`
+	FixatedSpecList []FixatedSpec `protobuf:"bytes,4,rep,name=fixatedSpecList,proto3" json:"fixatedSpecList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetSpecCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFixatedSpecList() []FixatedSpec {
+	if m != nil {
+		return m.FixatedSpecList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.spec.GenesisState")
 }
@@ -91,23 +99,25 @@ func init() {
 func init() { proto.RegisterFile("spec/genesis.proto", fileDescriptor_112148ec366411eb) }
 
 var fileDescriptor_112148ec366411eb = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
+	// 274 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x2e, 0x48, 0x4d,
 	0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0xcc, 0x49, 0x2c, 0x4b, 0xcc, 0x4b, 0x2d, 0xd1, 0x03, 0xd1, 0x7a, 0x20, 0x05, 0x52, 0x22, 0xe9,
 	0xf9, 0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50, 0x4a, 0x10, 0xac, 0xb9, 0x20, 0xb1,
-	0x28, 0x31, 0x17, 0xaa, 0x57, 0x8a, 0x1f, 0x2c, 0x04, 0x22, 0x20, 0x02, 0x4a, 0x0b, 0x18, 0xb9,
-	0x78, 0xdc, 0x21, 0xc6, 0x07, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x73, 0xb1, 0x41, 0x74, 0x48,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xea, 0x61, 0x58, 0xa7, 0x17, 0x00, 0x56, 0xe0, 0xc4,
-	0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb9, 0x90, 0x25, 0x17, 0x07, 0x48, 0xd2, 0x27, 0xb3,
-	0xb8, 0x44, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1c, 0x8b, 0xd6, 0xe0, 0x82, 0xd4, 0x64,
-	0xa8, 0x46, 0xb8, 0x72, 0x21, 0x19, 0x2e, 0x4e, 0x10, 0xdb, 0x39, 0xbf, 0x34, 0xaf, 0x44, 0x82,
-	0x59, 0x81, 0x51, 0x83, 0x25, 0x08, 0x21, 0xe0, 0x64, 0x77, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0x50, 0xab, 0xc0, 0xb4, 0x7e, 0x05, 0xd8, 0x8b, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0x60, 0x9f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x61, 0x87, 0x72, 0x4c, 0x01, 0x00,
-	0x00,
+	0x28, 0x31, 0x17, 0xaa, 0x57, 0x8a, 0x1f, 0x2c, 0x04, 0x22, 0xa0, 0x02, 0xe2, 0x60, 0x81, 0xb4,
+	0xcc, 0x8a, 0xc4, 0x92, 0xd4, 0x94, 0x78, 0x84, 0x84, 0xd2, 0x07, 0x46, 0x2e, 0x1e, 0x77, 0x88,
+	0xbd, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xe6, 0x5c, 0x6c, 0x10, 0xa3, 0x24, 0x18, 0x15, 0x18,
+	0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x30, 0xdc, 0xa1, 0x17, 0x00, 0x56, 0xe0, 0xc4, 0x72, 0xe2, 0x9e,
+	0x3c, 0x43, 0x10, 0x54, 0xb9, 0x90, 0x25, 0x17, 0x07, 0x48, 0xd2, 0x27, 0xb3, 0xb8, 0x44, 0x82,
+	0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1c, 0x8b, 0xd6, 0xe0, 0x82, 0xd4, 0x64, 0xa8, 0x46, 0xb8,
+	0x72, 0x21, 0x19, 0x2e, 0x4e, 0x10, 0xdb, 0x39, 0xbf, 0x34, 0xaf, 0x44, 0x82, 0x59, 0x81, 0x51,
+	0x83, 0x25, 0x08, 0x21, 0x20, 0xe4, 0xc7, 0xc5, 0x0f, 0x75, 0x78, 0x30, 0xcc, 0x7c, 0x16, 0xb0,
+	0xf9, 0x72, 0x58, 0xcc, 0x77, 0x43, 0xa8, 0x84, 0x5a, 0x83, 0xae, 0xd9, 0xc9, 0xee, 0xc4, 0x23,
+	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
+	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x54, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
+	0x92, 0xf3, 0x73, 0xf5, 0xa1, 0x46, 0x83, 0x69, 0xfd, 0x0a, 0x70, 0x58, 0xea, 0x97, 0x54, 0x16,
+	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43, 0xce, 0x18, 0x30, 0x00, 0xaf, 0x96, 0xe9, 0x02, 0xb5, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +140,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FixatedSpecList) > 0 {
+		for iNdEx := len(m.FixatedSpecList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixatedSpecList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.SpecCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpecCount))
 		i--
@@ -190,6 +214,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpecCount != 0 {
 		n += 1 + sovGenesis(uint64(m.SpecCount))
 	}
+	if len(m.FixatedSpecList) > 0 {
+		for _, e := range m.FixatedSpecList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,6 +344,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixatedSpecList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixatedSpecList = append(m.FixatedSpecList, FixatedSpec{})
+			if err := m.FixatedSpecList[len(m.FixatedSpecList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
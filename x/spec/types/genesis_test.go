@@ -31,6 +31,16 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				SpecCount: 2,
+				FixatedSpecList: []types.FixatedSpec{
+					{
+						Spec:          types.Spec{Index: "0"},
+						FixationBlock: 0,
+					},
+					{
+						Spec:          types.Spec{Index: "0"},
+						FixationBlock: 20,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -63,6 +73,23 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated fixatedSpec",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FixatedSpecList: []types.FixatedSpec{
+					{
+						Spec:          types.Spec{Index: "0"},
+						FixationBlock: 20,
+					},
+					{
+						Spec:          types.Spec{Index: "0"},
+						FixationBlock: 20,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,18 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// FixatedSpecKeyPrefix is the prefix to retrieve all FixatedSpec
+	FixatedSpecKeyPrefix = "FixatedSpec/value/"
+)
+
+// FixatedSpecKey returns the store key to retrieve a FixatedSpec from the index fields, the key of a spec index
+// is prefixed with SpecKey(index) and ordered by the fixation block so it can be iterated by block
+func FixatedSpecKey(
+	fixationBlock uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, fixationBlock)
+	return key
+}
@@ -14,12 +14,30 @@ func checkSpecProposal(spec Spec) error {
 	if len(strings.TrimSpace(spec.Index)) == 0 {
 		return sdkerrors.Wrap(ErrBlankSpecName, "spec index cannot be blank")
 	}
-	if len(spec.Apis) == 0 {
-		return sdkerrors.Wrap(ErrEmptyApis, "api list cannot be empty")
+	if len(spec.Apis) == 0 && len(spec.Imports) == 0 {
+		return sdkerrors.Wrap(ErrEmptyApis, "api list cannot be empty without imports")
 	}
 
+	checkUniqueImports := map[string]bool{}
+	for _, importIndex := range spec.Imports {
+		if len(strings.TrimSpace(importIndex)) == 0 {
+			return sdkerrors.Wrap(ErrInvalidImport, "import index cannot be blank")
+		}
+		if importIndex == spec.Index {
+			return sdkerrors.Wrap(ErrInvalidImport, fmt.Sprintf("spec %s can't import itself", spec.Index))
+		}
+		if checkUniqueImports[importIndex] {
+			return sdkerrors.Wrap(ErrInvalidImport, fmt.Sprintf("import must be unique: %s", importIndex))
+		}
+		checkUniqueImports[importIndex] = true
+	}
+
+	return checkServiceApis(spec.Name, spec.Apis)
+}
+
+func checkServiceApis(specName string, apis []ServiceApi) error {
 	checkUnique := map[string]bool{}
-	for i, api := range spec.Apis {
+	for i, api := range apis {
 		if len(strings.TrimSpace(api.Name)) == 0 {
 			return sdkerrors.Wrap(ErrBlankApiName, "api name cannot be blank")
 		}
@@ -27,17 +45,34 @@ func checkSpecProposal(spec Spec) error {
 			return sdkerrors.Wrap(ErrDuplicateApiName, fmt.Sprintf("api name must be unique: %s", api.Name))
 		}
 		if len(api.ApiInterfaces) == 0 {
-			return sdkerrors.Wrap(ErrBlankApiName, fmt.Sprintf("api interface cannot be empty at spec:%s, api %d", spec.Name, i))
+			return sdkerrors.Wrap(ErrBlankApiName, fmt.Sprintf("api interface cannot be empty at spec:%s, api %d", specName, i))
 		}
 		checkUnique[api.Name] = true
 	}
 	return nil
 }
 
+func checkChainIDs(chainIDs []string) error {
+	if len(chainIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmptyChainIDs, "proposal chain IDs cannot be empty")
+	}
+	checkUnique := map[string]bool{}
+	for _, chainID := range chainIDs {
+		if len(strings.TrimSpace(chainID)) == 0 {
+			return sdkerrors.Wrap(ErrBlankSpecName, "spec index cannot be blank")
+		}
+		if checkUnique[chainID] {
+			return sdkerrors.Wrap(ErrDuplicateSpecName, fmt.Sprintf("chain ID must be unique: %s", chainID))
+		}
+		checkUnique[chainID] = true
+	}
+	return nil
+}
+
 func stringSpec(spec Spec, b strings.Builder) strings.Builder {
 	b.WriteString(fmt.Sprintf(`    Spec name:
-	Name: %s, Spec index: %s, Enabled: %t, Apis: %d
-`, spec.Name, spec.Index, spec.Enabled, len(spec.Apis)))
+	Name: %s, Spec index: %s, Enabled: %t, Apis: %d, Imports: %v
+`, spec.Name, spec.Index, spec.Enabled, len(spec.Apis), spec.Imports))
 
 	for _, api := range spec.Apis {
 		b.WriteString(fmt.Sprintf(`        Api:
@@ -0,0 +1,57 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalSpecRemove = "SpecRemove"
+)
+
+var _ govtypes.Content = &SpecRemoveProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalSpecRemove)
+}
+
+func NewSpecRemoveProposal(title, description string, chainIDs []string) *SpecRemoveProposal {
+	return &SpecRemoveProposal{title, description, chainIDs}
+}
+
+// GetTitle returns the title of a proposal.
+func (pcp *SpecRemoveProposal) GetTitle() string { return pcp.Title }
+
+// GetDescription returns the description of a proposal.
+func (pcp *SpecRemoveProposal) GetDescription() string { return pcp.Description }
+
+// ProposalRoute returns the routing key of a proposal.
+func (pcp *SpecRemoveProposal) ProposalRoute() string { return ProposalsRouterKey }
+
+// ProposalType returns the type of a proposal.
+func (pcp *SpecRemoveProposal) ProposalType() string { return ProposalSpecRemove }
+
+// ValidateBasic validates the proposal
+func (pcp *SpecRemoveProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(pcp)
+	if err != nil {
+		return err
+	}
+
+	return checkChainIDs(pcp.ChainIds)
+}
+
+// String implements the Stringer interface.
+func (pcp SpecRemoveProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Spec Remove Proposal:
+	  Title:       %s
+	  Description: %s
+	  Chain IDs:   %s
+	`, pcp.Title, pcp.Description, strings.Join(pcp.ChainIds, ", ")))
+
+	return b.String()
+}
@@ -64,6 +64,8 @@ type Spec struct {
 	MinStakeProvider              types.Coin          `protobuf:"bytes,12,opt,name=min_stake_provider,json=minStakeProvider,proto3" json:"min_stake_provider"`
 	MinStakeClient                types.Coin          `protobuf:"bytes,13,opt,name=min_stake_client,json=minStakeClient,proto3" json:"min_stake_client"`
 	ProvidersTypes                Spec_ProvidersTypes `protobuf:"varint,14,opt,name=providers_types,json=providersTypes,proto3,enum=lavanet.lava.spec.Spec_ProvidersTypes" json:"providers_types,omitempty"`
+	Imports                       []string            `protobuf:"bytes,15,rep,name=imports,proto3" json:"imports,omitempty"`
+	QosPairing                    bool                `protobuf:"varint,16,opt,name=qos_pairing,json=qosPairing,proto3" json:"qos_pairing,omitempty"`
 }
 
 func (m *Spec) Reset()         { *m = Spec{} }
@@ -197,6 +199,20 @@ func (m *Spec) GetProvidersTypes() Spec_ProvidersTypes {
 	return Spec_dynamic
 }
 
+func (m *Spec) GetImports() []string {
+	if m != nil {
+		return m.Imports
+	}
+	return nil
+}
+
+func (m *Spec) GetQosPairing() bool {
+	if m != nil {
+		return m.QosPairing
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("lavanet.lava.spec.Spec_ProvidersTypes", Spec_ProvidersTypes_name, Spec_ProvidersTypes_value)
 	proto.RegisterType((*Spec)(nil), "lavanet.lava.spec.Spec")
@@ -205,46 +221,48 @@ func init() {
 func init() { proto.RegisterFile("spec/spec.proto", fileDescriptor_c4cc771ffab81d0a) }
 
 var fileDescriptor_c4cc771ffab81d0a = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x4f, 0x14, 0x3f,
-	0x1c, 0xc6, 0xb7, 0x3f, 0x96, 0x05, 0xba, 0x3f, 0x96, 0xb5, 0x41, 0x52, 0x88, 0x8c, 0x23, 0x31,
-	0x66, 0x4c, 0xcc, 0x4c, 0x80, 0x83, 0xde, 0x0c, 0x0b, 0x6e, 0x24, 0xd1, 0x88, 0xb3, 0x78, 0xf1,
-	0xd2, 0x74, 0x3a, 0x65, 0x69, 0x98, 0x69, 0xc7, 0x69, 0x59, 0x59, 0x5f, 0x85, 0x2f, 0xc3, 0xb3,
-	0xaf, 0x82, 0x23, 0x47, 0x4f, 0xc6, 0x2c, 0x6f, 0xc4, 0xb4, 0x33, 0x13, 0x20, 0x7a, 0xf0, 0xd2,
-	0x7f, 0xcf, 0xe7, 0xf9, 0xf6, 0x99, 0xcc, 0xb7, 0x70, 0x45, 0x17, 0x9c, 0x45, 0x76, 0x08, 0x8b,
-	0x52, 0x19, 0x85, 0xee, 0x65, 0x74, 0x42, 0x25, 0x37, 0xa1, 0x9d, 0x43, 0x2b, 0x6c, 0xac, 0x8e,
-	0xd5, 0x58, 0x39, 0x35, 0xb2, 0xab, 0x0a, 0xdc, 0x58, 0xab, 0x9c, 0xbc, 0x9c, 0x08, 0xc6, 0x09,
-	0x2d, 0x44, 0x7d, 0xee, 0x31, 0xa5, 0x73, 0xa5, 0xa3, 0x84, 0x6a, 0x1e, 0x4d, 0xb6, 0x13, 0x6e,
-	0xe8, 0x76, 0xc4, 0x94, 0x90, 0x95, 0xbe, 0xf5, 0xbd, 0x03, 0xdb, 0xa3, 0x82, 0x33, 0xb4, 0x0a,
-	0xe7, 0x85, 0x4c, 0xf9, 0x05, 0x06, 0x3e, 0x08, 0x96, 0xe2, 0x6a, 0x83, 0x10, 0x6c, 0x4b, 0x9a,
-	0x73, 0xfc, 0x9f, 0x3b, 0x74, 0x6b, 0xf4, 0x1c, 0xb6, 0x69, 0x21, 0x34, 0x9e, 0xf3, 0xe7, 0x82,
-	0xee, 0xce, 0x66, 0xf8, 0x47, 0xc4, 0x70, 0x54, 0xc5, 0xd8, 0x2b, 0xc4, 0xa0, 0x7d, 0xf9, 0xf3,
-	0x61, 0x2b, 0x76, 0x06, 0x84, 0xe1, 0x02, 0x97, 0x34, 0xc9, 0x78, 0x8a, 0xdb, 0x3e, 0x08, 0x16,
-	0xe3, 0x66, 0x8b, 0x76, 0xe1, 0xfd, 0x92, 0x67, 0x82, 0x26, 0x22, 0x13, 0x66, 0x4a, 0xcc, 0x69,
-	0xc9, 0xf5, 0xa9, 0xca, 0x52, 0x3c, 0xef, 0x83, 0x60, 0x39, 0x5e, 0xbd, 0x25, 0x1e, 0x37, 0x1a,
-	0x7a, 0x01, 0x71, 0x4a, 0x0d, 0x25, 0xb7, 0x9d, 0x4d, 0xfd, 0x8e, 0xab, 0xbf, 0x66, 0xf5, 0xf8,
-	0x46, 0x7e, 0x55, 0x5f, 0xf7, 0x1a, 0x3e, 0x4a, 0x32, 0xc5, 0xce, 0x48, 0x2a, 0xb4, 0xa1, 0x92,
-	0x71, 0x72, 0xa2, 0x4a, 0x72, 0x22, 0x24, 0xcd, 0xc4, 0x17, 0x9e, 0x12, 0x6b, 0xc3, 0x0b, 0xee,
-	0xea, 0x4d, 0x07, 0x1e, 0xd4, 0xdc, 0x50, 0x95, 0xc3, 0x86, 0x3a, 0xa0, 0x86, 0xa2, 0x97, 0xf0,
-	0x81, 0x03, 0x34, 0x11, 0xb2, 0x29, 0x40, 0x8d, 0x50, 0x92, 0x14, 0xa5, 0x52, 0x27, 0x78, 0xd1,
-	0x15, 0x59, 0xaf, 0x98, 0x43, 0x39, 0xbc, 0x45, 0x1c, 0x59, 0x00, 0x3d, 0x83, 0x88, 0x4e, 0x78,
-	0x49, 0xc7, 0x9c, 0x54, 0x91, 0x8c, 0xc8, 0x39, 0x5e, 0xf2, 0x41, 0x30, 0x17, 0xf7, 0x6b, 0x65,
-	0x60, 0x85, 0x63, 0x91, 0x73, 0xb4, 0x07, 0x3d, 0x9a, 0x65, 0xea, 0x33, 0x4f, 0x6b, 0x3a, 0xa3,
-	0x63, 0x97, 0xfd, 0x93, 0xd2, 0x44, 0x4f, 0x25, 0xc3, 0xd0, 0x39, 0xd7, 0x6b, 0xca, 0x39, 0xdf,
-	0xd0, 0xf1, 0x50, 0x95, 0xef, 0x95, 0x1e, 0x4d, 0x25, 0xb3, 0x17, 0x36, 0x56, 0x6d, 0xc8, 0x79,
-	0x91, 0x52, 0xc3, 0x53, 0xdc, 0xf5, 0x41, 0xd0, 0x8e, 0xfb, 0x49, 0xc5, 0x6b, 0xf3, 0xa1, 0x3a,
-	0x47, 0x6f, 0x21, 0xca, 0x85, 0x24, 0xda, 0xd0, 0x33, 0x6e, 0x3f, 0x69, 0x22, 0x52, 0x5e, 0xe2,
-	0xff, 0x7d, 0x10, 0x74, 0x77, 0xd6, 0xc3, 0xaa, 0xb7, 0x42, 0xdb, 0x5b, 0x61, 0xdd, 0x5b, 0xe1,
-	0xbe, 0x12, 0xb2, 0xfe, 0xeb, 0xfd, 0x5c, 0xc8, 0x91, 0x75, 0x1e, 0xd5, 0x46, 0x74, 0x08, 0xfb,
-	0x37, 0xe5, 0x58, 0x26, 0xb8, 0x34, 0x78, 0xf9, 0xdf, 0x8a, 0xf5, 0x9a, 0x62, 0xfb, 0xce, 0x86,
-	0xde, 0xc1, 0x95, 0x26, 0x8f, 0x26, 0x66, 0x5a, 0x70, 0x8d, 0x7b, 0x3e, 0x08, 0x7a, 0x3b, 0x4f,
-	0xfe, 0xd6, 0x90, 0x76, 0x68, 0x52, 0xe8, 0x63, 0x4b, 0xc7, 0xbd, 0xe2, 0xce, 0x7e, 0xeb, 0x29,
-	0xec, 0xdd, 0x25, 0x50, 0x17, 0x2e, 0xa4, 0x53, 0x49, 0x73, 0xc1, 0xfa, 0x2d, 0x04, 0x61, 0x47,
-	0x1b, 0x6a, 0x04, 0xeb, 0x83, 0xc1, 0xe0, 0xdb, 0xcc, 0x03, 0x97, 0x33, 0x0f, 0x5c, 0xcd, 0x3c,
-	0xf0, 0x6b, 0xe6, 0x81, 0xaf, 0xd7, 0x5e, 0xeb, 0xea, 0xda, 0x6b, 0xfd, 0xb8, 0xf6, 0x5a, 0x1f,
-	0x1f, 0x8f, 0x85, 0x39, 0x3d, 0x4f, 0x42, 0xa6, 0xf2, 0xa8, 0x8e, 0xe2, 0xe6, 0xe8, 0xc2, 0xbd,
-	0xec, 0xc8, 0x85, 0x4d, 0x3a, 0xee, 0xfd, 0xed, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x06,
-	0xfc, 0xcc, 0xf3, 0x03, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x14, 0xdf, 0x7e, 0x77, 0x59, 0x60, 0xf6, 0xcb, 0xb2, 0x4e, 0x90, 0x0c, 0x44, 0x4a, 0x25, 0xc6,
+	0xd4, 0xc4, 0xb4, 0x01, 0x0e, 0x7a, 0x33, 0x2c, 0xb8, 0x91, 0x44, 0x23, 0x76, 0xf1, 0xe2, 0xa5,
+	0x99, 0xb6, 0x43, 0x79, 0xa1, 0x9d, 0x29, 0x9d, 0x61, 0x65, 0xfd, 0x2b, 0xfc, 0x33, 0xfc, 0x53,
+	0x38, 0x72, 0xf4, 0x64, 0xcc, 0x72, 0xf6, 0x7f, 0x30, 0x33, 0x6d, 0x03, 0x44, 0x0f, 0x5e, 0x66,
+	0xe6, 0xbd, 0xcf, 0x8f, 0x79, 0xaf, 0x7d, 0x2d, 0x5a, 0x96, 0x05, 0x8b, 0x7d, 0xbd, 0x78, 0x45,
+	0x29, 0x94, 0xc0, 0x0f, 0x32, 0x3a, 0xa1, 0x9c, 0x29, 0x4f, 0xef, 0x9e, 0x06, 0xd6, 0x57, 0x52,
+	0x91, 0x0a, 0x83, 0xfa, 0xfa, 0x54, 0x11, 0xd7, 0x57, 0x2b, 0x25, 0x2b, 0x27, 0x10, 0xb3, 0x90,
+	0x16, 0x50, 0xe7, 0xed, 0x58, 0xc8, 0x5c, 0x48, 0x3f, 0xa2, 0x92, 0xf9, 0x93, 0xed, 0x88, 0x29,
+	0xba, 0xed, 0xc7, 0x02, 0x78, 0x85, 0x6f, 0xfd, 0xea, 0xa2, 0xce, 0xb8, 0x60, 0x31, 0x5e, 0x41,
+	0x73, 0xc0, 0x13, 0x76, 0x49, 0x2c, 0xc7, 0x72, 0x17, 0x83, 0x2a, 0xc0, 0x18, 0x75, 0x38, 0xcd,
+	0x19, 0xf9, 0xcf, 0x24, 0xcd, 0x19, 0xbf, 0x40, 0x1d, 0x5a, 0x80, 0x24, 0x6d, 0xa7, 0xed, 0xf6,
+	0x76, 0x36, 0xbc, 0x3f, 0x4a, 0xf4, 0xc6, 0x55, 0x19, 0x7b, 0x05, 0x0c, 0x3b, 0x57, 0x3f, 0x36,
+	0x5b, 0x81, 0x11, 0x60, 0x82, 0xe6, 0x19, 0xa7, 0x51, 0xc6, 0x12, 0xd2, 0x71, 0x2c, 0x77, 0x21,
+	0x68, 0x42, 0xbc, 0x8b, 0x1e, 0x96, 0x2c, 0x03, 0x1a, 0x41, 0x06, 0x6a, 0x1a, 0xaa, 0xd3, 0x92,
+	0xc9, 0x53, 0x91, 0x25, 0x64, 0xce, 0xb1, 0xdc, 0xa5, 0x60, 0xe5, 0x0e, 0x78, 0xdc, 0x60, 0xf8,
+	0x25, 0x22, 0x09, 0x55, 0x34, 0xbc, 0xab, 0x6c, 0xfc, 0xbb, 0xc6, 0x7f, 0x55, 0xe3, 0xc1, 0x2d,
+	0xfc, 0xba, 0xbe, 0xee, 0x0d, 0x7a, 0x1c, 0x65, 0x22, 0x3e, 0x0b, 0x13, 0x90, 0x8a, 0xf2, 0x98,
+	0x85, 0x27, 0xa2, 0x0c, 0x4f, 0x80, 0xd3, 0x0c, 0xbe, 0xb0, 0x24, 0xd4, 0x32, 0x32, 0x6f, 0xae,
+	0xde, 0x30, 0xc4, 0x83, 0x9a, 0x37, 0x12, 0xe5, 0xa8, 0x61, 0x1d, 0x50, 0x45, 0xf1, 0x2b, 0xf4,
+	0xc8, 0x10, 0x64, 0x08, 0xbc, 0x31, 0xa0, 0x0a, 0x04, 0x0f, 0x8b, 0x52, 0x88, 0x13, 0xb2, 0x60,
+	0x4c, 0xd6, 0x2a, 0xce, 0x21, 0x1f, 0xdd, 0x61, 0x1c, 0x69, 0x02, 0x7e, 0x8e, 0x30, 0x9d, 0xb0,
+	0x92, 0xa6, 0x2c, 0xac, 0x4a, 0x52, 0x90, 0x33, 0xb2, 0xe8, 0x58, 0x6e, 0x3b, 0x18, 0xd4, 0xc8,
+	0x50, 0x03, 0xc7, 0x90, 0x33, 0xbc, 0x87, 0x6c, 0x9a, 0x65, 0xe2, 0x33, 0x4b, 0x6a, 0x76, 0x46,
+	0x53, 0x53, 0xfb, 0xb9, 0x90, 0xa1, 0x9c, 0xf2, 0x98, 0x20, 0xa3, 0x5c, 0xab, 0x59, 0x46, 0xf9,
+	0x96, 0xa6, 0x23, 0x51, 0x7e, 0x10, 0x72, 0x3c, 0xe5, 0xb1, 0xbe, 0xb0, 0x91, 0x4a, 0x15, 0x5e,
+	0x14, 0x09, 0x55, 0x2c, 0x21, 0x3d, 0xc7, 0x72, 0x3b, 0xc1, 0x20, 0xaa, 0xf8, 0x52, 0x7d, 0xac,
+	0xf2, 0xf8, 0x1d, 0xc2, 0x39, 0xf0, 0x50, 0x2a, 0x7a, 0xc6, 0x74, 0x4b, 0x13, 0x48, 0x58, 0x49,
+	0xfe, 0x77, 0x2c, 0xb7, 0xb7, 0xb3, 0xe6, 0x55, 0xb3, 0xe5, 0xe9, 0xd9, 0xf2, 0xea, 0xd9, 0xf2,
+	0xf6, 0x05, 0xf0, 0xfa, 0xad, 0x0f, 0x72, 0xe0, 0x63, 0xad, 0x3c, 0xaa, 0x85, 0xf8, 0x10, 0x0d,
+	0x6e, 0xed, 0xe2, 0x0c, 0x18, 0x57, 0x64, 0xe9, 0xdf, 0xcc, 0xfa, 0x8d, 0xd9, 0xbe, 0x91, 0xe1,
+	0xf7, 0x68, 0xb9, 0xa9, 0x47, 0x86, 0x6a, 0x5a, 0x30, 0x49, 0xfa, 0x8e, 0xe5, 0xf6, 0x77, 0x9e,
+	0xfe, 0x6d, 0x20, 0xf5, 0xd2, 0x54, 0x21, 0x8f, 0x35, 0x3b, 0xe8, 0x17, 0xf7, 0x62, 0x3d, 0x9d,
+	0x90, 0x17, 0xa2, 0x54, 0x92, 0x2c, 0x3b, 0x6d, 0x77, 0x31, 0x68, 0x42, 0xbc, 0x89, 0x7a, 0xfa,
+	0xf9, 0x16, 0x14, 0x4a, 0xe0, 0x29, 0x19, 0x98, 0xd9, 0x42, 0xe7, 0x42, 0x1e, 0x55, 0x99, 0xad,
+	0x67, 0xa8, 0x7f, 0xdf, 0x1c, 0xf7, 0xd0, 0x7c, 0x32, 0xe5, 0x34, 0x87, 0x78, 0xd0, 0xc2, 0x08,
+	0x75, 0xa5, 0xa2, 0x0a, 0xe2, 0x81, 0x35, 0x1c, 0x7e, 0x9b, 0xd9, 0xd6, 0xd5, 0xcc, 0xb6, 0xae,
+	0x67, 0xb6, 0xf5, 0x73, 0x66, 0x5b, 0x5f, 0x6f, 0xec, 0xd6, 0xf5, 0x8d, 0xdd, 0xfa, 0x7e, 0x63,
+	0xb7, 0x3e, 0x3d, 0x49, 0x41, 0x9d, 0x5e, 0x44, 0x5e, 0x2c, 0x72, 0xbf, 0xee, 0xc2, 0xec, 0xfe,
+	0xa5, 0xf9, 0x29, 0xf8, 0xa6, 0xcf, 0xa8, 0x6b, 0x3e, 0xdd, 0xdd, 0xdf, 0x03, 0x00, 0x87, 0x8e,
+	0xe5, 0x89, 0x2e, 0x04, 0x00, 0x00,
 }
 
 func (this *Spec) Equal(that interface{}) bool {
@@ -313,6 +331,17 @@ func (this *Spec) Equal(that interface{}) bool {
 	if this.ProvidersTypes != that1.ProvidersTypes {
 		return false
 	}
+	if len(this.Imports) != len(that1.Imports) {
+		return false
+	}
+	for i := range this.Imports {
+		if this.Imports[i] != that1.Imports[i] {
+			return false
+		}
+	}
+	if this.QosPairing != that1.QosPairing {
+		return false
+	}
 	return true
 }
 func (m *Spec) Marshal() (dAtA []byte, err error) {
@@ -335,6 +364,27 @@ func (m *Spec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QosPairing {
+		i--
+		if m.QosPairing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Imports) > 0 {
+		for iNdEx := len(m.Imports) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Imports[iNdEx])
+			copy(dAtA[i:], m.Imports[iNdEx])
+			i = encodeVarintSpec(dAtA, i, uint64(len(m.Imports[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.ProvidersTypes != 0 {
 		i = encodeVarintSpec(dAtA, i, uint64(m.ProvidersTypes))
 		i--
@@ -503,6 +553,15 @@ func (m *Spec) Size() (n int) {
 	if m.ProvidersTypes != 0 {
 		n += 1 + sovSpec(uint64(m.ProvidersTypes))
 	}
+	if len(m.Imports) > 0 {
+		for _, s := range m.Imports {
+			l = len(s)
+			n += 1 + l + sovSpec(uint64(l))
+		}
+	}
+	if m.QosPairing {
+		n += 3
+	}
 	return n
 }
 
@@ -878,6 +937,58 @@ func (m *Spec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imports", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Imports = append(m.Imports, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QosPairing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QosPairing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpec(dAtA[iNdEx:])
@@ -13,9 +13,13 @@ const (
 )
 
 const (
-	ParamChangeEventName = "param_change"
-	SpecAddEventName     = "spec_add"
-	SpecModifyEventName  = "spec_modify"
+	ParamChangeEventName    = "param_change"
+	SpecAddEventName        = "spec_add"
+	SpecModifyEventName     = "spec_modify"
+	SpecFixatedEventName    = "spec_fixated"
+	SpecDisableEventName    = "spec_disable"
+	SpecApisModifyEventName = "spec_apis_modify"
+	SpecRemoveEventName     = "spec_remove"
 )
 
 const (